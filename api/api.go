@@ -3,9 +3,10 @@ package api
 import (
 	"net/http"
 
-	"github.com/PRPO-skupina-02/common/clients/auth/models"
 	"github.com/PRPO-skupina-02/common/middleware"
 	_ "github.com/PRPO-skupina-02/nakup/api/docs"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/seats"
 	"github.com/PRPO-skupina-02/nakup/services"
 	"github.com/gin-gonic/gin"
 	ut "github.com/go-playground/universal-translator"
@@ -26,7 +27,14 @@ import (
 //	@name						Authorization
 //	@description				Type "Bearer" followed by a space and JWT token.
 
-func Register(router *gin.Engine, db *gorm.DB, trans ut.Translator, timeSlotService services.TimeSlotService, authHost string) {
+//	@securityDefinitions.apikey	APIKeyAuth
+//	@in							header
+//	@name						X-API-Key
+//	@description				API key of a machine client such as a kiosk or partner.
+
+func Register(router *gin.Engine, db *gorm.DB, trans ut.Translator, timeSlotService services.TimeSlotService, userMiddleware gin.HandlerFunc, ticketPriceCents int, permissions Permissions, seatWatcher *seats.Watcher) {
+	scheduleResolver := services.NewScheduleResolver(timeSlotService)
+
 	// Healthcheck
 	router.GET("/healthcheck", healthcheck)
 
@@ -35,36 +43,118 @@ func Register(router *gin.Engine, db *gorm.DB, trans ut.Translator, timeSlotServ
 
 	// REST API
 	v1 := router.Group("/api/v1/nakup")
+	v1.Use(RequestIDMiddleware)
 	v1.Use(middleware.TransactionMiddleware(db))
 	v1.Use(middleware.TranslationMiddleware(trans))
 	v1.Use(middleware.ErrorMiddleware)
 	v1.Use(TimeSlotServiceMiddleware(timeSlotService))
-	v1.Use(middleware.UserMiddleware(authHost))
+	v1.Use(ScheduleResolverMiddleware(scheduleResolver))
+	v1.Use(TicketPriceMiddleware(ticketPriceCents))
+	v1.Use(AuthMiddleware(db, userMiddleware))
+	v1.Use(AuditMiddleware)
+	v1.Use(PermissionsMiddleware(permissions))
 
 	// Reservations
-	v1.POST("/reservations", ReservationsCreate)
-	v1.GET("/reservations/my", MyReservationsList)
+	v1.POST("/reservations", RequireScope(models.ScopeReservationsCreate), IdempotencyMiddleware, ReservationsCreate)
+	v1.GET("/reservations/my", RequireUser, MyReservationsList)
+	v1.GET("/reservations", RequirePermission(PermissionReservationList), TheaterScopeMiddleware, ReservationsList)
+	v1.GET("/reservations/export", RequirePermission(PermissionReservationExport), TheaterScopeMiddleware, ReservationsExport)
 
-	reservationsStaff := v1.Group("/reservations")
-	reservationsStaff.Use(middleware.RequireRole(models.ModelsUserRoleEmployee, models.ModelsUserRoleAdmin))
-	reservationsStaff.GET("", ReservationsList)
+	consistency := v1.Group("/reservations/consistency")
+	consistency.Use(RequirePermission(PermissionReservationReconcile))
+	consistency.GET("", ReservationsConsistencyCheck)
+	consistency.POST("/fix", IdempotencyMiddleware, ReservationsConsistencyFix)
 
-	reservations := v1.Group("/reservations/:reservationID")
-	reservations.Use(ReservationContextMiddleware)
-	reservations.Use(middleware.RequireRole(models.ModelsUserRoleEmployee, models.ModelsUserRoleAdmin))
-	reservations.GET("", ReservationsShow)
-	reservations.PUT("", ReservationsUpdate)
-	reservations.DELETE("", ReservationsDelete)
+	reservation := func(permission Permission) *gin.RouterGroup {
+		return v1.Group("/reservations/:reservationID", RequirePermission(permission), ReservationContextMiddleware, TheaterScopeMiddleware, RequireReservationTheater)
+	}
+	reservation(PermissionReservationView).GET("", ReservationsShow)
+	reservation(PermissionReservationUpdate).PUT("", ReservationsUpdate)
+	reservation(PermissionReservationUpdate).PATCH("", ReservationsPatch)
+	reservation(PermissionReservationDelete).DELETE("", ReservationsDelete)
+	reservation(PermissionAuditView).GET("/audit", ReservationAuditList)
+	v1.POST("/reservations/:reservationID/restore", RequirePermission(PermissionReservationRestore), DeletedReservationContextMiddleware, TheaterScopeMiddleware, RequireReservationTheater, IdempotencyMiddleware, ReservationsRestore)
 
 	// Purchases
-	purchases := v1.Group("/reservations/:reservationID/purchases")
-	purchases.Use(ReservationContextMiddleware)
-	purchases.Use(middleware.RequireRole(models.ModelsUserRoleEmployee, models.ModelsUserRoleAdmin))
-	purchases.GET("", PurchasesList)
-	purchases.GET("/:purchaseID", PurchasesShow)
-	purchases.POST("", PurchasesCreate)
-	purchases.PUT("/:purchaseID", PurchasesUpdate)
-	purchases.DELETE("/:purchaseID", PurchasesDelete)
+	reservation(PermissionPurchaseView).GET("/purchases", PurchasesList)
+	reservation(PermissionPurchaseView).GET("/purchases/:purchaseID", PurchasesShow)
+	reservation(PermissionPurchaseCreate).POST("/purchases", IdempotencyMiddleware, PurchasesCreate)
+	reservation(PermissionPurchaseUpdate).PUT("/purchases/:purchaseID", PurchasesUpdate)
+	reservation(PermissionPurchaseUpdate).PATCH("/purchases/:purchaseID", PurchasesPatch)
+	reservation(PermissionPurchaseDelete).DELETE("/purchases/:purchaseID", PurchasesDelete)
+	reservation(PermissionPurchaseRestore).POST("/purchases/:purchaseID/restore", IdempotencyMiddleware, PurchasesRestore)
+
+	v1.GET("/purchases/export", RequirePermission(PermissionPurchaseExport), TheaterScopeMiddleware, PurchasesExport)
+
+	// Reports
+	reports := v1.Group("/reports")
+	reports.Use(RequirePermission(PermissionReportView), TheaterScopeMiddleware)
+	reports.GET("/movies", ReportsMovies)
+	reports.GET("/occupancy", ReportsOccupancy)
+	reports.GET("/heatmap", ReportsHeatmap)
+
+	// Webhooks
+	webhooks := v1.Group("/webhooks")
+	webhooks.Use(RequirePermission(PermissionWebhookManage))
+	webhooks.GET("", WebhooksList)
+	webhooks.POST("", IdempotencyMiddleware, WebhooksCreate)
+
+	webhook := v1.Group("/webhooks/:webhookID")
+	webhook.Use(RequirePermission(PermissionWebhookManage))
+	webhook.Use(WebhookContextMiddleware)
+	webhook.GET("", WebhooksShow)
+	webhook.PUT("", WebhooksUpdate)
+	webhook.DELETE("", WebhooksDelete)
+	webhook.GET("/deliveries", WebhookDeliveriesList)
+	webhook.GET("/deliveries/:deliveryID", WebhookDeliveriesShow)
+	webhook.POST("/deliveries/:deliveryID/redeliver", IdempotencyMiddleware, WebhookDeliveriesRedeliver)
+
+	// Refunds
+	v1.GET("/refunds", RequirePermission(PermissionRefundView), TheaterScopeMiddleware, RefundsList)
+	v1.POST("/refunds/:refundID/complete", RequirePermission(PermissionRefundComplete), TheaterScopeMiddleware, IdempotencyMiddleware, RefundsComplete)
+
+	// Staff
+	staff := v1.Group("/staff/:userID")
+	staff.Use(RequirePermission(PermissionStaffManage))
+	staff.GET("/theaters", StaffTheatersShow)
+	staff.PUT("/theaters", StaffTheatersUpdate)
+
+	// Seats
+	v1.GET("/timeslots/:timeSlotID/seats", RequireScope(models.ScopeSeatMapRead), SeatMapShow)
+	v1.POST("/timeslots/:timeSlotID/seats/stream/tickets", RequireScope(models.ScopeSeatMapRead), SeatMapStreamTicketsCreate)
+	v1.POST("/timeslots/:timeSlotID/holds", RequireScope(models.ScopeReservationsCreate), IdempotencyMiddleware, SeatHoldsCreate)
+	v1.DELETE("/timeslots/:timeSlotID/holds/:holdID", RequireScope(models.ScopeReservationsCreate), SeatHoldsDelete)
+
+	// API keys
+	apiKeys := v1.Group("/api-keys")
+	apiKeys.Use(RequirePermission(PermissionAPIKeyManage))
+	apiKeys.GET("", APIKeysList)
+	apiKeys.POST("", APIKeysCreate)
+
+	apiKey := v1.Group("/api-keys/:apiKeyID")
+	apiKey.Use(RequirePermission(PermissionAPIKeyManage))
+	apiKey.Use(APIKeyContextMiddleware)
+	apiKey.GET("", APIKeysShow)
+	apiKey.DELETE("", APIKeysDelete)
+
+	// Spored
+	v1.POST("/spored/events", RequireScopeOrPermission(models.ScopeSporedEvents, PermissionSporedManage), IdempotencyMiddleware, SporedEventsReceive)
+
+	sporedCache := v1.Group("/spored/cache")
+	sporedCache.Use(RequirePermission(PermissionSporedManage))
+	sporedCache.GET("", SporedCacheStats)
+	sporedCache.DELETE("", SporedCachePurge)
+
+	// Streams stay open for as long as the client watches, so they run without
+	// a transaction instead of holding on to a database connection.
+	streams := router.Group("/api/v1/nakup")
+	streams.Use(RequestIDMiddleware)
+	streams.Use(DatabaseMiddleware(db))
+	streams.Use(middleware.TranslationMiddleware(trans))
+	streams.Use(middleware.ErrorMiddleware)
+	streams.Use(StreamAuthMiddleware(db, userMiddleware))
+	streams.Use(PermissionsMiddleware(permissions))
+	streams.GET("/timeslots/:timeSlotID/seats/stream", RequireScope(models.ScopeSeatMapRead), SeatWatcherMiddleware(seatWatcher), SeatMapStream)
 }
 
 func healthcheck(c *gin.Context) {
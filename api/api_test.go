@@ -2,10 +2,12 @@ package api
 
 import (
 	"testing"
+	"time"
 
-	"github.com/PRPO-skupina-02/common/clients/auth/models"
+	authmodels "github.com/PRPO-skupina-02/common/clients/auth/models"
 	"github.com/PRPO-skupina-02/common/middleware"
 	"github.com/PRPO-skupina-02/common/validation"
+	"github.com/PRPO-skupina-02/nakup/seats"
 	"github.com/PRPO-skupina-02/nakup/services"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -14,9 +16,9 @@ import (
 )
 
 // MockUserMiddleware creates a test middleware that sets a mock user in the context
-func MockUserMiddleware(userID uuid.UUID, role models.ModelsUserRole) gin.HandlerFunc {
+func MockUserMiddleware(userID uuid.UUID, role authmodels.ModelsUserRole) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		user := &models.APIUserResponse{
+		user := &authmodels.APIUserResponse{
 			ID:        userID.String(),
 			Email:     "test@example.com",
 			FirstName: "Test",
@@ -29,40 +31,36 @@ func MockUserMiddleware(userID uuid.UUID, role models.ModelsUserRole) gin.Handle
 	}
 }
 
-func TestingRouter(t *testing.T, db *gorm.DB, timeSlotService services.TimeSlotService) *gin.Engine {
-	router := gin.Default()
-	trans, err := validation.RegisterValidation()
-	require.NoError(t, err)
+const testingTicketPriceCents = 800
 
-	// Use mock auth instead of real auth for testing
-	router.Use(MockUserMiddleware(uuid.MustParse("00000000-0000-0000-0000-000000000001"), models.ModelsUserRoleEmployee))
+// testingSeatPollInterval keeps seat stream tests fast.
+const testingSeatPollInterval = 50 * time.Millisecond
 
-	// Register routes but skip the auth middleware since we added mock above
-	v1 := router.Group("/api/v1/nakup")
-	v1.Use(middleware.TransactionMiddleware(db))
-	v1.Use(middleware.TranslationMiddleware(trans))
-	v1.Use(middleware.ErrorMiddleware)
-	v1.Use(TimeSlotServiceMiddleware(timeSlotService))
+// testingUserID is the user requests of TestingRouter and AdminTestingRouter
+// are authenticated as. The employee is assigned to the theater of every
+// reservation in the fixtures.
+var testingUserID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
 
-	// Reservations
-	v1.POST("/reservations", ReservationsCreate)
-	v1.GET("/reservations/my", MyReservationsList)
-	v1.GET("/reservations", ReservationsList)
+func TestingRouter(t *testing.T, db *gorm.DB, timeSlotService services.TimeSlotService) *gin.Engine {
+	return TestingRouterWithUser(t, db, timeSlotService, testingUserID, authmodels.ModelsUserRoleEmployee)
+}
 
-	reservations := v1.Group("/reservations/:reservationID")
-	reservations.Use(ReservationContextMiddleware)
-	reservations.GET("", ReservationsShow)
-	reservations.PUT("", ReservationsUpdate)
-	reservations.DELETE("", ReservationsDelete)
+// AdminTestingRouter is TestingRouter authenticating requests as an admin, for
+// routes employees have no permission for.
+func AdminTestingRouter(t *testing.T, db *gorm.DB, timeSlotService services.TimeSlotService) *gin.Engine {
+	return TestingRouterWithUser(t, db, timeSlotService, testingUserID, authmodels.ModelsUserRoleAdmin)
+}
+
+// TestingRouterWithUser registers the routes of the API with the default
+// permissions, authenticating every request as the given user.
+func TestingRouterWithUser(t *testing.T, db *gorm.DB, timeSlotService services.TimeSlotService, userID uuid.UUID, role authmodels.ModelsUserRole) *gin.Engine {
+	router := gin.Default()
+	trans, err := validation.RegisterValidation()
+	require.NoError(t, err)
 
-	// Purchases
-	purchases := v1.Group("/reservations/:reservationID/purchases")
-	purchases.Use(ReservationContextMiddleware)
-	purchases.GET("", PurchasesList)
-	purchases.GET("/:purchaseID", PurchasesShow)
-	purchases.POST("", PurchasesCreate)
-	purchases.PUT("/:purchaseID", PurchasesUpdate)
-	purchases.DELETE("/:purchaseID", PurchasesDelete)
+	seatWatcher := seats.NewWatcher(db, testingSeatPollInterval)
+	go seatWatcher.Run(t.Context())
 
+	Register(router, db, trans, timeSlotService, MockUserMiddleware(userID, role), testingTicketPriceCents, DefaultPermissions(), seatWatcher)
 	return router
 }
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type APIKeyResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+func newAPIKeyResponse(apiKey models.APIKey) APIKeyResponse {
+	return APIKeyResponse{
+		ID:         apiKey.ID,
+		CreatedAt:  apiKey.CreatedAt,
+		UpdatedAt:  apiKey.UpdatedAt,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scopes:     apiKey.Scopes,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+	}
+}
+
+// APIKeyCreatedResponse is only returned when a key is created. The key can
+// not be shown again afterwards.
+type APIKeyCreatedResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+type APIKeyRequest struct {
+	Name      string     `json:"name" binding:"required"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1,unique,dive,oneof=reservations:create seatmap:read spored:events"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// APIKeysList
+//
+//	@Id				APIKeysList
+//	@Summary		List API keys
+//	@Description	List API keys of machine clients such as kiosks and partners
+//	@Tags			api-keys
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			limit	query		int		false	"Limit the number of responses"	Default(10)
+//	@Param			offset	query		int		false	"Offset the first response"		Default(0)
+//	@Param			sort	query		string	false	"Sort results"
+//	@Success		200		{object}	request.PaginatedResponse{data=[]APIKeyResponse}
+//	@Failure		400		{object}	middleware.HttpError
+//	@Failure		500		{object}	middleware.HttpError
+//	@Router			/api-keys [get]
+func APIKeysList(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	pagination := request.GetNormalizedPaginationArgs(c)
+	sort := request.GetSortOptions(c)
+
+	apiKeys, total, err := models.GetAPIKeys(tx, pagination, sort)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := []APIKeyResponse{}
+
+	for _, apiKey := range apiKeys {
+		response = append(response, newAPIKeyResponse(apiKey))
+	}
+
+	request.RenderPaginatedResponse(c, response, total)
+}
+
+// APIKeysCreate
+//
+//	@Id				APIKeysCreate
+//	@Summary		Create API key
+//	@Description	Create an API key. The key is only included in this response, store it right away. Idempotency keys are not supported, as the response would have to be stored with the key in it.
+//	@Tags			api-keys
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		APIKeyRequest	true	"request body"
+//	@Success		201		{object}	APIKeyCreatedResponse
+//	@Failure		400		{object}	middleware.HttpError
+//	@Failure		500		{object}	middleware.HttpError
+//	@Router			/api-keys [post]
+func APIKeysCreate(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+
+	var req APIKeyRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	key, err := models.GenerateAPIKey()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	apiKey := models.APIKey{
+		ID:        uuid.New(),
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	}
+	apiKey.SetKey(key)
+
+	err = apiKey.Create(tx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, APIKeyCreatedResponse{
+		APIKeyResponse: newAPIKeyResponse(apiKey),
+		Key:            key,
+	})
+}
+
+// APIKeysShow
+//
+//	@Id				APIKeysShow
+//	@Summary		Show API key
+//	@Description	Show API key
+//	@Tags			api-keys
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			apiKeyID	path		string	true	"API key ID"	Format(uuid)
+//	@Success		200			{object}	APIKeyResponse
+//	@Failure		400			{object}	middleware.HttpError
+//	@Failure		404			{object}	middleware.HttpError
+//	@Failure		500			{object}	middleware.HttpError
+//	@Router			/api-keys/{apiKeyID} [get]
+func APIKeysShow(c *gin.Context) {
+	apiKey := GetContextManagedAPIKey(c)
+	c.JSON(http.StatusOK, newAPIKeyResponse(apiKey))
+}
+
+// APIKeysDelete
+//
+//	@Id				APIKeysDelete
+//	@Summary		Delete API key
+//	@Description	Revoke an API key. Reservations created with it keep referencing it.
+//	@Tags			api-keys
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			apiKeyID	path	string	true	"API key ID"	Format(uuid)
+//	@Success		204
+//	@Failure		400	{object}	middleware.HttpError
+//	@Failure		404	{object}	middleware.HttpError
+//	@Failure		500	{object}	middleware.HttpError
+//	@Router			/api-keys/{apiKeyID} [delete]
+func APIKeysDelete(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	apiKey := GetContextManagedAPIKey(c)
+
+	err := models.DeleteAPIKey(tx, apiKey.ID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, "")
+}
@@ -0,0 +1,388 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeysList(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name   string
+		status int
+		params string
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+		},
+		{
+			name:   "ok-paginated",
+			status: http.StatusOK,
+			params: "?limit=1&offset=1",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/api-keys%s", testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestAPIKeysCreate(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		body   APIKeyRequest
+		status int
+	}{
+		{
+			name: "ok",
+			body: APIKeyRequest{
+				Name:      "Box office kiosk",
+				Scopes:    []string{models.ScopeReservationsCreate, models.ScopeSeatMapRead},
+				ExpiresAt: &expiresAt,
+			},
+			status: http.StatusCreated,
+		},
+		{
+			name: "validation-errors",
+			body: APIKeyRequest{
+				Scopes: []string{"reservations:delete"},
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "no-scopes",
+			body: APIKeyRequest{
+				Name:   "Box office kiosk",
+				Scopes: []string{},
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "no-body",
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			req := xtesting.NewTestingRequest(t, "/api/v1/nakup/api-keys", http.MethodPost, testCase.body)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"id":         xtesting.ValueUUID(),
+				"created_at": xtesting.ValueTimeInPastDuration(time.Second),
+				"updated_at": xtesting.ValueTimeInPastDuration(time.Second),
+				"prefix":     xtesting.ValueRegexp(`^[A-Za-z0-9_-]{8}$`),
+				"key":        xtesting.ValueBase64Token(256),
+			}
+
+			ignoreAPIKeys := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{
+				"ID":        xtesting.ValueUUID(),
+				"CreatedAt": xtesting.ValueTime(),
+				"UpdatedAt": xtesting.ValueTime(),
+				"Prefix":    xtesting.ValueRegexp(`^[A-Za-z0-9_-]{8}$`),
+				"KeyHash":   xtesting.ValueRegexp(`^[0-9a-f]{64}$`),
+			}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("name"), []models.APIKey{}, ignoreAPIKeys)
+		})
+	}
+}
+
+func TestAPIKeysCreateIdempotencyKey(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	err := fixtures.Load()
+	require.NoError(t, err)
+
+	body := APIKeyRequest{
+		Name:   "Box office kiosk",
+		Scopes: []string{models.ScopeReservationsCreate},
+	}
+
+	var keys []string
+	for range 2 {
+		req := xtesting.NewTestingRequest(t, "/api/v1/nakup/api-keys", http.MethodPost, body)
+		req.Header.Set(IdempotencyKeyHeader, "retry-key")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		assert.Empty(t, w.Header().Get(IdempotentReplayedHeader))
+
+		var response APIKeyCreatedResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		keys = append(keys, response.Key)
+	}
+
+	// Each request creates its own key, and no key is stored in plain text
+	// for replaying.
+	assert.NotEqual(t, keys[0], keys[1])
+
+	var stored []models.IdempotencyKey
+	require.NoError(t, db.Find(&stored).Error)
+	for _, idempotencyKey := range stored {
+		assert.NotEqual(t, "retry-key", idempotencyKey.Key)
+		for _, key := range keys {
+			assert.NotContains(t, string(idempotencyKey.Body), key)
+		}
+	}
+}
+
+func TestAPIKeysShow(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name     string
+		status   int
+		apiKeyID string
+	}{
+		{
+			name:     "ok",
+			status:   http.StatusOK,
+			apiKeyID: "e3c7a2f4-e0b1-11f0-9a3d-5f7b9c1d3e5f",
+		},
+		{
+			name:     "invalid-api-key-id",
+			status:   http.StatusNotFound,
+			apiKeyID: "01234567-0123-0123-0123-0123456789ab",
+		},
+		{
+			name:     "malformed-api-key-id",
+			status:   http.StatusBadRequest,
+			apiKeyID: "000",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/api-keys/%s", testCase.apiKeyID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestAPIKeysDelete(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name     string
+		status   int
+		apiKeyID string
+	}{
+		{
+			name:     "ok",
+			status:   http.StatusNoContent,
+			apiKeyID: "05e9c4b6-e0b2-11f0-9c5f-7b9d1e3f5a7b",
+		},
+		{
+			name:     "invalid-api-key-id",
+			status:   http.StatusNotFound,
+			apiKeyID: "01234567-0123-0123-0123-0123456789ab",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/api-keys/%s", testCase.apiKeyID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodDelete, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("name"), []models.APIKey{}, nil)
+		})
+	}
+}
+
+func TestAPIKeyAuth(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	timeSlotID := uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295")
+
+	service.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID, 10, 15)
+
+	reservation := ReservationRequest{
+		TimeSlotID: timeSlotID,
+		TheaterID:  theaterID,
+		RoomID:     roomID,
+		Type:       models.Pos,
+		Row:        7,
+		Col:        12,
+	}
+
+	// A failed request rolls back, but the key's use is still recorded.
+	takenReservation := reservation
+	takenReservation.Row = 5
+	takenReservation.Col = 10
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   any
+		key    string
+		status int
+		used   []string
+	}{
+		{
+			name:   "create-reservation",
+			method: http.MethodPost,
+			url:    "/api/v1/nakup/reservations",
+			body:   reservation,
+			key:    "kiosk-test-key",
+			status: http.StatusCreated,
+			used:   []string{"Lobby kiosk"},
+		},
+		{
+			name:   "create-reservation-missing-scope",
+			method: http.MethodPost,
+			url:    "/api/v1/nakup/reservations",
+			body:   reservation,
+			key:    "reseller-test-key",
+			status: http.StatusForbidden,
+			used:   []string{"Partner reseller"},
+		},
+		{
+			name:   "create-reservation-seat-taken",
+			method: http.MethodPost,
+			url:    "/api/v1/nakup/reservations",
+			body:   takenReservation,
+			key:    "kiosk-test-key",
+			status: http.StatusBadRequest,
+			used:   []string{"Lobby kiosk"},
+		},
+		{
+			name:   "create-reservation-expired",
+			method: http.MethodPost,
+			url:    "/api/v1/nakup/reservations",
+			body:   reservation,
+			key:    "expired-test-key",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "create-reservation-unknown-key",
+			method: http.MethodPost,
+			url:    "/api/v1/nakup/reservations",
+			body:   reservation,
+			key:    "unknown-test-key",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "seat-map",
+			method: http.MethodGet,
+			url:    fmt.Sprintf("/api/v1/nakup/timeslots/%s/seats", timeSlotID),
+			key:    "reseller-test-key",
+			status: http.StatusOK,
+			used:   []string{"Partner reseller"},
+		},
+		{
+			name:   "my-reservations",
+			method: http.MethodGet,
+			url:    "/api/v1/nakup/reservations/my",
+			key:    "kiosk-test-key",
+			status: http.StatusForbidden,
+			used:   []string{"Lobby kiosk"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			req := xtesting.NewTestingRequest(t, testCase.url, testCase.method, testCase.body)
+			req.Header.Set(APIKeyHeader, testCase.key)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"id":         xtesting.ValueUUID(),
+				"created_at": xtesting.ValueTimeInPastDuration(time.Second),
+				"updated_at": xtesting.ValueTimeInPastDuration(time.Second),
+			}
+
+			ignoreReservations := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"ID": xtesting.ValueUUID(), "CreatedAt": xtesting.ValueTime(), "UpdatedAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("time_slot_id, row, col"), []models.Reservation{}, ignoreReservations)
+
+			var used []string
+			err = db.Model(&models.APIKey{}).Where("last_used_at > ?", time.Now().Add(-time.Minute)).Pluck("name", &used).Error
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, testCase.used, used)
+		})
+	}
+}
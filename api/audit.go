@@ -0,0 +1,89 @@
+package api
+
+import (
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AuditChangeResponse struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+type AuditEntryResponse struct {
+	ID            uuid.UUID                      `json:"id"`
+	CreatedAt     time.Time                      `json:"created_at"`
+	ActorUserID   *uuid.UUID                     `json:"actor_user_id"`
+	ActorAPIKeyID *uuid.UUID                     `json:"actor_api_key_id"`
+	RequestID     *string                        `json:"request_id"`
+	Action        string                         `json:"action" enums:"create,update,delete,restore"`
+	EntityType    string                         `json:"entity_type" enums:"reservation,purchase"`
+	EntityID      uuid.UUID                      `json:"entity_id"`
+	Changes       map[string]AuditChangeResponse `json:"changes"`
+}
+
+func newAuditEntryResponse(entry models.AuditEntry) AuditEntryResponse {
+	changes := map[string]AuditChangeResponse{}
+	for field, change := range entry.Changes {
+		changes[field] = AuditChangeResponse{
+			Before: change.Before,
+			After:  change.After,
+		}
+	}
+
+	return AuditEntryResponse{
+		ID:            entry.ID,
+		CreatedAt:     entry.CreatedAt,
+		ActorUserID:   entry.ActorUserID,
+		ActorAPIKeyID: entry.ActorAPIKeyID,
+		RequestID:     entry.RequestID,
+		Action:        entry.Action,
+		EntityType:    entry.EntityType,
+		EntityID:      entry.EntityID,
+		Changes:       changes,
+	}
+}
+
+// ReservationAuditList
+//
+//	@Id				ReservationAuditList
+//	@Summary		List reservation history
+//	@Description	List every change of a reservation and its purchases, oldest first, together with who made it
+//	@Tags			reservations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			reservationID	path		string	true	"Reservation ID"				Format(uuid)
+//	@Param			limit			query		int		false	"Limit the number of responses"	Default(10)
+//	@Param			offset			query		int		false	"Offset the first response"		Default(0)
+//	@Param			sort			query		string	false	"Sort results"
+//	@Success		200				{object}	request.PaginatedResponse{data=[]AuditEntryResponse}
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		404				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Router			/reservations/{reservationID}/audit [get]
+func ReservationAuditList(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	reservation := GetContextReservation(c)
+	pagination := request.GetNormalizedPaginationArgs(c)
+	sort := request.GetSortOptions(c)
+
+	entries, total, err := models.GetReservationAuditEntries(tx, reservation.ID, pagination, sort)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := []AuditEntryResponse{}
+
+	for _, entry := range entries {
+		response = append(response, newAuditEntryResponse(entry))
+	}
+
+	request.RenderPaginatedResponse(c, response, total)
+}
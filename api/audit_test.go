@@ -0,0 +1,195 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReservationAuditList(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	tests := []struct {
+		name          string
+		status        int
+		params        string
+		reservationID string
+	}{
+		{
+			name:          "ok",
+			status:        http.StatusOK,
+			reservationID: "bae209f6-d059-11f0-b2a4-cbf992c2eb6d",
+		},
+		{
+			name:          "ok-sort",
+			status:        http.StatusOK,
+			params:        "?sort=-created_at",
+			reservationID: "bae209f6-d059-11f0-b2a4-cbf992c2eb6d",
+		},
+		{
+			name:          "ok-no-entries",
+			status:        http.StatusOK,
+			reservationID: "ea0b7f96-ddc9-11f0-9635-23efd36396bd",
+		},
+		{
+			name:          "invalid-reservation-id",
+			status:        http.StatusNotFound,
+			reservationID: "01234567-0123-0123-0123-0123456789ab",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/reservations/%s/audit%s", testCase.reservationID, testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestAuditTrail(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	timeSlotID := uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295")
+
+	service.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID, 10, 15)
+
+	reservation := ReservationRequest{
+		TimeSlotID: timeSlotID,
+		TheaterID:  theaterID,
+		RoomID:     roomID,
+		Type:       models.Online,
+		Row:        6,
+		Col:        10,
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   any
+		key    string
+		status int
+		ignore xtesting.ValuesCheckers
+	}{
+		{
+			name:   "update-reservation",
+			method: http.MethodPut,
+			url:    "/api/v1/nakup/reservations/bae209f6-d059-11f0-b2a4-cbf992c2eb6d",
+			body:   reservation,
+			status: http.StatusOK,
+		},
+		{
+			name:   "delete-reservation",
+			method: http.MethodDelete,
+			url:    "/api/v1/nakup/reservations/bae209f6-d059-11f0-b2a4-cbf992c2eb6d",
+			status: http.StatusNoContent,
+		},
+		{
+			name:   "create-reservation-api-key",
+			method: http.MethodPost,
+			url:    "/api/v1/nakup/reservations",
+			body:   reservation,
+			key:    "kiosk-test-key",
+			status: http.StatusCreated,
+			ignore: xtesting.ValuesCheckers{
+				"[3].EntityID":      xtesting.ValueUUID(),
+				"[3].ReservationID": xtesting.ValueUUID(),
+			},
+		},
+		{
+			name:   "create-purchase",
+			method: http.MethodPost,
+			url:    "/api/v1/nakup/reservations/ea0b7f96-ddc9-11f0-9635-23efd36396bd/purchases",
+			body: PurchaseRequest{
+				Type:              string(models.Drink),
+				Name:              "Water",
+				Count:             1,
+				PricePerItemCents: 200,
+			},
+			status: http.StatusCreated,
+			ignore: xtesting.ValuesCheckers{
+				"[3].EntityID": xtesting.ValueUUID(),
+			},
+		},
+		{
+			name:   "update-purchase",
+			method: http.MethodPut,
+			url:    "/api/v1/nakup/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7/purchases/aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+			body: PurchaseRequest{
+				Type:              string(models.Food),
+				Name:              "Popcorn",
+				Count:             1,
+				PricePerItemCents: 600,
+			},
+			status: http.StatusOK,
+		},
+		{
+			name:   "delete-purchase",
+			method: http.MethodDelete,
+			url:    "/api/v1/nakup/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7/purchases/bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+			status: http.StatusNoContent,
+		},
+		{
+			name:   "restore-reservation",
+			method: http.MethodPost,
+			url:    "/api/v1/nakup/reservations/0c3e5a7b-e4b2-11f0-8d1f-9b2c4d6e8f0a/restore",
+			status: http.StatusOK,
+		},
+		{
+			name:   "no-change-on-error",
+			method: http.MethodPut,
+			url:    "/api/v1/nakup/reservations/bae209f6-d059-11f0-b2a4-cbf992c2eb6d",
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			req := xtesting.NewTestingRequest(t, testCase.url, testCase.method, testCase.body)
+			req.Header.Set(RequestIDHeader, "audit-test-request")
+			if testCase.key != "" {
+				req.Header.Set(APIKeyHeader, testCase.key)
+			}
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreEntries := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"ID": xtesting.ValueUUID(), "CreatedAt": xtesting.ValueTime()}, 10)
+			for path, checker := range testCase.ignore {
+				ignoreEntries[path] = checker
+			}
+
+			assert.Equal(t, testCase.status, w.Code)
+			assert.Equal(t, "audit-test-request", w.Header().Get(RequestIDHeader))
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("created_at, id"), []models.AuditEntry{}, ignoreEntries)
+		})
+	}
+}
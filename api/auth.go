@@ -0,0 +1,298 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	authmodels "github.com/PRPO-skupina-02/common/clients/auth/models"
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// APIKeyHeader carries the key of a machine client such as a kiosk.
+const APIKeyHeader = "X-API-Key"
+
+// StreamTicketQueryParam carries the stream ticket of clients that cannot
+// send headers, such as a browser's EventSource. Tickets are short-lived and
+// single-use, so long-lived credentials never have to be put in a URL.
+const StreamTicketQueryParam = "ticket"
+
+const (
+	// AuthModeRemote asks the auth service to introspect every token.
+	AuthModeRemote = "remote"
+	// AuthModeLocal verifies tokens against the auth service's public keys.
+	AuthModeLocal = "local"
+	// AuthModeHybrid verifies tokens locally and falls back to remote
+	// introspection when the signing key is not available.
+	AuthModeHybrid = "hybrid"
+)
+
+// JWTUserMiddleware authenticates requests by verifying their bearer token
+// locally and sets the same context user as middleware.UserMiddleware, so
+// middleware.GetContextUserID and middleware.RequireRole work unchanged. When
+// fallback is set, tokens whose signing key cannot be found are passed to it
+// instead of being rejected.
+func JWTUserMiddleware(verifier *services.JWTVerifier, fallback gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, middleware.NewUnauthorizedError("Authorization header required"))
+			return
+		}
+
+		parts := strings.SplitN(authHeader, " ", 2)
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, middleware.NewUnauthorizedError("Invalid authorization header format"))
+			return
+		}
+
+		user, err := verifier.Verify(c.Request.Context(), parts[1])
+		if err != nil {
+			if fallback != nil && services.IsKeyUnavailable(err) {
+				slog.Warn("falling back to remote token introspection", "err", err)
+				fallback(c)
+				return
+			}
+
+			slog.Debug("rejected bearer token", "err", err)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, middleware.NewUnauthorizedError("Invalid or expired token"))
+			return
+		}
+
+		middleware.SetContextUser(c, user)
+
+		c.Next()
+	}
+}
+
+// AuthMiddleware authenticates requests that carry an API key in the
+// X-API-Key header and passes all other requests to userMiddleware. It must be
+// used after the transaction or database middleware. The key's last use is
+// recorded with db, outside the request transaction, so it is kept when the
+// request fails.
+func AuthMiddleware(db *gorm.DB, userMiddleware gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(APIKeyHeader)
+		if key == "" {
+			userMiddleware(c)
+			return
+		}
+
+		tx := middleware.GetContextTransaction(c)
+
+		apiKey, err := models.GetAPIKeyByKey(tx, key)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, middleware.NewUnauthorizedError("Invalid API key"))
+			return
+		}
+		if err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		now := time.Now()
+		if apiKey.Expired(now) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, middleware.NewUnauthorizedError("API key expired"))
+			return
+		}
+
+		if err := apiKey.Touch(db.WithContext(c.Request.Context()), now); err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		SetContextAPIKey(c, apiKey)
+
+		c.Next()
+	}
+}
+
+// StreamAuthMiddleware authenticates streams opened with a stream ticket as
+// the user or API key the ticket was issued to, and passes all other requests
+// to AuthMiddleware. A ticket only opens the stream of the time slot it was
+// issued for. It must be used after the database middleware.
+func StreamAuthMiddleware(db *gorm.DB, userMiddleware gin.HandlerFunc) gin.HandlerFunc {
+	authMiddleware := AuthMiddleware(db, userMiddleware)
+
+	return func(c *gin.Context) {
+		ticket := c.Query(StreamTicketQueryParam)
+		if ticket == "" {
+			authMiddleware(c)
+			return
+		}
+
+		timeSlotID, err := uuid.Parse(c.Param("timeSlotID"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, middleware.NewUnauthorizedError("Invalid or expired stream ticket"))
+			return
+		}
+
+		tx := middleware.GetContextTransaction(c)
+		now := time.Now()
+
+		streamTicket, err := models.RedeemStreamTicket(tx, timeSlotID, ticket, now)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, middleware.NewUnauthorizedError("Invalid or expired stream ticket"))
+			return
+		}
+		if err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		if streamTicket.APIKeyID == nil {
+			middleware.SetContextUser(c, &authmodels.APIUserResponse{
+				ID:     streamTicket.UserID.String(),
+				Role:   authmodels.ModelsUserRole(*streamTicket.UserRole),
+				Active: true,
+			})
+
+			c.Next()
+			return
+		}
+
+		// The key may have expired since the ticket was issued.
+		apiKey, err := models.GetAPIKey(tx, *streamTicket.APIKeyID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, middleware.NewUnauthorizedError("Invalid API key"))
+			return
+		}
+		if err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		if apiKey.Expired(now) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, middleware.NewUnauthorizedError("API key expired"))
+			return
+		}
+
+		SetContextAPIKey(c, apiKey)
+
+		c.Next()
+	}
+}
+
+// requestOwner identifies the user or API key that sent the request, for the
+// records that are scoped to them.
+func requestOwner(c *gin.Context) string {
+	if apiKey := GetContextAPIKey(c); apiKey != nil {
+		return "api_key:" + apiKey.ID.String()
+	}
+	return "user:" + middleware.GetContextUserID(c).String()
+}
+
+// RequireScope only lets API keys through if they were granted scope. Users
+// are not affected.
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKey := GetContextAPIKey(c)
+		if apiKey != nil && !apiKey.HasScope(scope) {
+			c.AbortWithStatusJSON(http.StatusForbidden, middleware.NewForbiddenError(fmt.Sprintf("API key is missing scope %s", scope)))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireScopeOrPermission lets API keys through that were granted scope and
+// users whose role was granted permission, for endpoints called both by other
+// services and by staff.
+func RequireScopeOrPermission(scope string, permission Permission) gin.HandlerFunc {
+	requireScope := RequireScope(scope)
+	requirePermission := RequirePermission(permission)
+
+	return func(c *gin.Context) {
+		if GetContextAPIKey(c) != nil {
+			requireScope(c)
+			return
+		}
+
+		requirePermission(c)
+	}
+}
+
+// RequireUser rejects API keys on routes that act on behalf of a user.
+func RequireUser(c *gin.Context) {
+	if GetContextAPIKey(c) != nil {
+		c.AbortWithStatusJSON(http.StatusForbidden, middleware.NewForbiddenError("API keys cannot access this endpoint"))
+		return
+	}
+
+	c.Next()
+}
+
+// RequirePermission only lets users through whose role was granted
+// permission in the mapping set by PermissionsMiddleware. API keys have no
+// role, so they are always rejected.
+func RequirePermission(permission Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if GetContextAPIKey(c) != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, middleware.NewForbiddenError("API keys cannot access this endpoint"))
+			return
+		}
+
+		role := middleware.GetContextUserRole(c)
+		if c.IsAborted() {
+			return
+		}
+
+		if !GetPermissions(c).Allows(role, permission) {
+			c.AbortWithStatusJSON(http.StatusForbidden, middleware.NewForbiddenError(fmt.Sprintf("Missing permission %s", permission)))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// TheaterScopeMiddleware limits employees to the theaters they are assigned
+// to, while admins stay global. It must be used after RequirePermission.
+func TheaterScopeMiddleware(c *gin.Context) {
+	if middleware.GetContextUserRole(c) == authmodels.ModelsUserRoleAdmin {
+		c.Next()
+		return
+	}
+
+	tx := middleware.GetContextTransaction(c)
+
+	theaterIDs, err := models.GetStaffTheaterIDs(tx, middleware.GetContextUserID(c))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	SetContextTheaterScope(c, theaterIDs)
+
+	c.Next()
+}
+
+// CanAccessTheater reports whether the request may manage reservations of
+// theaterID.
+func CanAccessTheater(c *gin.Context, theaterID uuid.UUID) bool {
+	theaterIDs := GetContextTheaterScope(c)
+	return theaterIDs == nil || slices.Contains(theaterIDs, theaterID)
+}
+
+// RequireReservationTheater rejects requests for reservations of theaters
+// outside the theater scope. It must be used after
+// ReservationContextMiddleware and TheaterScopeMiddleware.
+func RequireReservationTheater(c *gin.Context) {
+	reservation := GetContextReservation(c)
+	if !CanAccessTheater(c, reservation.TheaterID) {
+		c.AbortWithStatusJSON(http.StatusForbidden, middleware.NewForbiddenError("Not assigned to the reservation's theater"))
+		return
+	}
+
+	c.Next()
+}
@@ -0,0 +1,243 @@
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/clients/auth/models"
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	nakupmodels "github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testingKeySet map[string]crypto.PublicKey
+
+func (k testingKeySet) Key(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	key, ok := k[keyID]
+	if !ok {
+		return nil, services.ErrUnknownKey
+	}
+	return key, nil
+}
+
+func TestJWTUserMiddleware(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	verifier := services.NewJWTVerifier(testingKeySet{"current": publicKey})
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	sign := func(keyID string, expiresAt time.Time) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, services.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   userID.String(),
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+			Email: "test@example.com",
+			Role:  models.ModelsUserRoleAdmin,
+		})
+		token.Header["kid"] = keyID
+
+		signed, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+		return signed
+	}
+
+	fallback := MockUserMiddleware(uuid.MustParse("00000000-0000-0000-0000-000000000003"), models.ModelsUserRoleCustomer)
+
+	tests := []struct {
+		name     string
+		header   string
+		fallback gin.HandlerFunc
+		status   int
+	}{
+		{
+			name:   "ok",
+			header: "Bearer " + sign("current", time.Now().Add(time.Hour)),
+			status: http.StatusOK,
+		},
+		{
+			name:   "no-header",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "invalid-format",
+			header: "Token " + sign("current", time.Now().Add(time.Hour)),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "malformed-token",
+			header: "Bearer token",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:     "expired",
+			header:   "Bearer " + sign("current", time.Now().Add(-time.Hour)),
+			fallback: fallback,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			name:   "unknown-key",
+			header: "Bearer " + sign("rotated", time.Now().Add(time.Hour)),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:     "unknown-key-fallback",
+			header:   "Bearer " + sign("rotated", time.Now().Add(time.Hour)),
+			fallback: fallback,
+			status:   http.StatusOK,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/user", JWTUserMiddleware(verifier, testCase.fallback), func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{
+					"id":   middleware.GetContextUserID(c),
+					"role": middleware.GetContextUserRole(c),
+				})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/user", nil)
+			if testCase.header != "" {
+				req.Header.Set("Authorization", testCase.header)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestAPIKeyGuards(t *testing.T) {
+	kiosk := nakupmodels.APIKey{
+		ID:     uuid.MustParse("e3c7a2f4-e0b1-11f0-9a3d-5f7b9c1d3e5f"),
+		Name:   "Lobby kiosk",
+		Scopes: nakupmodels.APIKeyScopes{nakupmodels.ScopeReservationsCreate},
+	}
+	user := MockUserMiddleware(uuid.MustParse("00000000-0000-0000-0000-000000000002"), models.ModelsUserRoleCustomer)
+	employee := MockUserMiddleware(uuid.MustParse("00000000-0000-0000-0000-000000000001"), models.ModelsUserRoleEmployee)
+	apiKey := func(c *gin.Context) {
+		SetContextAPIKey(c, kiosk)
+		c.Next()
+	}
+
+	tests := []struct {
+		name   string
+		auth   gin.HandlerFunc
+		guard  gin.HandlerFunc
+		status int
+	}{
+		{
+			name:   "scope-api-key",
+			auth:   apiKey,
+			guard:  RequireScope(nakupmodels.ScopeReservationsCreate),
+			status: http.StatusOK,
+		},
+		{
+			name:   "scope-api-key-missing",
+			auth:   apiKey,
+			guard:  RequireScope(nakupmodels.ScopeSeatMapRead),
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "scope-user",
+			auth:   user,
+			guard:  RequireScope(nakupmodels.ScopeSeatMapRead),
+			status: http.StatusOK,
+		},
+		{
+			name:   "scope-or-permission-api-key",
+			auth:   apiKey,
+			guard:  RequireScopeOrPermission(nakupmodels.ScopeReservationsCreate, PermissionSporedManage),
+			status: http.StatusOK,
+		},
+		{
+			name:   "scope-or-permission-api-key-missing",
+			auth:   apiKey,
+			guard:  RequireScopeOrPermission(nakupmodels.ScopeSporedEvents, PermissionSporedManage),
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "scope-or-permission-user",
+			auth:   employee,
+			guard:  RequireScopeOrPermission(nakupmodels.ScopeSporedEvents, PermissionReservationView),
+			status: http.StatusOK,
+		},
+		{
+			name:   "scope-or-permission-user-missing",
+			auth:   employee,
+			guard:  RequireScopeOrPermission(nakupmodels.ScopeSporedEvents, PermissionSporedManage),
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "user-api-key",
+			auth:   apiKey,
+			guard:  RequireUser,
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "user-user",
+			auth:   user,
+			guard:  RequireUser,
+			status: http.StatusOK,
+		},
+		{
+			name:   "permission-api-key",
+			auth:   apiKey,
+			guard:  RequirePermission(PermissionReservationView),
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "permission-user",
+			auth:   employee,
+			guard:  RequirePermission(PermissionReservationView),
+			status: http.StatusOK,
+		},
+		{
+			name:   "permission-user-missing",
+			auth:   employee,
+			guard:  RequirePermission(PermissionReservationDelete),
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "permission-customer",
+			auth:   user,
+			guard:  RequirePermission(PermissionReservationView),
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/guarded", PermissionsMiddleware(DefaultPermissions()), testCase.auth, testCase.guard, func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"ok": true})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/guarded", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
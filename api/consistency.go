@@ -0,0 +1,319 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ConsistencyProblem string
+
+const (
+	ConsistencyTimeSlotMissing ConsistencyProblem = "TIME_SLOT_MISSING"
+	ConsistencyRoomMissing     ConsistencyProblem = "ROOM_MISSING"
+	ConsistencySeatOutOfBounds ConsistencyProblem = "SEAT_OUT_OF_BOUNDS"
+)
+
+type ConsistencyAction string
+
+const (
+	ConsistencyCancelled ConsistencyAction = "CANCELLED"
+	ConsistencyMoved     ConsistencyAction = "MOVED"
+)
+
+const (
+	ConsistencyFixCancel = "cancel"
+	ConsistencyFixMove   = "move"
+)
+
+type SeatResponse struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+type ConsistencyIssueResponse struct {
+	ReservationID uuid.UUID          `json:"reservation_id"`
+	TimeSlotID    uuid.UUID          `json:"time_slot_id"`
+	TheaterID     uuid.UUID          `json:"theater_id"`
+	RoomID        uuid.UUID          `json:"room_id"`
+	UserID        *uuid.UUID         `json:"user_id"`
+	Row           int                `json:"row"`
+	Col           int                `json:"col"`
+	Problem       ConsistencyProblem `json:"problem"`
+	RoomRows      *int               `json:"room_rows"`
+	RoomColumns   *int               `json:"room_columns"`
+	Action        *ConsistencyAction `json:"action"`
+	MovedTo       *SeatResponse      `json:"moved_to"`
+	Refund        *RefundResponse    `json:"refund"`
+}
+
+type ConsistencyReportResponse struct {
+	Checked   int                        `json:"checked"`
+	TimeSlots int                        `json:"time_slots"`
+	Issues    []ConsistencyIssueResponse `json:"issues"`
+}
+
+type ConsistencyFixRequest struct {
+	Mode string `json:"mode" binding:"required,oneof=cancel move"`
+}
+
+type consistencyIssue struct {
+	reservation models.Reservation
+	problem     ConsistencyProblem
+	room        *services.RoomInfo
+}
+
+func (i consistencyIssue) response() ConsistencyIssueResponse {
+	response := ConsistencyIssueResponse{
+		ReservationID: i.reservation.ID,
+		TimeSlotID:    i.reservation.TimeSlotID,
+		TheaterID:     i.reservation.TheaterID,
+		RoomID:        i.reservation.RoomID,
+		UserID:        i.reservation.UserID,
+		Row:           i.reservation.Row,
+		Col:           i.reservation.Col,
+		Problem:       i.problem,
+	}
+	if i.room != nil {
+		response.RoomRows = &i.room.Rows
+		response.RoomColumns = &i.room.Columns
+	}
+	return response
+}
+
+type consistencyCheck struct {
+	reservations []models.Reservation
+	timeSlots    int
+	issues       []consistencyIssue
+}
+
+// checkReservationConsistency resolves every time slot and room that has
+// reservations against spored and collects reservations that can no longer be
+// honoured. Lookups go through the spored cache, so purge it first to check
+// against the current state of spored.
+func checkReservationConsistency(ctx context.Context, tx *gorm.DB, resolver *services.ScheduleResolver) (consistencyCheck, error) {
+	reservations, err := models.GetAllReservations(tx)
+	if err != nil {
+		return consistencyCheck{}, err
+	}
+
+	timeSlotRefs := make([]services.TimeSlotRef, 0, len(reservations))
+	for _, reservation := range reservations {
+		timeSlotRefs = append(timeSlotRefs, services.TimeSlotRef{
+			TimeSlotID: reservation.TimeSlotID,
+			TheaterID:  reservation.TheaterID,
+			RoomID:     reservation.RoomID,
+		})
+	}
+
+	timeSlots, err := resolver.ResolveTimeSlots(ctx, timeSlotRefs)
+	if err != nil {
+		return consistencyCheck{}, err
+	}
+
+	roomRefs := []services.RoomRef{}
+	timeSlotIDs := map[uuid.UUID]bool{}
+	for _, reservation := range reservations {
+		timeSlotIDs[reservation.TimeSlotID] = true
+		if _, ok := timeSlots[reservation.TimeSlotID]; ok {
+			roomRefs = append(roomRefs, services.RoomRef{TheaterID: reservation.TheaterID, RoomID: reservation.RoomID})
+		}
+	}
+
+	rooms, err := resolver.ResolveRooms(ctx, roomRefs)
+	if err != nil {
+		return consistencyCheck{}, err
+	}
+
+	check := consistencyCheck{
+		reservations: reservations,
+		timeSlots:    len(timeSlotIDs),
+	}
+
+	for _, reservation := range reservations {
+		if _, ok := timeSlots[reservation.TimeSlotID]; !ok {
+			check.issues = append(check.issues, consistencyIssue{reservation: reservation, problem: ConsistencyTimeSlotMissing})
+			continue
+		}
+
+		room, ok := rooms[services.RoomRef{TheaterID: reservation.TheaterID, RoomID: reservation.RoomID}]
+		if !ok {
+			check.issues = append(check.issues, consistencyIssue{reservation: reservation, problem: ConsistencyRoomMissing})
+			continue
+		}
+
+		if reservation.Row > room.Rows || reservation.Col > room.Columns {
+			check.issues = append(check.issues, consistencyIssue{reservation: reservation, problem: ConsistencySeatOutOfBounds, room: &room})
+		}
+	}
+
+	return check, nil
+}
+
+func (c consistencyCheck) response() ConsistencyReportResponse {
+	response := ConsistencyReportResponse{
+		Checked:   len(c.reservations),
+		TimeSlots: c.timeSlots,
+		Issues:    []ConsistencyIssueResponse{},
+	}
+	for _, issue := range c.issues {
+		response.Issues = append(response.Issues, issue.response())
+	}
+	return response
+}
+
+// fix resolves every issue of the check. In move mode reservations whose seat
+// is outside of the room are moved to the nearest free seat; everything that
+// cannot be moved is cancelled and flagged for refund.
+func (c consistencyCheck) fix(tx *gorm.DB, mode string, ticketPriceCents int) (ConsistencyReportResponse, error) {
+	response := c.response()
+
+	taken := map[uuid.UUID]map[SeatResponse]bool{}
+	for _, reservation := range c.reservations {
+		if taken[reservation.TimeSlotID] == nil {
+			taken[reservation.TimeSlotID] = map[SeatResponse]bool{}
+		}
+		taken[reservation.TimeSlotID][SeatResponse{Row: reservation.Row, Col: reservation.Col}] = true
+	}
+
+	for i, issue := range c.issues {
+		result := &response.Issues[i]
+
+		if mode == ConsistencyFixMove && issue.problem == ConsistencySeatOutOfBounds {
+			seat, ok := nearestFreeSeat(*issue.room, taken[issue.reservation.TimeSlotID], issue.reservation.Row, issue.reservation.Col)
+			if ok {
+				reservation := issue.reservation
+				reservation.Row = seat.Row
+				reservation.Col = seat.Col
+
+				err := reservation.Save(tx)
+				if err != nil {
+					return ConsistencyReportResponse{}, err
+				}
+
+				taken[reservation.TimeSlotID][seat] = true
+
+				action := ConsistencyMoved
+				result.Action = &action
+				result.MovedTo = &seat
+				continue
+			}
+		}
+
+		reason := models.RefundSeatUnavailable
+		if issue.problem == ConsistencyTimeSlotMissing {
+			reason = models.RefundTimeSlotCancelled
+		}
+
+		refund, err := models.CancelReservation(tx, issue.reservation.ID, reason, ticketPriceCents)
+		if err != nil {
+			return ConsistencyReportResponse{}, err
+		}
+
+		action := ConsistencyCancelled
+		refundResponse := newRefundResponse(refund)
+		result.Action = &action
+		result.Refund = &refundResponse
+	}
+
+	return response, nil
+}
+
+// nearestFreeSeat returns the free seat of the room closest to the given seat,
+// preferring seats closer to the screen on ties.
+func nearestFreeSeat(room services.RoomInfo, taken map[SeatResponse]bool, row, col int) (SeatResponse, bool) {
+	target := SeatResponse{Row: min(row, room.Rows), Col: min(col, room.Columns)}
+
+	best, found, bestDistance := SeatResponse{}, false, 0
+	for r := 1; r <= room.Rows; r++ {
+		for c := 1; c <= room.Columns; c++ {
+			seat := SeatResponse{Row: r, Col: c}
+			if taken[seat] {
+				continue
+			}
+
+			distance := (r-target.Row)*(r-target.Row) + (c-target.Col)*(c-target.Col)
+			if !found || distance < bestDistance {
+				best, found, bestDistance = seat, true, distance
+			}
+		}
+	}
+
+	return best, found
+}
+
+// ReservationsConsistencyCheck
+//
+//	@Id				ReservationsConsistencyCheck
+//	@Summary		Check reservation consistency
+//	@Description	Resolve the time slot and room of every reservation in spored and report reservations whose time slot no longer exists or whose seat is outside of the room
+//	@Tags			reservations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	ConsistencyReportResponse
+//	@Failure		500	{object}	middleware.HttpError
+//	@Failure		503	{object}	middleware.HttpError
+//	@Router			/reservations/consistency [get]
+func ReservationsConsistencyCheck(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	resolver := GetScheduleResolver(c)
+
+	check, err := checkReservationConsistency(c.Request.Context(), tx, resolver)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, check.response())
+}
+
+// ReservationsConsistencyFix
+//
+//	@Id				ReservationsConsistencyFix
+//	@Summary		Fix reservation consistency
+//	@Description	Run the consistency check and fix every reported reservation. In cancel mode all of them are cancelled and flagged for refund. In move mode reservations whose seat is outside of the room are moved to the nearest free seat and the rest are cancelled and flagged for refund.
+//	@Tags			reservations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request			body		ConsistencyFixRequest	true	"request body"
+//	@Param			Idempotency-Key	header		string					false	"Key that makes retries of the request safe"
+//	@Success		200				{object}	ConsistencyReportResponse
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		422				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Failure		503				{object}	middleware.HttpError
+//	@Router			/reservations/consistency/fix [post]
+func ReservationsConsistencyFix(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	resolver := GetScheduleResolver(c)
+	ticketPriceCents := GetTicketPriceCents(c)
+
+	var req ConsistencyFixRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	check, err := checkReservationConsistency(c.Request.Context(), tx, resolver)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response, err := check.fix(tx, req.Mode, ticketPriceCents)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// newConsistencyTimeSlotService returns a spored where room 1 lost its last two
+// columns and the time slot of the POS reservation was deleted.
+func newConsistencyTimeSlotService() *services.MockTimeSlotService {
+	service := services.NewMockTimeSlotService()
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+
+	service.AddValidTimeSlotWithRoom(theaterID, roomID, uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"), 10, 8)
+	service.AddValidTimeSlotWithRoom(theaterID, roomID, uuid.MustParse("eed99bc8-1fb4-443b-8287-a988a3bc4406"), 10, 8)
+
+	return service
+}
+
+func consistencyRefundCheckers() xtesting.ValuesCheckers {
+	checkers := xtesting.ValuesCheckers{}
+	for i := range 3 {
+		checkers[fmt.Sprintf("issues.[%d].refund.id", i)] = xtesting.ValueUUID()
+		checkers[fmt.Sprintf("issues.[%d].refund.created_at", i)] = xtesting.ValueTimeInPastDuration(time.Second)
+		checkers[fmt.Sprintf("issues.[%d].refund.updated_at", i)] = xtesting.ValueTimeInPastDuration(time.Second)
+	}
+	return checkers
+}
+
+func TestReservationsConsistencyCheck(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+
+	tests := []struct {
+		name    string
+		status  int
+		service func() *services.MockTimeSlotService
+	}{
+		{
+			name:    "ok",
+			status:  http.StatusOK,
+			service: newConsistencyTimeSlotService,
+		},
+		{
+			name:   "ok-consistent",
+			status: http.StatusOK,
+			service: func() *services.MockTimeSlotService {
+				service := services.NewMockTimeSlotService()
+				theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+				service.AddValidTimeSlot(theaterID, uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1"), uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"))
+				service.AddValidTimeSlot(theaterID, uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1"), uuid.MustParse("eed99bc8-1fb4-443b-8287-a988a3bc4406"))
+				service.AddValidTimeSlot(theaterID, uuid.MustParse("3f1a7e2c-df46-11f0-9b5d-0b3c8e6f1a2d"), uuid.MustParse("5475b333-1883-4261-8b58-944235693558"))
+				return service
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			r := AdminTestingRouter(t, db, testCase.service())
+
+			req := xtesting.NewTestingRequest(t, "/api/v1/nakup/reservations/consistency", http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestReservationsConsistencyFix(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	r := AdminTestingRouter(t, db, newConsistencyTimeSlotService())
+
+	tests := []struct {
+		name   string
+		status int
+		body   ConsistencyFixRequest
+	}{
+		{
+			name:   "ok-cancel",
+			status: http.StatusOK,
+			body:   ConsistencyFixRequest{Mode: ConsistencyFixCancel},
+		},
+		{
+			name:   "ok-move",
+			status: http.StatusOK,
+			body:   ConsistencyFixRequest{Mode: ConsistencyFixMove},
+		},
+		{
+			name:   "validation-errors",
+			status: http.StatusBadRequest,
+			body:   ConsistencyFixRequest{Mode: "delete"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			req := xtesting.NewTestingRequest(t, "/api/v1/nakup/reservations/consistency/fix", http.MethodPost, testCase.body)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreReservations := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"UpdatedAt": xtesting.ValueTime()}, 10)
+			ignoreRefunds := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"ID": xtesting.ValueUUID(), "CreatedAt": xtesting.ValueTime(), "UpdatedAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, consistencyRefundCheckers())
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Reservation{}, ignoreReservations)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Purchase{}, nil)
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("created_at"), []models.Refund{}, ignoreRefunds)
+		})
+	}
+}
+
+func TestNearestFreeSeat(t *testing.T) {
+	room := services.RoomInfo{Rows: 3, Columns: 4}
+
+	tests := []struct {
+		name  string
+		taken []SeatResponse
+		row   int
+		col   int
+		seat  SeatResponse
+		found bool
+	}{
+		{
+			name:  "clamped-to-room",
+			row:   2,
+			col:   6,
+			seat:  SeatResponse{Row: 2, Col: 4},
+			found: true,
+		},
+		{
+			name:  "taken-prefers-front",
+			taken: []SeatResponse{{Row: 2, Col: 4}},
+			row:   2,
+			col:   6,
+			seat:  SeatResponse{Row: 1, Col: 4},
+			found: true,
+		},
+		{
+			name:  "row-outside-of-room",
+			taken: []SeatResponse{{Row: 3, Col: 2}},
+			row:   5,
+			col:   2,
+			seat:  SeatResponse{Row: 2, Col: 2},
+			found: true,
+		},
+		{
+			name: "room-full",
+			taken: []SeatResponse{
+				{Row: 1, Col: 1}, {Row: 1, Col: 2}, {Row: 1, Col: 3}, {Row: 1, Col: 4},
+				{Row: 2, Col: 1}, {Row: 2, Col: 2}, {Row: 2, Col: 3}, {Row: 2, Col: 4},
+				{Row: 3, Col: 1}, {Row: 3, Col: 2}, {Row: 3, Col: 3}, {Row: 3, Col: 4},
+			},
+			row: 4,
+			col: 4,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			taken := map[SeatResponse]bool{}
+			for _, seat := range testCase.taken {
+				taken[seat] = true
+			}
+
+			seat, found := nearestFreeSeat(room, taken, testCase.row, testCase.col)
+			assert.Equal(t, testCase.found, found)
+			assert.Equal(t, testCase.seat, seat)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// PaginationCursor is the pagination query value that opts into cursor
+// pagination.
+const PaginationCursor = "cursor"
+
+// CursorQuery opts a listing into cursor pagination. Cursor pages are selected
+// by the position of the last (or first) item of the previous page instead of
+// an offset, which stays fast however far the listing is paged.
+type CursorQuery struct {
+	Pagination string `form:"pagination" binding:"omitempty,oneof=offset cursor"`
+	Cursor     string `form:"cursor"`
+	Count      bool   `form:"count"`
+}
+
+// CursorPaginatedResponse is a page of a listing that supports both offset and
+// cursor pagination. Offset pages carry the offset and total. Cursor pages
+// carry the cursors of the adjacent pages and only carry the total when it was
+// asked for with count=true.
+type CursorPaginatedResponse struct {
+	Data       any     `json:"data"`
+	Offset     *int    `json:"offset,omitempty"`
+	Limit      int     `json:"limit"`
+	Total      *int    `json:"total,omitempty"`
+	NextCursor *string `json:"next_cursor,omitempty"`
+	PrevCursor *string `json:"prev_cursor,omitempty"`
+}
+
+// pageCursor is the decoded form of the opaque cursors handed out to clients.
+// It carries the sort, so following a cursor keeps the order of the listing.
+type pageCursor struct {
+	Sort     string    `json:"s"`
+	Value    time.Time `json:"v"`
+	ID       uuid.UUID `json:"i"`
+	Backward bool      `json:"b,omitempty"`
+}
+
+func encodeCursor(cursor pageCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(value string) (pageCursor, error) {
+	var cursor pageCursor
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, middleware.NewBadRequestError("invalid cursor")
+	}
+
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return cursor, middleware.NewBadRequestError("invalid cursor")
+	}
+
+	return cursor, nil
+}
+
+// bindCursorQuery reports whether the request asked for cursor pagination and
+// returns the keyset of the requested page. Pages are sorted by created_at
+// unless another keyset column is requested with sort.
+func bindCursorQuery(c *gin.Context) (*models.KeysetOptions, CursorQuery, bool) {
+	var query CursorQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(err)
+		return nil, query, false
+	}
+
+	if query.Pagination != PaginationCursor && query.Cursor == "" {
+		return nil, query, true
+	}
+
+	if c.Query(request.OffsetQueryKey) != "" {
+		_ = c.Error(middleware.NewBadRequestError("offset cannot be used with cursor pagination"))
+		return nil, query, false
+	}
+
+	sort := &request.SortOptions{Column: "created_at"}
+	if requested := request.GetSortOptions(c); requested != nil {
+		sort = requested
+	}
+
+	keyset := &models.KeysetOptions{
+		Column: sort.Column,
+		Desc:   sort.Desc,
+		Limit:  request.GetNormalizedPaginationArgs(c).Limit,
+	}
+
+	if query.Cursor != "" {
+		cursor, err := decodeCursor(query.Cursor)
+		if err != nil {
+			_ = c.Error(err)
+			return nil, query, false
+		}
+
+		if c.Query("sort") != "" && formatCursorSort(keyset) != cursor.Sort {
+			_ = c.Error(middleware.NewBadRequestError("cursor was issued for a different sort"))
+			return nil, query, false
+		}
+
+		keyset.Column = strings.TrimPrefix(cursor.Sort, "-")
+		keyset.Desc = strings.HasPrefix(cursor.Sort, "-")
+		keyset.After = &models.KeysetPosition{Value: cursor.Value, ID: cursor.ID}
+		keyset.Backward = cursor.Backward
+	}
+
+	if !slices.Contains(models.KeysetColumns, keyset.Column) {
+		_ = c.Error(middleware.NewBadRequestError("cursor pagination can only sort by " + strings.Join(models.KeysetColumns, " or ")))
+		return nil, query, false
+	}
+
+	return keyset, query, true
+}
+
+func formatCursorSort(keyset *models.KeysetOptions) string {
+	if keyset.Desc {
+		return "-" + keyset.Column
+	}
+	return keyset.Column
+}
+
+// renderCursorPage responds with a cursor page of data. more reports whether
+// there are more items in the direction the page was selected in, position
+// returns the keyset position of an item.
+func renderCursorPage[T any](c *gin.Context, data []T, keyset *models.KeysetOptions, more bool, total *int, position func(T, string) models.KeysetPosition) {
+	response := CursorPaginatedResponse{
+		Data:  data,
+		Limit: keyset.Limit,
+		Total: total,
+	}
+
+	cursorAt := func(item T, backward bool) *string {
+		itemPosition := position(item, keyset.Column)
+		cursor := encodeCursor(pageCursor{
+			Sort:     formatCursorSort(keyset),
+			Value:    itemPosition.Value,
+			ID:       itemPosition.ID,
+			Backward: backward,
+		})
+		return &cursor
+	}
+
+	if len(data) > 0 {
+		first, last := data[0], data[len(data)-1]
+
+		if keyset.Backward {
+			response.NextCursor = cursorAt(last, false)
+			if more {
+				response.PrevCursor = cursorAt(first, true)
+			}
+		} else {
+			if more {
+				response.NextCursor = cursorAt(last, false)
+			}
+			if keyset.After != nil {
+				response.PrevCursor = cursorAt(first, true)
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// versionETag returns the entity tag of a reservation or purchase version.
+func versionETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+func setVersionETag(c *gin.Context, version int) {
+	c.Header("ETag", versionETag(version))
+}
+
+// checkIfMatch reports whether the request may change an entity at version.
+// Requests without an If-Match header always may, others fail with 412
+// Precondition Failed unless one of the listed tags is current.
+func checkIfMatch(c *gin.Context, version int) bool {
+	header := c.GetHeader("If-Match")
+	if header == "" || etagListMatches(header, versionETag(version), false) {
+		return true
+	}
+
+	_ = c.Error(&middleware.HttpError{
+		Code:    http.StatusPreconditionFailed,
+		Message: "Changed since it was fetched",
+	})
+	return false
+}
+
+// notModified responds with 304 Not Modified and reports true if the
+// If-None-Match header of the request lists the current tag of an entity at
+// version.
+func notModified(c *gin.Context, version int) bool {
+	header := c.GetHeader("If-None-Match")
+	if header == "" || !etagListMatches(header, versionETag(version), true) {
+		return false
+	}
+
+	setVersionETag(c, version)
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+// etagListMatches reports whether a comma separated list of entity tags
+// matches etag. Weak tags only match with weak comparison, which If-None-Match
+// uses and If-Match does not.
+func etagListMatches(header, etag string, weak bool) bool {
+	for candidate := range strings.SplitSeq(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if weak {
+			candidate = strings.TrimPrefix(candidate, "W/")
+		}
+		if candidate == etag {
+			return true
+		}
+	}
+	return false
+}
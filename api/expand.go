@@ -0,0 +1,152 @@
+package api
+
+import (
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	ExpandTimeSlot = "timeslot"
+	ExpandMovie    = "movie"
+	ExpandRoom     = "room"
+)
+
+type ReservationsQuery struct {
+	Expand []string `form:"expand" json:"expand" collection_format:"csv" binding:"omitempty,dive,oneof=timeslot movie room"`
+}
+
+type ReservationTimeSlotResponse struct {
+	MovieID   uuid.UUID `json:"movie_id"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+type ReservationMovieResponse struct {
+	ID            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	LengthMinutes int       `json:"length_minutes"`
+}
+
+type ReservationRoomResponse struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Rows    int       `json:"rows"`
+	Columns int       `json:"columns"`
+}
+
+func bindReservationsQuery(c *gin.Context) (ReservationsQuery, bool) {
+	var query ReservationsQuery
+	err := c.ShouldBindQuery(&query)
+	if err != nil {
+		_ = c.Error(err)
+		return ReservationsQuery{}, false
+	}
+	return query, true
+}
+
+// expandReservations embeds the time slot, movie and room of each reservation
+// as requested by expand. Every distinct entity is looked up in spored once and
+// the lookups run in parallel. Entities that no longer exist in spored are left
+// out, and when spored cannot be reached the reservations are returned as they
+// are with a Warning header instead of failing the request.
+func expandReservations(c *gin.Context, reservations []ReservationResponse, expand []string) {
+	if len(expand) == 0 || len(reservations) == 0 {
+		return
+	}
+
+	ctx := c.Request.Context()
+	resolver := GetScheduleResolver(c)
+	degraded := false
+
+	if slices.Contains(expand, ExpandTimeSlot) || slices.Contains(expand, ExpandMovie) {
+		refs := make([]services.TimeSlotRef, 0, len(reservations))
+		for _, reservation := range reservations {
+			refs = append(refs, services.TimeSlotRef{
+				TimeSlotID: reservation.TimeSlotID,
+				TheaterID:  reservation.TheaterID,
+				RoomID:     reservation.RoomID,
+			})
+		}
+
+		timeSlots, err := resolver.ResolveTimeSlots(ctx, refs)
+		if err != nil {
+			slog.Warn("failed to expand reservation time slots", "err", err)
+			degraded = true
+		}
+
+		movies := map[uuid.UUID]services.MovieInfo{}
+		if err == nil && slices.Contains(expand, ExpandMovie) {
+			movieIDs := []uuid.UUID{}
+			for _, timeSlot := range timeSlots {
+				if !slices.Contains(movieIDs, timeSlot.MovieID) {
+					movieIDs = append(movieIDs, timeSlot.MovieID)
+				}
+			}
+
+			movies, err = resolver.ResolveMovies(ctx, movieIDs)
+			if err != nil {
+				slog.Warn("failed to expand reservation movies", "err", err)
+				degraded = true
+			}
+		}
+
+		for i := range reservations {
+			timeSlot, ok := timeSlots[reservations[i].TimeSlotID]
+			if !ok {
+				continue
+			}
+
+			if slices.Contains(expand, ExpandTimeSlot) {
+				reservations[i].TimeSlot = &ReservationTimeSlotResponse{
+					MovieID:   timeSlot.MovieID,
+					StartTime: timeSlot.StartTime,
+					EndTime:   timeSlot.EndTime,
+				}
+			}
+
+			if movie, ok := movies[timeSlot.MovieID]; ok {
+				reservations[i].Movie = &ReservationMovieResponse{
+					ID:            movie.MovieID,
+					Name:          movie.Name,
+					LengthMinutes: movie.LengthMinutes,
+				}
+			}
+		}
+	}
+
+	if slices.Contains(expand, ExpandRoom) {
+		refs := make([]services.RoomRef, 0, len(reservations))
+		for _, reservation := range reservations {
+			refs = append(refs, services.RoomRef{TheaterID: reservation.TheaterID, RoomID: reservation.RoomID})
+		}
+
+		rooms, err := resolver.ResolveRooms(ctx, refs)
+		if err != nil {
+			slog.Warn("failed to expand reservation rooms", "err", err)
+			degraded = true
+		}
+
+		for i := range reservations {
+			room, ok := rooms[services.RoomRef{TheaterID: reservations[i].TheaterID, RoomID: reservations[i].RoomID}]
+			if !ok {
+				continue
+			}
+
+			reservations[i].Room = &ReservationRoomResponse{
+				ID:      room.RoomID,
+				Name:    room.Name,
+				Rows:    room.Rows,
+				Columns: room.Columns,
+			}
+		}
+	}
+
+	if degraded {
+		c.Header("Warning", `199 nakup "spored unavailable, reservation details were not expanded"`)
+	}
+}
@@ -0,0 +1,231 @@
+package api
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/PRPO-skupina-02/nakup/export"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/gin-gonic/gin"
+)
+
+const exportFlushEvery = 500
+
+var (
+	reservationExportHeader = []string{
+		"id",
+		"created_at",
+		"updated_at",
+		"time_slot_id",
+		"theater_id",
+		"room_id",
+		"user_id",
+		"type",
+		"row",
+		"col",
+		"purchase_count",
+		"purchases_total_cents",
+	}
+	purchaseExportHeader = []string{
+		"id",
+		"created_at",
+		"updated_at",
+		"reservation_id",
+		"time_slot_id",
+		"user_id",
+		"reservation_type",
+		"row",
+		"col",
+		"type",
+		"name",
+		"count",
+		"price_per_item_cents",
+		"line_total_cents",
+	}
+)
+
+// exportResponseWriter defers writing response headers until the export
+// produces its first bytes, so errors that happen before that can still be
+// rendered as regular JSON errors.
+type exportResponseWriter struct {
+	c        *gin.Context
+	format   export.Format
+	filename string
+	started  bool
+}
+
+func (w *exportResponseWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.c.Header("Content-Type", w.format.ContentType())
+		w.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-%s.%s"`, w.filename, time.Now().Format("20060102-150405"), w.format))
+		w.c.Status(http.StatusOK)
+	}
+	return w.c.Writer.Write(p)
+}
+
+func (w *exportResponseWriter) Flush() {
+	if w.started {
+		w.c.Writer.Flush()
+	}
+}
+
+// exportSort returns the requested sort, rejecting columns that are not among
+// the sortable columns of the export.
+func exportSort[V any](c *gin.Context, columns map[string]V) (*request.SortOptions, bool) {
+	sort := request.GetSortOptions(c)
+	if sort == nil {
+		return nil, true
+	}
+
+	if _, ok := columns[sort.Column]; !ok {
+		sortable := slices.Sorted(maps.Keys(columns))
+		_ = c.Error(middleware.NewBadRequestError(fmt.Sprintf("cannot sort by %s, sort by one of %s", sort.Column, strings.Join(sortable, ", "))))
+		return nil, false
+	}
+
+	return sort, true
+}
+
+func streamExport[T any](c *gin.Context, name string, header []string, each func(fn func(T) error) error, record func(T) []any) {
+	format, err := export.ParseFormat(c.Query("format"))
+	if err != nil {
+		_ = c.Error(middleware.NewBadRequestError(err.Error()))
+		return
+	}
+
+	out := &exportResponseWriter{c: c, format: format, filename: name}
+	writer := export.NewWriter(format, out, name, header)
+
+	rows := 0
+	err = each(func(row T) error {
+		if err := writer.Write(record(row)); err != nil {
+			return err
+		}
+
+		rows++
+		if rows%exportFlushEvery == 0 {
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			out.Flush()
+		}
+		return nil
+	})
+	if err != nil && !out.started {
+		_ = c.Error(err)
+		return
+	}
+	if err != nil {
+		// Headers and part of the body are already on the wire, so the
+		// client can only notice the failure through a truncated file.
+		slog.Error("export failed mid-stream", "export", name, "rows", rows, "err", err)
+		c.Abort()
+		return
+	}
+
+	err = writer.Close()
+	if err != nil {
+		slog.Error("failed to finish export", "export", name, "rows", rows, "err", err)
+		c.Abort()
+		return
+	}
+	out.Flush()
+}
+
+// ReservationsExport
+//
+//	@Id				ReservationsExport
+//	@Summary		Export reservations
+//	@Description	Stream all reservations as a CSV or XLSX file, including purchase totals per reservation
+//	@Tags			reservations
+//	@Produce		text/csv
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Security		BearerAuth
+//	@Param			format	query		string	false	"Export format"	Enums(csv, xlsx)	Default(csv)
+//	@Param			sort	query		string	false	"Sort by one of the exported columns, prefixed with - for descending order"
+//	@Success		200		{file}		file
+//	@Failure		400		{object}	middleware.HttpError
+//	@Failure		500		{object}	middleware.HttpError
+//	@Router			/reservations/export [get]
+func ReservationsExport(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	sort, ok := exportSort(c, models.ReservationExportSorts)
+	if !ok {
+		return
+	}
+
+	each := func(fn func(models.ReservationExportRow) error) error {
+		return models.EachReservationExportRow(tx, GetContextTheaterScope(c), sort, fn)
+	}
+
+	streamExport(c, "reservations", reservationExportHeader, each, func(row models.ReservationExportRow) []any {
+		return []any{
+			row.ID,
+			row.CreatedAt,
+			row.UpdatedAt,
+			row.TimeSlotID,
+			row.TheaterID,
+			row.RoomID,
+			row.UserID,
+			string(row.Type),
+			row.Row,
+			row.Col,
+			row.PurchaseCount,
+			row.PurchasesTotalCents,
+		}
+	})
+}
+
+// PurchasesExport
+//
+//	@Id				PurchasesExport
+//	@Summary		Export purchases
+//	@Description	Stream all purchases joined with their reservations as a CSV or XLSX file, including line totals
+//	@Tags			purchases
+//	@Produce		text/csv
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Security		BearerAuth
+//	@Param			format	query		string	false	"Export format"	Enums(csv, xlsx)	Default(csv)
+//	@Param			sort	query		string	false	"Sort by one of the exported columns, prefixed with - for descending order"
+//	@Success		200		{file}		file
+//	@Failure		400		{object}	middleware.HttpError
+//	@Failure		500		{object}	middleware.HttpError
+//	@Router			/purchases/export [get]
+func PurchasesExport(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	sort, ok := exportSort(c, models.PurchaseExportSorts)
+	if !ok {
+		return
+	}
+
+	each := func(fn func(models.PurchaseExportRow) error) error {
+		return models.EachPurchaseExportRow(tx, GetContextTheaterScope(c), sort, fn)
+	}
+
+	streamExport(c, "purchases", purchaseExportHeader, each, func(row models.PurchaseExportRow) []any {
+		return []any{
+			row.ID,
+			row.CreatedAt,
+			row.UpdatedAt,
+			row.ReservationID,
+			row.TimeSlotID,
+			row.UserID,
+			string(row.ReservationType),
+			row.Row,
+			row.Col,
+			string(row.Type),
+			row.Name,
+			row.Count,
+			row.PricePerItemCents,
+			row.LineTotalCents,
+		}
+	})
+}
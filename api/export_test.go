@@ -0,0 +1,175 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func assertExportResponse(t *testing.T, w *httptest.ResponseRecorder, format string) {
+	switch format {
+	case "xlsx":
+		assert.Equal(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", w.Header().Get("content-type"))
+		assert.Regexp(t, `^attachment; filename=".+\.xlsx"$`, w.Header().Get("content-disposition"))
+
+		archive, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+		require.NoError(t, err)
+
+		names := []string{}
+		for _, f := range archive.File {
+			names = append(names, f.Name)
+		}
+		assert.Contains(t, names, "xl/worksheets/sheet1.xml")
+	default:
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("content-type"))
+		assert.Regexp(t, `^attachment; filename=".+\.csv"$`, w.Header().Get("content-disposition"))
+
+		fileNamePath := fmt.Sprintf("testdata/%s.golden", t.Name())
+		xtesting.UpdateGoldenIfFlagSet(t, w.Body.Bytes(), fileNamePath)
+		assert.Equal(t, string(xtesting.ReadGoldenFile(t, fileNamePath)), w.Body.String())
+	}
+}
+
+func TestReservationsExport(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	tests := []struct {
+		name   string
+		status int
+		params string
+		format string
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+		},
+		{
+			name:   "ok-sort",
+			status: http.StatusOK,
+			params: "?sort=-row",
+		},
+		{
+			name:   "ok-sort-computed",
+			status: http.StatusOK,
+			params: "?sort=-purchases_total_cents",
+		},
+		{
+			name:   "ok-xlsx",
+			status: http.StatusOK,
+			params: "?format=xlsx",
+			format: "xlsx",
+		},
+		{
+			name:   "invalid-format",
+			status: http.StatusBadRequest,
+			params: "?format=pdf",
+			format: "json",
+		},
+		{
+			name:   "invalid-sort",
+			status: http.StatusBadRequest,
+			params: "?sort=password",
+			format: "json",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/reservations/export%s", testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			if testCase.format == "json" {
+				xtesting.AssertGoldenJSON(t, w)
+				return
+			}
+			assertExportResponse(t, w, testCase.format)
+		})
+	}
+}
+
+func TestPurchasesExport(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	tests := []struct {
+		name   string
+		status int
+		params string
+		format string
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+		},
+		{
+			name:   "ok-sort",
+			status: http.StatusOK,
+			params: "?sort=-price_per_item_cents",
+		},
+		{
+			name:   "ok-sort-computed",
+			status: http.StatusOK,
+			params: "?sort=-line_total_cents",
+		},
+		{
+			name:   "ok-xlsx",
+			status: http.StatusOK,
+			params: "?format=xlsx",
+			format: "xlsx",
+		},
+		{
+			name:   "invalid-format",
+			status: http.StatusBadRequest,
+			params: "?format=pdf",
+			format: "json",
+		},
+		{
+			name:   "invalid-sort",
+			status: http.StatusBadRequest,
+			params: "?sort=password",
+			format: "json",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/purchases/export%s", testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			if testCase.format == "json" {
+				xtesting.AssertGoldenJSON(t, w)
+				return
+			}
+			assertExportResponse(t, w, testCase.format)
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	heatmapCellSize = 24
+	heatmapCellGap  = 2
+	heatmapMargin   = 32
+)
+
+type HeatmapQuery struct {
+	TheaterID string `form:"theater_id" json:"theater_id" binding:"required,uuid"`
+	RoomID    string `form:"room_id" json:"room_id" binding:"required,uuid"`
+	DateRangeQuery
+}
+
+type HeatmapResponse struct {
+	TheaterID  uuid.UUID   `json:"theater_id"`
+	RoomID     uuid.UUID   `json:"room_id"`
+	RoomName   string      `json:"room_name"`
+	From       string      `json:"from"`
+	To         string      `json:"to"`
+	Rows       int         `json:"rows"`
+	Columns    int         `json:"columns"`
+	Screenings int         `json:"screenings"`
+	Bookings   int         `json:"bookings"`
+	Counts     [][]int     `json:"counts"`
+	Scores     [][]float64 `json:"scores"`
+}
+
+// ReportsHeatmap
+//
+//	@Id				ReportsHeatmap
+//	@Summary		Seat popularity heatmap
+//	@Description	Bookings per seat of a room for screenings starting within the date range. Every booking is scored by how early it was made relative to the other bookings of the same screening: the earliest booking scores 1 and a booking made right at the screening start scores 0.
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Produce		image/svg+xml
+//	@Security		BearerAuth
+//	@Param			theater_id	query		string	true	"Theater ID"						Format(uuid)
+//	@Param			room_id		query		string	true	"Room ID"							Format(uuid)
+//	@Param			from		query		string	true	"First screening date (YYYY-MM-DD)"	Format(date)
+//	@Param			to			query		string	true	"Last screening date (YYYY-MM-DD)"	Format(date)
+//	@Param			format		query		string	false	"Response format"					Enums(json, svg)	Default(json)
+//	@Success		200			{object}	HeatmapResponse
+//	@Failure		400			{object}	middleware.HttpError
+//	@Failure		403			{object}	middleware.HttpError
+//	@Failure		404			{object}	middleware.HttpError
+//	@Failure		500			{object}	middleware.HttpError
+//	@Failure		503			{object}	middleware.HttpError
+//	@Router			/reports/heatmap [get]
+func ReportsHeatmap(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	resolver := GetScheduleResolver(c)
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "svg" {
+		_ = c.Error(middleware.NewBadRequestError(fmt.Sprintf("unsupported heatmap format %q", format)))
+		return
+	}
+
+	var query HeatmapQuery
+	err := c.ShouldBindQuery(&query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	from, to, err := query.Bounds()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	roomRef := services.RoomRef{
+		TheaterID: uuid.MustParse(query.TheaterID),
+		RoomID:    uuid.MustParse(query.RoomID),
+	}
+
+	if !CanAccessTheater(c, roomRef.TheaterID) {
+		_ = c.Error(middleware.NewForbiddenError("Not assigned to the theater"))
+		return
+	}
+
+	rooms, err := resolver.ResolveRooms(c.Request.Context(), []services.RoomRef{roomRef})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	room, ok := rooms[roomRef]
+	if !ok {
+		_ = c.Error(middleware.NewNamedNotFoundError("room"))
+		return
+	}
+
+	bookings, err := models.GetRoomSeatBookings(tx, roomRef.TheaterID, roomRef.RoomID, from, to)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	refs := make([]services.TimeSlotRef, 0, len(bookings))
+	for _, booking := range bookings {
+		refs = append(refs, services.TimeSlotRef{
+			TimeSlotID: booking.TimeSlotID,
+			TheaterID:  roomRef.TheaterID,
+			RoomID:     roomRef.RoomID,
+		})
+	}
+
+	timeSlots, err := resolver.ResolveTimeSlots(c.Request.Context(), refs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := HeatmapResponse{
+		TheaterID: room.TheaterID,
+		RoomID:    room.RoomID,
+		RoomName:  room.Name,
+		From:      from.Format(time.DateOnly),
+		To:        to.AddDate(0, 0, -1).Format(time.DateOnly),
+		Rows:      room.Rows,
+		Columns:   room.Columns,
+		Counts:    make([][]int, room.Rows),
+		Scores:    make([][]float64, room.Rows),
+	}
+	for i := range room.Rows {
+		response.Counts[i] = make([]int, room.Columns)
+		response.Scores[i] = make([]float64, room.Columns)
+	}
+
+	screenings := map[uuid.UUID][]models.SeatBooking{}
+	for _, booking := range bookings {
+		timeSlot, ok := timeSlots[booking.TimeSlotID]
+		if !ok {
+			continue
+		}
+
+		startTime := timeSlot.StartTime
+		if startTime.Before(from) || !startTime.Before(to) {
+			continue
+		}
+
+		// The room may have shrunk since the seat was sold.
+		if booking.Row < 1 || booking.Row > room.Rows || booking.Col < 1 || booking.Col > room.Columns {
+			continue
+		}
+
+		screenings[booking.TimeSlotID] = append(screenings[booking.TimeSlotID], booking)
+	}
+
+	for timeSlotID, screeningBookings := range screenings {
+		startTime := timeSlots[timeSlotID].StartTime
+
+		longestLead := time.Duration(0)
+		for _, booking := range screeningBookings {
+			longestLead = max(longestLead, startTime.Sub(booking.CreatedAt))
+		}
+
+		for _, booking := range screeningBookings {
+			weight := 1.0
+			if longestLead > 0 {
+				weight = max(startTime.Sub(booking.CreatedAt), 0).Seconds() / longestLead.Seconds()
+			}
+
+			response.Counts[booking.Row-1][booking.Col-1]++
+			response.Scores[booking.Row-1][booking.Col-1] += weight
+		}
+
+		response.Screenings++
+		response.Bookings += len(screeningBookings)
+	}
+
+	for _, row := range response.Scores {
+		for i, score := range row {
+			row[i] = math.Round(score*100) / 100
+		}
+	}
+
+	if format == "svg" {
+		c.Header("Content-Type", "image/svg+xml")
+		c.Status(http.StatusOK)
+		renderHeatmapSVG(c.Writer, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// renderHeatmapSVG draws the seat scores as a grid of cells shaded from white
+// (never booked) to red (highest score), with the screen at the top.
+func renderHeatmapSVG(w io.Writer, heatmap HeatmapResponse) {
+	maxScore := 0.0
+	for _, row := range heatmap.Scores {
+		for _, score := range row {
+			maxScore = max(maxScore, score)
+		}
+	}
+
+	step := heatmapCellSize + heatmapCellGap
+	width := 2*heatmapMargin + heatmap.Columns*step
+	height := 2*heatmapMargin + heatmap.Rows*step
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="10">`+"\n", width, height, width, height)
+	fmt.Fprintf(&b, `<rect x="%d" y="8" width="%d" height="6" fill="#999999"/>`+"\n", heatmapMargin, heatmap.Columns*step-heatmapCellGap)
+	fmt.Fprintf(&b, `<text x="%d" y="26" text-anchor="middle">SCREEN</text>`+"\n", width/2)
+
+	for r := range heatmap.Rows {
+		y := heatmapMargin + r*step
+		fmt.Fprintf(&b, `<text x="%d" y="%d" text-anchor="end">%d</text>`+"\n", heatmapMargin-6, y+heatmapCellSize/2+4, r+1)
+
+		for col := range heatmap.Columns {
+			x := heatmapMargin + col*step
+
+			intensity := 0.0
+			if maxScore > 0 {
+				intensity = heatmap.Scores[r][col] / maxScore
+			}
+
+			fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"><title>Row %d, seat %d (bookings: %d, score: %g)</title></rect>`+"\n",
+				x, y, heatmapCellSize, heatmapCellSize, heatmapColor(intensity), r+1, col+1, heatmap.Counts[r][col], heatmap.Scores[r][col])
+		}
+	}
+
+	b.WriteString("</svg>\n")
+
+	_, _ = io.WriteString(w, b.String())
+}
+
+func heatmapColor(intensity float64) string {
+	channel := func(from, to int) int {
+		return from + int(math.Round(float64(to-from)*intensity))
+	}
+	return fmt.Sprintf("#%02x%02x%02x", channel(0xf5, 0xc6), channel(0xf5, 0x28), channel(0xf5, 0x28))
+}
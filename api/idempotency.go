@@ -0,0 +1,142 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKeyHeader lets clients retry POST requests safely. Retries with
+// the same key and body are answered with the stored response of the first
+// successful attempt, which is marked with IdempotentReplayedHeader.
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// maxIdempotencyKeyLength limits the length of idempotency keys sent by
+// clients.
+const maxIdempotencyKeyLength = 255
+
+// idempotencyResponseWriter keeps a copy of the response body, so it can be
+// stored for retries.
+type idempotencyResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *idempotencyResponseWriter) Write(p []byte) (int, error) {
+	w.body.Write(p)
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *idempotencyResponseWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// IdempotencyMiddleware handles POST requests with an Idempotency-Key header
+// at most once per key. Only successful responses are stored, so failed
+// requests can be retried with the same key. The key is claimed in the
+// request's transaction, which makes concurrent retries wait for the first
+// attempt. It is used on each POST route after the route's authorization
+// middleware, so requests that are not allowed never claim or replay a key.
+func IdempotencyMiddleware(c *gin.Context) {
+	key := c.GetHeader(IdempotencyKeyHeader)
+	if c.Request.Method != http.MethodPost || key == "" {
+		c.Next()
+		return
+	}
+
+	if len(key) > maxIdempotencyKeyLength {
+		c.AbortWithStatusJSON(http.StatusBadRequest, middleware.NewBadRequestError("Idempotency-Key is too long"))
+		return
+	}
+
+	owner := requestOwner(c)
+	if c.IsAborted() {
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	tx := middleware.GetContextTransaction(c)
+	now := time.Now()
+
+	claim := models.IdempotencyKey{
+		ID:          uuid.New(),
+		ExpiresAt:   now.Add(models.IdempotencyKeyTTL),
+		Owner:       owner,
+		Key:         key,
+		RequestHash: hashIdempotentRequest(c.Request, body),
+	}
+
+	existing, claimed, err := models.ClaimIdempotencyKey(tx, &claim, now)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	if !claimed {
+		if existing.RequestHash != claim.RequestHash {
+			c.AbortWithStatusJSON(http.StatusUnprocessableEntity, &middleware.HttpError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: "Idempotency-Key was already used for a different request",
+			})
+			return
+		}
+
+		c.Header(IdempotentReplayedHeader, "true")
+		c.Data(existing.StatusCode, existing.ContentType, existing.Body)
+		c.Abort()
+		return
+	}
+
+	writer := &idempotencyResponseWriter{ResponseWriter: c.Writer}
+	c.Writer = writer
+
+	c.Next()
+
+	// Errors are only written by the error middleware, and they roll the
+	// transaction and with it the claim back.
+	if len(c.Errors) > 0 {
+		return
+	}
+
+	status := writer.Status()
+	if status < http.StatusOK || status >= http.StatusMultipleChoices {
+		if err := models.DeleteIdempotencyKey(tx, claim.ID); err != nil {
+			_ = c.Error(err)
+		}
+		return
+	}
+
+	claim.StatusCode = status
+	claim.ContentType = writer.Header().Get("Content-Type")
+	claim.Body = writer.body.Bytes()
+
+	if err := claim.Save(tx); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+// hashIdempotentRequest identifies a request by its method, URL and body.
+func hashIdempotentRequest(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
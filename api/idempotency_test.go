@@ -0,0 +1,176 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	authmodels "github.com/PRPO-skupina-02/common/clients/auth/models"
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	water := PurchaseRequest{
+		Type:              string(models.Drink),
+		Name:              "Water",
+		Count:             1,
+		PricePerItemCents: 200,
+	}
+	juice := PurchaseRequest{
+		Type:              string(models.Drink),
+		Name:              "Juice",
+		Count:             2,
+		PricePerItemCents: 300,
+	}
+
+	type attempt struct {
+		key      string
+		body     any
+		status   int
+		replayed bool
+	}
+
+	tests := []struct {
+		name     string
+		attempts []attempt
+	}{
+		{
+			name: "replayed",
+			attempts: []attempt{
+				{key: "retry-key", body: water, status: http.StatusCreated},
+				{key: "retry-key", body: water, status: http.StatusCreated, replayed: true},
+			},
+		},
+		{
+			name: "different-body",
+			attempts: []attempt{
+				{key: "retry-key", body: water, status: http.StatusCreated},
+				{key: "retry-key", body: juice, status: http.StatusUnprocessableEntity},
+			},
+		},
+		{
+			name: "different-keys",
+			attempts: []attempt{
+				{key: "first-key", body: water, status: http.StatusCreated},
+				{key: "second-key", body: water, status: http.StatusCreated},
+			},
+		},
+		{
+			name: "without-key",
+			attempts: []attempt{
+				{body: water, status: http.StatusCreated},
+				{body: water, status: http.StatusCreated},
+			},
+		},
+		{
+			name: "failed-request-not-stored",
+			attempts: []attempt{
+				{key: "retry-key", status: http.StatusBadRequest},
+				{key: "retry-key", body: water, status: http.StatusCreated},
+			},
+		},
+		{
+			name: "expired-key",
+			attempts: []attempt{
+				{key: "expired-key", body: water, status: http.StatusCreated},
+			},
+		},
+		{
+			name: "key-of-other-user",
+			attempts: []attempt{
+				{key: "shared-key", body: water, status: http.StatusCreated},
+			},
+		},
+		{
+			name: "key-too-long",
+			attempts: []attempt{
+				{key: strings.Repeat("k", 256), body: water, status: http.StatusBadRequest},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			var first *httptest.ResponseRecorder
+			var w *httptest.ResponseRecorder
+
+			for _, attempt := range testCase.attempts {
+				req := xtesting.NewTestingRequest(t, "/api/v1/nakup/reservations/ea0b7f96-ddc9-11f0-9635-23efd36396bd/purchases", http.MethodPost, attempt.body)
+				if attempt.key != "" {
+					req.Header.Set(IdempotencyKeyHeader, attempt.key)
+				}
+				w = httptest.NewRecorder()
+
+				r.ServeHTTP(w, req)
+
+				assert.Equal(t, attempt.status, w.Code)
+				if attempt.replayed {
+					assert.Equal(t, "true", w.Header().Get(IdempotentReplayedHeader))
+					assert.Equal(t, first.Body.String(), w.Body.String())
+				} else {
+					assert.Empty(t, w.Header().Get(IdempotentReplayedHeader))
+				}
+
+				if first == nil {
+					first = w
+				}
+			}
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"id":         xtesting.ValueUUID(),
+				"created_at": xtesting.ValueTime(),
+				"updated_at": xtesting.ValueTime(),
+			}
+
+			ignorePurchases := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"ID": xtesting.ValueUUID(), "CreatedAt": xtesting.ValueTime(), "UpdatedAt": xtesting.ValueTime()}, 10)
+
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db.Where("reservation_id = ?", "ea0b7f96-ddc9-11f0-9635-23efd36396bd"), []models.Purchase{}, ignorePurchases)
+		})
+	}
+}
+
+func TestIdempotencyKeysAfterAuthorization(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	err := fixtures.Load()
+	require.NoError(t, err)
+
+	service := services.NewMockTimeSlotService()
+	r := TestingRouterWithUser(t, db, service, uuid.MustParse("00000000-0000-0000-0000-000000000002"), authmodels.ModelsUserRoleCustomer)
+
+	water := PurchaseRequest{
+		Type:              string(models.Drink),
+		Name:              "Water",
+		Count:             1,
+		PricePerItemCents: 200,
+	}
+
+	// Requests without permission are rejected before they claim the key.
+	req := xtesting.NewTestingRequest(t, "/api/v1/nakup/reservations/ea0b7f96-ddc9-11f0-9635-23efd36396bd/purchases", http.MethodPost, water)
+	req.Header.Set(IdempotencyKeyHeader, "forbidden-key")
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	var count int64
+	err = db.Model(&models.IdempotencyKey{}).Where("key = ?", "forbidden-key").Count(&count).Error
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
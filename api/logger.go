@@ -0,0 +1,64 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// redactedQueryParams carry credentials, so their values are left out of the
+// access log. Streams no longer accept access_token and api_key, but clients
+// may still send them.
+var redactedQueryParams = []string{StreamTicketQueryParam, "access_token", "api_key"}
+
+// LoggerMiddleware logs requests in the format of gin.Logger, with the
+// credentials in their query strings redacted.
+func LoggerMiddleware() gin.HandlerFunc {
+	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
+		var statusColor, methodColor, resetColor string
+		if param.IsOutputColor() {
+			statusColor = param.StatusCodeColor()
+			methodColor = param.MethodColor()
+			resetColor = param.ResetColor()
+		}
+
+		if param.Latency > time.Minute {
+			param.Latency = param.Latency.Truncate(time.Second)
+		}
+
+		return fmt.Sprintf("[GIN] %v |%s %3d %s| %13v | %15s |%s %-7s %s %#v\n%s",
+			param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+			statusColor, param.StatusCode, resetColor,
+			param.Latency,
+			param.ClientIP,
+			methodColor, param.Method, resetColor,
+			redactPath(param.Path),
+			param.ErrorMessage,
+		)
+	})
+}
+
+// redactPath replaces the values of redactedQueryParams in a path with its
+// query string. Query strings that cannot be parsed are left out entirely.
+func redactPath(path string) string {
+	path, rawQuery, found := strings.Cut(path, "?")
+	if !found {
+		return path
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return path + "?REDACTED"
+	}
+
+	for _, param := range redactedQueryParams {
+		if query.Has(param) {
+			query.Set(param, "REDACTED")
+		}
+	}
+
+	return path + "?" + query.Encode()
+}
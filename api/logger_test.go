@@ -0,0 +1,47 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactPath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "no-query",
+			path: "/api/v1/nakup/reservations",
+			want: "/api/v1/nakup/reservations",
+		},
+		{
+			name: "no-credentials",
+			path: "/api/v1/nakup/reservations?limit=10",
+			want: "/api/v1/nakup/reservations?limit=10",
+		},
+		{
+			name: "ticket",
+			path: "/api/v1/nakup/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/seats/stream?ticket=secret",
+			want: "/api/v1/nakup/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/seats/stream?ticket=REDACTED",
+		},
+		{
+			name: "legacy-credentials",
+			path: "/api/v1/nakup/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/seats/stream?access_token=secret&api_key=secret",
+			want: "/api/v1/nakup/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/seats/stream?access_token=REDACTED&api_key=REDACTED",
+		},
+		{
+			name: "malformed-query",
+			path: "/api/v1/nakup/reservations?ticket=%zz",
+			want: "/api/v1/nakup/reservations?REDACTED",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, redactPath(testCase.path))
+		})
+	}
+}
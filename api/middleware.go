@@ -2,24 +2,64 @@ package api
 
 import (
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/PRPO-skupina-02/common/middleware"
 	"github.com/PRPO-skupina-02/common/request"
 	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/seats"
 	"github.com/PRPO-skupina-02/nakup/services"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 const (
-	TimeSlotServiceKey    = "timeslot_service"
-	contextReservationKey = "reservation"
+	TimeSlotServiceKey      = "timeslot_service"
+	ScheduleResolverKey     = "schedule_resolver"
+	SeatWatcherKey          = "seat_watcher"
+	TicketPriceCentsKey     = "ticket_price_cents"
+	PermissionsKey          = "permissions"
+	contextReservationKey   = "reservation"
+	contextWebhookKey       = "webhook"
+	contextAPIKeyKey        = "api_key"
+	contextManagedAPIKeyKey = "managed_api_key"
+	contextTheaterScopeKey  = "theater_scope"
+	contextRequestIDKey     = "request_id"
 )
 
+// RequestIDHeader carries the ID of a request, which is generated unless the
+// client sends one.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength limits the length of request IDs sent by clients.
+const maxRequestIDLength = 128
+
+// DatabaseMiddleware sets db as the context transaction without beginning
+// one, so every statement commits on its own. It replaces the transaction
+// middleware on long-lived requests such as streams, which must not hold on
+// to a connection for as long as they are open.
+func DatabaseMiddleware(db *gorm.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		middleware.SetContextTransaction(c, db.WithContext(c.Request.Context()))
+		c.Next()
+	}
+}
+
+// TimeSlotServiceMiddleware must be used after the error middleware, so that
+// it can tell clients when to retry before the error is written.
 func TimeSlotServiceMiddleware(service services.TimeSlotService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Set(TimeSlotServiceKey, service)
 		c.Next()
+
+		var unavailable *services.UnavailableError
+		if err := c.Errors.Last(); err != nil && errors.As(err, &unavailable) {
+			retryAfter := int(math.Ceil(unavailable.RetryAfter.Seconds()))
+			c.Header("Retry-After", strconv.Itoa(max(retryAfter, 1)))
+		}
 	}
 }
 
@@ -31,6 +71,62 @@ func GetTimeSlotService(c *gin.Context) services.TimeSlotService {
 	return timeSlotService.(services.TimeSlotService)
 }
 
+func ScheduleResolverMiddleware(resolver *services.ScheduleResolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(ScheduleResolverKey, resolver)
+		c.Next()
+	}
+}
+
+func TicketPriceMiddleware(ticketPriceCents int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(TicketPriceCentsKey, ticketPriceCents)
+		c.Next()
+	}
+}
+
+func PermissionsMiddleware(permissions Permissions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(PermissionsKey, permissions)
+		c.Next()
+	}
+}
+
+func SeatWatcherMiddleware(watcher *seats.Watcher) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(SeatWatcherKey, watcher)
+		c.Next()
+	}
+}
+
+func GetSeatWatcher(c *gin.Context) *seats.Watcher {
+	watcher, exists := c.Get(SeatWatcherKey)
+	if !exists {
+		return nil
+	}
+	return watcher.(*seats.Watcher)
+}
+
+func GetScheduleResolver(c *gin.Context) *services.ScheduleResolver {
+	resolver, exists := c.Get(ScheduleResolverKey)
+	if !exists {
+		return nil
+	}
+	return resolver.(*services.ScheduleResolver)
+}
+
+func GetTicketPriceCents(c *gin.Context) int {
+	return c.GetInt(TicketPriceCentsKey)
+}
+
+func GetPermissions(c *gin.Context) Permissions {
+	permissions, exists := c.Get(PermissionsKey)
+	if !exists {
+		return nil
+	}
+	return permissions.(Permissions)
+}
+
 func SetContextReservation(c *gin.Context, reservation models.Reservation) {
 	c.Set(contextReservationKey, reservation)
 }
@@ -53,7 +149,14 @@ func ReservationContextMiddleware(c *gin.Context) {
 		return
 	}
 
-	reservation, err := models.GetReservation(tx, id)
+	// Requests that change the reservation or its purchases lock it, so
+	// that the version they check If-Match against stays current.
+	getReservation := models.GetReservationForUpdate
+	if c.Request.Method == http.MethodGet {
+		getReservation = models.GetReservation
+	}
+
+	reservation, err := getReservation(tx, id)
 	if err != nil {
 		_ = c.AbortWithError(http.StatusNotFound, err)
 		return
@@ -63,3 +166,163 @@ func ReservationContextMiddleware(c *gin.Context) {
 
 	c.Next()
 }
+
+// DeletedReservationContextMiddleware is ReservationContextMiddleware for
+// endpoints that operate on deleted reservations.
+func DeletedReservationContextMiddleware(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	id, err := request.GetUUIDParam(c, "reservationID")
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	reservation, err := models.GetDeletedReservation(tx, id)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	SetContextReservation(c, reservation)
+
+	c.Next()
+}
+
+func SetContextWebhook(c *gin.Context, subscription models.WebhookSubscription) {
+	c.Set(contextWebhookKey, subscription)
+}
+
+func GetContextWebhook(c *gin.Context) models.WebhookSubscription {
+	subscription, ok := c.Get(contextWebhookKey)
+	if !ok {
+		_ = c.AbortWithError(http.StatusInternalServerError, errors.New("Could not get webhook from context"))
+		return models.WebhookSubscription{}
+	}
+
+	return subscription.(models.WebhookSubscription)
+}
+
+func WebhookContextMiddleware(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	id, err := request.GetUUIDParam(c, "webhookID")
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	subscription, err := models.GetWebhookSubscription(tx, id)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	SetContextWebhook(c, subscription)
+
+	c.Next()
+}
+
+// SetContextAPIKey stores the API key the request was authenticated with.
+func SetContextAPIKey(c *gin.Context, apiKey models.APIKey) {
+	c.Set(contextAPIKeyKey, &apiKey)
+}
+
+// GetContextAPIKey returns the API key the request was authenticated with, or
+// nil if it was made by a user.
+func GetContextAPIKey(c *gin.Context) *models.APIKey {
+	apiKey, ok := c.Get(contextAPIKeyKey)
+	if !ok {
+		return nil
+	}
+
+	return apiKey.(*models.APIKey)
+}
+
+func SetContextManagedAPIKey(c *gin.Context, apiKey models.APIKey) {
+	c.Set(contextManagedAPIKeyKey, apiKey)
+}
+
+func GetContextManagedAPIKey(c *gin.Context) models.APIKey {
+	apiKey, ok := c.Get(contextManagedAPIKeyKey)
+	if !ok {
+		_ = c.AbortWithError(http.StatusInternalServerError, errors.New("Could not get API key from context"))
+		return models.APIKey{}
+	}
+
+	return apiKey.(models.APIKey)
+}
+
+func APIKeyContextMiddleware(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	id, err := request.GetUUIDParam(c, "apiKeyID")
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	apiKey, err := models.GetAPIKey(tx, id)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	SetContextManagedAPIKey(c, apiKey)
+
+	c.Next()
+}
+
+// SetContextTheaterScope limits the request to reservations of theaterIDs.
+func SetContextTheaterScope(c *gin.Context, theaterIDs []uuid.UUID) {
+	c.Set(contextTheaterScopeKey, theaterIDs)
+}
+
+// GetContextTheaterScope returns the theaters the request is limited to, or
+// nil if it may access every theater.
+func GetContextTheaterScope(c *gin.Context) []uuid.UUID {
+	theaterIDs, ok := c.Get(contextTheaterScopeKey)
+	if !ok {
+		return nil
+	}
+
+	return theaterIDs.([]uuid.UUID)
+}
+
+// RequestIDMiddleware takes the request ID from the X-Request-ID header or
+// generates one, and echoes it in the response.
+func RequestIDMiddleware(c *gin.Context) {
+	requestID := c.GetHeader(RequestIDHeader)
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		requestID = uuid.NewString()
+	}
+
+	c.Set(contextRequestIDKey, requestID)
+	c.Header(RequestIDHeader, requestID)
+
+	c.Next()
+}
+
+func GetContextRequestID(c *gin.Context) string {
+	return c.GetString(contextRequestIDKey)
+}
+
+// AuditMiddleware attributes changes made in the request's transaction to the
+// authenticated user or API key. It must be used after AuthMiddleware.
+func AuditMiddleware(c *gin.Context) {
+	actor := models.AuditActor{
+		RequestID: GetContextRequestID(c),
+	}
+
+	if apiKey := GetContextAPIKey(c); apiKey != nil {
+		actor.APIKeyID = &apiKey.ID
+	} else {
+		userID := middleware.GetContextUserID(c)
+		if c.IsAborted() {
+			return
+		}
+		actor.UserID = &userID
+	}
+
+	tx := middleware.GetContextTransaction(c)
+	middleware.SetContextTransaction(c, tx.WithContext(models.WithAuditActor(c.Request.Context(), actor)))
+
+	c.Next()
+}
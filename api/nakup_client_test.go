@@ -0,0 +1,120 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/purchases"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/reservations"
+	clientmodels "github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestingNakupClient talks to the API as an admin, so the client tests can
+// cover the whole reservation lifecycle.
+func newTestingNakupClient(t *testing.T, service services.TimeSlotService) *nakup.Client {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	err := fixtures.Load()
+	require.NoError(t, err)
+
+	server := httptest.NewServer(AdminTestingRouter(t, db, service))
+	t.Cleanup(server.Close)
+
+	return nakup.New(strings.TrimPrefix(server.URL, "http://"), nakup.WithToken("token"))
+}
+
+func TestNakupClientReservations(t *testing.T) {
+	theaterID := "bae209f6-d059-11f0-b2a4-cbf992c2eb6d"
+	roomID := "925c2358-df46-11f0-a38e-abe580bde3d1"
+	timeSlotID := "9d71d7fd-d88e-41a1-86dc-21b7f2550295"
+
+	service := services.NewMockTimeSlotService()
+	service.AddValidTimeSlotWithRoom(uuid.MustParse(theaterID), uuid.MustParse(roomID), uuid.MustParse(timeSlotID), 10, 15)
+	client := newTestingNakupClient(t, service)
+
+	request := &clientmodels.APIReservationRequest{
+		TimeSlotID: swag.String(timeSlotID),
+		TheaterID:  swag.String(theaterID),
+		RoomID:     swag.String(roomID),
+		Type:       swag.String(clientmodels.APIReservationRequestTypeONLINE),
+		Row:        swag.Int64(7),
+		Col:        swag.Int64(12),
+	}
+
+	created, err := client.Reservations.ReservationsCreate(reservations.NewReservationsCreateParams().WithRequest(request), nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), created.Payload.Row)
+	assert.Equal(t, int64(12), created.Payload.Col)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", created.Payload.UserID)
+	reservationID := strfmt.UUID(created.Payload.ID)
+
+	_, err = client.Reservations.ReservationsCreate(reservations.NewReservationsCreateParams().WithRequest(request), nil)
+	assert.Equal(t, middleware.NewBadRequestError("seat already reserved"), err)
+
+	request.Row = swag.Int64(11)
+	_, err = client.Reservations.ReservationsCreate(reservations.NewReservationsCreateParams().WithRequest(request), nil)
+	var httpError *middleware.HttpError
+	require.True(t, errors.As(err, &httpError))
+	assert.Equal(t, http.StatusBadRequest, httpError.Code)
+	assert.Equal(t, map[string]string{"row": "row must be 10 or less"}, httpError.Fields)
+
+	shown, err := client.Reservations.ReservationsShow(reservations.NewReservationsShowParams().WithReservationID(reservationID), nil)
+	require.NoError(t, err)
+	assert.Equal(t, created.Payload.ID, shown.Payload.ID)
+	assert.Equal(t, created.Payload.TimeSlotID, shown.Payload.TimeSlotID)
+
+	purchase, err := client.Purchases.PurchasesCreate(purchases.NewPurchasesCreateParams().
+		WithReservationID(reservationID).
+		WithRequest(&clientmodels.APIPurchaseRequest{
+			Type:              swag.String(clientmodels.APIPurchaseRequestTypeFOOD),
+			Name:              swag.String("Popcorn"),
+			Count:             swag.Int64(2),
+			PricePerItemCents: swag.Int64(450),
+		}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Popcorn", purchase.Payload.Name)
+
+	ids := []string{}
+	for reservation, err := range client.AllReservations(reservations.NewReservationsListParams().WithLimit(swag.Int64(2))) {
+		require.NoError(t, err)
+		ids = append(ids, reservation.ID)
+	}
+	assert.Len(t, ids, 4, "all fixture reservations and the created one are listed across pages")
+	assert.Contains(t, ids, created.Payload.ID)
+
+	_, err = client.Reservations.ReservationsDelete(reservations.NewReservationsDeleteParams().WithReservationID(reservationID), nil)
+	require.NoError(t, err)
+
+	_, err = client.Reservations.ReservationsShow(reservations.NewReservationsShowParams().WithReservationID(reservationID), nil)
+	assert.Equal(t, middleware.NewNotFoundError(), err)
+}
+
+func TestNakupClientSporedUnavailable(t *testing.T) {
+	service := services.NewMockTimeSlotService()
+	service.ShouldError = true
+	service.Error = &services.UnavailableError{}
+	client := newTestingNakupClient(t, service)
+
+	_, err := client.Reservations.ReservationsCreate(reservations.NewReservationsCreateParams().WithRequest(&clientmodels.APIReservationRequest{
+		TimeSlotID: swag.String("9d71d7fd-d88e-41a1-86dc-21b7f2550295"),
+		TheaterID:  swag.String("bae209f6-d059-11f0-b2a4-cbf992c2eb6d"),
+		RoomID:     swag.String("925c2358-df46-11f0-a38e-abe580bde3d1"),
+		Type:       swag.String(clientmodels.APIReservationRequestTypePOS),
+		Row:        swag.Int64(1),
+		Col:        swag.Int64(2),
+	}), nil)
+
+	assert.Equal(t, &middleware.HttpError{Code: http.StatusServiceUnavailable, Message: "spored unavailable"}, err)
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// bindMergePatch applies the JSON Merge Patch (RFC 7396) in the request body
+// to current and binds the result to obj. The result is validated like
+// ShouldBindJSON would, so fields the patch removes fail their required rules.
+func bindMergePatch(c *gin.Context, current any, obj any) error {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return err
+	}
+
+	var patch any
+	if err := json.Unmarshal(body, &patch); err != nil {
+		return middleware.NewBadRequestError("invalid merge patch document")
+	}
+
+	if _, ok := patch.(map[string]any); !ok {
+		return middleware.NewBadRequestError("merge patch document must be an object")
+	}
+
+	document, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+
+	var target any
+	if err := json.Unmarshal(document, &target); err != nil {
+		return err
+	}
+
+	merged, err := json.Marshal(mergePatch(target, patch))
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(merged, obj); err != nil {
+		return middleware.NewBadRequestError("invalid merge patch document")
+	}
+
+	return binding.Validator.ValidateStruct(obj)
+}
+
+// mergePatch merges patch into target. Members set to null are removed,
+// objects are merged recursively and any other value replaces the target.
+func mergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+
+	return targetObject
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	authmodels "github.com/PRPO-skupina-02/common/clients/auth/models"
+)
+
+// Permission names an action a role can be allowed to perform.
+type Permission string
+
+const (
+	PermissionReservationList      Permission = "reservation.list"
+	PermissionReservationView      Permission = "reservation.view"
+	PermissionReservationUpdate    Permission = "reservation.update"
+	PermissionReservationDelete    Permission = "reservation.delete"
+	PermissionReservationRestore   Permission = "reservation.restore"
+	PermissionReservationExport    Permission = "reservation.export"
+	PermissionReservationReconcile Permission = "reservation.reconcile"
+	PermissionAuditView            Permission = "audit.view"
+	PermissionPurchaseView         Permission = "purchase.view"
+	PermissionPurchaseCreate       Permission = "purchase.create"
+	PermissionPurchaseUpdate       Permission = "purchase.update"
+	PermissionPurchaseDelete       Permission = "purchase.delete"
+	PermissionPurchaseRestore      Permission = "purchase.restore"
+	PermissionPurchaseExport       Permission = "purchase.export"
+	PermissionReportView           Permission = "report.view"
+	PermissionRefundView           Permission = "refund.view"
+	PermissionRefundComplete       Permission = "refund.complete"
+	PermissionWebhookManage        Permission = "webhook.manage"
+	PermissionStaffManage          Permission = "staff.manage"
+	PermissionAPIKeyManage         Permission = "apikey.manage"
+	PermissionSporedManage         Permission = "spored.manage"
+)
+
+// AllPermissions lists every known permission.
+var AllPermissions = []Permission{
+	PermissionReservationList,
+	PermissionReservationView,
+	PermissionReservationUpdate,
+	PermissionReservationDelete,
+	PermissionReservationRestore,
+	PermissionReservationExport,
+	PermissionReservationReconcile,
+	PermissionAuditView,
+	PermissionPurchaseView,
+	PermissionPurchaseCreate,
+	PermissionPurchaseUpdate,
+	PermissionPurchaseDelete,
+	PermissionPurchaseRestore,
+	PermissionPurchaseExport,
+	PermissionReportView,
+	PermissionRefundView,
+	PermissionRefundComplete,
+	PermissionWebhookManage,
+	PermissionStaffManage,
+	PermissionAPIKeyManage,
+	PermissionSporedManage,
+}
+
+var userRoles = []authmodels.ModelsUserRole{
+	authmodels.ModelsUserRoleCustomer,
+	authmodels.ModelsUserRoleEmployee,
+	authmodels.ModelsUserRoleAdmin,
+}
+
+// Permissions maps roles to the permissions they are granted. Roles that are
+// missing are granted nothing.
+type Permissions map[authmodels.ModelsUserRole][]Permission
+
+// Allows reports whether role was granted permission.
+func (p Permissions) Allows(role authmodels.ModelsUserRole, permission Permission) bool {
+	return slices.Contains(p[role], permission)
+}
+
+// DefaultPermissions returns the mapping used when no permissions file is
+// configured. Employees handle the box office, while changing prices,
+// deleting and restoring reservations and configuring the service is left to
+// admins.
+func DefaultPermissions() Permissions {
+	return Permissions{
+		authmodels.ModelsUserRoleEmployee: {
+			PermissionReservationList,
+			PermissionReservationView,
+			PermissionReservationUpdate,
+			PermissionReservationExport,
+			PermissionAuditView,
+			PermissionPurchaseView,
+			PermissionPurchaseCreate,
+			PermissionPurchaseDelete,
+			PermissionPurchaseExport,
+			PermissionReportView,
+			PermissionRefundView,
+			PermissionRefundComplete,
+		},
+		authmodels.ModelsUserRoleAdmin: slices.Clone(AllPermissions),
+	}
+}
+
+// LoadPermissions reads a role to permission mapping from a JSON file such as
+// {"employee": ["reservation.view", "purchase.create"]}. Unknown roles and
+// permissions are rejected, so typos do not silently lock users out.
+func LoadPermissions(path string) (Permissions, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions Permissions
+	if err := json.Unmarshal(data, &permissions); err != nil {
+		return nil, fmt.Errorf("parse permissions file %s: %w", path, err)
+	}
+
+	for role, granted := range permissions {
+		if !slices.Contains(userRoles, role) {
+			return nil, fmt.Errorf("permissions file %s: unknown role %q", path, role)
+		}
+		for _, permission := range granted {
+			if !slices.Contains(AllPermissions, permission) {
+				return nil, fmt.Errorf("permissions file %s: unknown permission %q", path, permission)
+			}
+		}
+	}
+
+	return permissions, nil
+}
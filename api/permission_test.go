@@ -0,0 +1,217 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	authmodels "github.com/PRPO-skupina-02/common/clients/auth/models"
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/common/validation"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/seats"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var (
+	anyRole   = []authmodels.ModelsUserRole{authmodels.ModelsUserRoleCustomer, authmodels.ModelsUserRoleEmployee, authmodels.ModelsUserRoleAdmin}
+	staffRole = []authmodels.ModelsUserRole{authmodels.ModelsUserRoleEmployee, authmodels.ModelsUserRoleAdmin}
+	adminRole = []authmodels.ModelsUserRole{authmodels.ModelsUserRoleAdmin}
+)
+
+type routePermission struct {
+	method     string
+	route      string
+	url        string
+	permission Permission
+	roles      []authmodels.ModelsUserRole
+}
+
+// routePermissions lists every route of the API with the permission it
+// requires and the roles that are granted it by default. Routes without a
+// permission are open to every user.
+var routePermissions = []routePermission{
+	{http.MethodPost, "/reservations", "/reservations", "", anyRole},
+	{http.MethodGet, "/reservations/my", "/reservations/my", "", anyRole},
+	{http.MethodGet, "/reservations", "/reservations", PermissionReservationList, staffRole},
+	{http.MethodGet, "/reservations/export", "/reservations/export", PermissionReservationExport, staffRole},
+	{http.MethodGet, "/reservations/consistency", "/reservations/consistency", PermissionReservationReconcile, adminRole},
+	{http.MethodPost, "/reservations/consistency/fix", "/reservations/consistency/fix", PermissionReservationReconcile, adminRole},
+	{http.MethodGet, "/reservations/:reservationID", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7", PermissionReservationView, staffRole},
+	{http.MethodPut, "/reservations/:reservationID", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7", PermissionReservationUpdate, staffRole},
+	{http.MethodPatch, "/reservations/:reservationID", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7", PermissionReservationUpdate, staffRole},
+	{http.MethodDelete, "/reservations/:reservationID", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7", PermissionReservationDelete, adminRole},
+	{http.MethodPost, "/reservations/:reservationID/restore", "/reservations/0c3e5a7b-e4b2-11f0-8d1f-9b2c4d6e8f0a/restore", PermissionReservationRestore, adminRole},
+	{http.MethodGet, "/reservations/:reservationID/audit", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7/audit", PermissionAuditView, staffRole},
+	{http.MethodGet, "/reservations/:reservationID/purchases", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7/purchases", PermissionPurchaseView, staffRole},
+	{http.MethodGet, "/reservations/:reservationID/purchases/:purchaseID", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7/purchases/aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa", PermissionPurchaseView, staffRole},
+	{http.MethodPost, "/reservations/:reservationID/purchases", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7/purchases", PermissionPurchaseCreate, staffRole},
+	{http.MethodPut, "/reservations/:reservationID/purchases/:purchaseID", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7/purchases/aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa", PermissionPurchaseUpdate, adminRole},
+	{http.MethodPatch, "/reservations/:reservationID/purchases/:purchaseID", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7/purchases/aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa", PermissionPurchaseUpdate, adminRole},
+	{http.MethodDelete, "/reservations/:reservationID/purchases/:purchaseID", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7/purchases/aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa", PermissionPurchaseDelete, staffRole},
+	{http.MethodPost, "/reservations/:reservationID/purchases/:purchaseID/restore", "/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7/purchases/99999999-9999-9999-9999-999999999999/restore", PermissionPurchaseRestore, adminRole},
+	{http.MethodGet, "/purchases/export", "/purchases/export", PermissionPurchaseExport, staffRole},
+	{http.MethodGet, "/reports/movies", "/reports/movies", PermissionReportView, staffRole},
+	{http.MethodGet, "/reports/occupancy", "/reports/occupancy", PermissionReportView, staffRole},
+	{http.MethodGet, "/reports/heatmap", "/reports/heatmap", PermissionReportView, staffRole},
+	{http.MethodGet, "/webhooks", "/webhooks", PermissionWebhookManage, adminRole},
+	{http.MethodPost, "/webhooks", "/webhooks", PermissionWebhookManage, adminRole},
+	{http.MethodGet, "/webhooks/:webhookID", "/webhooks/c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c", PermissionWebhookManage, adminRole},
+	{http.MethodPut, "/webhooks/:webhookID", "/webhooks/c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c", PermissionWebhookManage, adminRole},
+	{http.MethodDelete, "/webhooks/:webhookID", "/webhooks/c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c", PermissionWebhookManage, adminRole},
+	{http.MethodGet, "/webhooks/:webhookID/deliveries", "/webhooks/c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c/deliveries", PermissionWebhookManage, adminRole},
+	{http.MethodGet, "/webhooks/:webhookID/deliveries/:deliveryID", "/webhooks/c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c/deliveries/e3c7a2f4-df46-11f0-ad3e-5f7a9b1c3d4e", PermissionWebhookManage, adminRole},
+	{http.MethodPost, "/webhooks/:webhookID/deliveries/:deliveryID/redeliver", "/webhooks/c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c/deliveries/e3c7a2f4-df46-11f0-ad3e-5f7a9b1c3d4e/redeliver", PermissionWebhookManage, adminRole},
+	{http.MethodGet, "/refunds", "/refunds", PermissionRefundView, staffRole},
+	{http.MethodPost, "/refunds/:refundID/complete", "/refunds/5a6b7c8d-e0f1-11f0-9a1b-1c2d3e4f5a6b/complete", PermissionRefundComplete, staffRole},
+	{http.MethodGet, "/staff/:userID/theaters", "/staff/00000000-0000-0000-0000-000000000001/theaters", PermissionStaffManage, adminRole},
+	{http.MethodPut, "/staff/:userID/theaters", "/staff/00000000-0000-0000-0000-000000000001/theaters", PermissionStaffManage, adminRole},
+	{http.MethodGet, "/timeslots/:timeSlotID/seats", "/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/seats", "", anyRole},
+	{http.MethodGet, "/timeslots/:timeSlotID/seats/stream", "/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/seats/stream", "", anyRole},
+	{http.MethodPost, "/timeslots/:timeSlotID/seats/stream/tickets", "/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/seats/stream/tickets", "", anyRole},
+	{http.MethodPost, "/timeslots/:timeSlotID/holds", "/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/holds", "", anyRole},
+	{http.MethodDelete, "/timeslots/:timeSlotID/holds/:holdID", "/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/holds/5a1b2c3d-f0a1-11f0-9c2e-1b3d5f7a9c0e", "", anyRole},
+	{http.MethodGet, "/api-keys", "/api-keys", PermissionAPIKeyManage, adminRole},
+	{http.MethodPost, "/api-keys", "/api-keys", PermissionAPIKeyManage, adminRole},
+	{http.MethodGet, "/api-keys/:apiKeyID", "/api-keys/e3c7a2f4-e0b1-11f0-9a3d-5f7b9c1d3e5f", PermissionAPIKeyManage, adminRole},
+	{http.MethodDelete, "/api-keys/:apiKeyID", "/api-keys/e3c7a2f4-e0b1-11f0-9a3d-5f7b9c1d3e5f", PermissionAPIKeyManage, adminRole},
+	{http.MethodPost, "/spored/events", "/spored/events", PermissionSporedManage, adminRole},
+	{http.MethodGet, "/spored/cache", "/spored/cache", PermissionSporedManage, adminRole},
+	{http.MethodDelete, "/spored/cache", "/spored/cache", PermissionSporedManage, adminRole},
+}
+
+func permissionsRouter(t *testing.T, db *gorm.DB, role authmodels.ModelsUserRole) *gin.Engine {
+	trans, err := validation.RegisterValidation()
+	require.NoError(t, err)
+
+	userIDs := map[authmodels.ModelsUserRole]uuid.UUID{
+		authmodels.ModelsUserRoleCustomer: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		authmodels.ModelsUserRoleEmployee: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		authmodels.ModelsUserRoleAdmin:    uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+	}
+
+	router := gin.New()
+	Register(router, db, trans, services.NewMockTimeSlotService(), MockUserMiddleware(userIDs[role], role), testingTicketPriceCents, DefaultPermissions(), seats.NewWatcher(db, seats.DefaultPollInterval))
+	return router
+}
+
+func TestRoutePermissionsComplete(t *testing.T) {
+	router := permissionsRouter(t, nil, authmodels.ModelsUserRoleAdmin)
+
+	for _, route := range router.Routes() {
+		path, ok := strings.CutPrefix(route.Path, "/api/v1/nakup")
+		if !ok {
+			continue
+		}
+
+		found := slices.ContainsFunc(routePermissions, func(r routePermission) bool {
+			return r.method == route.Method && r.route == path
+		})
+		assert.True(t, found, "%s %s is missing from the permission matrix", route.Method, path)
+	}
+
+	for _, route := range routePermissions {
+		for _, role := range anyRole {
+			allowed := route.permission == "" || DefaultPermissions().Allows(role, route.permission)
+			assert.Equal(t, slices.Contains(route.roles, role), allowed, "%s %s for %s", route.method, route.route, role)
+		}
+	}
+}
+
+func TestRoutePermissions(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+
+	for _, role := range anyRole {
+		router := permissionsRouter(t, db, role)
+
+		for _, route := range routePermissions {
+			t.Run(string(role)+" "+route.method+" "+route.route, func(t *testing.T) {
+				err := fixtures.Load()
+				assert.NoError(t, err)
+
+				// Streams only end when the client goes away.
+				ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+				defer cancel()
+
+				req := xtesting.NewTestingRequest(t, "/api/v1/nakup"+route.url, route.method, nil).WithContext(ctx)
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				var response middleware.HttpError
+				_ = json.Unmarshal(w.Body.Bytes(), &response)
+				denied := w.Code == http.StatusForbidden && strings.HasPrefix(response.Message, "Missing permission")
+
+				if slices.Contains(route.roles, role) {
+					assert.False(t, denied, "unexpected %d: %s", w.Code, w.Body.String())
+				} else {
+					assert.Equal(t, http.StatusForbidden, w.Code)
+					assert.Equal(t, "Missing permission "+string(route.permission), response.Message)
+				}
+			})
+		}
+	}
+}
+
+func TestLoadPermissions(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		permissions Permissions
+		err         string
+	}{
+		{
+			name:    "ok",
+			content: `{"employee": ["reservation.view", "purchase.create"], "admin": ["reservation.delete"]}`,
+			permissions: Permissions{
+				authmodels.ModelsUserRoleEmployee: {PermissionReservationView, PermissionPurchaseCreate},
+				authmodels.ModelsUserRoleAdmin:    {PermissionReservationDelete},
+			},
+		},
+		{
+			name:    "unknown-role",
+			content: `{"manager": ["reservation.view"]}`,
+			err:     `unknown role "manager"`,
+		},
+		{
+			name:    "unknown-permission",
+			content: `{"employee": ["reservation.destroy"]}`,
+			err:     `unknown permission "reservation.destroy"`,
+		},
+		{
+			name:    "malformed",
+			content: `["reservation.view"]`,
+			err:     "parse permissions file",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "permissions.json")
+			require.NoError(t, os.WriteFile(path, []byte(testCase.content), 0o600))
+
+			permissions, err := LoadPermissions(path)
+			if testCase.err != "" {
+				assert.ErrorContains(t, err, testCase.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.permissions, permissions)
+		})
+	}
+}
@@ -90,9 +90,11 @@ type PurchaseRequest struct {
 //	@Security		BearerAuth
 //	@Param			reservationID	path		string			true	"Reservation ID"	Format(uuid)
 //	@Param			request			body		PurchaseRequest	true	"request body"
-//	@Success		200				{object}	PurchaseResponse
+//	@Param			Idempotency-Key	header		string			false	"Key that makes retries of the request safe"
+//	@Success		201				{object}	PurchaseResponse
 //	@Failure		400				{object}	middleware.HttpError
 //	@Failure		404				{object}	middleware.HttpError
+//	@Failure		422				{object}	middleware.HttpError
 //	@Failure		500				{object}	middleware.HttpError
 //	@Router			/reservations/{reservationID}/purchases [post]
 func PurchasesCreate(c *gin.Context) {
@@ -135,10 +137,13 @@ func PurchasesCreate(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Param			reservationID	path		string	true	"Reservation ID"	Format(uuid)
 //	@Param			purchaseID		path		string	true	"Purchase ID"		Format(uuid)
+//	@Param			If-None-Match	header		string	false	"Respond with 304 if the purchase's ETag still matches"
 //	@Success		200				{object}	PurchaseResponse
-//	@Failure		400				{object}	middleware.HttpError
-//	@Failure		404				{object}	middleware.HttpError
-//	@Failure		500				{object}	middleware.HttpError
+//	@Header			200				{string}	ETag	"Version of the purchase"
+//	@Success		304
+//	@Failure		400	{object}	middleware.HttpError
+//	@Failure		404	{object}	middleware.HttpError
+//	@Failure		500	{object}	middleware.HttpError
 //	@Router			/reservations/{reservationID}/purchases/{purchaseID} [get]
 func PurchasesShow(c *gin.Context) {
 	tx := middleware.GetContextTransaction(c)
@@ -155,6 +160,11 @@ func PurchasesShow(c *gin.Context) {
 		return
 	}
 
+	if notModified(c, purchase.Version) {
+		return
+	}
+
+	setVersionETag(c, purchase.Version)
 	c.JSON(http.StatusOK, newPurchaseResponse(purchase))
 }
 
@@ -170,9 +180,12 @@ func PurchasesShow(c *gin.Context) {
 //	@Param			reservationID	path		string			true	"Reservation ID"	Format(uuid)
 //	@Param			purchaseID		path		string			true	"Purchase ID"		Format(uuid)
 //	@Param			request			body		PurchaseRequest	true	"request body"
+//	@Param			If-Match		header		string			false	"Only change the purchase if its ETag still matches"
 //	@Success		200				{object}	PurchaseResponse
+//	@Header			200				{string}	ETag	"Version of the purchase"
 //	@Failure		400				{object}	middleware.HttpError
 //	@Failure		404				{object}	middleware.HttpError
+//	@Failure		412				{object}	middleware.HttpError
 //	@Failure		500				{object}	middleware.HttpError
 //	@Router			/reservations/{reservationID}/purchases/{purchaseID} [put]
 func PurchasesUpdate(c *gin.Context) {
@@ -197,6 +210,78 @@ func PurchasesUpdate(c *gin.Context) {
 		return
 	}
 
+	if !checkIfMatch(c, purchase.Version) {
+		return
+	}
+
+	purchase.Type = models.PurchaseType(req.Type)
+	purchase.Name = req.Name
+	purchase.Count = req.Count
+	purchase.PricePerItemCents = req.PricePerItemCents
+
+	err = purchase.Save(tx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	setVersionETag(c, purchase.Version)
+	c.JSON(http.StatusOK, newPurchaseResponse(purchase))
+}
+
+// PurchasesPatch
+//
+//	@Id				PurchasesPatch
+//	@Summary		Partially update purchase
+//	@Description	Change some fields of a purchase with a JSON Merge Patch document
+//	@Tags			purchases
+//	@Accept			json,application/merge-patch+json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			reservationID	path		string			true	"Reservation ID"	Format(uuid)
+//	@Param			purchaseID		path		string			true	"Purchase ID"		Format(uuid)
+//	@Param			request			body		PurchaseRequest	true	"merge patch document"
+//	@Param			If-Match		header		string			false	"Only change the purchase if its ETag still matches"
+//	@Success		200				{object}	PurchaseResponse
+//	@Header			200				{string}	ETag	"Version of the purchase"
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		404				{object}	middleware.HttpError
+//	@Failure		412				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Router			/reservations/{reservationID}/purchases/{purchaseID} [patch]
+func PurchasesPatch(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	reservation := GetContextReservation(c)
+	id, err := request.GetUUIDParam(c, "purchaseID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	purchase, err := models.GetPurchase(tx, reservation.ID, id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if !checkIfMatch(c, purchase.Version) {
+		return
+	}
+
+	current := PurchaseRequest{
+		Type:              string(purchase.Type),
+		Name:              purchase.Name,
+		Count:             purchase.Count,
+		PricePerItemCents: purchase.PricePerItemCents,
+	}
+
+	var req PurchaseRequest
+	err = bindMergePatch(c, current, &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
 	purchase.Type = models.PurchaseType(req.Type)
 	purchase.Name = req.Name
 	purchase.Count = req.Count
@@ -208,6 +293,7 @@ func PurchasesUpdate(c *gin.Context) {
 		return
 	}
 
+	setVersionETag(c, purchase.Version)
 	c.JSON(http.StatusOK, newPurchaseResponse(purchase))
 }
 
@@ -222,9 +308,11 @@ func PurchasesUpdate(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Param			reservationID	path	string	true	"Reservation ID"	Format(uuid)
 //	@Param			purchaseID		path	string	true	"Purchase ID"		Format(uuid)
+//	@Param			If-Match		header	string	false	"Only change the purchase if its ETag still matches"
 //	@Success		204
 //	@Failure		400	{object}	middleware.HttpError
 //	@Failure		404	{object}	middleware.HttpError
+//	@Failure		412	{object}	middleware.HttpError
 //	@Failure		500	{object}	middleware.HttpError
 //	@Router			/reservations/{reservationID}/purchases/{purchaseID} [delete]
 func PurchasesDelete(c *gin.Context) {
@@ -236,6 +324,16 @@ func PurchasesDelete(c *gin.Context) {
 		return
 	}
 
+	purchase, err := models.GetPurchase(tx, reservation.ID, id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if !checkIfMatch(c, purchase.Version) {
+		return
+	}
+
 	err = models.DeletePurchase(tx, reservation.ID, id)
 	if err != nil {
 		_ = c.Error(err)
@@ -244,3 +342,45 @@ func PurchasesDelete(c *gin.Context) {
 
 	c.JSON(http.StatusNoContent, "")
 }
+
+// PurchasesRestore
+//
+//	@Id				PurchasesRestore
+//	@Summary		Restore purchase
+//	@Description	Restore a deleted purchase
+//	@Tags			purchases
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			reservationID	path		string	true	"Reservation ID"	Format(uuid)
+//	@Param			purchaseID		path		string	true	"Purchase ID"		Format(uuid)
+//	@Param			Idempotency-Key	header		string	false	"Key that makes retries of the request safe"
+//	@Success		200				{object}	PurchaseResponse
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		404				{object}	middleware.HttpError
+//	@Failure		422				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Router			/reservations/{reservationID}/purchases/{purchaseID}/restore [post]
+func PurchasesRestore(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	reservation := GetContextReservation(c)
+	id, err := request.GetUUIDParam(c, "purchaseID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	purchase, err := models.GetDeletedPurchase(tx, reservation.ID, id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	err = models.RestorePurchase(tx, &purchase)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newPurchaseResponse(purchase))
+}
@@ -272,7 +272,7 @@ func TestPurchasesShow(t *testing.T) {
 func TestPurchasesUpdate(t *testing.T) {
 	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
 	service := services.NewMockTimeSlotService()
-	r := TestingRouter(t, db, service)
+	r := AdminTestingRouter(t, db, service)
 
 	tests := []struct {
 		name          string
@@ -423,6 +423,73 @@ func TestPurchasesUpdate(t *testing.T) {
 	}
 }
 
+func TestPurchasesPatch(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name          string
+		body          any
+		status        int
+		purchaseID    string
+		reservationID string
+	}{
+		{
+			name:          "ok",
+			body:          map[string]any{"count": 3},
+			status:        http.StatusOK,
+			purchaseID:    "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+			reservationID: "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+		{
+			name:          "validation-errors",
+			body:          map[string]any{"name": "AB", "type": nil},
+			status:        http.StatusBadRequest,
+			purchaseID:    "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+			reservationID: "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+		{
+			name:          "purchase-from-different-reservation",
+			body:          map[string]any{"count": 3},
+			status:        http.StatusNotFound,
+			purchaseID:    "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+			reservationID: "bae209f6-d059-11f0-b2a4-cbf992c2eb6d",
+		},
+		{
+			name:          "invalid-purchase-id",
+			body:          map[string]any{"count": 3},
+			status:        http.StatusNotFound,
+			purchaseID:    "01234567-0123-0123-0123-0123456789ab",
+			reservationID: "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/reservations/%s/purchases/%s", testCase.reservationID, testCase.purchaseID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodPatch, testCase.body)
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"updated_at": xtesting.ValueTimeInPastDuration(time.Second),
+			}
+
+			ignorePurchases := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"UpdatedAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Purchase{}, ignorePurchases)
+		})
+	}
+}
 func TestPurchasesDelete(t *testing.T) {
 	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
 	service := services.NewMockTimeSlotService()
@@ -502,3 +569,156 @@ func TestPurchasesDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestPurchasesRestore(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name          string
+		status        int
+		purchaseID    string
+		reservationID string
+	}{
+		{
+			name:          "ok",
+			status:        http.StatusOK,
+			purchaseID:    "99999999-9999-9999-9999-999999999999",
+			reservationID: "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+		{
+			name:          "not-deleted",
+			status:        http.StatusNotFound,
+			purchaseID:    "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+			reservationID: "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+		{
+			name:          "deleted-reservation",
+			status:        http.StatusNotFound,
+			purchaseID:    "eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee",
+			reservationID: "0c3e5a7b-e4b2-11f0-8d1f-9b2c4d6e8f0a",
+		},
+		{
+			name:          "invalid-purchase-id",
+			status:        http.StatusNotFound,
+			purchaseID:    "01234567-0123-0123-0123-0123456789ab",
+			reservationID: "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/reservations/%s/purchases/%s/restore", testCase.reservationID, testCase.purchaseID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodPost, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Purchase{}, nil)
+		})
+	}
+}
+
+func TestPurchasesConditionalRequests(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	popcorn := PurchaseRequest{
+		Type:              string(models.Food),
+		Name:              "Popcorn",
+		Count:             2,
+		PricePerItemCents: 550,
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		body        any
+		ifMatch     string
+		ifNoneMatch string
+		status      int
+		etag        string
+	}{
+		{
+			name:   "show",
+			method: http.MethodGet,
+			status: http.StatusOK,
+			etag:   `"1"`,
+		},
+		{
+			name:        "show-not-modified",
+			method:      http.MethodGet,
+			ifNoneMatch: `"1"`,
+			status:      http.StatusNotModified,
+			etag:        `"1"`,
+		},
+		{
+			name:    "update",
+			method:  http.MethodPut,
+			body:    popcorn,
+			ifMatch: `"1"`,
+			status:  http.StatusOK,
+			etag:    `"2"`,
+		},
+		{
+			name:    "update-stale",
+			method:  http.MethodPut,
+			body:    popcorn,
+			ifMatch: `"2"`,
+			status:  http.StatusPreconditionFailed,
+		},
+		{
+			name:    "delete",
+			method:  http.MethodDelete,
+			ifMatch: `"1"`,
+			status:  http.StatusNoContent,
+		},
+		{
+			name:    "delete-stale",
+			method:  http.MethodDelete,
+			ifMatch: `"2"`,
+			status:  http.StatusPreconditionFailed,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			req := xtesting.NewTestingRequest(t, "/api/v1/nakup/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7/purchases/aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa", testCase.method, testCase.body)
+			if testCase.ifMatch != "" {
+				req.Header.Set("If-Match", testCase.ifMatch)
+			}
+			if testCase.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", testCase.ifNoneMatch)
+			}
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"updated_at": xtesting.ValueTime(),
+			}
+
+			ignorePurchases := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"UpdatedAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			assert.Equal(t, testCase.etag, w.Header().Get("ETag"))
+			if testCase.status == http.StatusNotModified {
+				assert.Empty(t, w.Body.String())
+			} else {
+				xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			}
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Purchase{}, ignorePurchases)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RefundResponse struct {
+	ID            uuid.UUID           `json:"id"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+	ReservationID uuid.UUID           `json:"reservation_id"`
+	TimeSlotID    uuid.UUID           `json:"time_slot_id"`
+	UserID        *uuid.UUID          `json:"user_id"`
+	Reason        models.RefundReason `json:"reason"`
+	Status        models.RefundStatus `json:"status"`
+	AmountCents   int                 `json:"amount_cents"`
+	RefundedAt    *time.Time          `json:"refunded_at"`
+}
+
+func newRefundResponse(refund models.Refund) RefundResponse {
+	return RefundResponse{
+		ID:            refund.ID,
+		CreatedAt:     refund.CreatedAt,
+		UpdatedAt:     refund.UpdatedAt,
+		ReservationID: refund.ReservationID,
+		TimeSlotID:    refund.TimeSlotID,
+		UserID:        refund.UserID,
+		Reason:        refund.Reason,
+		Status:        refund.Status,
+		AmountCents:   refund.AmountCents,
+		RefundedAt:    refund.RefundedAt,
+	}
+}
+
+type RefundsQuery struct {
+	Status models.RefundStatus `form:"status" json:"status" binding:"omitempty,oneof=PENDING REFUNDED"`
+}
+
+// RefundsList
+//
+//	@Id				RefundsList
+//	@Summary		List refunds
+//	@Description	List refunds owed for reservations that were cancelled because of schedule changes in spored. Employees only see the refunds of their theaters.
+//	@Tags			refunds
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			status	query		string	false	"Only list refunds with this status"	Enums(PENDING, REFUNDED)
+//	@Param			limit	query		int		false	"Limit the number of responses"			Default(10)
+//	@Param			offset	query		int		false	"Offset the first response"				Default(0)
+//	@Param			sort	query		string	false	"Sort results"
+//	@Success		200		{object}	request.PaginatedResponse{data=[]RefundResponse}
+//	@Failure		400		{object}	middleware.HttpError
+//	@Failure		500		{object}	middleware.HttpError
+//	@Router			/refunds [get]
+func RefundsList(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	pagination := request.GetNormalizedPaginationArgs(c)
+	sort := request.GetSortOptions(c)
+
+	var query RefundsQuery
+	err := c.ShouldBindQuery(&query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var status *models.RefundStatus
+	if query.Status != "" {
+		status = &query.Status
+	}
+
+	refunds, total, err := models.GetRefunds(tx, GetContextTheaterScope(c), status, pagination, sort)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := []RefundResponse{}
+
+	for _, refund := range refunds {
+		response = append(response, newRefundResponse(refund))
+	}
+
+	request.RenderPaginatedResponse(c, response, total)
+}
+
+// RefundsComplete
+//
+//	@Id				RefundsComplete
+//	@Summary		Complete refund
+//	@Description	Mark a refund as paid back to the customer
+//	@Tags			refunds
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			refundID		path		string	true	"Refund ID"	Format(uuid)
+//	@Param			Idempotency-Key	header		string	false	"Key that makes retries of the request safe"
+//	@Success		200				{object}	RefundResponse
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		403				{object}	middleware.HttpError
+//	@Failure		404				{object}	middleware.HttpError
+//	@Failure		422				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Router			/refunds/{refundID}/complete [post]
+func RefundsComplete(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	id, err := request.GetUUIDParam(c, "refundID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	refund, err := models.GetRefund(tx, id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if !canAccessRefund(c, refund) {
+		_ = c.Error(middleware.NewForbiddenError("Not assigned to the refund's theater"))
+		return
+	}
+
+	if refund.Status == models.RefundRefunded {
+		_ = c.Error(middleware.NewBadRequestError("refund already completed"))
+		return
+	}
+
+	now := time.Now()
+	refund.Status = models.RefundRefunded
+	refund.RefundedAt = &now
+
+	err = refund.Save(tx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newRefundResponse(refund))
+}
+
+// canAccessRefund reports whether the request may handle refund. Refunds whose
+// theater is not known yet are left to admins.
+func canAccessRefund(c *gin.Context, refund models.Refund) bool {
+	if refund.TheaterID == nil {
+		return GetContextTheaterScope(c) == nil
+	}
+	return CanAccessTheater(c, *refund.TheaterID)
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefundsList(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	tests := []struct {
+		name   string
+		status int
+		params string
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+		},
+		{
+			name:   "ok-pending",
+			status: http.StatusOK,
+			params: "?status=PENDING",
+		},
+		{
+			name:   "ok-sort",
+			status: http.StatusOK,
+			params: "?sort=created_at",
+		},
+		{
+			name:   "invalid-status",
+			status: http.StatusBadRequest,
+			params: "?status=DONE",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/refunds%s", testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestRefundsComplete(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	tests := []struct {
+		name     string
+		status   int
+		refundID string
+	}{
+		{
+			name:     "ok",
+			status:   http.StatusOK,
+			refundID: "5a6b7c8d-e0f1-11f0-9a1b-1c2d3e4f5a6b",
+		},
+		{
+			name:     "already-completed",
+			status:   http.StatusBadRequest,
+			refundID: "6c7d8e9f-e0f1-11f0-bd4e-4f5a6b7c8d9e",
+		},
+		{
+			name:     "invalid-refund-id",
+			status:   http.StatusNotFound,
+			refundID: "01234567-0123-0123-0123-0123456789ab",
+		},
+		{
+			name:     "malformed-refund-id",
+			status:   http.StatusBadRequest,
+			refundID: "01234567-0123-0123",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/refunds/%s/complete", testCase.refundID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodPost, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"updated_at":  xtesting.ValueTimeInPastDuration(time.Second),
+				"refunded_at": xtesting.ValueTimeInPastDuration(time.Second),
+			}
+
+			ignoreRefunds := xtesting.ValuesCheckers{}
+			if testCase.status == http.StatusOK {
+				ignoreRefunds["[0].UpdatedAt"] = xtesting.ValueTime()
+				ignoreRefunds["[0].RefundedAt"] = xtesting.ValueTime()
+			}
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Refund{}, ignoreRefunds)
+		})
+	}
+}
@@ -0,0 +1,391 @@
+package api
+
+import (
+	"cmp"
+	"maps"
+	"math"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type DateRangeQuery struct {
+	From string `form:"from" json:"from" binding:"required,datetime=2006-01-02"`
+	To   string `form:"to" json:"to" binding:"required,datetime=2006-01-02"`
+}
+
+// Bounds returns the half-open interval [from, to) covering both the from and
+// the to date completely.
+func (q DateRangeQuery) Bounds() (time.Time, time.Time, error) {
+	from, err := time.ParseInLocation(time.DateOnly, q.From, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	to, err := time.ParseInLocation(time.DateOnly, q.To, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, middleware.NewBadRequestError("to must not be before from")
+	}
+
+	return from, to.AddDate(0, 0, 1), nil
+}
+
+func bindDateRange(c *gin.Context) (time.Time, time.Time, bool) {
+	var query DateRangeQuery
+	err := c.ShouldBindQuery(&query)
+	if err != nil {
+		_ = c.Error(err)
+		return time.Time{}, time.Time{}, false
+	}
+
+	from, to, err := query.Bounds()
+	if err != nil {
+		_ = c.Error(err)
+		return time.Time{}, time.Time{}, false
+	}
+
+	return from, to, true
+}
+
+type MovieReportEntry struct {
+	MovieID                uuid.UUID `json:"movie_id"`
+	Title                  string    `json:"title"`
+	Screenings             int       `json:"screenings"`
+	Admissions             int       `json:"admissions"`
+	TicketRevenueCents     int       `json:"ticket_revenue_cents"`
+	ConcessionRevenueCents int       `json:"concession_revenue_cents"`
+	TotalRevenueCents      int       `json:"total_revenue_cents"`
+}
+
+type MovieReportResponse struct {
+	From             string             `json:"from"`
+	To               string             `json:"to"`
+	TicketPriceCents int                `json:"ticket_price_cents"`
+	Movies           []MovieReportEntry `json:"movies"`
+}
+
+// ReportsMovies
+//
+//	@Id				ReportsMovies
+//	@Summary		Revenue and admissions per movie
+//	@Description	Admissions, ticket revenue and concession revenue per movie for screenings starting within the date range. Employees only see the screenings of their theaters.
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			from	query		string	true	"First screening date (YYYY-MM-DD)"	Format(date)
+//	@Param			to		query		string	true	"Last screening date (YYYY-MM-DD)"	Format(date)
+//	@Success		200		{object}	MovieReportResponse
+//	@Failure		400		{object}	middleware.HttpError
+//	@Failure		500		{object}	middleware.HttpError
+//	@Failure		503		{object}	middleware.HttpError
+//	@Router			/reports/movies [get]
+func ReportsMovies(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	resolver := GetScheduleResolver(c)
+	ticketPriceCents := GetTicketPriceCents(c)
+
+	from, to, ok := bindDateRange(c)
+	if !ok {
+		return
+	}
+
+	sales, err := models.GetTimeSlotSales(tx, GetContextTheaterScope(c), from, to)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	refs := make([]services.TimeSlotRef, 0, len(sales))
+	for _, sale := range sales {
+		refs = append(refs, services.TimeSlotRef{
+			TimeSlotID: sale.TimeSlotID,
+			TheaterID:  sale.TheaterID,
+			RoomID:     sale.RoomID,
+		})
+	}
+
+	resolved, err := resolver.Resolve(c.Request.Context(), refs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	entries := map[uuid.UUID]*MovieReportEntry{}
+	for _, sale := range sales {
+		timeSlot, ok := resolved[sale.TimeSlotID]
+		if !ok {
+			continue
+		}
+
+		startTime := timeSlot.TimeSlot.StartTime
+		if startTime.Before(from) || !startTime.Before(to) {
+			continue
+		}
+
+		entry, ok := entries[timeSlot.Movie.MovieID]
+		if !ok {
+			entry = &MovieReportEntry{
+				MovieID: timeSlot.Movie.MovieID,
+				Title:   timeSlot.Movie.Name,
+			}
+			entries[timeSlot.Movie.MovieID] = entry
+		}
+
+		entry.Screenings++
+		entry.Admissions += sale.Admissions
+		entry.TicketRevenueCents += sale.Admissions * ticketPriceCents
+		entry.ConcessionRevenueCents += sale.ConcessionRevenueCents
+		entry.TotalRevenueCents = entry.TicketRevenueCents + entry.ConcessionRevenueCents
+	}
+
+	movies := []MovieReportEntry{}
+	for _, entry := range entries {
+		movies = append(movies, *entry)
+	}
+
+	slices.SortFunc(movies, func(a, b MovieReportEntry) int {
+		return cmp.Or(
+			cmp.Compare(b.TotalRevenueCents, a.TotalRevenueCents),
+			cmp.Compare(a.Title, b.Title),
+		)
+	})
+
+	c.JSON(http.StatusOK, MovieReportResponse{
+		From:             from.Format(time.DateOnly),
+		To:               to.AddDate(0, 0, -1).Format(time.DateOnly),
+		TicketPriceCents: ticketPriceCents,
+		Movies:           movies,
+	})
+}
+
+type OccupancyStats struct {
+	Capacity         int     `json:"capacity"`
+	Sold             int     `json:"sold"`
+	Online           int     `json:"online"`
+	POS              int     `json:"pos"`
+	OccupancyPercent float64 `json:"occupancy_percent"`
+}
+
+func (s *OccupancyStats) add(capacity int, occupancy models.TimeSlotOccupancy) {
+	s.Capacity += capacity
+	s.Sold += occupancy.Sold
+	s.Online += occupancy.Online
+	s.POS += occupancy.POS
+
+	if s.Capacity > 0 {
+		s.OccupancyPercent = math.Round(float64(s.Sold)/float64(s.Capacity)*10000) / 100
+	}
+}
+
+type TimeSlotOccupancyEntry struct {
+	TimeSlotID uuid.UUID `json:"time_slot_id"`
+	TheaterID  uuid.UUID `json:"theater_id"`
+	RoomID     uuid.UUID `json:"room_id"`
+	StartTime  time.Time `json:"start_time"`
+	OccupancyStats
+}
+
+type RoomOccupancyEntry struct {
+	TheaterID  uuid.UUID `json:"theater_id"`
+	RoomID     uuid.UUID `json:"room_id"`
+	RoomName   string    `json:"room_name"`
+	Screenings int       `json:"screenings"`
+	OccupancyStats
+}
+
+type WeekdayHourOccupancyEntry struct {
+	Weekday    string `json:"weekday"`
+	Hour       int    `json:"hour"`
+	Screenings int    `json:"screenings"`
+	OccupancyStats
+}
+
+type OccupancyReportResponse struct {
+	From         string                      `json:"from,omitempty"`
+	To           string                      `json:"to,omitempty"`
+	TimeSlots    []TimeSlotOccupancyEntry    `json:"time_slots"`
+	Rooms        []RoomOccupancyEntry        `json:"rooms"`
+	WeekdayHours []WeekdayHourOccupancyEntry `json:"weekday_hours"`
+}
+
+// isoWeekday numbers the days of the week from Monday (1) to Sunday (7).
+func isoWeekday(day time.Weekday) int {
+	if day == time.Sunday {
+		return 7
+	}
+	return int(day)
+}
+
+// ReportsOccupancy
+//
+//	@Id				ReportsOccupancy
+//	@Summary		Seat occupancy
+//	@Description	Sold seats versus room capacity for a single time slot or for all screenings starting within the date range, aggregated per room and per weekday and hour. Employees only see the screenings of their theaters.
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			time_slot_id	query		string	false	"Time slot ID, replaces the date range"	Format(uuid)
+//	@Param			from			query		string	false	"First screening date (YYYY-MM-DD)"		Format(date)
+//	@Param			to				query		string	false	"Last screening date (YYYY-MM-DD)"		Format(date)
+//	@Success		200				{object}	OccupancyReportResponse
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Failure		503				{object}	middleware.HttpError
+//	@Router			/reports/occupancy [get]
+func ReportsOccupancy(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	resolver := GetScheduleResolver(c)
+
+	response := OccupancyReportResponse{
+		TimeSlots:    []TimeSlotOccupancyEntry{},
+		Rooms:        []RoomOccupancyEntry{},
+		WeekdayHours: []WeekdayHourOccupancyEntry{},
+	}
+
+	var timeSlotID *uuid.UUID
+	var from, to time.Time
+	if param := c.Query("time_slot_id"); param != "" {
+		id, err := uuid.Parse(param)
+		if err != nil {
+			_ = c.Error(middleware.NewBadRequestError("time_slot_id must be a valid UUID"))
+			return
+		}
+		timeSlotID = &id
+	} else {
+		var ok bool
+		from, to, ok = bindDateRange(c)
+		if !ok {
+			return
+		}
+		response.From = from.Format(time.DateOnly)
+		response.To = to.AddDate(0, 0, -1).Format(time.DateOnly)
+	}
+
+	occupancies, err := models.GetTimeSlotOccupancy(tx, GetContextTheaterScope(c), from, to, timeSlotID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	timeSlotRefs := make([]services.TimeSlotRef, 0, len(occupancies))
+	roomRefs := make([]services.RoomRef, 0, len(occupancies))
+	for _, occupancy := range occupancies {
+		timeSlotRefs = append(timeSlotRefs, services.TimeSlotRef{
+			TimeSlotID: occupancy.TimeSlotID,
+			TheaterID:  occupancy.TheaterID,
+			RoomID:     occupancy.RoomID,
+		})
+		roomRefs = append(roomRefs, services.RoomRef{
+			TheaterID: occupancy.TheaterID,
+			RoomID:    occupancy.RoomID,
+		})
+	}
+
+	timeSlots, err := resolver.ResolveTimeSlots(c.Request.Context(), timeSlotRefs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	rooms, err := resolver.ResolveRooms(c.Request.Context(), roomRefs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	roomEntries := map[services.RoomRef]*RoomOccupancyEntry{}
+	weekdayHourEntries := map[[2]int]*WeekdayHourOccupancyEntry{}
+
+	for _, occupancy := range occupancies {
+		timeSlot, ok := timeSlots[occupancy.TimeSlotID]
+		if !ok {
+			continue
+		}
+
+		roomRef := services.RoomRef{TheaterID: occupancy.TheaterID, RoomID: occupancy.RoomID}
+		room, ok := rooms[roomRef]
+		if !ok {
+			continue
+		}
+
+		startTime := timeSlot.StartTime
+		if timeSlotID == nil && (startTime.Before(from) || !startTime.Before(to)) {
+			continue
+		}
+
+		capacity := room.Rows * room.Columns
+
+		timeSlotEntry := TimeSlotOccupancyEntry{
+			TimeSlotID: occupancy.TimeSlotID,
+			TheaterID:  occupancy.TheaterID,
+			RoomID:     occupancy.RoomID,
+			StartTime:  startTime,
+		}
+		timeSlotEntry.add(capacity, occupancy)
+		response.TimeSlots = append(response.TimeSlots, timeSlotEntry)
+
+		roomEntry, ok := roomEntries[roomRef]
+		if !ok {
+			roomEntry = &RoomOccupancyEntry{
+				TheaterID: room.TheaterID,
+				RoomID:    room.RoomID,
+				RoomName:  room.Name,
+			}
+			roomEntries[roomRef] = roomEntry
+		}
+		roomEntry.Screenings++
+		roomEntry.add(capacity, occupancy)
+
+		localStart := startTime.In(time.Local)
+		key := [2]int{isoWeekday(localStart.Weekday()), localStart.Hour()}
+		weekdayHourEntry, ok := weekdayHourEntries[key]
+		if !ok {
+			weekdayHourEntry = &WeekdayHourOccupancyEntry{
+				Weekday: localStart.Weekday().String(),
+				Hour:    localStart.Hour(),
+			}
+			weekdayHourEntries[key] = weekdayHourEntry
+		}
+		weekdayHourEntry.Screenings++
+		weekdayHourEntry.add(capacity, occupancy)
+	}
+
+	slices.SortFunc(response.TimeSlots, func(a, b TimeSlotOccupancyEntry) int {
+		return cmp.Or(
+			a.StartTime.Compare(b.StartTime),
+			cmp.Compare(a.TimeSlotID.String(), b.TimeSlotID.String()),
+		)
+	})
+
+	for _, entry := range roomEntries {
+		response.Rooms = append(response.Rooms, *entry)
+	}
+	slices.SortFunc(response.Rooms, func(a, b RoomOccupancyEntry) int {
+		return cmp.Or(
+			cmp.Compare(a.TheaterID.String(), b.TheaterID.String()),
+			cmp.Compare(a.RoomID.String(), b.RoomID.String()),
+		)
+	})
+
+	keys := slices.Collect(maps.Keys(weekdayHourEntries))
+	slices.SortFunc(keys, func(a, b [2]int) int {
+		return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+	})
+	for _, key := range keys {
+		response.WeekdayHours = append(response.WeekdayHours, *weekdayHourEntries[key])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,242 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportsMovies(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID1 := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	roomID2 := uuid.MustParse("3f1a7e2c-df46-11f0-9b5d-0b3c8e6f1a2d")
+	movieID1 := uuid.MustParse("6a1d3c52-df46-11f0-8f3a-7b1e2c4d5f60")
+	movieID2 := uuid.MustParse("7b2e4d63-df46-11f0-9a4b-8c2f3d5e6a71")
+
+	service.AddMovie(movieID1, "Dune: Part Two", 166)
+	service.AddMovie(movieID2, "Oppenheimer", 180)
+	service.SetTimeSlotSchedule(theaterID, roomID1, uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"), movieID1, time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC))
+	service.SetTimeSlotSchedule(theaterID, roomID2, uuid.MustParse("5475b333-1883-4261-8b58-944235693558"), movieID2, time.Date(2025, 12, 6, 20, 0, 0, 0, time.UTC))
+	service.SetTimeSlotSchedule(theaterID, roomID1, uuid.MustParse("eed99bc8-1fb4-443b-8287-a988a3bc4406"), movieID1, time.Date(2025, 10, 5, 17, 30, 0, 0, time.UTC))
+
+	tests := []struct {
+		name   string
+		status int
+		params string
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+			params: "?from=2025-10-01&to=2025-12-31",
+		},
+		{
+			name:   "ok-december",
+			status: http.StatusOK,
+			params: "?from=2025-12-01&to=2025-12-31",
+		},
+		{
+			name:   "ok-no-screenings",
+			status: http.StatusOK,
+			params: "?from=2026-01-01&to=2026-01-31",
+		},
+		{
+			name:   "missing-range",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "malformed-range",
+			status: http.StatusBadRequest,
+			params: "?from=01.12.2025&to=2025-12-31",
+		},
+		{
+			name:   "inverted-range",
+			status: http.StatusBadRequest,
+			params: "?from=2025-12-31&to=2025-12-01",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/reports/movies%s", testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestReportsOccupancy(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID1 := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	roomID2 := uuid.MustParse("3f1a7e2c-df46-11f0-9b5d-0b3c8e6f1a2d")
+	movieID := uuid.MustParse("6a1d3c52-df46-11f0-8f3a-7b1e2c4d5f60")
+
+	service.AddRoom(theaterID, roomID1, "Dvorana 1", 10, 15)
+	service.AddRoom(theaterID, roomID2, "Dvorana 2", 8, 10)
+	service.SetTimeSlotSchedule(theaterID, roomID1, uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"), movieID, time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC))
+	service.SetTimeSlotSchedule(theaterID, roomID2, uuid.MustParse("5475b333-1883-4261-8b58-944235693558"), movieID, time.Date(2025, 12, 6, 20, 0, 0, 0, time.UTC))
+	service.SetTimeSlotSchedule(theaterID, roomID1, uuid.MustParse("eed99bc8-1fb4-443b-8287-a988a3bc4406"), movieID, time.Date(2025, 10, 5, 17, 30, 0, 0, time.UTC))
+
+	tests := []struct {
+		name   string
+		status int
+		params string
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+			params: "?from=2025-10-01&to=2025-12-31",
+		},
+		{
+			name:   "ok-december",
+			status: http.StatusOK,
+			params: "?from=2025-12-01&to=2025-12-31",
+		},
+		{
+			name:   "ok-time-slot",
+			status: http.StatusOK,
+			params: "?time_slot_id=5475b333-1883-4261-8b58-944235693558",
+		},
+		{
+			name:   "ok-time-slot-unsold",
+			status: http.StatusOK,
+			params: "?time_slot_id=2d8f4b1e-df46-11f0-8c3a-5f7e9a1b2c3d",
+		},
+		{
+			name:   "invalid-time-slot",
+			status: http.StatusBadRequest,
+			params: "?time_slot_id=not-a-uuid",
+		},
+		{
+			name:   "missing-range",
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/reports/occupancy%s", testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestReportsHeatmap(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	movieID := uuid.MustParse("6a1d3c52-df46-11f0-8f3a-7b1e2c4d5f60")
+
+	service.AddRoom(theaterID, roomID, "Dvorana 1", 10, 15)
+	service.SetTimeSlotSchedule(theaterID, roomID, uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"), movieID, time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC))
+	service.SetTimeSlotSchedule(theaterID, roomID, uuid.MustParse("eed99bc8-1fb4-443b-8287-a988a3bc4406"), movieID, time.Date(2025, 10, 5, 17, 30, 0, 0, time.UTC))
+
+	room := fmt.Sprintf("theater_id=%s&room_id=%s", theaterID, roomID)
+
+	tests := []struct {
+		name   string
+		status int
+		params string
+		svg    bool
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+			params: "?" + room + "&from=2025-10-01&to=2025-12-31",
+		},
+		{
+			name:   "ok-december",
+			status: http.StatusOK,
+			params: "?" + room + "&from=2025-12-01&to=2025-12-31",
+		},
+		{
+			name:   "ok-svg",
+			status: http.StatusOK,
+			params: "?" + room + "&from=2025-10-01&to=2025-12-31&format=svg",
+			svg:    true,
+		},
+		{
+			name:   "missing-params",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid-theater",
+			status: http.StatusBadRequest,
+			params: "?theater_id=x&room_id=" + roomID.String() + "&from=2025-10-01&to=2025-12-31",
+		},
+		{
+			name:   "invalid-format",
+			status: http.StatusBadRequest,
+			params: "?" + room + "&from=2025-10-01&to=2025-12-31&format=pdf",
+		},
+		{
+			name:   "unknown-room",
+			status: http.StatusNotFound,
+			params: "?theater_id=" + theaterID.String() + "&room_id=" + uuid.Nil.String() + "&from=2025-10-01&to=2025-12-31",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/reports/heatmap%s", testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			if !testCase.svg {
+				xtesting.AssertGoldenJSON(t, w)
+				return
+			}
+
+			assert.Equal(t, "image/svg+xml", w.Header().Get("content-type"))
+
+			fileNamePath := fmt.Sprintf("testdata/%s.golden", t.Name())
+			xtesting.UpdateGoldenIfFlagSet(t, w.Body.Bytes(), fileNamePath)
+			assert.Equal(t, string(xtesting.ReadGoldenFile(t, fileNamePath)), w.Body.String())
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/PRPO-skupina-02/common/request"
 	"github.com/PRPO-skupina-02/common/validation"
 	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -18,10 +19,17 @@ type ReservationResponse struct {
 	CreatedAt  time.Time              `json:"created_at"`
 	UpdatedAt  time.Time              `json:"updated_at"`
 	TimeSlotID uuid.UUID              `json:"time_slot_id"`
-	UserID     uuid.UUID              `json:"user_id"`
+	TheaterID  uuid.UUID              `json:"theater_id"`
+	RoomID     uuid.UUID              `json:"room_id"`
+	UserID     *uuid.UUID             `json:"user_id"`
+	APIKeyID   *uuid.UUID             `json:"api_key_id,omitempty"`
 	Type       models.ReservationType `json:"type"`
 	Row        int                    `json:"row"`
 	Col        int                    `json:"col"`
+
+	TimeSlot *ReservationTimeSlotResponse `json:"time_slot,omitempty"`
+	Movie    *ReservationMovieResponse    `json:"movie,omitempty"`
+	Room     *ReservationRoomResponse     `json:"room,omitempty"`
 }
 
 func newReservationResponse(reservation models.Reservation) ReservationResponse {
@@ -30,7 +38,10 @@ func newReservationResponse(reservation models.Reservation) ReservationResponse
 		CreatedAt:  reservation.CreatedAt,
 		UpdatedAt:  reservation.UpdatedAt,
 		TimeSlotID: reservation.TimeSlotID,
+		TheaterID:  reservation.TheaterID,
+		RoomID:     reservation.RoomID,
 		UserID:     reservation.UserID,
+		APIKeyID:   reservation.APIKeyID,
 		Type:       reservation.Type,
 		Row:        reservation.Row,
 		Col:        reservation.Col,
@@ -46,20 +57,39 @@ func newReservationResponse(reservation models.Reservation) ReservationResponse
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			limit	query		int		false	"Limit the number of responses"	Default(10)
-//	@Param			offset	query		int		false	"Offset the first response"		Default(0)
-//	@Param			sort	query		string	false	"Sort results"
-//	@Success		200		{object}	request.PaginatedResponse{data=[]ReservationResponse}
-//	@Failure		400		{object}	middleware.HttpError
-//	@Failure		404		{object}	middleware.HttpError
-//	@Failure		500		{object}	middleware.HttpError
+//	@Param			limit		query		int			false	"Limit the number of responses"	Default(10)
+//	@Param			offset		query		int			false	"Offset the first response"		Default(0)
+//	@Param			sort		query		string		false	"Sort results, only by created_at or updated_at with cursor pagination"
+//	@Param			expand		query		[]string	false	"Embed details from spored"				collectionFormat(csv)	Enums(timeslot, movie, room)
+//	@Param			pagination	query		string		false	"Page with cursors instead of offsets"	Enums(offset, cursor)
+//	@Param			cursor		query		string		false	"Cursor of the page to return, implies cursor pagination"
+//	@Param			count		query		bool		false	"Include the total with cursor pagination"
+//	@Success		200			{object}	CursorPaginatedResponse{data=[]ReservationResponse}
+//	@Failure		400			{object}	middleware.HttpError
+//	@Failure		404			{object}	middleware.HttpError
+//	@Failure		500			{object}	middleware.HttpError
 //	@Router			/reservations [get]
 func ReservationsList(c *gin.Context) {
 	tx := middleware.GetContextTransaction(c)
 	pagination := request.GetNormalizedPaginationArgs(c)
 	sort := request.GetSortOptions(c)
 
-	reservations, total, err := models.GetReservations(tx, pagination, sort)
+	query, ok := bindReservationsQuery(c)
+	if !ok {
+		return
+	}
+
+	keyset, cursorQuery, ok := bindCursorQuery(c)
+	if !ok {
+		return
+	}
+
+	if keyset != nil {
+		reservationsCursorPage(c, keyset, cursorQuery.Count, query.Expand)
+		return
+	}
+
+	reservations, total, err := models.GetReservations(tx, GetContextTheaterScope(c), pagination, sort)
 	if err != nil {
 		_ = c.Error(err)
 		return
@@ -71,9 +101,52 @@ func ReservationsList(c *gin.Context) {
 		response = append(response, newReservationResponse(reservation))
 	}
 
+	expandReservations(c, response, query.Expand)
+
 	request.RenderPaginatedResponse(c, response, total)
 }
 
+// reservationsCursorPage responds with the page of reservations selected by
+// keyset. The total is only counted when asked for, as counting is what makes
+// paging large tables slow.
+func reservationsCursorPage(c *gin.Context, keyset *models.KeysetOptions, count bool, expand []string) {
+	tx := middleware.GetContextTransaction(c)
+	theaterIDs := GetContextTheaterScope(c)
+
+	reservations, more, err := models.GetReservationsKeyset(tx, theaterIDs, keyset)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var total *int
+	if count {
+		counted, err := models.CountReservations(tx, theaterIDs)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		total = &counted
+	}
+
+	response := []ReservationResponse{}
+
+	for _, reservation := range reservations {
+		response = append(response, newReservationResponse(reservation))
+	}
+
+	expandReservations(c, response, expand)
+
+	renderCursorPage(c, response, keyset, more, total, reservationKeysetPosition)
+}
+
+func reservationKeysetPosition(reservation ReservationResponse, column string) models.KeysetPosition {
+	if column == "updated_at" {
+		return models.KeysetPosition{Value: reservation.UpdatedAt, ID: reservation.ID}
+	}
+	return models.KeysetPosition{Value: reservation.CreatedAt, ID: reservation.ID}
+}
+
 // MyReservationsList
 //
 //	@Id				MyReservationsList
@@ -83,9 +156,10 @@ func ReservationsList(c *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			limit	query		int		false	"Limit the number of responses"	Default(10)
-//	@Param			offset	query		int		false	"Offset the first response"		Default(0)
-//	@Param			sort	query		string	false	"Sort results"
+//	@Param			limit	query		int			false	"Limit the number of responses"	Default(10)
+//	@Param			offset	query		int			false	"Offset the first response"		Default(0)
+//	@Param			sort	query		string		false	"Sort results"
+//	@Param			expand	query		[]string	false	"Embed details from spored"	collectionFormat(csv)	Enums(timeslot, movie, room)
 //	@Success		200		{object}	request.PaginatedResponse{data=[]ReservationResponse}
 //	@Failure		400		{object}	middleware.HttpError
 //	@Failure		401		{object}	middleware.HttpError
@@ -97,6 +171,11 @@ func MyReservationsList(c *gin.Context) {
 	pagination := request.GetNormalizedPaginationArgs(c)
 	sort := request.GetSortOptions(c)
 
+	query, ok := bindReservationsQuery(c)
+	if !ok {
+		return
+	}
+
 	reservations, total, err := models.GetUserReservations(tx, userID, pagination, sort)
 	if err != nil {
 		_ = c.Error(err)
@@ -109,6 +188,8 @@ func MyReservationsList(c *gin.Context) {
 		response = append(response, newReservationResponse(reservation))
 	}
 
+	expandReservations(c, response, query.Expand)
+
 	request.RenderPaginatedResponse(c, response, total)
 }
 
@@ -116,7 +197,7 @@ type ReservationRequest struct {
 	TimeSlotID uuid.UUID              `json:"time_slot_id" binding:"required"`
 	TheaterID  uuid.UUID              `json:"theater_id" binding:"required"`
 	RoomID     uuid.UUID              `json:"room_id" binding:"required"`
-	Type       models.ReservationType `json:"type" binding:"required,oneof=ONLINE POS"`
+	Type       models.ReservationType `json:"type" binding:"required,oneof=ONLINE POS" swaggertype:"string" enums:"ONLINE,POS"`
 	Row        int                    `json:"row" binding:"required,min=1"`
 	Col        int                    `json:"col" binding:"required,min=1"`
 }
@@ -125,20 +206,25 @@ type ReservationRequest struct {
 //
 //	@Id				ReservationsCreate
 //	@Summary		Create reservation
-//	@Description	Create reservation
+//	@Description	Create reservation. Reservations created with an API key record the key instead of a user.
 //	@Tags			reservations
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			request	body		ReservationRequest	true	"request body"
-//	@Success		200		{object}	ReservationResponse
-//	@Failure		400		{object}	middleware.HttpError
-//	@Failure		404		{object}	middleware.HttpError
-//	@Failure		500		{object}	middleware.HttpError
+//	@Security		APIKeyAuth
+//	@Param			request			body		ReservationRequest	true	"request body"
+//	@Param			Idempotency-Key	header		string				false	"Key that makes retries of the request safe"
+//	@Success		201				{object}	ReservationResponse
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		401				{object}	middleware.HttpError
+//	@Failure		403				{object}	middleware.HttpError
+//	@Failure		404				{object}	middleware.HttpError
+//	@Failure		422				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Failure		503				{object}	middleware.HttpError
 //	@Router			/reservations [post]
 func ReservationsCreate(c *gin.Context) {
 	tx := middleware.GetContextTransaction(c)
-	timeSlotService := GetTimeSlotService(c)
 
 	var req ReservationRequest
 	err := c.ShouldBindJSON(&req)
@@ -147,27 +233,8 @@ func ReservationsCreate(c *gin.Context) {
 		return
 	}
 
-	timeSlotInfo, err := timeSlotService.ValidateTimeSlotExists(req.TheaterID, req.RoomID, req.TimeSlotID)
-	if err != nil {
-		_ = c.Error(err)
-		return
-	}
-
-	validator, err := validation.GetDefaultValidationEngine()
-	if err != nil {
-		_ = c.Error(err)
-		return
-	}
-
-	err = validator.VarWithKey("row", req.Row, fmt.Sprintf("required,min=1,max=%d", timeSlotInfo.Rows))
-	if err != nil {
-		_ = c.Error(err)
-		return
-	}
-
-	err = validator.VarWithKey("col", req.Col, fmt.Sprintf("required,min=1,max=%d", timeSlotInfo.Columns))
-	if err != nil {
-		_ = c.Error(err)
+	timeSlotInfo, ok := validateSeat(c, req.TheaterID, req.RoomID, req.TimeSlotID, req.Row, req.Col)
+	if !ok {
 		return
 	}
 
@@ -182,15 +249,27 @@ func ReservationsCreate(c *gin.Context) {
 		return
 	}
 
-	userID := middleware.GetContextUserID(c)
+	if !takeSeatHold(c, req.TimeSlotID, req.Row, req.Col) {
+		return
+	}
 
 	reservation := models.Reservation{
 		ID:         uuid.New(),
 		TimeSlotID: req.TimeSlotID,
-		UserID:     userID,
+		TheaterID:  req.TheaterID,
+		RoomID:     req.RoomID,
 		Type:       req.Type,
 		Row:        req.Row,
 		Col:        req.Col,
+
+		ScreeningStartsAt: screeningStart(timeSlotInfo),
+	}
+
+	if apiKey := GetContextAPIKey(c); apiKey != nil {
+		reservation.APIKeyID = &apiKey.ID
+	} else {
+		userID := middleware.GetContextUserID(c)
+		reservation.UserID = &userID
 	}
 
 	err = reservation.Create(tx)
@@ -211,15 +290,33 @@ func ReservationsCreate(c *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			reservationID	path		string	true	"Reservation ID"	Format(uuid)
+//	@Param			reservationID	path		string		true	"Reservation ID"			Format(uuid)
+//	@Param			expand			query		[]string	false	"Embed details from spored"	collectionFormat(csv)	Enums(timeslot, movie, room)
+//	@Param			If-None-Match	header		string		false	"Respond with 304 if the reservation's ETag still matches"
 //	@Success		200				{object}	ReservationResponse
-//	@Failure		400				{object}	middleware.HttpError
-//	@Failure		404				{object}	middleware.HttpError
-//	@Failure		500				{object}	middleware.HttpError
+//	@Header			200				{string}	ETag	"Version of the reservation"
+//	@Success		304
+//	@Failure		400	{object}	middleware.HttpError
+//	@Failure		404	{object}	middleware.HttpError
+//	@Failure		500	{object}	middleware.HttpError
 //	@Router			/reservations/{reservationID} [get]
 func ReservationsShow(c *gin.Context) {
 	reservation := GetContextReservation(c)
-	c.JSON(http.StatusOK, newReservationResponse(reservation))
+
+	query, ok := bindReservationsQuery(c)
+	if !ok {
+		return
+	}
+
+	if notModified(c, reservation.Version) {
+		return
+	}
+
+	response := []ReservationResponse{newReservationResponse(reservation)}
+	expandReservations(c, response, query.Expand)
+
+	setVersionETag(c, reservation.Version)
+	c.JSON(http.StatusOK, response[0])
 }
 
 // ReservationsUpdate
@@ -233,16 +330,23 @@ func ReservationsShow(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Param			reservationID	path		string				true	"Reservation ID"	Format(uuid)
 //	@Param			request			body		ReservationRequest	true	"request body"
+//	@Param			If-Match		header		string				false	"Only change the reservation if its ETag still matches"
 //	@Success		200				{object}	ReservationResponse
+//	@Header			200				{string}	ETag	"Version of the reservation"
 //	@Failure		400				{object}	middleware.HttpError
 //	@Failure		404				{object}	middleware.HttpError
+//	@Failure		412				{object}	middleware.HttpError
 //	@Failure		500				{object}	middleware.HttpError
+//	@Failure		503				{object}	middleware.HttpError
 //	@Router			/reservations/{reservationID} [put]
 func ReservationsUpdate(c *gin.Context) {
 	tx := middleware.GetContextTransaction(c)
-	timeSlotService := GetTimeSlotService(c)
 	reservation := GetContextReservation(c)
 
+	if !checkIfMatch(c, reservation.Version) {
+		return
+	}
+
 	var req ReservationRequest
 	err := c.ShouldBindJSON(&req)
 	if err != nil {
@@ -250,42 +354,91 @@ func ReservationsUpdate(c *gin.Context) {
 		return
 	}
 
-	timeSlotInfo, err := timeSlotService.ValidateTimeSlotExists(req.TheaterID, req.RoomID, req.TimeSlotID)
-	if err != nil {
-		_ = c.Error(err)
+	timeSlotInfo, ok := validateReservationSeat(c, req, reservation.ID)
+	if !ok {
 		return
 	}
 
-	validator, err := validation.GetDefaultValidationEngine()
+	reservation.TimeSlotID = req.TimeSlotID
+	reservation.TheaterID = req.TheaterID
+	reservation.RoomID = req.RoomID
+	reservation.Type = req.Type
+	reservation.Row = req.Row
+	reservation.Col = req.Col
+	reservation.ScreeningStartsAt = screeningStart(timeSlotInfo)
+
+	err = reservation.Save(tx)
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
 
-	err = validator.VarWithKey("row", req.Row, fmt.Sprintf("required,min=1,max=%d", timeSlotInfo.Rows))
-	if err != nil {
-		_ = c.Error(err)
+	setVersionETag(c, reservation.Version)
+	c.JSON(http.StatusOK, newReservationResponse(reservation))
+}
+
+// ReservationsPatch
+//
+//	@Id				ReservationsPatch
+//	@Summary		Partially update reservation
+//	@Description	Change some fields of a reservation with a JSON Merge Patch document. The seat is only validated again when the time slot, theater, room, row or column changes.
+//	@Tags			reservations
+//	@Accept			json,application/merge-patch+json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			reservationID	path		string				true	"Reservation ID"	Format(uuid)
+//	@Param			request			body		ReservationRequest	true	"merge patch document"
+//	@Param			If-Match		header		string				false	"Only change the reservation if its ETag still matches"
+//	@Success		200				{object}	ReservationResponse
+//	@Header			200				{string}	ETag	"Version of the reservation"
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		403				{object}	middleware.HttpError
+//	@Failure		404				{object}	middleware.HttpError
+//	@Failure		412				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Failure		503				{object}	middleware.HttpError
+//	@Router			/reservations/{reservationID} [patch]
+func ReservationsPatch(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	reservation := GetContextReservation(c)
+
+	if !checkIfMatch(c, reservation.Version) {
 		return
 	}
 
-	err = validator.VarWithKey("col", req.Col, fmt.Sprintf("required,min=1,max=%d", timeSlotInfo.Columns))
-	if err != nil {
-		_ = c.Error(err)
-		return
+	current := ReservationRequest{
+		TimeSlotID: reservation.TimeSlotID,
+		TheaterID:  reservation.TheaterID,
+		RoomID:     reservation.RoomID,
+		Type:       reservation.Type,
+		Row:        reservation.Row,
+		Col:        reservation.Col,
 	}
 
-	hasDuplicate, err := models.CheckDuplicateReservation(tx, req.TimeSlotID, req.Row, req.Col, &reservation.ID)
+	var req ReservationRequest
+	err := bindMergePatch(c, current, &req)
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
 
-	if hasDuplicate {
-		_ = c.Error(middleware.NewBadRequestError("seat already reserved"))
-		return
+	seatChanged := req.TimeSlotID != current.TimeSlotID ||
+		req.TheaterID != current.TheaterID ||
+		req.RoomID != current.RoomID ||
+		req.Row != current.Row ||
+		req.Col != current.Col
+
+	if seatChanged {
+		timeSlotInfo, ok := validateReservationSeat(c, req, reservation.ID)
+		if !ok {
+			return
+		}
+		reservation.ScreeningStartsAt = screeningStart(timeSlotInfo)
 	}
 
 	reservation.TimeSlotID = req.TimeSlotID
+	reservation.TheaterID = req.TheaterID
+	reservation.RoomID = req.RoomID
 	reservation.Type = req.Type
 	reservation.Row = req.Row
 	reservation.Col = req.Col
@@ -296,9 +449,107 @@ func ReservationsUpdate(c *gin.Context) {
 		return
 	}
 
+	setVersionETag(c, reservation.Version)
 	c.JSON(http.StatusOK, newReservationResponse(reservation))
 }
 
+// validateReservationSeat checks that a reservation may be moved to the seat
+// in req: the theater must be accessible, the time slot must exist in spored,
+// the seat must be inside the room, not reserved by another reservation and
+// not held by someone else. It returns the time slot the reservation is moved
+// to.
+func validateReservationSeat(c *gin.Context, req ReservationRequest, reservationID uuid.UUID) (*services.TimeSlotInfo, bool) {
+	tx := middleware.GetContextTransaction(c)
+
+	if !CanAccessTheater(c, req.TheaterID) {
+		_ = c.Error(middleware.NewForbiddenError("Not assigned to the reservation's theater"))
+		return nil, false
+	}
+
+	timeSlotInfo, ok := validateSeat(c, req.TheaterID, req.RoomID, req.TimeSlotID, req.Row, req.Col)
+	if !ok {
+		return nil, false
+	}
+
+	hasDuplicate, err := models.CheckDuplicateReservation(tx, req.TimeSlotID, req.Row, req.Col, &reservationID)
+	if err != nil {
+		_ = c.Error(err)
+		return nil, false
+	}
+
+	if hasDuplicate {
+		_ = c.Error(middleware.NewBadRequestError("seat already reserved"))
+		return nil, false
+	}
+
+	if !takeSeatHold(c, req.TimeSlotID, req.Row, req.Col) {
+		return nil, false
+	}
+
+	return timeSlotInfo, true
+}
+
+// validateSeat checks that the time slot exists in spored and that the seat is
+// inside its room. It returns the time slot.
+func validateSeat(c *gin.Context, theaterID, roomID, timeSlotID uuid.UUID, row, col int) (*services.TimeSlotInfo, bool) {
+	timeSlotService := GetTimeSlotService(c)
+
+	timeSlotInfo, err := timeSlotService.ValidateTimeSlotExists(c.Request.Context(), theaterID, roomID, timeSlotID)
+	if err != nil {
+		_ = c.Error(err)
+		return nil, false
+	}
+
+	validator, err := validation.GetDefaultValidationEngine()
+	if err != nil {
+		_ = c.Error(err)
+		return nil, false
+	}
+
+	err = validator.VarWithKey("row", row, fmt.Sprintf("required,min=1,max=%d", timeSlotInfo.Rows))
+	if err != nil {
+		_ = c.Error(err)
+		return nil, false
+	}
+
+	err = validator.VarWithKey("col", col, fmt.Sprintf("required,min=1,max=%d", timeSlotInfo.Columns))
+	if err != nil {
+		_ = c.Error(err)
+		return nil, false
+	}
+
+	return timeSlotInfo, true
+}
+
+// takeSeatHold refuses seats held by someone else and uses up the request's
+// own hold of the seat, which is about to be reserved.
+func takeSeatHold(c *gin.Context, timeSlotID uuid.UUID, row, col int) bool {
+	tx := middleware.GetContextTransaction(c)
+
+	taken, err := models.TakeSeatHold(tx, timeSlotID, row, col, requestOwner(c), time.Now())
+	if err != nil {
+		_ = c.Error(err)
+		return false
+	}
+
+	if !taken {
+		_ = c.Error(middleware.NewBadRequestError("seat is held"))
+		return false
+	}
+
+	return true
+}
+
+// screeningStart returns the start time of the time slot to record on its
+// reservations, or nil when spored did not report one.
+func screeningStart(info *services.TimeSlotInfo) *time.Time {
+	if info.StartTime.IsZero() {
+		return nil
+	}
+	startTime := info.StartTime
+	return &startTime
+}
+
 // ReservationsDelete
 //
 //	@Id				ReservationsDelete
@@ -309,15 +560,21 @@ func ReservationsUpdate(c *gin.Context) {
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			reservationID	path	string	true	"Reservation ID"	Format(uuid)
+//	@Param			If-Match		header	string	false	"Only change the reservation if its ETag still matches"
 //	@Success		204
 //	@Failure		400	{object}	middleware.HttpError
 //	@Failure		404	{object}	middleware.HttpError
+//	@Failure		412	{object}	middleware.HttpError
 //	@Failure		500	{object}	middleware.HttpError
 //	@Router			/reservations/{reservationID} [delete]
 func ReservationsDelete(c *gin.Context) {
 	tx := middleware.GetContextTransaction(c)
 	reservation := GetContextReservation(c)
 
+	if !checkIfMatch(c, reservation.Version) {
+		return
+	}
+
 	err := models.DeleteReservation(tx, reservation.ID)
 	if err != nil {
 		_ = c.Error(err)
@@ -326,3 +583,56 @@ func ReservationsDelete(c *gin.Context) {
 
 	c.JSON(http.StatusNoContent, "")
 }
+
+// ReservationsRestore
+//
+//	@Id				ReservationsRestore
+//	@Summary		Restore reservation
+//	@Description	Restore a deleted reservation together with the purchases that were deleted with it. Fails if the seat has been reserved again or the reservation was refunded.
+//	@Tags			reservations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			reservationID	path		string	true	"Reservation ID"	Format(uuid)
+//	@Param			Idempotency-Key	header		string	false	"Key that makes retries of the request safe"
+//	@Success		200				{object}	ReservationResponse
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		404				{object}	middleware.HttpError
+//	@Failure		409				{object}	middleware.HttpError
+//	@Failure		422				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Router			/reservations/{reservationID}/restore [post]
+func ReservationsRestore(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	reservation := GetContextReservation(c)
+
+	hasDuplicate, err := models.CheckDuplicateReservation(tx, reservation.TimeSlotID, reservation.Row, reservation.Col, &reservation.ID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if hasDuplicate {
+		_ = c.Error(&middleware.HttpError{Code: http.StatusConflict, Message: "seat has been reserved again"})
+		return
+	}
+
+	hasRefund, err := models.ReservationHasRefund(tx, reservation.ID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if hasRefund {
+		_ = c.Error(&middleware.HttpError{Code: http.StatusConflict, Message: "reservation was refunded"})
+		return
+	}
+
+	err = models.RestoreReservation(tx, &reservation)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newReservationResponse(reservation))
+}
@@ -16,15 +16,35 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+var expandMovieID = uuid.MustParse("4f6b1a9e-e0a1-11f0-a5c3-7b2d9e4f8a10")
+
+// addExpandSchedule adds the schedule of two of the three fixture time slots to
+// service. The third one is left out as if it was deleted from spored.
+func addExpandSchedule(service *services.MockTimeSlotService) {
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID1 := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	roomID2 := uuid.MustParse("3f1a7e2c-df46-11f0-9b5d-0b3c8e6f1a2d")
+
+	service.AddMovie(expandMovieID, "Dune: Part Two", 166)
+	service.AddRoom(theaterID, roomID1, "Dvorana 1", 10, 15)
+	service.AddRoom(theaterID, roomID2, "Dvorana 2", 8, 12)
+	service.SetTimeSlotSchedule(theaterID, roomID1, uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"), expandMovieID, time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC))
+	service.SetTimeSlotSchedule(theaterID, roomID2, uuid.MustParse("5475b333-1883-4261-8b58-944235693558"), expandMovieID, time.Date(2025, 12, 6, 20, 30, 0, 0, time.UTC))
+}
+
 func TestReservationsList(t *testing.T) {
 	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
 	service := services.NewMockTimeSlotService()
 	r := TestingRouter(t, db, service)
 
+	addExpandSchedule(service)
+
 	tests := []struct {
-		name   string
-		status int
-		params string
+		name      string
+		status    int
+		params    string
+		sporedErr error
+		warning   string
 	}{
 		{
 			name:   "ok",
@@ -45,6 +65,114 @@ func TestReservationsList(t *testing.T) {
 			status: http.StatusOK,
 			params: "?limit=2&offset=1&sort=updated_at",
 		},
+		{
+			name:   "ok-expand",
+			status: http.StatusOK,
+			params: "?expand=timeslot,movie,room",
+		},
+		{
+			name:      "ok-expand-spored-unavailable",
+			status:    http.StatusOK,
+			params:    "?expand=timeslot,movie,room",
+			sporedErr: &services.UnavailableError{RetryAfter: time.Minute},
+			warning:   `199 nakup "spored unavailable, reservation details were not expanded"`,
+		},
+		{
+			name:   "invalid-expand",
+			status: http.StatusBadRequest,
+			params: "?expand=timeslot,seat",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			service.ShouldError = testCase.sporedErr != nil
+			service.Error = testCase.sporedErr
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/reservations%s", testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			assert.Equal(t, testCase.warning, w.Header().Get("Warning"))
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestReservationsListCursor(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	afterSecond := encodeCursor(pageCursor{
+		Sort:  "created_at",
+		Value: time.Date(2025, 11, 30, 23, 59, 59, 0, time.UTC),
+		ID:    uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d"),
+	})
+	beforeLast := encodeCursor(pageCursor{
+		Sort:     "created_at",
+		Value:    time.Date(2025, 12, 1, 8, 0, 0, 0, time.UTC),
+		ID:       uuid.MustParse("fb126c8c-d059-11f0-8fa4-b35f33be83b7"),
+		Backward: true,
+	})
+
+	tests := []struct {
+		name   string
+		status int
+		params string
+	}{
+		{
+			name:   "ok-first-page",
+			status: http.StatusOK,
+			params: "?pagination=cursor&limit=2",
+		},
+		{
+			name:   "ok-next-page",
+			status: http.StatusOK,
+			params: "?limit=2&cursor=" + afterSecond,
+		},
+		{
+			name:   "ok-prev-page",
+			status: http.StatusOK,
+			params: "?limit=2&cursor=" + beforeLast,
+		},
+		{
+			name:   "ok-sort",
+			status: http.StatusOK,
+			params: "?pagination=cursor&limit=1&sort=-created_at",
+		},
+		{
+			name:   "ok-count",
+			status: http.StatusOK,
+			params: "?pagination=cursor&count=true",
+		},
+		{
+			name:   "unsupported-sort",
+			status: http.StatusBadRequest,
+			params: "?pagination=cursor&sort=row",
+		},
+		{
+			name:   "sort-mismatch",
+			status: http.StatusBadRequest,
+			params: "?sort=-created_at&cursor=" + afterSecond,
+		},
+		{
+			name:   "with-offset",
+			status: http.StatusBadRequest,
+			params: "?pagination=cursor&offset=1",
+		},
+		{
+			name:   "invalid-cursor",
+			status: http.StatusBadRequest,
+			params: "?cursor=invalid",
+		},
 	}
 
 	for _, testCase := range tests {
@@ -77,9 +205,11 @@ func TestReservationsCreate(t *testing.T) {
 	service.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID, 10, 15)
 
 	tests := []struct {
-		name   string
-		body   ReservationRequest
-		status int
+		name       string
+		body       ReservationRequest
+		status     int
+		sporedErr  error
+		retryAfter string
 	}{
 		{
 			name: "ok",
@@ -141,6 +271,18 @@ func TestReservationsCreate(t *testing.T) {
 			},
 			status: http.StatusBadRequest,
 		},
+		{
+			name: "held-seat",
+			body: ReservationRequest{
+				TimeSlotID: timeSlotID,
+				TheaterID:  theaterID,
+				RoomID:     roomID,
+				Type:       models.Online,
+				Row:        8,
+				Col:        8,
+			},
+			status: http.StatusBadRequest,
+		},
 		{
 			name: "invalid-timeslot",
 			body: ReservationRequest{
@@ -153,6 +295,20 @@ func TestReservationsCreate(t *testing.T) {
 			},
 			status: http.StatusNotFound,
 		},
+		{
+			name: "spored-unavailable",
+			body: ReservationRequest{
+				TimeSlotID: timeSlotID,
+				TheaterID:  theaterID,
+				RoomID:     roomID,
+				Type:       models.Online,
+				Row:        7,
+				Col:        12,
+			},
+			status:     http.StatusServiceUnavailable,
+			sporedErr:  &services.UnavailableError{RetryAfter: 1500 * time.Millisecond},
+			retryAfter: "2",
+		},
 		{
 			name:   "no-body",
 			status: http.StatusBadRequest,
@@ -164,6 +320,9 @@ func TestReservationsCreate(t *testing.T) {
 			err := fixtures.Load()
 			assert.NoError(t, err)
 
+			service.ShouldError = testCase.sporedErr != nil
+			service.Error = testCase.sporedErr
+
 			targetURL := "/api/v1/nakup/reservations"
 
 			req := xtesting.NewTestingRequest(t, targetURL, http.MethodPost, testCase.body)
@@ -181,6 +340,7 @@ func TestReservationsCreate(t *testing.T) {
 			ignoreReservations := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"ID": xtesting.ValueUUID(), "CreatedAt": xtesting.ValueTime(), "UpdatedAt": xtesting.ValueTime()}, 10)
 
 			assert.Equal(t, testCase.status, w.Code)
+			assert.Equal(t, testCase.retryAfter, w.Header().Get("Retry-After"))
 			xtesting.AssertGoldenJSON(t, w, ignoreResp)
 			xtesting.AssertGoldenDatabaseTable(t, db.Order("time_slot_id, row, col"), []models.Reservation{}, ignoreReservations)
 		})
@@ -192,16 +352,25 @@ func TestReservationsShow(t *testing.T) {
 	service := services.NewMockTimeSlotService()
 	r := TestingRouter(t, db, service)
 
+	addExpandSchedule(service)
+
 	tests := []struct {
 		name   string
 		status int
 		id     string
+		params string
 	}{
 		{
 			name:   "ok",
 			status: http.StatusOK,
 			id:     "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
 		},
+		{
+			name:   "ok-expand",
+			status: http.StatusOK,
+			id:     "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+			params: "?expand=timeslot,movie,room",
+		},
 		{
 			name:   "invalid-id",
 			status: http.StatusNotFound,
@@ -224,7 +393,7 @@ func TestReservationsShow(t *testing.T) {
 			err := fixtures.Load()
 			assert.NoError(t, err)
 
-			targetURL := fmt.Sprintf("/api/v1/nakup/reservations/%s", testCase.id)
+			targetURL := fmt.Sprintf("/api/v1/nakup/reservations/%s%s", testCase.id, testCase.params)
 
 			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
 			w := httptest.NewRecorder()
@@ -393,11 +562,122 @@ func TestReservationsUpdate(t *testing.T) {
 	}
 }
 
-func TestReservationsDelete(t *testing.T) {
+func TestReservationsPatch(t *testing.T) {
 	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
 	service := services.NewMockTimeSlotService()
 	r := TestingRouter(t, db, service)
 
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	timeSlotID1 := uuid.MustParse("eed99bc8-1fb4-443b-8287-a988a3bc4406")
+	timeSlotID2 := uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295")
+
+	service.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID1, 12, 18)
+	service.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID2, 10, 15)
+
+	tests := []struct {
+		name   string
+		body   any
+		status int
+		id     string
+	}{
+		{
+			// The reservation's time slot is unknown to spored, so this only
+			// passes without validating the seat.
+			name:   "ok-type",
+			body:   map[string]any{"type": models.Online},
+			status: http.StatusOK,
+			id:     "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+		{
+			name: "ok-seat",
+			body: map[string]any{
+				"time_slot_id": timeSlotID1,
+				"room_id":      roomID,
+				"row":          10,
+				"col":          15,
+			},
+			status: http.StatusOK,
+			id:     "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+		{
+			name: "row-too-large",
+			body: map[string]any{
+				"time_slot_id": timeSlotID2,
+				"room_id":      roomID,
+				"row":          11,
+			},
+			status: http.StatusBadRequest,
+			id:     "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+		{
+			name: "duplicate-seat",
+			body: map[string]any{
+				"time_slot_id": timeSlotID2,
+				"room_id":      roomID,
+				"row":          5,
+				"col":          10,
+			},
+			status: http.StatusBadRequest,
+			id:     "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+		{
+			name:   "validation-errors",
+			body:   map[string]any{"type": "INVALID", "row": nil},
+			status: http.StatusBadRequest,
+			id:     "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+		{
+			name:   "wrong-value-type",
+			body:   map[string]any{"row": "five"},
+			status: http.StatusBadRequest,
+			id:     "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+		{
+			name:   "not-an-object",
+			body:   []any{},
+			status: http.StatusBadRequest,
+			id:     "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+		},
+		{
+			name:   "invalid-id",
+			body:   map[string]any{"type": models.Online},
+			status: http.StatusNotFound,
+			id:     "01234567-0123-0123-0123-0123456789ab",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/reservations/%s", testCase.id)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodPatch, testCase.body)
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"updated_at": xtesting.ValueTimeInPastDuration(time.Second),
+			}
+
+			ignoreReservations := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"UpdatedAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Reservation{}, ignoreReservations)
+		})
+	}
+}
+
+func TestReservationsDelete(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
 	tests := []struct {
 		name   string
 		status int
@@ -445,11 +725,222 @@ func TestReservationsDelete(t *testing.T) {
 	}
 }
 
+func TestReservationsConditionalRequests(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	timeSlotID := uuid.MustParse("eed99bc8-1fb4-443b-8287-a988a3bc4406")
+
+	service.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID, 12, 18)
+
+	reservation := ReservationRequest{
+		TimeSlotID: timeSlotID,
+		TheaterID:  theaterID,
+		RoomID:     roomID,
+		Type:       models.Pos,
+		Row:        4,
+		Col:        4,
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		body        any
+		ifMatch     string
+		ifNoneMatch string
+		status      int
+		etag        string
+	}{
+		{
+			name:   "show",
+			method: http.MethodGet,
+			status: http.StatusOK,
+			etag:   `"1"`,
+		},
+		{
+			name:        "show-not-modified",
+			method:      http.MethodGet,
+			ifNoneMatch: `"1"`,
+			status:      http.StatusNotModified,
+			etag:        `"1"`,
+		},
+		{
+			name:        "show-not-modified-weak",
+			method:      http.MethodGet,
+			ifNoneMatch: `"0", W/"1"`,
+			status:      http.StatusNotModified,
+			etag:        `"1"`,
+		},
+		{
+			name:        "show-modified",
+			method:      http.MethodGet,
+			ifNoneMatch: `"0"`,
+			status:      http.StatusOK,
+			etag:        `"1"`,
+		},
+		{
+			name:    "update",
+			method:  http.MethodPut,
+			body:    reservation,
+			ifMatch: `"1"`,
+			status:  http.StatusOK,
+			etag:    `"2"`,
+		},
+		{
+			name:   "update-without-if-match",
+			method: http.MethodPut,
+			body:   reservation,
+			status: http.StatusOK,
+			etag:   `"2"`,
+		},
+		{
+			name:    "update-stale",
+			method:  http.MethodPut,
+			body:    reservation,
+			ifMatch: `"0"`,
+			status:  http.StatusPreconditionFailed,
+		},
+		{
+			name:    "update-weak",
+			method:  http.MethodPut,
+			body:    reservation,
+			ifMatch: `W/"1"`,
+			status:  http.StatusPreconditionFailed,
+		},
+		{
+			name:    "delete-any",
+			method:  http.MethodDelete,
+			ifMatch: "*",
+			status:  http.StatusNoContent,
+		},
+		{
+			name:    "delete-stale",
+			method:  http.MethodDelete,
+			ifMatch: `"2", "3"`,
+			status:  http.StatusPreconditionFailed,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			req := xtesting.NewTestingRequest(t, "/api/v1/nakup/reservations/fb126c8c-d059-11f0-8fa4-b35f33be83b7", testCase.method, testCase.body)
+			if testCase.ifMatch != "" {
+				req.Header.Set("If-Match", testCase.ifMatch)
+			}
+			if testCase.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", testCase.ifNoneMatch)
+			}
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"updated_at": xtesting.ValueTime(),
+			}
+
+			ignoreReservations := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"UpdatedAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			assert.Equal(t, testCase.etag, w.Header().Get("ETag"))
+			if testCase.status == http.StatusNotModified {
+				assert.Empty(t, w.Body.String())
+			} else {
+				xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			}
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Reservation{}, ignoreReservations)
+		})
+	}
+}
+
+func TestReservationsRestore(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name        string
+		status      int
+		id          string
+		deleteFirst bool
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+			id:     "0c3e5a7b-e4b2-11f0-8d1f-9b2c4d6e8f0a",
+		},
+		{
+			name:        "ok-after-delete",
+			status:      http.StatusOK,
+			id:          "fb126c8c-d059-11f0-8fa4-b35f33be83b7",
+			deleteFirst: true,
+		},
+		{
+			name:   "seat-reserved-again",
+			status: http.StatusConflict,
+			id:     "1d4f6b8c-e4b2-11f0-9e2a-ac3d5e7f9a1b",
+		},
+		{
+			name:   "refunded",
+			status: http.StatusConflict,
+			id:     "7e8f9a0b-e0f1-11f0-8b2c-2d3e4f5a6b7c",
+		},
+		{
+			name:   "not-deleted",
+			status: http.StatusNotFound,
+			id:     "bae209f6-d059-11f0-b2a4-cbf992c2eb6d",
+		},
+		{
+			name:   "invalid-id",
+			status: http.StatusNotFound,
+			id:     "01234567-0123-0123-0123-0123456789ab",
+		},
+		{
+			name:   "nil-id",
+			status: http.StatusBadRequest,
+			id:     "00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			if testCase.deleteFirst {
+				req := xtesting.NewTestingRequest(t, fmt.Sprintf("/api/v1/nakup/reservations/%s", testCase.id), http.MethodDelete, nil)
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+				assert.Equal(t, http.StatusNoContent, w.Code)
+			}
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/reservations/%s/restore", testCase.id)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodPost, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Reservation{}, nil)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Purchase{}, nil)
+		})
+	}
+}
+
 func TestMyReservationsList(t *testing.T) {
 	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
 	service := services.NewMockTimeSlotService()
 	r := TestingRouter(t, db, service)
 
+	addExpandSchedule(service)
+
 	tests := []struct {
 		name   string
 		status int
@@ -469,6 +960,11 @@ func TestMyReservationsList(t *testing.T) {
 			status: http.StatusOK,
 			params: "?sort=-updated_at",
 		},
+		{
+			name:   "ok-expand",
+			status: http.StatusOK,
+			params: "?expand=movie",
+		},
 	}
 
 	for _, testCase := range tests {
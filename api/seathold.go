@@ -0,0 +1,170 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SeatHoldRequest struct {
+	TheaterID uuid.UUID `json:"theater_id" binding:"required"`
+	RoomID    uuid.UUID `json:"room_id" binding:"required"`
+	Row       int       `json:"row" binding:"required,min=1"`
+	Col       int       `json:"col" binding:"required,min=1"`
+}
+
+type SeatHoldResponse struct {
+	ID         uuid.UUID `json:"id"`
+	TimeSlotID uuid.UUID `json:"time_slot_id"`
+	TheaterID  uuid.UUID `json:"theater_id"`
+	RoomID     uuid.UUID `json:"room_id"`
+	Row        int       `json:"row"`
+	Col        int       `json:"col"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+func newSeatHoldResponse(hold models.SeatHold) SeatHoldResponse {
+	return SeatHoldResponse{
+		ID:         hold.ID,
+		TimeSlotID: hold.TimeSlotID,
+		TheaterID:  hold.TheaterID,
+		RoomID:     hold.RoomID,
+		Row:        hold.Row,
+		Col:        hold.Col,
+		ExpiresAt:  hold.ExpiresAt,
+	}
+}
+
+// SeatHoldsCreate
+//
+//	@Id				SeatHoldsCreate
+//	@Summary		Hold seat
+//	@Description	Hold a seat of a time slot while the customer completes the reservation. Nobody else can hold or reserve the seat until the hold expires, is released or is used up by reserving the seat. Holding a seat again renews the hold.
+//	@Tags			seats
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		APIKeyAuth
+//	@Param			timeSlotID		path		string			true	"Time slot ID"	Format(uuid)
+//	@Param			request			body		SeatHoldRequest	true	"request body"
+//	@Param			Idempotency-Key	header		string			false	"Key that makes retries of the request safe"
+//	@Success		201				{object}	SeatHoldResponse
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		401				{object}	middleware.HttpError
+//	@Failure		403				{object}	middleware.HttpError
+//	@Failure		404				{object}	middleware.HttpError
+//	@Failure		422				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Failure		503				{object}	middleware.HttpError
+//	@Router			/timeslots/{timeSlotID}/holds [post]
+func SeatHoldsCreate(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+
+	timeSlotID, err := request.GetUUIDParam(c, "timeSlotID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req SeatHoldRequest
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if _, ok := validateSeat(c, req.TheaterID, req.RoomID, timeSlotID, req.Row, req.Col); !ok {
+		return
+	}
+
+	now := time.Now()
+	hold := models.SeatHold{
+		ID:         uuid.New(),
+		ExpiresAt:  now.Add(models.SeatHoldTTL),
+		TimeSlotID: timeSlotID,
+		TheaterID:  req.TheaterID,
+		RoomID:     req.RoomID,
+		Row:        req.Row,
+		Col:        req.Col,
+		Owner:      requestOwner(c),
+	}
+
+	claimed, err := models.ClaimSeatHold(tx, &hold, now)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if !claimed {
+		_ = c.Error(middleware.NewBadRequestError("seat is held"))
+		return
+	}
+
+	// The seat is claimed before looking for its reservation, so a reservation
+	// made at the same time is either seen here or waits for the hold.
+	hasDuplicate, err := models.CheckDuplicateReservation(tx, timeSlotID, req.Row, req.Col, nil)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if hasDuplicate {
+		_ = c.Error(middleware.NewBadRequestError("seat already reserved"))
+		return
+	}
+
+	c.JSON(http.StatusCreated, newSeatHoldResponse(hold))
+}
+
+// SeatHoldsDelete
+//
+//	@Id				SeatHoldsDelete
+//	@Summary		Release seat hold
+//	@Description	Release a seat held by the user or API key making the request
+//	@Tags			seats
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		APIKeyAuth
+//	@Param			timeSlotID	path	string	true	"Time slot ID"	Format(uuid)
+//	@Param			holdID		path	string	true	"Seat hold ID"	Format(uuid)
+//	@Success		204
+//	@Failure		400	{object}	middleware.HttpError
+//	@Failure		401	{object}	middleware.HttpError
+//	@Failure		403	{object}	middleware.HttpError
+//	@Failure		404	{object}	middleware.HttpError
+//	@Failure		500	{object}	middleware.HttpError
+//	@Router			/timeslots/{timeSlotID}/holds/{holdID} [delete]
+func SeatHoldsDelete(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+
+	timeSlotID, err := request.GetUUIDParam(c, "timeSlotID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	holdID, err := request.GetUUIDParam(c, "holdID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	hold, err := models.GetSeatHold(tx, timeSlotID, holdID, requestOwner(c))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if err := models.ReleaseSeatHold(tx, hold); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, "")
+}
@@ -0,0 +1,209 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	authmodels "github.com/PRPO-skupina-02/common/clients/auth/models"
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeatHoldsCreate(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	employee := TestingRouter(t, db, service)
+	customer := TestingRouterWithUser(t, db, service, uuid.MustParse("00000000-0000-0000-0000-000000000002"), authmodels.ModelsUserRoleCustomer)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	timeSlotID := "9d71d7fd-d88e-41a1-86dc-21b7f2550295"
+
+	service.AddValidTimeSlotWithRoom(theaterID, roomID, uuid.MustParse(timeSlotID), 10, 15)
+
+	tests := []struct {
+		name       string
+		body       SeatHoldRequest
+		status     int
+		timeSlotID string
+		customer   bool
+	}{
+		{
+			name: "ok",
+			body: SeatHoldRequest{
+				TheaterID: theaterID,
+				RoomID:    roomID,
+				Row:       6,
+				Col:       6,
+			},
+			status:     http.StatusCreated,
+			timeSlotID: timeSlotID,
+		},
+		{
+			name: "ok-expired-hold",
+			body: SeatHoldRequest{
+				TheaterID: theaterID,
+				RoomID:    roomID,
+				Row:       9,
+				Col:       9,
+			},
+			status:     http.StatusCreated,
+			timeSlotID: timeSlotID,
+		},
+		{
+			name: "ok-renew",
+			body: SeatHoldRequest{
+				TheaterID: theaterID,
+				RoomID:    roomID,
+				Row:       8,
+				Col:       8,
+			},
+			status:     http.StatusCreated,
+			timeSlotID: timeSlotID,
+			customer:   true,
+		},
+		{
+			name: "held-seat",
+			body: SeatHoldRequest{
+				TheaterID: theaterID,
+				RoomID:    roomID,
+				Row:       8,
+				Col:       8,
+			},
+			status:     http.StatusBadRequest,
+			timeSlotID: timeSlotID,
+		},
+		{
+			name: "reserved-seat",
+			body: SeatHoldRequest{
+				TheaterID: theaterID,
+				RoomID:    roomID,
+				Row:       5,
+				Col:       10,
+			},
+			status:     http.StatusBadRequest,
+			timeSlotID: timeSlotID,
+		},
+		{
+			name: "row-too-large",
+			body: SeatHoldRequest{
+				TheaterID: theaterID,
+				RoomID:    roomID,
+				Row:       11,
+				Col:       5,
+			},
+			status:     http.StatusBadRequest,
+			timeSlotID: timeSlotID,
+		},
+		{
+			name:       "no-body",
+			status:     http.StatusBadRequest,
+			timeSlotID: timeSlotID,
+		},
+		{
+			name: "malformed-time-slot-id",
+			body: SeatHoldRequest{
+				TheaterID: theaterID,
+				RoomID:    roomID,
+				Row:       6,
+				Col:       6,
+			},
+			status:     http.StatusBadRequest,
+			timeSlotID: "000",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			r := employee
+			if testCase.customer {
+				r = customer
+			}
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/timeslots/%s/holds", testCase.timeSlotID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodPost, testCase.body)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"id":         xtesting.ValueUUID(),
+				"expires_at": xtesting.ValueTime(),
+			}
+
+			ignoreHolds := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"ID": xtesting.ValueUUID(), "CreatedAt": xtesting.ValueTime(), "ExpiresAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("row, col"), []models.SeatHold{}, ignoreHolds)
+		})
+	}
+}
+
+func TestSeatHoldsDelete(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	employee := TestingRouter(t, db, service)
+	customer := TestingRouterWithUser(t, db, service, uuid.MustParse("00000000-0000-0000-0000-000000000002"), authmodels.ModelsUserRoleCustomer)
+
+	tests := []struct {
+		name     string
+		status   int
+		holdID   string
+		customer bool
+	}{
+		{
+			name:     "ok",
+			status:   http.StatusNoContent,
+			holdID:   "5a1b2c3d-f0a1-11f0-9c2e-1b3d5f7a9c0e",
+			customer: true,
+		},
+		{
+			name:   "other-owner",
+			status: http.StatusNotFound,
+			holdID: "5a1b2c3d-f0a1-11f0-9c2e-1b3d5f7a9c0e",
+		},
+		{
+			name:     "invalid-hold-id",
+			status:   http.StatusNotFound,
+			holdID:   "01234567-0123-0123-0123-0123456789ab",
+			customer: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			r := employee
+			if testCase.customer {
+				r = customer
+			}
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/holds/%s", testCase.holdID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodDelete, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreHolds := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"CreatedAt": xtesting.ValueTime(), "ExpiresAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("row, col"), []models.SeatHold{}, ignoreHolds)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/seats"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// seatStreamKeepAlive is how often an idle seat stream sends a comment, so
+// proxies do not close it.
+const seatStreamKeepAlive = 30 * time.Second
+
+type SeatMapResponse struct {
+	TimeSlotID uuid.UUID      `json:"time_slot_id"`
+	Reserved   []SeatResponse `json:"reserved"`
+	Held       []SeatResponse `json:"held"`
+}
+
+type SeatMapStreamTicketResponse struct {
+	Ticket    string    `json:"ticket"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type SeatChangeResponse struct {
+	Row   int         `json:"row"`
+	Col   int         `json:"col"`
+	State seats.State `json:"state" swaggertype:"string" enums:"reserved,held,released"`
+}
+
+// SeatMapShow
+//
+//	@Id				SeatMapShow
+//	@Summary		Show seat map
+//	@Description	List the seats that are already reserved for a time slot and the seats that are held while a reservation is being made
+//	@Tags			seats
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		APIKeyAuth
+//	@Param			timeSlotID	path		string	true	"Time slot ID"	Format(uuid)
+//	@Success		200			{object}	SeatMapResponse
+//	@Failure		400			{object}	middleware.HttpError
+//	@Failure		401			{object}	middleware.HttpError
+//	@Failure		403			{object}	middleware.HttpError
+//	@Failure		500			{object}	middleware.HttpError
+//	@Router			/timeslots/{timeSlotID}/seats [get]
+func SeatMapShow(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+
+	timeSlotID, err := request.GetUUIDParam(c, "timeSlotID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	reservations, err := models.GetTimeSlotReservations(tx, timeSlotID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	holds, err := models.GetTimeSlotsHeldSeats(tx, []uuid.UUID{timeSlotID}, time.Now())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := SeatMapResponse{
+		TimeSlotID: timeSlotID,
+		Reserved:   []SeatResponse{},
+		Held:       []SeatResponse{},
+	}
+
+	reserved := map[SeatResponse]bool{}
+	for _, reservation := range reservations {
+		seat := SeatResponse{
+			Row: reservation.Row,
+			Col: reservation.Col,
+		}
+		reserved[seat] = true
+		response.Reserved = append(response.Reserved, seat)
+	}
+
+	for _, hold := range holds {
+		seat := SeatResponse{
+			Row: hold.Row,
+			Col: hold.Col,
+		}
+		if !reserved[seat] {
+			response.Held = append(response.Held, seat)
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SeatMapStream
+//
+//	@Id				SeatMapStream
+//	@Summary		Stream seat map
+//	@Description	Stream the reserved and held seats of a time slot as Server-Sent Events. The stream starts with a `snapshot` event carrying a SeatMapResponse, followed by a `seat` event carrying a SeatChangeResponse whenever a seat is reserved, held or released through any replica, including when a hold expires. Clients should reconnect when the stream ends. Browsers, whose EventSource cannot send headers, pass a stream ticket as `ticket` in the query instead.
+//	@Tags			seats
+//	@Produce		text/event-stream
+//	@Security		BearerAuth
+//	@Security		APIKeyAuth
+//	@Param			timeSlotID	path		string	true	"Time slot ID"	Format(uuid)
+//	@Param			ticket		query		string	false	"Stream ticket, for clients such as EventSource that cannot send headers"
+//	@Success		200			{object}	SeatChangeResponse
+//	@Failure		400			{object}	middleware.HttpError
+//	@Failure		401			{object}	middleware.HttpError
+//	@Failure		403			{object}	middleware.HttpError
+//	@Failure		500			{object}	middleware.HttpError
+//	@Router			/timeslots/{timeSlotID}/seats/stream [get]
+func SeatMapStream(c *gin.Context) {
+	watcher := GetSeatWatcher(c)
+
+	timeSlotID, err := request.GetUUIDParam(c, "timeSlotID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	subscription, err := watcher.Subscribe(c.Request.Context(), timeSlotID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	defer subscription.Close()
+
+	snapshot := SeatMapResponse{
+		TimeSlotID: timeSlotID,
+		Reserved:   []SeatResponse{},
+		Held:       []SeatResponse{},
+	}
+
+	for _, seat := range subscription.Snapshot {
+		response := SeatResponse{
+			Row: seat.Row,
+			Col: seat.Col,
+		}
+
+		if seat.State == seats.StateHeld {
+			snapshot.Held = append(snapshot.Held, response)
+		} else {
+			snapshot.Reserved = append(snapshot.Reserved, response)
+		}
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.SSEvent("snapshot", snapshot)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(seatStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-keepAlive.C:
+			_, _ = io.WriteString(c.Writer, ": keep-alive\n\n")
+		case changes, ok := <-subscription.Changes:
+			if !ok {
+				return
+			}
+
+			for _, change := range changes {
+				c.SSEvent("seat", SeatChangeResponse{
+					Row:   change.Row,
+					Col:   change.Col,
+					State: change.State,
+				})
+			}
+		}
+
+		c.Writer.Flush()
+	}
+}
+
+// SeatMapStreamTicketsCreate
+//
+//	@Id				SeatMapStreamTicketsCreate
+//	@Summary		Create seat stream ticket
+//	@Description	Issue a ticket that opens the seat stream of a time slot once, as the user or API key making the request, for clients such as EventSource that cannot send headers. The ticket expires after 30 seconds.
+//	@Tags			seats
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		APIKeyAuth
+//	@Param			timeSlotID	path		string	true	"Time slot ID"	Format(uuid)
+//	@Success		201			{object}	SeatMapStreamTicketResponse
+//	@Failure		400			{object}	middleware.HttpError
+//	@Failure		401			{object}	middleware.HttpError
+//	@Failure		403			{object}	middleware.HttpError
+//	@Failure		500			{object}	middleware.HttpError
+//	@Router			/timeslots/{timeSlotID}/seats/stream/tickets [post]
+func SeatMapStreamTicketsCreate(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+
+	timeSlotID, err := request.GetUUIDParam(c, "timeSlotID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	ticket, err := models.GenerateAPIKey()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	streamTicket := models.StreamTicket{
+		ID:         uuid.New(),
+		ExpiresAt:  time.Now().Add(models.StreamTicketTTL),
+		TimeSlotID: timeSlotID,
+	}
+	streamTicket.SetTicket(ticket)
+
+	if apiKey := GetContextAPIKey(c); apiKey != nil {
+		streamTicket.APIKeyID = &apiKey.ID
+	} else {
+		userID := middleware.GetContextUserID(c)
+		role := string(middleware.GetContextUserRole(c))
+		streamTicket.UserID = &userID
+		streamTicket.UserRole = &role
+	}
+
+	if err := streamTicket.Create(tx); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, SeatMapStreamTicketResponse{
+		Ticket:    ticket,
+		ExpiresAt: streamTicket.ExpiresAt,
+	})
+}
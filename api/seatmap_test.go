@@ -0,0 +1,263 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeatMapShow(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := TestingRouter(t, db, service)
+
+	tests := []struct {
+		name       string
+		status     int
+		timeSlotID string
+	}{
+		{
+			name:       "ok",
+			status:     http.StatusOK,
+			timeSlotID: "9d71d7fd-d88e-41a1-86dc-21b7f2550295",
+		},
+		{
+			name:       "ok-no-reservations",
+			status:     http.StatusOK,
+			timeSlotID: "01234567-0123-0123-0123-0123456789ab",
+		},
+		{
+			name:       "malformed-time-slot-id",
+			status:     http.StatusBadRequest,
+			timeSlotID: "000",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/timeslots/%s/seats", testCase.timeSlotID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+// readSSEvent reads the next event of a Server-Sent Events stream, skipping
+// comments.
+func readSSEvent(t *testing.T, reader *bufio.Reader) string {
+	var event strings.Builder
+
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+
+		switch {
+		case line == "\n" && event.Len() > 0:
+			return event.String()
+		case line == "\n", strings.HasPrefix(line, ":"):
+		default:
+			event.WriteString(line)
+		}
+	}
+}
+
+// newStreamTicket issues a stream ticket for a time slot to apiKey, or to the
+// testing user when apiKey is empty.
+func newStreamTicket(t *testing.T, r http.Handler, timeSlotID, apiKey string) string {
+	req := xtesting.NewTestingRequest(t, "/api/v1/nakup/timeslots/"+timeSlotID+"/seats/stream/tickets", http.MethodPost, nil)
+	if apiKey != "" {
+		req.Header.Set(APIKeyHeader, apiKey)
+	}
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var response SeatMapStreamTicketResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Ticket
+}
+
+func TestSeatMapStream(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	timeSlotID := uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295")
+
+	service.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID, 10, 15)
+
+	t.Run("ok", func(t *testing.T) {
+		require.NoError(t, fixtures.Load())
+
+		server := httptest.NewServer(r)
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/nakup/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/seats/stream", nil)
+		require.NoError(t, err)
+
+		resp, err := server.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream;charset=utf-8", resp.Header.Get("Content-Type"))
+
+		reader := bufio.NewReader(resp.Body)
+		assert.Equal(t, "event:snapshot\ndata:{\"time_slot_id\":\"9d71d7fd-d88e-41a1-86dc-21b7f2550295\",\"reserved\":[{\"row\":5,\"col\":10}],\"held\":[{\"row\":8,\"col\":8}]}\n", readSSEvent(t, reader))
+
+		create := xtesting.NewTestingRequest(t, "/api/v1/nakup/reservations", http.MethodPost, ReservationRequest{
+			TimeSlotID: timeSlotID,
+			TheaterID:  theaterID,
+			RoomID:     roomID,
+			Type:       models.Online,
+			Row:        6,
+			Col:        10,
+		})
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, create)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, "event:seat\ndata:{\"row\":6,\"col\":10,\"state\":\"reserved\"}\n", readSSEvent(t, reader))
+
+		var created ReservationResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+
+		remove := xtesting.NewTestingRequest(t, "/api/v1/nakup/reservations/"+created.ID.String(), http.MethodDelete, nil)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, remove)
+		require.Equal(t, http.StatusNoContent, w.Code)
+
+		assert.Equal(t, "event:seat\ndata:{\"row\":6,\"col\":10,\"state\":\"released\"}\n", readSSEvent(t, reader))
+
+		hold := xtesting.NewTestingRequest(t, "/api/v1/nakup/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/holds", http.MethodPost, SeatHoldRequest{
+			TheaterID: theaterID,
+			RoomID:    roomID,
+			Row:       6,
+			Col:       11,
+		})
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, hold)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		assert.Equal(t, "event:seat\ndata:{\"row\":6,\"col\":11,\"state\":\"held\"}\n", readSSEvent(t, reader))
+
+		var held SeatHoldResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &held))
+
+		release := xtesting.NewTestingRequest(t, "/api/v1/nakup/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/holds/"+held.ID.String(), http.MethodDelete, nil)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, release)
+		require.Equal(t, http.StatusNoContent, w.Code)
+
+		assert.Equal(t, "event:seat\ndata:{\"row\":6,\"col\":11,\"state\":\"released\"}\n", readSSEvent(t, reader))
+	})
+
+	tickets := []struct {
+		name   string
+		apiKey string
+	}{
+		{name: "user-ticket"},
+		{name: "api-key-ticket", apiKey: "reseller-test-key"},
+	}
+
+	for _, testCase := range tickets {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.NoError(t, fixtures.Load())
+
+			server := httptest.NewServer(r)
+			defer server.Close()
+
+			ticket := newStreamTicket(t, r, timeSlotID.String(), testCase.apiKey)
+			targetURL := "/api/v1/nakup/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/seats/stream?ticket=" + ticket
+
+			ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+			defer cancel()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+targetURL, nil)
+			require.NoError(t, err)
+
+			resp, err := server.Client().Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			reader := bufio.NewReader(resp.Body)
+			assert.True(t, strings.HasPrefix(readSSEvent(t, reader), "event:snapshot\n"))
+
+			// Tickets can only be used once.
+			reuse := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, reuse)
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+		})
+	}
+
+	t.Run("ticket-of-other-time-slot", func(t *testing.T) {
+		require.NoError(t, fixtures.Load())
+
+		ticket := newStreamTicket(t, r, "01234567-0123-0123-0123-0123456789ab", "reseller-test-key")
+
+		req := xtesting.NewTestingRequest(t, "/api/v1/nakup/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/seats/stream?ticket="+ticket, http.MethodGet, nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		xtesting.AssertGoldenJSON(t, w)
+	})
+
+	t.Run("unknown-ticket", func(t *testing.T) {
+		require.NoError(t, fixtures.Load())
+
+		req := xtesting.NewTestingRequest(t, "/api/v1/nakup/timeslots/9d71d7fd-d88e-41a1-86dc-21b7f2550295/seats/stream?ticket=unknown-ticket", http.MethodGet, nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		xtesting.AssertGoldenJSON(t, w)
+	})
+
+	t.Run("malformed-time-slot-id", func(t *testing.T) {
+		require.NoError(t, fixtures.Load())
+
+		req := xtesting.NewTestingRequest(t, "/api/v1/nakup/timeslots/000/seats/stream", http.MethodGet, nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		xtesting.AssertGoldenJSON(t, w)
+	})
+}
@@ -0,0 +1,261 @@
+package api
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	SporedTimeSlotUpdated = "time_slot.updated"
+	SporedTimeSlotDeleted = "time_slot.deleted"
+)
+
+type SporedEventRequest struct {
+	Type       string    `json:"type" binding:"required,oneof=time_slot.updated time_slot.deleted"`
+	TimeSlotID uuid.UUID `json:"time_slot_id" binding:"required"`
+	TheaterID  uuid.UUID `json:"theater_id" binding:"required_if=Type time_slot.updated"`
+	RoomID     uuid.UUID `json:"room_id" binding:"required_if=Type time_slot.updated"`
+	// StartTime is the (possibly new) start of an updated time slot.
+	StartTime *time.Time `json:"start_time"`
+}
+
+type SporedEventResponse struct {
+	TimeSlotID uuid.UUID        `json:"time_slot_id"`
+	Updated    []uuid.UUID      `json:"updated"`
+	Refunds    []RefundResponse `json:"refunds"`
+}
+
+type CacheStatsResponse struct {
+	Hits         uint64  `json:"hits"`
+	NegativeHits uint64  `json:"negative_hits"`
+	Misses       uint64  `json:"misses"`
+	Fetches      uint64  `json:"fetches"`
+	Entries      int     `json:"entries"`
+	HitRatio     float64 `json:"hit_ratio"`
+}
+
+func newCacheStatsResponse(stats services.CacheStats) CacheStatsResponse {
+	response := CacheStatsResponse{
+		Hits:         stats.Hits,
+		NegativeHits: stats.NegativeHits,
+		Misses:       stats.Misses,
+		Fetches:      stats.Fetches,
+		Entries:      stats.Entries,
+	}
+
+	lookups := stats.Hits + stats.NegativeHits + stats.Misses
+	if lookups > 0 {
+		response.HitRatio = math.Round(float64(stats.Hits+stats.NegativeHits)/float64(lookups)*10000) / 10000
+	}
+
+	return response
+}
+
+type SporedCacheStatsResponse struct {
+	Validations CacheStatsResponse `json:"validations"`
+	TimeSlots   CacheStatsResponse `json:"time_slots"`
+	Rooms       CacheStatsResponse `json:"rooms"`
+	Movies      CacheStatsResponse `json:"movies"`
+}
+
+// SporedEventsReceive
+//
+//	@Id				SporedEventsReceive
+//	@Summary		Receive spored event
+//	@Description	Apply a time slot change made in spored to its reservations. When a time slot is deleted all of its reservations are cancelled and flagged for refund. When a time slot is updated its reservations are moved to the (possibly new) room and start time and the ones whose seat does not exist in that room anymore are cancelled and flagged for refund.
+//	@Tags			spored
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		APIKeyAuth
+//	@Param			request			body		SporedEventRequest	true	"request body"
+//	@Param			Idempotency-Key	header		string				false	"Key that makes retries of the request safe"
+//	@Success		200				{object}	SporedEventResponse
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		401				{object}	middleware.HttpError
+//	@Failure		403				{object}	middleware.HttpError
+//	@Failure		404				{object}	middleware.HttpError
+//	@Failure		422				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Failure		503				{object}	middleware.HttpError
+//	@Router			/spored/events [post]
+func SporedEventsReceive(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	timeSlotService := GetTimeSlotService(c)
+	ticketPriceCents := GetTicketPriceCents(c)
+
+	var req SporedEventRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if cache, ok := timeSlotService.(services.TimeSlotCache); ok {
+		cache.InvalidateTimeSlot(req.TimeSlotID)
+		if req.Type == SporedTimeSlotUpdated {
+			cache.InvalidateRoom(req.TheaterID, req.RoomID)
+		}
+	}
+
+	// The other replicas drop their entries once the transaction commits.
+	if err := models.NotifySporedTimeSlotInvalidated(tx, req.TimeSlotID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if req.Type == SporedTimeSlotUpdated {
+		if err := models.NotifySporedRoomInvalidated(tx, req.TheaterID, req.RoomID); err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+
+	reservations, err := models.GetTimeSlotReservations(tx, req.TimeSlotID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := SporedEventResponse{
+		TimeSlotID: req.TimeSlotID,
+		Updated:    []uuid.UUID{},
+		Refunds:    []RefundResponse{},
+	}
+
+	cancel := func(reservation models.Reservation, reason models.RefundReason) error {
+		refund, err := models.CancelReservation(tx, reservation.ID, reason, ticketPriceCents)
+		if err != nil {
+			return err
+		}
+		response.Refunds = append(response.Refunds, newRefundResponse(refund))
+		return nil
+	}
+
+	switch req.Type {
+	case SporedTimeSlotDeleted:
+		for _, reservation := range reservations {
+			err := cancel(reservation, models.RefundTimeSlotCancelled)
+			if err != nil {
+				_ = c.Error(err)
+				return
+			}
+		}
+	case SporedTimeSlotUpdated:
+		room, err := timeSlotService.GetRoom(c.Request.Context(), req.TheaterID, req.RoomID)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		for _, reservation := range reservations {
+			if reservation.Row > room.Rows || reservation.Col > room.Columns {
+				err := cancel(reservation, models.RefundSeatUnavailable)
+				if err != nil {
+					_ = c.Error(err)
+					return
+				}
+				continue
+			}
+
+			if reservation.TheaterID == room.TheaterID && reservation.RoomID == room.RoomID {
+				continue
+			}
+
+			reservation.TheaterID = room.TheaterID
+			reservation.RoomID = room.RoomID
+
+			err := reservation.Save(tx)
+			if err != nil {
+				_ = c.Error(err)
+				return
+			}
+			response.Updated = append(response.Updated, reservation.ID)
+		}
+
+		// Recorded after the moves, so saving them does not undo the new start.
+		if req.StartTime != nil {
+			err := models.ScheduleTimeSlotReservations(tx, req.TimeSlotID, *req.StartTime)
+			if err != nil {
+				_ = c.Error(err)
+				return
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func getTimeSlotCache(c *gin.Context) (services.TimeSlotCache, error) {
+	cache, ok := GetTimeSlotService(c).(services.TimeSlotCache)
+	if !ok {
+		return nil, middleware.NewNamedNotFoundError("cache")
+	}
+	return cache, nil
+}
+
+// SporedCacheStats
+//
+//	@Id				SporedCacheStats
+//	@Summary		Spored cache statistics
+//	@Description	Hits, misses and size of the cache in front of spored lookups. Negative hits are lookups answered from cached "not found" responses, fetches are requests that were actually sent to spored after concurrent misses were merged.
+//	@Tags			spored
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	SporedCacheStatsResponse
+//	@Failure		404	{object}	middleware.HttpError
+//	@Failure		500	{object}	middleware.HttpError
+//	@Router			/spored/cache [get]
+func SporedCacheStats(c *gin.Context) {
+	cache, err := getTimeSlotCache(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	stats := cache.Stats()
+
+	c.JSON(http.StatusOK, SporedCacheStatsResponse{
+		Validations: newCacheStatsResponse(stats.Validations),
+		TimeSlots:   newCacheStatsResponse(stats.TimeSlots),
+		Rooms:       newCacheStatsResponse(stats.Rooms),
+		Movies:      newCacheStatsResponse(stats.Movies),
+	})
+}
+
+// SporedCachePurge
+//
+//	@Id				SporedCachePurge
+//	@Summary		Purge spored cache
+//	@Description	Drop every cached spored lookup on every replica
+//	@Tags			spored
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		204
+//	@Failure		404	{object}	middleware.HttpError
+//	@Failure		500	{object}	middleware.HttpError
+//	@Router			/spored/cache [delete]
+func SporedCachePurge(c *gin.Context) {
+	cache, err := getTimeSlotCache(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	cache.Purge()
+
+	if err := models.NotifySporedCachePurged(middleware.GetContextTransaction(c)); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, "")
+}
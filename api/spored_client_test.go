@@ -0,0 +1,206 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/clients/spored/sporedtest"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFakeSpored starts a fake spored server with the rooms, movie and time
+// slots used by addExpandSchedule, and returns it with a time slot service
+// that talks to it through the generated client.
+func newFakeSpored(t *testing.T) (*sporedtest.Server, *services.SporedTimeSlotService) {
+	server := sporedtest.NewServer(t)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID1 := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	roomID2 := uuid.MustParse("3f1a7e2c-df46-11f0-9b5d-0b3c8e6f1a2d")
+	startTime1 := time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC)
+	startTime2 := time.Date(2025, 12, 6, 20, 30, 0, 0, time.UTC)
+
+	server.AddMovie(sporedtest.Movie{ID: expandMovieID, Name: "Dune: Part Two", LengthMinutes: 166})
+	server.AddRoom(sporedtest.Room{ID: roomID1, TheaterID: theaterID, Name: "Dvorana 1", Rows: 10, Columns: 15})
+	server.AddRoom(sporedtest.Room{ID: roomID2, TheaterID: theaterID, Name: "Dvorana 2", Rows: 8, Columns: 12})
+	server.AddTimeSlot(sporedtest.TimeSlot{
+		ID:        uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"),
+		RoomID:    roomID1,
+		MovieID:   expandMovieID,
+		StartTime: startTime1,
+		EndTime:   startTime1.Add(2 * time.Hour),
+	})
+	server.AddTimeSlot(sporedtest.TimeSlot{
+		ID:        uuid.MustParse("5475b333-1883-4261-8b58-944235693558"),
+		RoomID:    roomID2,
+		MovieID:   expandMovieID,
+		StartTime: startTime2,
+		EndTime:   startTime2.Add(2 * time.Hour),
+	})
+
+	service := services.NewSporedTimeSlotService(server.Client())
+	service.RetryBackoff = time.Millisecond
+
+	return server, service
+}
+
+func TestReservationsCreateThroughSpored(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+
+	tests := []struct {
+		name       string
+		timeSlotID uuid.UUID
+		prepare    func(server *sporedtest.Server, service *services.SporedTimeSlotService)
+		status     int
+		retryAfter string
+	}{
+		{
+			name:       "ok",
+			timeSlotID: uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"),
+			status:     http.StatusCreated,
+		},
+		{
+			name:       "ok-after-retry",
+			timeSlotID: uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"),
+			prepare: func(server *sporedtest.Server, service *services.SporedTimeSlotService) {
+				server.FailNext(http.StatusInternalServerError, 1)
+			},
+			status: http.StatusCreated,
+		},
+		{
+			name:       "unknown-time-slot",
+			timeSlotID: uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff"),
+			status:     http.StatusNotFound,
+		},
+		{
+			name:       "spored-error",
+			timeSlotID: uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"),
+			prepare: func(server *sporedtest.Server, service *services.SporedTimeSlotService) {
+				server.Fail(http.StatusInternalServerError)
+			},
+			status:     http.StatusServiceUnavailable,
+			retryAfter: "30",
+		},
+		{
+			name:       "spored-slow",
+			timeSlotID: uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"),
+			prepare: func(server *sporedtest.Server, service *services.SporedTimeSlotService) {
+				server.SetDelay(200 * time.Millisecond)
+				service.Timeout = 20 * time.Millisecond
+			},
+			status:     http.StatusServiceUnavailable,
+			retryAfter: "30",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			server, service := newFakeSpored(t)
+			if testCase.prepare != nil {
+				testCase.prepare(server, service)
+			}
+			r := TestingRouter(t, db, service)
+
+			body := ReservationRequest{
+				TimeSlotID: testCase.timeSlotID,
+				TheaterID:  theaterID,
+				RoomID:     roomID,
+				Type:       models.Online,
+				Row:        7,
+				Col:        12,
+			}
+
+			req := xtesting.NewTestingRequest(t, "/api/v1/nakup/reservations", http.MethodPost, body)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"id":         xtesting.ValueUUID(),
+				"created_at": xtesting.ValueTimeInPastDuration(time.Second),
+				"updated_at": xtesting.ValueTimeInPastDuration(time.Second),
+			}
+
+			ignoreReservations := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"ID": xtesting.ValueUUID(), "CreatedAt": xtesting.ValueTime(), "UpdatedAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			assert.Equal(t, testCase.retryAfter, w.Header().Get("Retry-After"))
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("time_slot_id, row, col"), []models.Reservation{}, ignoreReservations)
+		})
+	}
+}
+
+func TestReservationsListThroughSpored(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+
+	tests := []struct {
+		name    string
+		params  string
+		prepare func(server *sporedtest.Server)
+		status  int
+		warning string
+	}{
+		{
+			name:   "ok-expand",
+			params: "?expand=timeslot,movie,room",
+			status: http.StatusOK,
+		},
+		{
+			name:   "ok-expand-movie-removed",
+			params: "?expand=timeslot,movie,room",
+			prepare: func(server *sporedtest.Server) {
+				server.RemoveMovie(expandMovieID)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name:   "ok-expand-spored-down",
+			params: "?expand=timeslot,movie,room",
+			prepare: func(server *sporedtest.Server) {
+				server.Fail(http.StatusServiceUnavailable)
+			},
+			status:  http.StatusOK,
+			warning: `199 nakup "spored unavailable, reservation details were not expanded"`,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			server, service := newFakeSpored(t)
+			if testCase.prepare != nil {
+				testCase.prepare(server)
+			}
+			r := TestingRouter(t, db, service)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/reservations%s", testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			assert.Equal(t, testCase.warning, w.Header().Get("Warning"))
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
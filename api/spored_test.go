@@ -0,0 +1,298 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	authmodels "github.com/PRPO-skupina-02/common/clients/auth/models"
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSporedEventsReceive(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID1 := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	roomID2 := uuid.MustParse("3f1a7e2c-df46-11f0-9b5d-0b3c8e6f1a2d")
+
+	// Room 1 lost its last two columns, so seat 5/10 does not exist anymore.
+	service.AddRoom(theaterID, roomID1, "Dvorana 1", 10, 8)
+	service.AddRoom(theaterID, roomID2, "Dvorana 2", 10, 10)
+
+	tests := []struct {
+		name   string
+		status int
+		body   SporedEventRequest
+	}{
+		{
+			name:   "ok-deleted",
+			status: http.StatusOK,
+			body: SporedEventRequest{
+				Type:       SporedTimeSlotDeleted,
+				TimeSlotID: uuid.MustParse("5475b333-1883-4261-8b58-944235693558"),
+			},
+		},
+		{
+			name:   "ok-deleted-no-reservations",
+			status: http.StatusOK,
+			body: SporedEventRequest{
+				Type:       SporedTimeSlotDeleted,
+				TimeSlotID: uuid.MustParse("01234567-0123-0123-0123-0123456789ab"),
+			},
+		},
+		{
+			name:   "ok-updated-room-resized",
+			status: http.StatusOK,
+			body: SporedEventRequest{
+				Type:       SporedTimeSlotUpdated,
+				TimeSlotID: uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"),
+				TheaterID:  theaterID,
+				RoomID:     roomID1,
+			},
+		},
+		{
+			name:   "ok-updated-room-changed",
+			status: http.StatusOK,
+			body: SporedEventRequest{
+				Type:       SporedTimeSlotUpdated,
+				TimeSlotID: uuid.MustParse("eed99bc8-1fb4-443b-8287-a988a3bc4406"),
+				TheaterID:  theaterID,
+				RoomID:     roomID2,
+			},
+		},
+		{
+			name:   "unknown-room",
+			status: http.StatusNotFound,
+			body: SporedEventRequest{
+				Type:       SporedTimeSlotUpdated,
+				TimeSlotID: uuid.MustParse("eed99bc8-1fb4-443b-8287-a988a3bc4406"),
+				TheaterID:  theaterID,
+				RoomID:     uuid.MustParse("01234567-0123-0123-0123-0123456789ab"),
+			},
+		},
+		{
+			name:   "validation-errors",
+			status: http.StatusBadRequest,
+			body: SporedEventRequest{
+				Type:       SporedTimeSlotUpdated,
+				TimeSlotID: uuid.MustParse("eed99bc8-1fb4-443b-8287-a988a3bc4406"),
+			},
+		},
+		{
+			name:   "unknown-type",
+			status: http.StatusBadRequest,
+			body: SporedEventRequest{
+				Type:       "time_slot.moved",
+				TimeSlotID: uuid.MustParse("eed99bc8-1fb4-443b-8287-a988a3bc4406"),
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			req := xtesting.NewTestingRequest(t, "/api/v1/nakup/spored/events", http.MethodPost, testCase.body)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"refunds.[0].id":         xtesting.ValueUUID(),
+				"refunds.[0].created_at": xtesting.ValueTimeInPastDuration(time.Second),
+				"refunds.[0].updated_at": xtesting.ValueTimeInPastDuration(time.Second),
+			}
+
+			ignoreReservations := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"UpdatedAt": xtesting.ValueTime()}, 10)
+			ignoreRefunds := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"ID": xtesting.ValueUUID(), "CreatedAt": xtesting.ValueTime(), "UpdatedAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Reservation{}, ignoreReservations)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.Purchase{}, nil)
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("created_at"), []models.Refund{}, ignoreRefunds)
+		})
+	}
+}
+
+func TestSporedCacheStats(t *testing.T) {
+	db, _ := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	timeSlotID := uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295")
+	deletedTimeSlotID := uuid.MustParse("01234567-0123-0123-0123-0123456789ab")
+
+	tests := []struct {
+		name   string
+		status int
+		cached bool
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+			cached: true,
+		},
+		{
+			name:   "not-cached",
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			mock := services.NewMockTimeSlotService()
+			mock.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID, 10, 10)
+
+			var service services.TimeSlotService = mock
+			if testCase.cached {
+				service = services.NewCachedTimeSlotService(mock, time.Minute, time.Second)
+			}
+			r := AdminTestingRouter(t, db, service)
+
+			for range 2 {
+				_, _ = service.ValidateTimeSlotExists(t.Context(), theaterID, roomID, timeSlotID)
+				_, _ = service.ValidateTimeSlotExists(t.Context(), theaterID, roomID, deletedTimeSlotID)
+			}
+			_, _ = service.GetRoom(t.Context(), theaterID, roomID)
+
+			req := xtesting.NewTestingRequest(t, "/api/v1/nakup/spored/cache", http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, nil)
+		})
+	}
+}
+
+func TestSporedCachePurge(t *testing.T) {
+	db, _ := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	timeSlotID := uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295")
+
+	tests := []struct {
+		name   string
+		status int
+		cached bool
+	}{
+		{
+			name:   "ok",
+			status: http.StatusNoContent,
+			cached: true,
+		},
+		{
+			name:   "not-cached",
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			mock := services.NewMockTimeSlotService()
+			mock.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID, 10, 10)
+
+			var service services.TimeSlotService = mock
+			cache := services.NewCachedTimeSlotService(mock, time.Minute, time.Second)
+			if testCase.cached {
+				service = cache
+			}
+			r := AdminTestingRouter(t, db, service)
+
+			_, _ = service.ValidateTimeSlotExists(t.Context(), theaterID, roomID, timeSlotID)
+			_, _ = service.GetRoom(t.Context(), theaterID, roomID)
+
+			req := xtesting.NewTestingRequest(t, "/api/v1/nakup/spored/cache", http.MethodDelete, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, nil)
+			assert.Zero(t, cache.Stats().Validations.Entries)
+			assert.Zero(t, cache.Stats().Rooms.Entries)
+		})
+	}
+}
+
+func TestSporedEventsReceiveWithAPIKey(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	r := permissionsRouter(t, db, authmodels.ModelsUserRoleCustomer)
+
+	event := SporedEventRequest{
+		Type:       SporedTimeSlotDeleted,
+		TimeSlotID: uuid.MustParse("01234567-0123-0123-0123-0123456789ab"),
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   any
+		key    string
+		status int
+	}{
+		{
+			name:   "ok",
+			method: http.MethodPost,
+			url:    "/api/v1/nakup/spored/events",
+			body:   event,
+			key:    "spored-test-key",
+			status: http.StatusOK,
+		},
+		{
+			name:   "missing-scope",
+			method: http.MethodPost,
+			url:    "/api/v1/nakup/spored/events",
+			body:   event,
+			key:    "kiosk-test-key",
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "cache",
+			method: http.MethodGet,
+			url:    "/api/v1/nakup/spored/cache",
+			key:    "spored-test-key",
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			apiKey := models.APIKey{
+				ID:     uuid.MustParse("16fad5c7-e0b2-11f0-8d6a-8cae2f406b8c"),
+				Name:   "Spored",
+				Scopes: models.APIKeyScopes{models.ScopeSporedEvents},
+			}
+			apiKey.SetKey("spored-test-key")
+			require.NoError(t, apiKey.Create(db))
+
+			req := xtesting.NewTestingRequest(t, testCase.url, testCase.method, testCase.body)
+			req.Header.Set(APIKeyHeader, testCase.key)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type StaffTheatersResponse struct {
+	UserID     uuid.UUID   `json:"user_id"`
+	TheaterIDs []uuid.UUID `json:"theater_ids"`
+}
+
+type StaffTheatersRequest struct {
+	TheaterIDs []uuid.UUID `json:"theater_ids" binding:"required,unique,dive,required"`
+}
+
+// StaffTheatersShow
+//
+//	@Id				StaffTheatersShow
+//	@Summary		Show staff theaters
+//	@Description	List the theaters an employee is assigned to
+//	@Tags			staff
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			userID	path		string	true	"User ID"	Format(uuid)
+//	@Success		200		{object}	StaffTheatersResponse
+//	@Failure		400		{object}	middleware.HttpError
+//	@Failure		500		{object}	middleware.HttpError
+//	@Router			/staff/{userID}/theaters [get]
+func StaffTheatersShow(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+
+	userID, err := request.GetUUIDParam(c, "userID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	theaterIDs, err := models.GetStaffTheaterIDs(tx, userID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, StaffTheatersResponse{
+		UserID:     userID,
+		TheaterIDs: theaterIDs,
+	})
+}
+
+// StaffTheatersUpdate
+//
+//	@Id				StaffTheatersUpdate
+//	@Summary		Update staff theaters
+//	@Description	Replace the theaters an employee is assigned to. Employees can only manage reservations of their theaters, admins are not limited.
+//	@Tags			staff
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			userID	path		string					true	"User ID"	Format(uuid)
+//	@Param			request	body		StaffTheatersRequest	true	"request body"
+//	@Success		200		{object}	StaffTheatersResponse
+//	@Failure		400		{object}	middleware.HttpError
+//	@Failure		500		{object}	middleware.HttpError
+//	@Router			/staff/{userID}/theaters [put]
+func StaffTheatersUpdate(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+
+	userID, err := request.GetUUIDParam(c, "userID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req StaffTheatersRequest
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	err = models.SetStaffTheaterIDs(tx, userID, req.TheaterIDs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	theaterIDs, err := models.GetStaffTheaterIDs(tx, userID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, StaffTheatersResponse{
+		UserID:     userID,
+		TheaterIDs: theaterIDs,
+	})
+}
@@ -0,0 +1,313 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	authmodels "github.com/PRPO-skupina-02/common/clients/auth/models"
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaffTheatersShow(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name   string
+		status int
+		userID string
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+			userID: "00000000-0000-0000-0000-000000000001",
+		},
+		{
+			name:   "ok-unassigned",
+			status: http.StatusOK,
+			userID: "00000000-0000-0000-0000-000000000002",
+		},
+		{
+			name:   "malformed-user-id",
+			status: http.StatusBadRequest,
+			userID: "000",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/staff/%s/theaters", testCase.userID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestStaffTheatersUpdate(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	otherTheaterID := uuid.MustParse("4c8e2a16-e2a0-11f0-9d3b-7f1a5c9e3b2d")
+
+	tests := []struct {
+		name   string
+		body   any
+		status int
+		userID string
+	}{
+		{
+			name: "ok",
+			body: StaffTheatersRequest{
+				TheaterIDs: []uuid.UUID{theaterID, otherTheaterID},
+			},
+			status: http.StatusOK,
+			userID: "00000000-0000-0000-0000-000000000003",
+		},
+		{
+			name: "ok-unassign",
+			body: StaffTheatersRequest{
+				TheaterIDs: []uuid.UUID{},
+			},
+			status: http.StatusOK,
+			userID: "00000000-0000-0000-0000-000000000001",
+		},
+		{
+			name: "duplicate-theaters",
+			body: StaffTheatersRequest{
+				TheaterIDs: []uuid.UUID{theaterID, theaterID},
+			},
+			status: http.StatusBadRequest,
+			userID: "00000000-0000-0000-0000-000000000003",
+		},
+		{
+			name: "nil-theater",
+			body: StaffTheatersRequest{
+				TheaterIDs: []uuid.UUID{theaterID, uuid.Nil},
+			},
+			status: http.StatusBadRequest,
+			userID: "00000000-0000-0000-0000-000000000003",
+		},
+		{
+			name:   "no-body",
+			status: http.StatusBadRequest,
+			userID: "00000000-0000-0000-0000-000000000003",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/staff/%s/theaters", testCase.userID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodPut, testCase.body)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreAssignments := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"CreatedAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("user_id, theater_id"), []models.StaffTheater{}, ignoreAssignments)
+		})
+	}
+}
+
+func TestReservationsTheaterScope(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+
+	theaterID := uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d")
+	otherTheaterID := uuid.MustParse("4c8e2a16-e2a0-11f0-9d3b-7f1a5c9e3b2d")
+	roomID := uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1")
+	timeSlotID := uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295")
+
+	service.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID, 10, 15)
+	service.AddValidTimeSlotWithRoom(otherTheaterID, roomID, timeSlotID, 10, 15)
+
+	assigned := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherTheater := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	unassigned := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	admin := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+
+	reservationURL := "/api/v1/nakup/reservations/bae209f6-d059-11f0-b2a4-cbf992c2eb6d"
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		role   authmodels.ModelsUserRole
+		method string
+		url    string
+		body   any
+		status int
+	}{
+		{
+			name:   "list-other-theater",
+			userID: otherTheater,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodGet,
+			url:    "/api/v1/nakup/reservations",
+			status: http.StatusOK,
+		},
+		{
+			name:   "list-unassigned",
+			userID: unassigned,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodGet,
+			url:    "/api/v1/nakup/reservations",
+			status: http.StatusOK,
+		},
+		{
+			name:   "list-admin",
+			userID: admin,
+			role:   authmodels.ModelsUserRoleAdmin,
+			method: http.MethodGet,
+			url:    "/api/v1/nakup/reservations",
+			status: http.StatusOK,
+		},
+		{
+			name:   "show-other-theater",
+			userID: otherTheater,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodGet,
+			url:    reservationURL,
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "show-admin",
+			userID: admin,
+			role:   authmodels.ModelsUserRoleAdmin,
+			method: http.MethodGet,
+			url:    reservationURL,
+			status: http.StatusOK,
+		},
+		{
+			name:   "update-other-theater",
+			userID: otherTheater,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodPut,
+			url:    reservationURL,
+			body: ReservationRequest{
+				TimeSlotID: timeSlotID,
+				TheaterID:  otherTheaterID,
+				RoomID:     roomID,
+				Type:       models.Online,
+				Row:        6,
+				Col:        6,
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "update-move-to-other-theater",
+			userID: assigned,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodPut,
+			url:    reservationURL,
+			body: ReservationRequest{
+				TimeSlotID: timeSlotID,
+				TheaterID:  otherTheaterID,
+				RoomID:     roomID,
+				Type:       models.Online,
+				Row:        6,
+				Col:        6,
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "delete-purchase-other-theater",
+			userID: otherTheater,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodDelete,
+			url:    reservationURL + "/purchases/dddddddd-dddd-dddd-dddd-dddddddddddd",
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "purchases-other-theater",
+			userID: otherTheater,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodGet,
+			url:    reservationURL + "/purchases",
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "refunds-other-theater",
+			userID: otherTheater,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodGet,
+			url:    "/api/v1/nakup/refunds",
+			status: http.StatusOK,
+		},
+		{
+			name:   "complete-refund-other-theater",
+			userID: otherTheater,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodPost,
+			url:    "/api/v1/nakup/refunds/5a6b7c8d-e0f1-11f0-9a1b-1c2d3e4f5a6b/complete",
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "movies-report-other-theater",
+			userID: otherTheater,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodGet,
+			url:    "/api/v1/nakup/reports/movies?from=2025-10-01&to=2025-12-31",
+			status: http.StatusOK,
+		},
+		{
+			name:   "occupancy-report-other-theater",
+			userID: otherTheater,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodGet,
+			url:    "/api/v1/nakup/reports/occupancy?from=2025-10-01&to=2025-12-31",
+			status: http.StatusOK,
+		},
+		{
+			name:   "heatmap-other-theater",
+			userID: otherTheater,
+			role:   authmodels.ModelsUserRoleEmployee,
+			method: http.MethodGet,
+			url:    "/api/v1/nakup/reports/heatmap?theater_id=bae209f6-d059-11f0-b2a4-cbf992c2eb6d&room_id=925c2358-df46-11f0-a38e-abe580bde3d1&from=2025-10-01&to=2025-12-31",
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			r := TestingRouterWithUser(t, db, service, testCase.userID, testCase.role)
+
+			req := xtesting.NewTestingRequest(t, testCase.url, testCase.method, testCase.body)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("time_slot_id, row, col"), []models.Reservation{}, nil)
+		})
+	}
+}
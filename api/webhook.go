@@ -0,0 +1,402 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WebhookResponse struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Active     bool      `json:"active"`
+}
+
+func newWebhookResponse(subscription models.WebhookSubscription) WebhookResponse {
+	return WebhookResponse{
+		ID:         subscription.ID,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		Active:     subscription.Active,
+	}
+}
+
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID                    `json:"id"`
+	CreatedAt      time.Time                    `json:"created_at"`
+	UpdatedAt      time.Time                    `json:"updated_at"`
+	EventID        uuid.UUID                    `json:"event_id"`
+	EventType      string                       `json:"event_type"`
+	Status         models.WebhookDeliveryStatus `json:"status"`
+	Attempts       int                          `json:"attempts"`
+	NextAttemptAt  *time.Time                   `json:"next_attempt_at"`
+	LastStatusCode *int                         `json:"last_status_code"`
+	LastError      *string                      `json:"last_error"`
+	DeliveredAt    *time.Time                   `json:"delivered_at"`
+}
+
+func newWebhookDeliveryResponse(delivery models.WebhookDelivery) WebhookDeliveryResponse {
+	return WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
+
+type WebhookDeliveryAttemptResponse struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	StatusCode *int      `json:"status_code"`
+	Error      *string   `json:"error"`
+	DurationMs int       `json:"duration_ms"`
+}
+
+type WebhookDeliveryDetailResponse struct {
+	WebhookDeliveryResponse
+	Payload     json.RawMessage                  `json:"payload" swaggertype:"object"`
+	AttemptLogs []WebhookDeliveryAttemptResponse `json:"attempt_logs"`
+}
+
+func newWebhookDeliveryDetailResponse(delivery models.WebhookDelivery, attempts []models.WebhookDeliveryAttempt) WebhookDeliveryDetailResponse {
+	response := WebhookDeliveryDetailResponse{
+		WebhookDeliveryResponse: newWebhookDeliveryResponse(delivery),
+		Payload:                 delivery.Payload,
+		AttemptLogs:             []WebhookDeliveryAttemptResponse{},
+	}
+
+	for _, attempt := range attempts {
+		response.AttemptLogs = append(response.AttemptLogs, WebhookDeliveryAttemptResponse{
+			ID:         attempt.ID,
+			CreatedAt:  attempt.CreatedAt,
+			StatusCode: attempt.StatusCode,
+			Error:      attempt.Error,
+			DurationMs: attempt.DurationMs,
+		})
+	}
+
+	return response
+}
+
+type WebhookRequest struct {
+	URL        string   `json:"url" binding:"required,url"`
+	EventTypes []string `json:"event_types" binding:"required,min=1,unique,dive,oneof=reservation.created reservation.updated reservation.cancelled reservation.restored purchase.created purchase.updated purchase.deleted purchase.restored"`
+	Secret     string   `json:"secret" binding:"required,min=16"`
+	Active     *bool    `json:"active"`
+}
+
+func (r WebhookRequest) active() bool {
+	return r.Active == nil || *r.Active
+}
+
+// WebhooksList
+//
+//	@Id				WebhooksList
+//	@Summary		List webhook subscriptions
+//	@Description	List webhook subscriptions
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			limit	query		int		false	"Limit the number of responses"	Default(10)
+//	@Param			offset	query		int		false	"Offset the first response"		Default(0)
+//	@Param			sort	query		string	false	"Sort results"
+//	@Success		200		{object}	request.PaginatedResponse{data=[]WebhookResponse}
+//	@Failure		400		{object}	middleware.HttpError
+//	@Failure		500		{object}	middleware.HttpError
+//	@Router			/webhooks [get]
+func WebhooksList(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	pagination := request.GetNormalizedPaginationArgs(c)
+	sort := request.GetSortOptions(c)
+
+	subscriptions, total, err := models.GetWebhookSubscriptions(tx, pagination, sort)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := []WebhookResponse{}
+
+	for _, subscription := range subscriptions {
+		response = append(response, newWebhookResponse(subscription))
+	}
+
+	request.RenderPaginatedResponse(c, response, total)
+}
+
+// WebhooksCreate
+//
+//	@Id				WebhooksCreate
+//	@Summary		Create webhook subscription
+//	@Description	Subscribe a URL to reservation and purchase events. Deliveries are signed with HMAC-SHA256 using the secret.
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request			body		WebhookRequest	true	"request body"
+//	@Param			Idempotency-Key	header		string			false	"Key that makes retries of the request safe"
+//	@Success		201				{object}	WebhookResponse
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		422				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Router			/webhooks [post]
+func WebhooksCreate(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+
+	var req WebhookRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	subscription := models.WebhookSubscription{
+		ID:         uuid.New(),
+		URL:        req.URL,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+		Active:     req.active(),
+	}
+
+	err = subscription.Create(tx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, newWebhookResponse(subscription))
+}
+
+// WebhooksShow
+//
+//	@Id				WebhooksShow
+//	@Summary		Show webhook subscription
+//	@Description	Show webhook subscription
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			webhookID	path		string	true	"Webhook ID"	Format(uuid)
+//	@Success		200			{object}	WebhookResponse
+//	@Failure		400			{object}	middleware.HttpError
+//	@Failure		404			{object}	middleware.HttpError
+//	@Failure		500			{object}	middleware.HttpError
+//	@Router			/webhooks/{webhookID} [get]
+func WebhooksShow(c *gin.Context) {
+	subscription := GetContextWebhook(c)
+	c.JSON(http.StatusOK, newWebhookResponse(subscription))
+}
+
+// WebhooksUpdate
+//
+//	@Id				WebhooksUpdate
+//	@Summary		Update webhook subscription
+//	@Description	Update webhook subscription
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			webhookID	path		string			true	"Webhook ID"	Format(uuid)
+//	@Param			request		body		WebhookRequest	true	"request body"
+//	@Success		200			{object}	WebhookResponse
+//	@Failure		400			{object}	middleware.HttpError
+//	@Failure		404			{object}	middleware.HttpError
+//	@Failure		500			{object}	middleware.HttpError
+//	@Router			/webhooks/{webhookID} [put]
+func WebhooksUpdate(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	subscription := GetContextWebhook(c)
+
+	var req WebhookRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	subscription.URL = req.URL
+	subscription.EventTypes = req.EventTypes
+	subscription.Secret = req.Secret
+	subscription.Active = req.active()
+
+	err = subscription.Save(tx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newWebhookResponse(subscription))
+}
+
+// WebhooksDelete
+//
+//	@Id				WebhooksDelete
+//	@Summary		Delete webhook subscription
+//	@Description	Delete webhook subscription together with its delivery logs
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			webhookID	path	string	true	"Webhook ID"	Format(uuid)
+//	@Success		204
+//	@Failure		400	{object}	middleware.HttpError
+//	@Failure		404	{object}	middleware.HttpError
+//	@Failure		500	{object}	middleware.HttpError
+//	@Router			/webhooks/{webhookID} [delete]
+func WebhooksDelete(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	subscription := GetContextWebhook(c)
+
+	err := models.DeleteWebhookSubscription(tx, subscription.ID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, "")
+}
+
+// WebhookDeliveriesList
+//
+//	@Id				WebhookDeliveriesList
+//	@Summary		List webhook deliveries
+//	@Description	List deliveries of a webhook subscription
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			webhookID	path		string	true	"Webhook ID"					Format(uuid)
+//	@Param			limit		query		int		false	"Limit the number of responses"	Default(10)
+//	@Param			offset		query		int		false	"Offset the first response"		Default(0)
+//	@Param			sort		query		string	false	"Sort results"
+//	@Success		200			{object}	request.PaginatedResponse{data=[]WebhookDeliveryResponse}
+//	@Failure		400			{object}	middleware.HttpError
+//	@Failure		404			{object}	middleware.HttpError
+//	@Failure		500			{object}	middleware.HttpError
+//	@Router			/webhooks/{webhookID}/deliveries [get]
+func WebhookDeliveriesList(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	subscription := GetContextWebhook(c)
+	pagination := request.GetNormalizedPaginationArgs(c)
+	sort := request.GetSortOptions(c)
+
+	deliveries, total, err := models.GetWebhookDeliveries(tx, subscription.ID, pagination, sort)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := []WebhookDeliveryResponse{}
+
+	for _, delivery := range deliveries {
+		response = append(response, newWebhookDeliveryResponse(delivery))
+	}
+
+	request.RenderPaginatedResponse(c, response, total)
+}
+
+// WebhookDeliveriesShow
+//
+//	@Id				WebhookDeliveriesShow
+//	@Summary		Show webhook delivery
+//	@Description	Show a webhook delivery with its payload and the log of every attempt
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			webhookID	path		string	true	"Webhook ID"	Format(uuid)
+//	@Param			deliveryID	path		string	true	"Delivery ID"	Format(uuid)
+//	@Success		200			{object}	WebhookDeliveryDetailResponse
+//	@Failure		400			{object}	middleware.HttpError
+//	@Failure		404			{object}	middleware.HttpError
+//	@Failure		500			{object}	middleware.HttpError
+//	@Router			/webhooks/{webhookID}/deliveries/{deliveryID} [get]
+func WebhookDeliveriesShow(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	subscription := GetContextWebhook(c)
+	id, err := request.GetUUIDParam(c, "deliveryID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	delivery, err := models.GetWebhookDelivery(tx, subscription.ID, id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	attempts, err := models.GetWebhookDeliveryAttempts(tx, delivery.ID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newWebhookDeliveryDetailResponse(delivery, attempts))
+}
+
+// WebhookDeliveriesRedeliver
+//
+//	@Id				WebhookDeliveriesRedeliver
+//	@Summary		Redeliver webhook
+//	@Description	Queue a delivery to be sent again right away, including deliveries in the dead-letter state
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			webhookID		path		string	true	"Webhook ID"	Format(uuid)
+//	@Param			deliveryID		path		string	true	"Delivery ID"	Format(uuid)
+//	@Param			Idempotency-Key	header		string	false	"Key that makes retries of the request safe"
+//	@Success		202				{object}	WebhookDeliveryResponse
+//	@Failure		400				{object}	middleware.HttpError
+//	@Failure		404				{object}	middleware.HttpError
+//	@Failure		422				{object}	middleware.HttpError
+//	@Failure		500				{object}	middleware.HttpError
+//	@Router			/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver [post]
+func WebhookDeliveriesRedeliver(c *gin.Context) {
+	tx := middleware.GetContextTransaction(c)
+	subscription := GetContextWebhook(c)
+	id, err := request.GetUUIDParam(c, "deliveryID")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	delivery, err := models.GetWebhookDelivery(tx, subscription.ID, id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	now := time.Now()
+	delivery.Status = models.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = &now
+
+	err = delivery.Save(tx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, newWebhookDeliveryResponse(delivery))
+}
@@ -0,0 +1,455 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/common/xtesting"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooksList(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name   string
+		status int
+		params string
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+		},
+		{
+			name:   "ok-paginated",
+			status: http.StatusOK,
+			params: "?limit=1&offset=1",
+		},
+		{
+			name:   "ok-sort",
+			status: http.StatusOK,
+			params: "?sort=-created_at",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/webhooks%s", testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestWebhooksCreate(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	inactive := false
+
+	tests := []struct {
+		name   string
+		body   WebhookRequest
+		status int
+	}{
+		{
+			name: "ok",
+			body: WebhookRequest{
+				URL:        "https://crm.example.com/webhooks",
+				EventTypes: []string{models.EventPurchaseCreated, models.EventPurchaseDeleted},
+				Secret:     "crm-secret-0123456789",
+			},
+			status: http.StatusCreated,
+		},
+		{
+			name: "ok-inactive",
+			body: WebhookRequest{
+				URL:        "https://crm.example.com/webhooks",
+				EventTypes: []string{models.EventReservationUpdated},
+				Secret:     "crm-secret-0123456789",
+				Active:     &inactive,
+			},
+			status: http.StatusCreated,
+		},
+		{
+			name: "validation-errors",
+			body: WebhookRequest{
+				URL:        "not a url",
+				EventTypes: []string{"reservation.deleted"},
+				Secret:     "short",
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "no-event-types",
+			body: WebhookRequest{
+				URL:        "https://crm.example.com/webhooks",
+				EventTypes: []string{},
+				Secret:     "crm-secret-0123456789",
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "no-body",
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			req := xtesting.NewTestingRequest(t, "/api/v1/nakup/webhooks", http.MethodPost, testCase.body)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"id":         xtesting.ValueUUID(),
+				"created_at": xtesting.ValueTimeInPastDuration(time.Second),
+				"updated_at": xtesting.ValueTimeInPastDuration(time.Second),
+			}
+
+			ignoreSubscriptions := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"ID": xtesting.ValueUUID(), "CreatedAt": xtesting.ValueTime(), "UpdatedAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db.Order("url"), []models.WebhookSubscription{}, ignoreSubscriptions)
+		})
+	}
+}
+
+func TestWebhooksShow(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name      string
+		status    int
+		webhookID string
+	}{
+		{
+			name:      "ok",
+			status:    http.StatusOK,
+			webhookID: "c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c",
+		},
+		{
+			name:      "invalid-webhook-id",
+			status:    http.StatusNotFound,
+			webhookID: "01234567-0123-0123-0123-0123456789ab",
+		},
+		{
+			name:      "nil-webhook-id",
+			status:    http.StatusBadRequest,
+			webhookID: "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			name:      "malformed-webhook-id",
+			status:    http.StatusBadRequest,
+			webhookID: "000",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/webhooks/%s", testCase.webhookID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestWebhooksUpdate(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name      string
+		body      WebhookRequest
+		status    int
+		webhookID string
+	}{
+		{
+			name: "ok",
+			body: WebhookRequest{
+				URL:        "https://signage.example.com/v2/events",
+				EventTypes: []string{models.EventReservationCreated, models.EventPurchaseCreated},
+				Secret:     "rotated-secret-0123456789",
+			},
+			status:    http.StatusOK,
+			webhookID: "d2b6f1e3-df46-11f0-9c2d-4e6f8a0b2c3d",
+		},
+		{
+			name: "validation-errors",
+			body: WebhookRequest{
+				URL:        "https://signage.example.com/v2/events",
+				EventTypes: []string{models.EventPurchaseCreated, models.EventPurchaseCreated},
+				Secret:     "rotated-secret-0123456789",
+			},
+			status:    http.StatusBadRequest,
+			webhookID: "d2b6f1e3-df46-11f0-9c2d-4e6f8a0b2c3d",
+		},
+		{
+			name: "invalid-webhook-id",
+			body: WebhookRequest{
+				URL:        "https://signage.example.com/v2/events",
+				EventTypes: []string{models.EventPurchaseCreated},
+				Secret:     "rotated-secret-0123456789",
+			},
+			status:    http.StatusNotFound,
+			webhookID: "01234567-0123-0123-0123-0123456789ab",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/webhooks/%s", testCase.webhookID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodPut, testCase.body)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"updated_at": xtesting.ValueTimeInPastDuration(time.Second),
+			}
+
+			ignoreSubscriptions := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"UpdatedAt": xtesting.ValueTime()}, 10)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.WebhookSubscription{}, ignoreSubscriptions)
+		})
+	}
+}
+
+func TestWebhooksDelete(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name      string
+		status    int
+		webhookID string
+	}{
+		{
+			name:      "ok",
+			status:    http.StatusNoContent,
+			webhookID: "c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c",
+		},
+		{
+			name:      "invalid-webhook-id",
+			status:    http.StatusNotFound,
+			webhookID: "01234567-0123-0123-0123-0123456789ab",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/webhooks/%s", testCase.webhookID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodDelete, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.WebhookSubscription{}, nil)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.WebhookDelivery{}, nil)
+		})
+	}
+}
+
+func TestWebhookDeliveriesList(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name      string
+		status    int
+		params    string
+		webhookID string
+	}{
+		{
+			name:      "ok",
+			status:    http.StatusOK,
+			webhookID: "c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c",
+		},
+		{
+			name:      "ok-sort",
+			status:    http.StatusOK,
+			params:    "?sort=-created_at",
+			webhookID: "c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c",
+		},
+		{
+			name:      "ok-no-deliveries",
+			status:    http.StatusOK,
+			webhookID: "d2b6f1e3-df46-11f0-9c2d-4e6f8a0b2c3d",
+		},
+		{
+			name:      "invalid-webhook-id",
+			status:    http.StatusNotFound,
+			webhookID: "01234567-0123-0123-0123-0123456789ab",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/webhooks/%s/deliveries%s", testCase.webhookID, testCase.params)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestWebhookDeliveriesShow(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name       string
+		status     int
+		webhookID  string
+		deliveryID string
+	}{
+		{
+			name:       "ok",
+			status:     http.StatusOK,
+			webhookID:  "c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c",
+			deliveryID: "f4d8b3a5-df46-11f0-bf4a-6b8c0d2e4f5a",
+		},
+		{
+			name:       "delivery-from-different-webhook",
+			status:     http.StatusNotFound,
+			webhookID:  "d2b6f1e3-df46-11f0-9c2d-4e6f8a0b2c3d",
+			deliveryID: "f4d8b3a5-df46-11f0-bf4a-6b8c0d2e4f5a",
+		},
+		{
+			name:       "malformed-delivery-id",
+			status:     http.StatusBadRequest,
+			webhookID:  "c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c",
+			deliveryID: "000",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/webhooks/%s/deliveries/%s", testCase.webhookID, testCase.deliveryID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodGet, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w)
+		})
+	}
+}
+
+func TestWebhookDeliveriesRedeliver(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	service := services.NewMockTimeSlotService()
+	r := AdminTestingRouter(t, db, service)
+
+	tests := []struct {
+		name       string
+		status     int
+		webhookID  string
+		deliveryID string
+	}{
+		{
+			name:       "ok",
+			status:     http.StatusAccepted,
+			webhookID:  "c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c",
+			deliveryID: "f4d8b3a5-df46-11f0-bf4a-6b8c0d2e4f5a",
+		},
+		{
+			name:       "invalid-delivery-id",
+			status:     http.StatusNotFound,
+			webhookID:  "c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c",
+			deliveryID: "01234567-0123-0123-0123-0123456789ab",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := fixtures.Load()
+			assert.NoError(t, err)
+
+			targetURL := fmt.Sprintf("/api/v1/nakup/webhooks/%s/deliveries/%s/redeliver", testCase.webhookID, testCase.deliveryID)
+
+			req := xtesting.NewTestingRequest(t, targetURL, http.MethodPost, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			ignoreResp := xtesting.ValuesCheckers{
+				"updated_at":      xtesting.ValueTimeInPastDuration(time.Second),
+				"next_attempt_at": xtesting.ValueTimeInPastDuration(time.Second),
+			}
+
+			ignoreDeliveries := xtesting.GenerateValueCheckersForArrays(map[string]xtesting.ValueChecker{"UpdatedAt": xtesting.ValueTime()}, 10)
+			if testCase.status == http.StatusAccepted {
+				ignoreDeliveries["[1].NextAttemptAt"] = xtesting.ValueTime()
+			}
+
+			assert.Equal(t, testCase.status, w.Code)
+			xtesting.AssertGoldenJSON(t, w, ignoreResp)
+			xtesting.AssertGoldenDatabaseTable(t, db, []models.WebhookDelivery{}, ignoreDeliveries)
+		})
+	}
+}
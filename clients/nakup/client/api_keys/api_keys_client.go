@@ -0,0 +1,257 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package api_keys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new api keys API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+// New creates a new api keys API client with basic auth credentials.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - user: user for basic authentication header.
+// - password: password for basic authentication header.
+func NewClientWithBasicAuth(host, basePath, scheme, user, password string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BasicAuth(user, password)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+// New creates a new api keys API client with a bearer token for authentication.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - bearerToken: bearer token for Bearer authentication header.
+func NewClientWithBearerToken(host, basePath, scheme, bearerToken string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BearerToken(bearerToken)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+/*
+Client for api keys API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption may be used to customize the behavior of Client methods.
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	APIKeysCreate(params *APIKeysCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*APIKeysCreateCreated, error)
+
+	APIKeysDelete(params *APIKeysDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*APIKeysDeleteNoContent, error)
+
+	APIKeysList(params *APIKeysListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*APIKeysListOK, error)
+
+	APIKeysShow(params *APIKeysShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*APIKeysShowOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+APIKeysCreate creates API key
+
+Create an API key. The key is only included in this response, store it right away. Idempotency keys are not supported, as the response would have to be stored with the key in it.
+*/
+func (a *Client) APIKeysCreate(params *APIKeysCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*APIKeysCreateCreated, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewAPIKeysCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "APIKeysCreate",
+		Method:             "POST",
+		PathPattern:        "/api-keys",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &APIKeysCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*APIKeysCreateCreated)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for APIKeysCreate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+APIKeysDelete deletes API key
+
+Revoke an API key. Reservations created with it keep referencing it.
+*/
+func (a *Client) APIKeysDelete(params *APIKeysDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*APIKeysDeleteNoContent, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewAPIKeysDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "APIKeysDelete",
+		Method:             "DELETE",
+		PathPattern:        "/api-keys/{apiKeyID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &APIKeysDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*APIKeysDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for APIKeysDelete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+APIKeysList lists API keys
+
+List API keys of machine clients such as kiosks and partners
+*/
+func (a *Client) APIKeysList(params *APIKeysListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*APIKeysListOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewAPIKeysListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "APIKeysList",
+		Method:             "GET",
+		PathPattern:        "/api-keys",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &APIKeysListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*APIKeysListOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for APIKeysList: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+APIKeysShow shows API key
+
+Show API key
+*/
+func (a *Client) APIKeysShow(params *APIKeysShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*APIKeysShowOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewAPIKeysShowParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "APIKeysShow",
+		Method:             "GET",
+		PathPattern:        "/api-keys/{apiKeyID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &APIKeysShowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*APIKeysShowOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for APIKeysShow: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
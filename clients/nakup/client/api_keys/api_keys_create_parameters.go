@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package api_keys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewAPIKeysCreateParams creates a new APIKeysCreateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAPIKeysCreateParams() *APIKeysCreateParams {
+	return &APIKeysCreateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAPIKeysCreateParamsWithTimeout creates a new APIKeysCreateParams object
+// with the ability to set a timeout on a request.
+func NewAPIKeysCreateParamsWithTimeout(timeout time.Duration) *APIKeysCreateParams {
+	return &APIKeysCreateParams{
+		timeout: timeout,
+	}
+}
+
+// NewAPIKeysCreateParamsWithContext creates a new APIKeysCreateParams object
+// with the ability to set a context for a request.
+func NewAPIKeysCreateParamsWithContext(ctx context.Context) *APIKeysCreateParams {
+	return &APIKeysCreateParams{
+		Context: ctx,
+	}
+}
+
+// NewAPIKeysCreateParamsWithHTTPClient creates a new APIKeysCreateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAPIKeysCreateParamsWithHTTPClient(client *http.Client) *APIKeysCreateParams {
+	return &APIKeysCreateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+APIKeysCreateParams contains all the parameters to send to the API endpoint
+
+	for the API keys create operation.
+
+	Typically these are written to a http.Request.
+*/
+type APIKeysCreateParams struct {
+
+	/* Request.
+
+	   request body
+	*/
+	Request *models.APIAPIKeyRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the API keys create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *APIKeysCreateParams) WithDefaults() *APIKeysCreateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the API keys create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *APIKeysCreateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the API keys create params
+func (o *APIKeysCreateParams) WithTimeout(timeout time.Duration) *APIKeysCreateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the API keys create params
+func (o *APIKeysCreateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the API keys create params
+func (o *APIKeysCreateParams) WithContext(ctx context.Context) *APIKeysCreateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the API keys create params
+func (o *APIKeysCreateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the API keys create params
+func (o *APIKeysCreateParams) WithHTTPClient(client *http.Client) *APIKeysCreateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the API keys create params
+func (o *APIKeysCreateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRequest adds the request to the API keys create params
+func (o *APIKeysCreateParams) WithRequest(request *models.APIAPIKeyRequest) *APIKeysCreateParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the API keys create params
+func (o *APIKeysCreateParams) SetRequest(request *models.APIAPIKeyRequest) {
+	o.Request = request
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *APIKeysCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
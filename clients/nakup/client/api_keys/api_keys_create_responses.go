@@ -0,0 +1,259 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package api_keys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// APIKeysCreateReader is a Reader for the APIKeysCreate structure.
+type APIKeysCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *APIKeysCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 201:
+		result := NewAPIKeysCreateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewAPIKeysCreateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAPIKeysCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /api-keys] APIKeysCreate", response, response.Code())
+	}
+}
+
+// NewAPIKeysCreateCreated creates a APIKeysCreateCreated with default headers values
+func NewAPIKeysCreateCreated() *APIKeysCreateCreated {
+	return &APIKeysCreateCreated{}
+}
+
+/*
+APIKeysCreateCreated describes a response with status code 201, with default header values.
+
+Created
+*/
+type APIKeysCreateCreated struct {
+	Payload *models.APIAPIKeyCreatedResponse
+}
+
+// IsSuccess returns true when this api keys create created response has a 2xx status code
+func (o *APIKeysCreateCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this api keys create created response has a 3xx status code
+func (o *APIKeysCreateCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys create created response has a 4xx status code
+func (o *APIKeysCreateCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this api keys create created response has a 5xx status code
+func (o *APIKeysCreateCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this api keys create created response a status code equal to that given
+func (o *APIKeysCreateCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the api keys create created response
+func (o *APIKeysCreateCreated) Code() int {
+	return 201
+}
+
+func (o *APIKeysCreateCreated) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api-keys][%d] apiKeysCreateCreated %s", 201, payload)
+}
+
+func (o *APIKeysCreateCreated) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api-keys][%d] apiKeysCreateCreated %s", 201, payload)
+}
+
+func (o *APIKeysCreateCreated) GetPayload() *models.APIAPIKeyCreatedResponse {
+	return o.Payload
+}
+
+func (o *APIKeysCreateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIAPIKeyCreatedResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewAPIKeysCreateBadRequest creates a APIKeysCreateBadRequest with default headers values
+func NewAPIKeysCreateBadRequest() *APIKeysCreateBadRequest {
+	return &APIKeysCreateBadRequest{}
+}
+
+/*
+APIKeysCreateBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type APIKeysCreateBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this api keys create bad request response has a 2xx status code
+func (o *APIKeysCreateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this api keys create bad request response has a 3xx status code
+func (o *APIKeysCreateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys create bad request response has a 4xx status code
+func (o *APIKeysCreateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this api keys create bad request response has a 5xx status code
+func (o *APIKeysCreateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this api keys create bad request response a status code equal to that given
+func (o *APIKeysCreateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the api keys create bad request response
+func (o *APIKeysCreateBadRequest) Code() int {
+	return 400
+}
+
+func (o *APIKeysCreateBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api-keys][%d] apiKeysCreateBadRequest %s", 400, payload)
+}
+
+func (o *APIKeysCreateBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api-keys][%d] apiKeysCreateBadRequest %s", 400, payload)
+}
+
+func (o *APIKeysCreateBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *APIKeysCreateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewAPIKeysCreateInternalServerError creates a APIKeysCreateInternalServerError with default headers values
+func NewAPIKeysCreateInternalServerError() *APIKeysCreateInternalServerError {
+	return &APIKeysCreateInternalServerError{}
+}
+
+/*
+APIKeysCreateInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type APIKeysCreateInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this api keys create internal server error response has a 2xx status code
+func (o *APIKeysCreateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this api keys create internal server error response has a 3xx status code
+func (o *APIKeysCreateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys create internal server error response has a 4xx status code
+func (o *APIKeysCreateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this api keys create internal server error response has a 5xx status code
+func (o *APIKeysCreateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this api keys create internal server error response a status code equal to that given
+func (o *APIKeysCreateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the api keys create internal server error response
+func (o *APIKeysCreateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *APIKeysCreateInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api-keys][%d] apiKeysCreateInternalServerError %s", 500, payload)
+}
+
+func (o *APIKeysCreateInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /api-keys][%d] apiKeysCreateInternalServerError %s", 500, payload)
+}
+
+func (o *APIKeysCreateInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *APIKeysCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package api_keys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAPIKeysDeleteParams creates a new APIKeysDeleteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAPIKeysDeleteParams() *APIKeysDeleteParams {
+	return &APIKeysDeleteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAPIKeysDeleteParamsWithTimeout creates a new APIKeysDeleteParams object
+// with the ability to set a timeout on a request.
+func NewAPIKeysDeleteParamsWithTimeout(timeout time.Duration) *APIKeysDeleteParams {
+	return &APIKeysDeleteParams{
+		timeout: timeout,
+	}
+}
+
+// NewAPIKeysDeleteParamsWithContext creates a new APIKeysDeleteParams object
+// with the ability to set a context for a request.
+func NewAPIKeysDeleteParamsWithContext(ctx context.Context) *APIKeysDeleteParams {
+	return &APIKeysDeleteParams{
+		Context: ctx,
+	}
+}
+
+// NewAPIKeysDeleteParamsWithHTTPClient creates a new APIKeysDeleteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAPIKeysDeleteParamsWithHTTPClient(client *http.Client) *APIKeysDeleteParams {
+	return &APIKeysDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+APIKeysDeleteParams contains all the parameters to send to the API endpoint
+
+	for the API keys delete operation.
+
+	Typically these are written to a http.Request.
+*/
+type APIKeysDeleteParams struct {
+
+	/* APIKeyID.
+
+	   API key ID
+
+	   Format: uuid
+	*/
+	APIKeyID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the API keys delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *APIKeysDeleteParams) WithDefaults() *APIKeysDeleteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the API keys delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *APIKeysDeleteParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the API keys delete params
+func (o *APIKeysDeleteParams) WithTimeout(timeout time.Duration) *APIKeysDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the API keys delete params
+func (o *APIKeysDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the API keys delete params
+func (o *APIKeysDeleteParams) WithContext(ctx context.Context) *APIKeysDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the API keys delete params
+func (o *APIKeysDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the API keys delete params
+func (o *APIKeysDeleteParams) WithHTTPClient(client *http.Client) *APIKeysDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the API keys delete params
+func (o *APIKeysDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIKeyID adds the aPIKeyID to the API keys delete params
+func (o *APIKeysDeleteParams) WithAPIKeyID(aPIKeyID strfmt.UUID) *APIKeysDeleteParams {
+	o.SetAPIKeyID(aPIKeyID)
+	return o
+}
+
+// SetAPIKeyID adds the apiKeyId to the API keys delete params
+func (o *APIKeysDeleteParams) SetAPIKeyID(aPIKeyID strfmt.UUID) {
+	o.APIKeyID = aPIKeyID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *APIKeysDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param apiKeyID
+	if err := r.SetPathParam("apiKeyID", o.APIKeyID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package api_keys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// APIKeysDeleteReader is a Reader for the APIKeysDelete structure.
+type APIKeysDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *APIKeysDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 204:
+		result := NewAPIKeysDeleteNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewAPIKeysDeleteBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAPIKeysDeleteNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAPIKeysDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /api-keys/{apiKeyID}] APIKeysDelete", response, response.Code())
+	}
+}
+
+// NewAPIKeysDeleteNoContent creates a APIKeysDeleteNoContent with default headers values
+func NewAPIKeysDeleteNoContent() *APIKeysDeleteNoContent {
+	return &APIKeysDeleteNoContent{}
+}
+
+/*
+APIKeysDeleteNoContent describes a response with status code 204, with default header values.
+
+No Content
+*/
+type APIKeysDeleteNoContent struct {
+}
+
+// IsSuccess returns true when this api keys delete no content response has a 2xx status code
+func (o *APIKeysDeleteNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this api keys delete no content response has a 3xx status code
+func (o *APIKeysDeleteNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys delete no content response has a 4xx status code
+func (o *APIKeysDeleteNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this api keys delete no content response has a 5xx status code
+func (o *APIKeysDeleteNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this api keys delete no content response a status code equal to that given
+func (o *APIKeysDeleteNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the api keys delete no content response
+func (o *APIKeysDeleteNoContent) Code() int {
+	return 204
+}
+
+func (o *APIKeysDeleteNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /api-keys/{apiKeyID}][%d] apiKeysDeleteNoContent", 204)
+}
+
+func (o *APIKeysDeleteNoContent) String() string {
+	return fmt.Sprintf("[DELETE /api-keys/{apiKeyID}][%d] apiKeysDeleteNoContent", 204)
+}
+
+func (o *APIKeysDeleteNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAPIKeysDeleteBadRequest creates a APIKeysDeleteBadRequest with default headers values
+func NewAPIKeysDeleteBadRequest() *APIKeysDeleteBadRequest {
+	return &APIKeysDeleteBadRequest{}
+}
+
+/*
+APIKeysDeleteBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type APIKeysDeleteBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this api keys delete bad request response has a 2xx status code
+func (o *APIKeysDeleteBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this api keys delete bad request response has a 3xx status code
+func (o *APIKeysDeleteBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys delete bad request response has a 4xx status code
+func (o *APIKeysDeleteBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this api keys delete bad request response has a 5xx status code
+func (o *APIKeysDeleteBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this api keys delete bad request response a status code equal to that given
+func (o *APIKeysDeleteBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the api keys delete bad request response
+func (o *APIKeysDeleteBadRequest) Code() int {
+	return 400
+}
+
+func (o *APIKeysDeleteBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /api-keys/{apiKeyID}][%d] apiKeysDeleteBadRequest %s", 400, payload)
+}
+
+func (o *APIKeysDeleteBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /api-keys/{apiKeyID}][%d] apiKeysDeleteBadRequest %s", 400, payload)
+}
+
+func (o *APIKeysDeleteBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *APIKeysDeleteBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewAPIKeysDeleteNotFound creates a APIKeysDeleteNotFound with default headers values
+func NewAPIKeysDeleteNotFound() *APIKeysDeleteNotFound {
+	return &APIKeysDeleteNotFound{}
+}
+
+/*
+APIKeysDeleteNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type APIKeysDeleteNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this api keys delete not found response has a 2xx status code
+func (o *APIKeysDeleteNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this api keys delete not found response has a 3xx status code
+func (o *APIKeysDeleteNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys delete not found response has a 4xx status code
+func (o *APIKeysDeleteNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this api keys delete not found response has a 5xx status code
+func (o *APIKeysDeleteNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this api keys delete not found response a status code equal to that given
+func (o *APIKeysDeleteNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the api keys delete not found response
+func (o *APIKeysDeleteNotFound) Code() int {
+	return 404
+}
+
+func (o *APIKeysDeleteNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /api-keys/{apiKeyID}][%d] apiKeysDeleteNotFound %s", 404, payload)
+}
+
+func (o *APIKeysDeleteNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /api-keys/{apiKeyID}][%d] apiKeysDeleteNotFound %s", 404, payload)
+}
+
+func (o *APIKeysDeleteNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *APIKeysDeleteNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewAPIKeysDeleteInternalServerError creates a APIKeysDeleteInternalServerError with default headers values
+func NewAPIKeysDeleteInternalServerError() *APIKeysDeleteInternalServerError {
+	return &APIKeysDeleteInternalServerError{}
+}
+
+/*
+APIKeysDeleteInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type APIKeysDeleteInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this api keys delete internal server error response has a 2xx status code
+func (o *APIKeysDeleteInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this api keys delete internal server error response has a 3xx status code
+func (o *APIKeysDeleteInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys delete internal server error response has a 4xx status code
+func (o *APIKeysDeleteInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this api keys delete internal server error response has a 5xx status code
+func (o *APIKeysDeleteInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this api keys delete internal server error response a status code equal to that given
+func (o *APIKeysDeleteInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the api keys delete internal server error response
+func (o *APIKeysDeleteInternalServerError) Code() int {
+	return 500
+}
+
+func (o *APIKeysDeleteInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /api-keys/{apiKeyID}][%d] apiKeysDeleteInternalServerError %s", 500, payload)
+}
+
+func (o *APIKeysDeleteInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /api-keys/{apiKeyID}][%d] apiKeysDeleteInternalServerError %s", 500, payload)
+}
+
+func (o *APIKeysDeleteInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *APIKeysDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,435 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package api_keys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// APIKeysListReader is a Reader for the APIKeysList structure.
+type APIKeysListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *APIKeysListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAPIKeysListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewAPIKeysListBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAPIKeysListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /api-keys] APIKeysList", response, response.Code())
+	}
+}
+
+// NewAPIKeysListOK creates a APIKeysListOK with default headers values
+func NewAPIKeysListOK() *APIKeysListOK {
+	return &APIKeysListOK{}
+}
+
+/*
+APIKeysListOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type APIKeysListOK struct {
+	Payload *APIKeysListOKBody
+}
+
+// IsSuccess returns true when this api keys list o k response has a 2xx status code
+func (o *APIKeysListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this api keys list o k response has a 3xx status code
+func (o *APIKeysListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys list o k response has a 4xx status code
+func (o *APIKeysListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this api keys list o k response has a 5xx status code
+func (o *APIKeysListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this api keys list o k response a status code equal to that given
+func (o *APIKeysListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the api keys list o k response
+func (o *APIKeysListOK) Code() int {
+	return 200
+}
+
+func (o *APIKeysListOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys][%d] apiKeysListOK %s", 200, payload)
+}
+
+func (o *APIKeysListOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys][%d] apiKeysListOK %s", 200, payload)
+}
+
+func (o *APIKeysListOK) GetPayload() *APIKeysListOKBody {
+	return o.Payload
+}
+
+func (o *APIKeysListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(APIKeysListOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewAPIKeysListBadRequest creates a APIKeysListBadRequest with default headers values
+func NewAPIKeysListBadRequest() *APIKeysListBadRequest {
+	return &APIKeysListBadRequest{}
+}
+
+/*
+APIKeysListBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type APIKeysListBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this api keys list bad request response has a 2xx status code
+func (o *APIKeysListBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this api keys list bad request response has a 3xx status code
+func (o *APIKeysListBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys list bad request response has a 4xx status code
+func (o *APIKeysListBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this api keys list bad request response has a 5xx status code
+func (o *APIKeysListBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this api keys list bad request response a status code equal to that given
+func (o *APIKeysListBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the api keys list bad request response
+func (o *APIKeysListBadRequest) Code() int {
+	return 400
+}
+
+func (o *APIKeysListBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys][%d] apiKeysListBadRequest %s", 400, payload)
+}
+
+func (o *APIKeysListBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys][%d] apiKeysListBadRequest %s", 400, payload)
+}
+
+func (o *APIKeysListBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *APIKeysListBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewAPIKeysListInternalServerError creates a APIKeysListInternalServerError with default headers values
+func NewAPIKeysListInternalServerError() *APIKeysListInternalServerError {
+	return &APIKeysListInternalServerError{}
+}
+
+/*
+APIKeysListInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type APIKeysListInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this api keys list internal server error response has a 2xx status code
+func (o *APIKeysListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this api keys list internal server error response has a 3xx status code
+func (o *APIKeysListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys list internal server error response has a 4xx status code
+func (o *APIKeysListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this api keys list internal server error response has a 5xx status code
+func (o *APIKeysListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this api keys list internal server error response a status code equal to that given
+func (o *APIKeysListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the api keys list internal server error response
+func (o *APIKeysListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *APIKeysListInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys][%d] apiKeysListInternalServerError %s", 500, payload)
+}
+
+func (o *APIKeysListInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys][%d] apiKeysListInternalServerError %s", 500, payload)
+}
+
+func (o *APIKeysListInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *APIKeysListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+/*
+APIKeysListOKBody API keys list o k body
+swagger:model APIKeysListOKBody
+*/
+type APIKeysListOKBody struct {
+	models.RequestPaginatedResponse
+
+	// data
+	Data []*models.APIAPIKeyResponse `json:"data"`
+}
+
+// UnmarshalJSON unmarshals this object from a JSON structure
+func (o *APIKeysListOKBody) UnmarshalJSON(raw []byte) error {
+	// APIKeysListOKBodyAO0
+	var aPIKeysListOKBodyAO0 models.RequestPaginatedResponse
+	if err := swag.ReadJSON(raw, &aPIKeysListOKBodyAO0); err != nil {
+		return err
+	}
+	o.RequestPaginatedResponse = aPIKeysListOKBodyAO0
+
+	// APIKeysListOKBodyAO1
+	var dataAPIKeysListOKBodyAO1 struct {
+		Data []*models.APIAPIKeyResponse `json:"data"`
+	}
+	if err := swag.ReadJSON(raw, &dataAPIKeysListOKBodyAO1); err != nil {
+		return err
+	}
+
+	o.Data = dataAPIKeysListOKBodyAO1.Data
+
+	return nil
+}
+
+// MarshalJSON marshals this object to a JSON structure
+func (o APIKeysListOKBody) MarshalJSON() ([]byte, error) {
+	_parts := make([][]byte, 0, 2)
+
+	aPIKeysListOKBodyAO0, err := swag.WriteJSON(o.RequestPaginatedResponse)
+	if err != nil {
+		return nil, err
+	}
+	_parts = append(_parts, aPIKeysListOKBodyAO0)
+	var dataAPIKeysListOKBodyAO1 struct {
+		Data []*models.APIAPIKeyResponse `json:"data"`
+	}
+
+	dataAPIKeysListOKBodyAO1.Data = o.Data
+
+	jsonDataAPIKeysListOKBodyAO1, errAPIKeysListOKBodyAO1 := swag.WriteJSON(dataAPIKeysListOKBodyAO1)
+	if errAPIKeysListOKBodyAO1 != nil {
+		return nil, errAPIKeysListOKBodyAO1
+	}
+	_parts = append(_parts, jsonDataAPIKeysListOKBodyAO1)
+	return swag.ConcatJSON(_parts...), nil
+}
+
+// Validate validates this API keys list o k body
+func (o *APIKeysListOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.Validate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *APIKeysListOKBody) validateData(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Data) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Data); i++ {
+		if swag.IsZero(o.Data[i]) { // not required
+			continue
+		}
+
+		if o.Data[i] != nil {
+			if err := o.Data[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("apiKeysListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("apiKeysListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this API keys list o k body based on the context it is used
+func (o *APIKeysListOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.ContextValidate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateData(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *APIKeysListOKBody) contextValidateData(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Data); i++ {
+
+		if o.Data[i] != nil {
+
+			if swag.IsZero(o.Data[i]) { // not required
+				return nil
+			}
+
+			if err := o.Data[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("apiKeysListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("apiKeysListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *APIKeysListOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *APIKeysListOKBody) UnmarshalBinary(b []byte) error {
+	var res APIKeysListOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
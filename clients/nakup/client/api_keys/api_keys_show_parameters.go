@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package api_keys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAPIKeysShowParams creates a new APIKeysShowParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAPIKeysShowParams() *APIKeysShowParams {
+	return &APIKeysShowParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAPIKeysShowParamsWithTimeout creates a new APIKeysShowParams object
+// with the ability to set a timeout on a request.
+func NewAPIKeysShowParamsWithTimeout(timeout time.Duration) *APIKeysShowParams {
+	return &APIKeysShowParams{
+		timeout: timeout,
+	}
+}
+
+// NewAPIKeysShowParamsWithContext creates a new APIKeysShowParams object
+// with the ability to set a context for a request.
+func NewAPIKeysShowParamsWithContext(ctx context.Context) *APIKeysShowParams {
+	return &APIKeysShowParams{
+		Context: ctx,
+	}
+}
+
+// NewAPIKeysShowParamsWithHTTPClient creates a new APIKeysShowParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAPIKeysShowParamsWithHTTPClient(client *http.Client) *APIKeysShowParams {
+	return &APIKeysShowParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+APIKeysShowParams contains all the parameters to send to the API endpoint
+
+	for the API keys show operation.
+
+	Typically these are written to a http.Request.
+*/
+type APIKeysShowParams struct {
+
+	/* APIKeyID.
+
+	   API key ID
+
+	   Format: uuid
+	*/
+	APIKeyID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the API keys show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *APIKeysShowParams) WithDefaults() *APIKeysShowParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the API keys show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *APIKeysShowParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the API keys show params
+func (o *APIKeysShowParams) WithTimeout(timeout time.Duration) *APIKeysShowParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the API keys show params
+func (o *APIKeysShowParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the API keys show params
+func (o *APIKeysShowParams) WithContext(ctx context.Context) *APIKeysShowParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the API keys show params
+func (o *APIKeysShowParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the API keys show params
+func (o *APIKeysShowParams) WithHTTPClient(client *http.Client) *APIKeysShowParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the API keys show params
+func (o *APIKeysShowParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIKeyID adds the aPIKeyID to the API keys show params
+func (o *APIKeysShowParams) WithAPIKeyID(aPIKeyID strfmt.UUID) *APIKeysShowParams {
+	o.SetAPIKeyID(aPIKeyID)
+	return o
+}
+
+// SetAPIKeyID adds the apiKeyId to the API keys show params
+func (o *APIKeysShowParams) SetAPIKeyID(aPIKeyID strfmt.UUID) {
+	o.APIKeyID = aPIKeyID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *APIKeysShowParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param apiKeyID
+	if err := r.SetPathParam("apiKeyID", o.APIKeyID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package api_keys
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// APIKeysShowReader is a Reader for the APIKeysShow structure.
+type APIKeysShowReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *APIKeysShowReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAPIKeysShowOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewAPIKeysShowBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewAPIKeysShowNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewAPIKeysShowInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /api-keys/{apiKeyID}] APIKeysShow", response, response.Code())
+	}
+}
+
+// NewAPIKeysShowOK creates a APIKeysShowOK with default headers values
+func NewAPIKeysShowOK() *APIKeysShowOK {
+	return &APIKeysShowOK{}
+}
+
+/*
+APIKeysShowOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type APIKeysShowOK struct {
+	Payload *models.APIAPIKeyResponse
+}
+
+// IsSuccess returns true when this api keys show o k response has a 2xx status code
+func (o *APIKeysShowOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this api keys show o k response has a 3xx status code
+func (o *APIKeysShowOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys show o k response has a 4xx status code
+func (o *APIKeysShowOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this api keys show o k response has a 5xx status code
+func (o *APIKeysShowOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this api keys show o k response a status code equal to that given
+func (o *APIKeysShowOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the api keys show o k response
+func (o *APIKeysShowOK) Code() int {
+	return 200
+}
+
+func (o *APIKeysShowOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys/{apiKeyID}][%d] apiKeysShowOK %s", 200, payload)
+}
+
+func (o *APIKeysShowOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys/{apiKeyID}][%d] apiKeysShowOK %s", 200, payload)
+}
+
+func (o *APIKeysShowOK) GetPayload() *models.APIAPIKeyResponse {
+	return o.Payload
+}
+
+func (o *APIKeysShowOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIAPIKeyResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewAPIKeysShowBadRequest creates a APIKeysShowBadRequest with default headers values
+func NewAPIKeysShowBadRequest() *APIKeysShowBadRequest {
+	return &APIKeysShowBadRequest{}
+}
+
+/*
+APIKeysShowBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type APIKeysShowBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this api keys show bad request response has a 2xx status code
+func (o *APIKeysShowBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this api keys show bad request response has a 3xx status code
+func (o *APIKeysShowBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys show bad request response has a 4xx status code
+func (o *APIKeysShowBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this api keys show bad request response has a 5xx status code
+func (o *APIKeysShowBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this api keys show bad request response a status code equal to that given
+func (o *APIKeysShowBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the api keys show bad request response
+func (o *APIKeysShowBadRequest) Code() int {
+	return 400
+}
+
+func (o *APIKeysShowBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys/{apiKeyID}][%d] apiKeysShowBadRequest %s", 400, payload)
+}
+
+func (o *APIKeysShowBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys/{apiKeyID}][%d] apiKeysShowBadRequest %s", 400, payload)
+}
+
+func (o *APIKeysShowBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *APIKeysShowBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewAPIKeysShowNotFound creates a APIKeysShowNotFound with default headers values
+func NewAPIKeysShowNotFound() *APIKeysShowNotFound {
+	return &APIKeysShowNotFound{}
+}
+
+/*
+APIKeysShowNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type APIKeysShowNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this api keys show not found response has a 2xx status code
+func (o *APIKeysShowNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this api keys show not found response has a 3xx status code
+func (o *APIKeysShowNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys show not found response has a 4xx status code
+func (o *APIKeysShowNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this api keys show not found response has a 5xx status code
+func (o *APIKeysShowNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this api keys show not found response a status code equal to that given
+func (o *APIKeysShowNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the api keys show not found response
+func (o *APIKeysShowNotFound) Code() int {
+	return 404
+}
+
+func (o *APIKeysShowNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys/{apiKeyID}][%d] apiKeysShowNotFound %s", 404, payload)
+}
+
+func (o *APIKeysShowNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys/{apiKeyID}][%d] apiKeysShowNotFound %s", 404, payload)
+}
+
+func (o *APIKeysShowNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *APIKeysShowNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewAPIKeysShowInternalServerError creates a APIKeysShowInternalServerError with default headers values
+func NewAPIKeysShowInternalServerError() *APIKeysShowInternalServerError {
+	return &APIKeysShowInternalServerError{}
+}
+
+/*
+APIKeysShowInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type APIKeysShowInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this api keys show internal server error response has a 2xx status code
+func (o *APIKeysShowInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this api keys show internal server error response has a 3xx status code
+func (o *APIKeysShowInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this api keys show internal server error response has a 4xx status code
+func (o *APIKeysShowInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this api keys show internal server error response has a 5xx status code
+func (o *APIKeysShowInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this api keys show internal server error response a status code equal to that given
+func (o *APIKeysShowInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the api keys show internal server error response
+func (o *APIKeysShowInternalServerError) Code() int {
+	return 500
+}
+
+func (o *APIKeysShowInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys/{apiKeyID}][%d] apiKeysShowInternalServerError %s", 500, payload)
+}
+
+func (o *APIKeysShowInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /api-keys/{apiKeyID}][%d] apiKeysShowInternalServerError %s", 500, payload)
+}
+
+func (o *APIKeysShowInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *APIKeysShowInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package client
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/api_keys"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/purchases"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/refunds"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/reports"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/reservations"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/seats"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/spored"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/staff"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/webhooks"
+)
+
+// Default nakup HTTP client.
+var Default = NewHTTPClient(nil)
+
+const (
+	// DefaultHost is the default Host
+	// found in Meta (info) section of spec file
+	DefaultHost string = "localhost:8081"
+	// DefaultBasePath is the default BasePath
+	// found in Meta (info) section of spec file
+	DefaultBasePath string = "/api/v1/nakup"
+)
+
+// DefaultSchemes are the default schemes found in Meta (info) section of spec file
+var DefaultSchemes = []string{"http"}
+
+// NewHTTPClient creates a new nakup HTTP client.
+func NewHTTPClient(formats strfmt.Registry) *Nakup {
+	return NewHTTPClientWithConfig(formats, nil)
+}
+
+// NewHTTPClientWithConfig creates a new nakup HTTP client,
+// using a customizable transport config.
+func NewHTTPClientWithConfig(formats strfmt.Registry, cfg *TransportConfig) *Nakup {
+	// ensure nullable parameters have default
+	if cfg == nil {
+		cfg = DefaultTransportConfig()
+	}
+
+	// create transport and client
+	transport := httptransport.New(cfg.Host, cfg.BasePath, cfg.Schemes)
+	return New(transport, formats)
+}
+
+// New creates a new nakup client
+func New(transport runtime.ClientTransport, formats strfmt.Registry) *Nakup {
+	// ensure nullable parameters have default
+	if formats == nil {
+		formats = strfmt.Default
+	}
+
+	cli := new(Nakup)
+	cli.Transport = transport
+	cli.APIKeys = api_keys.New(transport, formats)
+	cli.Purchases = purchases.New(transport, formats)
+	cli.Refunds = refunds.New(transport, formats)
+	cli.Reports = reports.New(transport, formats)
+	cli.Reservations = reservations.New(transport, formats)
+	cli.Seats = seats.New(transport, formats)
+	cli.Spored = spored.New(transport, formats)
+	cli.Staff = staff.New(transport, formats)
+	cli.Webhooks = webhooks.New(transport, formats)
+	return cli
+}
+
+// DefaultTransportConfig creates a TransportConfig with the
+// default settings taken from the meta section of the spec file.
+func DefaultTransportConfig() *TransportConfig {
+	return &TransportConfig{
+		Host:     DefaultHost,
+		BasePath: DefaultBasePath,
+		Schemes:  DefaultSchemes,
+	}
+}
+
+// TransportConfig contains the transport related info,
+// found in the meta section of the spec file.
+type TransportConfig struct {
+	Host     string
+	BasePath string
+	Schemes  []string
+}
+
+// WithHost overrides the default host,
+// provided by the meta section of the spec file.
+func (cfg *TransportConfig) WithHost(host string) *TransportConfig {
+	cfg.Host = host
+	return cfg
+}
+
+// WithBasePath overrides the default basePath,
+// provided by the meta section of the spec file.
+func (cfg *TransportConfig) WithBasePath(basePath string) *TransportConfig {
+	cfg.BasePath = basePath
+	return cfg
+}
+
+// WithSchemes overrides the default schemes,
+// provided by the meta section of the spec file.
+func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
+	cfg.Schemes = schemes
+	return cfg
+}
+
+// Nakup is a client for nakup
+type Nakup struct {
+	APIKeys api_keys.ClientService
+
+	Purchases purchases.ClientService
+
+	Refunds refunds.ClientService
+
+	Reports reports.ClientService
+
+	Reservations reservations.ClientService
+
+	Seats seats.ClientService
+
+	Spored spored.ClientService
+
+	Staff staff.ClientService
+
+	Webhooks webhooks.ClientService
+
+	Transport runtime.ClientTransport
+}
+
+// SetTransport changes the transport on the client and all its subresources
+func (c *Nakup) SetTransport(transport runtime.ClientTransport) {
+	c.Transport = transport
+	c.APIKeys.SetTransport(transport)
+	c.Purchases.SetTransport(transport)
+	c.Refunds.SetTransport(transport)
+	c.Reports.SetTransport(transport)
+	c.Reservations.SetTransport(transport)
+	c.Seats.SetTransport(transport)
+	c.Spored.SetTransport(transport)
+	c.Staff.SetTransport(transport)
+	c.Webhooks.SetTransport(transport)
+}
@@ -0,0 +1,499 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new purchases API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+// New creates a new purchases API client with basic auth credentials.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - user: user for basic authentication header.
+// - password: password for basic authentication header.
+func NewClientWithBasicAuth(host, basePath, scheme, user, password string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BasicAuth(user, password)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+// New creates a new purchases API client with a bearer token for authentication.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - bearerToken: bearer token for Bearer authentication header.
+func NewClientWithBearerToken(host, basePath, scheme, bearerToken string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BearerToken(bearerToken)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+/*
+Client for purchases API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption may be used to customize the behavior of Client methods.
+type ClientOption func(*runtime.ClientOperation)
+
+// This client is generated with a few options you might find useful for your swagger spec.
+//
+// Feel free to add you own set of options.
+
+// WithContentType allows the client to force the Content-Type header
+// to negotiate a specific Consumer from the server.
+//
+// You may use this option to set arbitrary extensions to your MIME media type.
+func WithContentType(mime string) ClientOption {
+	return func(r *runtime.ClientOperation) {
+		r.ConsumesMediaTypes = []string{mime}
+	}
+}
+
+// WithContentTypeApplicationJSON sets the Content-Type header to "application/json".
+func WithContentTypeApplicationJSON(r *runtime.ClientOperation) {
+	r.ConsumesMediaTypes = []string{"application/json"}
+}
+
+// WithContentTypeApplicationMergePatchJSON sets the Content-Type header to "application/merge-patch+json".
+func WithContentTypeApplicationMergePatchJSON(r *runtime.ClientOperation) {
+	r.ConsumesMediaTypes = []string{"application/merge-patch+json"}
+}
+
+// WithAccept allows the client to force the Accept header
+// to negotiate a specific Producer from the server.
+//
+// You may use this option to set arbitrary extensions to your MIME media type.
+func WithAccept(mime string) ClientOption {
+	return func(r *runtime.ClientOperation) {
+		r.ProducesMediaTypes = []string{mime}
+	}
+}
+
+// WithAcceptApplicationJSON sets the Accept header to "application/json".
+func WithAcceptApplicationJSON(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/json"}
+}
+
+// WithAcceptApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet sets the Accept header to "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet".
+func WithAcceptApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"}
+}
+
+// WithAcceptTextCsv sets the Accept header to "text/csv".
+func WithAcceptTextCsv(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"text/csv"}
+}
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	PurchasesCreate(params *PurchasesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesCreateCreated, error)
+
+	PurchasesDelete(params *PurchasesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesDeleteNoContent, error)
+
+	PurchasesExport(params *PurchasesExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*PurchasesExportOK, error)
+
+	PurchasesList(params *PurchasesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesListOK, error)
+
+	PurchasesPatch(params *PurchasesPatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesPatchOK, error)
+
+	PurchasesRestore(params *PurchasesRestoreParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesRestoreOK, error)
+
+	PurchasesShow(params *PurchasesShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesShowOK, error)
+
+	PurchasesUpdate(params *PurchasesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesUpdateOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+PurchasesCreate creates purchase
+
+Create purchase
+*/
+func (a *Client) PurchasesCreate(params *PurchasesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesCreateCreated, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewPurchasesCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PurchasesCreate",
+		Method:             "POST",
+		PathPattern:        "/reservations/{reservationID}/purchases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PurchasesCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*PurchasesCreateCreated)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PurchasesCreate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PurchasesDelete deletes purchase
+
+Delete purchase
+*/
+func (a *Client) PurchasesDelete(params *PurchasesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesDeleteNoContent, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewPurchasesDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PurchasesDelete",
+		Method:             "DELETE",
+		PathPattern:        "/reservations/{reservationID}/purchases/{purchaseID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PurchasesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*PurchasesDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PurchasesDelete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PurchasesExport exports purchases
+
+Stream all purchases joined with their reservations as a CSV or XLSX file, including line totals
+*/
+func (a *Client) PurchasesExport(params *PurchasesExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*PurchasesExportOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewPurchasesExportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PurchasesExport",
+		Method:             "GET",
+		PathPattern:        "/purchases/export",
+		ProducesMediaTypes: []string{"text/csv", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PurchasesExportReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*PurchasesExportOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PurchasesExport: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PurchasesList lists purchases
+
+List purchases
+*/
+func (a *Client) PurchasesList(params *PurchasesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesListOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewPurchasesListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PurchasesList",
+		Method:             "GET",
+		PathPattern:        "/reservations/{reservationID}/purchases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PurchasesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*PurchasesListOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PurchasesList: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PurchasesPatch partiallies update purchase
+
+Change some fields of a purchase with a JSON Merge Patch document
+*/
+func (a *Client) PurchasesPatch(params *PurchasesPatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesPatchOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewPurchasesPatchParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PurchasesPatch",
+		Method:             "PATCH",
+		PathPattern:        "/reservations/{reservationID}/purchases/{purchaseID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/merge-patch+json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PurchasesPatchReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*PurchasesPatchOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PurchasesPatch: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PurchasesRestore restores purchase
+
+Restore a deleted purchase
+*/
+func (a *Client) PurchasesRestore(params *PurchasesRestoreParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesRestoreOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewPurchasesRestoreParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PurchasesRestore",
+		Method:             "POST",
+		PathPattern:        "/reservations/{reservationID}/purchases/{purchaseID}/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PurchasesRestoreReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*PurchasesRestoreOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PurchasesRestore: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PurchasesShow shows purchase
+
+Show purchase
+*/
+func (a *Client) PurchasesShow(params *PurchasesShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesShowOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewPurchasesShowParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PurchasesShow",
+		Method:             "GET",
+		PathPattern:        "/reservations/{reservationID}/purchases/{purchaseID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PurchasesShowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*PurchasesShowOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PurchasesShow: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PurchasesUpdate updates purchase
+
+Update purchase
+*/
+func (a *Client) PurchasesUpdate(params *PurchasesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PurchasesUpdateOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewPurchasesUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PurchasesUpdate",
+		Method:             "PUT",
+		PathPattern:        "/reservations/{reservationID}/purchases/{purchaseID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PurchasesUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*PurchasesUpdateOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PurchasesUpdate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
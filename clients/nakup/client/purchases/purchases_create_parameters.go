@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewPurchasesCreateParams creates a new PurchasesCreateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPurchasesCreateParams() *PurchasesCreateParams {
+	return &PurchasesCreateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPurchasesCreateParamsWithTimeout creates a new PurchasesCreateParams object
+// with the ability to set a timeout on a request.
+func NewPurchasesCreateParamsWithTimeout(timeout time.Duration) *PurchasesCreateParams {
+	return &PurchasesCreateParams{
+		timeout: timeout,
+	}
+}
+
+// NewPurchasesCreateParamsWithContext creates a new PurchasesCreateParams object
+// with the ability to set a context for a request.
+func NewPurchasesCreateParamsWithContext(ctx context.Context) *PurchasesCreateParams {
+	return &PurchasesCreateParams{
+		Context: ctx,
+	}
+}
+
+// NewPurchasesCreateParamsWithHTTPClient creates a new PurchasesCreateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPurchasesCreateParamsWithHTTPClient(client *http.Client) *PurchasesCreateParams {
+	return &PurchasesCreateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PurchasesCreateParams contains all the parameters to send to the API endpoint
+
+	for the purchases create operation.
+
+	Typically these are written to a http.Request.
+*/
+type PurchasesCreateParams struct {
+
+	/* IdempotencyKey.
+
+	   Key that makes retries of the request safe
+	*/
+	IdempotencyKey *string
+
+	/* Request.
+
+	   request body
+	*/
+	Request *models.APIPurchaseRequest
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the purchases create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesCreateParams) WithDefaults() *PurchasesCreateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the purchases create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesCreateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the purchases create params
+func (o *PurchasesCreateParams) WithTimeout(timeout time.Duration) *PurchasesCreateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the purchases create params
+func (o *PurchasesCreateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the purchases create params
+func (o *PurchasesCreateParams) WithContext(ctx context.Context) *PurchasesCreateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the purchases create params
+func (o *PurchasesCreateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the purchases create params
+func (o *PurchasesCreateParams) WithHTTPClient(client *http.Client) *PurchasesCreateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the purchases create params
+func (o *PurchasesCreateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIdempotencyKey adds the idempotencyKey to the purchases create params
+func (o *PurchasesCreateParams) WithIdempotencyKey(idempotencyKey *string) *PurchasesCreateParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the purchases create params
+func (o *PurchasesCreateParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
+// WithRequest adds the request to the purchases create params
+func (o *PurchasesCreateParams) WithRequest(request *models.APIPurchaseRequest) *PurchasesCreateParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the purchases create params
+func (o *PurchasesCreateParams) SetRequest(request *models.APIPurchaseRequest) {
+	o.Request = request
+}
+
+// WithReservationID adds the reservationID to the purchases create params
+func (o *PurchasesCreateParams) WithReservationID(reservationID strfmt.UUID) *PurchasesCreateParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the purchases create params
+func (o *PurchasesCreateParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PurchasesCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,411 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// PurchasesCreateReader is a Reader for the PurchasesCreate structure.
+type PurchasesCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PurchasesCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 201:
+		result := NewPurchasesCreateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPurchasesCreateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPurchasesCreateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewPurchasesCreateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPurchasesCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /reservations/{reservationID}/purchases] PurchasesCreate", response, response.Code())
+	}
+}
+
+// NewPurchasesCreateCreated creates a PurchasesCreateCreated with default headers values
+func NewPurchasesCreateCreated() *PurchasesCreateCreated {
+	return &PurchasesCreateCreated{}
+}
+
+/*
+PurchasesCreateCreated describes a response with status code 201, with default header values.
+
+Created
+*/
+type PurchasesCreateCreated struct {
+	Payload *models.APIPurchaseResponse
+}
+
+// IsSuccess returns true when this purchases create created response has a 2xx status code
+func (o *PurchasesCreateCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this purchases create created response has a 3xx status code
+func (o *PurchasesCreateCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases create created response has a 4xx status code
+func (o *PurchasesCreateCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases create created response has a 5xx status code
+func (o *PurchasesCreateCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases create created response a status code equal to that given
+func (o *PurchasesCreateCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the purchases create created response
+func (o *PurchasesCreateCreated) Code() int {
+	return 201
+}
+
+func (o *PurchasesCreateCreated) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases][%d] purchasesCreateCreated %s", 201, payload)
+}
+
+func (o *PurchasesCreateCreated) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases][%d] purchasesCreateCreated %s", 201, payload)
+}
+
+func (o *PurchasesCreateCreated) GetPayload() *models.APIPurchaseResponse {
+	return o.Payload
+}
+
+func (o *PurchasesCreateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIPurchaseResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesCreateBadRequest creates a PurchasesCreateBadRequest with default headers values
+func NewPurchasesCreateBadRequest() *PurchasesCreateBadRequest {
+	return &PurchasesCreateBadRequest{}
+}
+
+/*
+PurchasesCreateBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type PurchasesCreateBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases create bad request response has a 2xx status code
+func (o *PurchasesCreateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases create bad request response has a 3xx status code
+func (o *PurchasesCreateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases create bad request response has a 4xx status code
+func (o *PurchasesCreateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases create bad request response has a 5xx status code
+func (o *PurchasesCreateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases create bad request response a status code equal to that given
+func (o *PurchasesCreateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the purchases create bad request response
+func (o *PurchasesCreateBadRequest) Code() int {
+	return 400
+}
+
+func (o *PurchasesCreateBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases][%d] purchasesCreateBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesCreateBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases][%d] purchasesCreateBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesCreateBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesCreateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesCreateNotFound creates a PurchasesCreateNotFound with default headers values
+func NewPurchasesCreateNotFound() *PurchasesCreateNotFound {
+	return &PurchasesCreateNotFound{}
+}
+
+/*
+PurchasesCreateNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PurchasesCreateNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases create not found response has a 2xx status code
+func (o *PurchasesCreateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases create not found response has a 3xx status code
+func (o *PurchasesCreateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases create not found response has a 4xx status code
+func (o *PurchasesCreateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases create not found response has a 5xx status code
+func (o *PurchasesCreateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases create not found response a status code equal to that given
+func (o *PurchasesCreateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the purchases create not found response
+func (o *PurchasesCreateNotFound) Code() int {
+	return 404
+}
+
+func (o *PurchasesCreateNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases][%d] purchasesCreateNotFound %s", 404, payload)
+}
+
+func (o *PurchasesCreateNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases][%d] purchasesCreateNotFound %s", 404, payload)
+}
+
+func (o *PurchasesCreateNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesCreateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesCreateUnprocessableEntity creates a PurchasesCreateUnprocessableEntity with default headers values
+func NewPurchasesCreateUnprocessableEntity() *PurchasesCreateUnprocessableEntity {
+	return &PurchasesCreateUnprocessableEntity{}
+}
+
+/*
+PurchasesCreateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Unprocessable Entity
+*/
+type PurchasesCreateUnprocessableEntity struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases create unprocessable entity response has a 2xx status code
+func (o *PurchasesCreateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases create unprocessable entity response has a 3xx status code
+func (o *PurchasesCreateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases create unprocessable entity response has a 4xx status code
+func (o *PurchasesCreateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases create unprocessable entity response has a 5xx status code
+func (o *PurchasesCreateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases create unprocessable entity response a status code equal to that given
+func (o *PurchasesCreateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the purchases create unprocessable entity response
+func (o *PurchasesCreateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *PurchasesCreateUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases][%d] purchasesCreateUnprocessableEntity %s", 422, payload)
+}
+
+func (o *PurchasesCreateUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases][%d] purchasesCreateUnprocessableEntity %s", 422, payload)
+}
+
+func (o *PurchasesCreateUnprocessableEntity) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesCreateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesCreateInternalServerError creates a PurchasesCreateInternalServerError with default headers values
+func NewPurchasesCreateInternalServerError() *PurchasesCreateInternalServerError {
+	return &PurchasesCreateInternalServerError{}
+}
+
+/*
+PurchasesCreateInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type PurchasesCreateInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases create internal server error response has a 2xx status code
+func (o *PurchasesCreateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases create internal server error response has a 3xx status code
+func (o *PurchasesCreateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases create internal server error response has a 4xx status code
+func (o *PurchasesCreateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases create internal server error response has a 5xx status code
+func (o *PurchasesCreateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this purchases create internal server error response a status code equal to that given
+func (o *PurchasesCreateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the purchases create internal server error response
+func (o *PurchasesCreateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PurchasesCreateInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases][%d] purchasesCreateInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesCreateInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases][%d] purchasesCreateInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesCreateInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPurchasesDeleteParams creates a new PurchasesDeleteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPurchasesDeleteParams() *PurchasesDeleteParams {
+	return &PurchasesDeleteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPurchasesDeleteParamsWithTimeout creates a new PurchasesDeleteParams object
+// with the ability to set a timeout on a request.
+func NewPurchasesDeleteParamsWithTimeout(timeout time.Duration) *PurchasesDeleteParams {
+	return &PurchasesDeleteParams{
+		timeout: timeout,
+	}
+}
+
+// NewPurchasesDeleteParamsWithContext creates a new PurchasesDeleteParams object
+// with the ability to set a context for a request.
+func NewPurchasesDeleteParamsWithContext(ctx context.Context) *PurchasesDeleteParams {
+	return &PurchasesDeleteParams{
+		Context: ctx,
+	}
+}
+
+// NewPurchasesDeleteParamsWithHTTPClient creates a new PurchasesDeleteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPurchasesDeleteParamsWithHTTPClient(client *http.Client) *PurchasesDeleteParams {
+	return &PurchasesDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PurchasesDeleteParams contains all the parameters to send to the API endpoint
+
+	for the purchases delete operation.
+
+	Typically these are written to a http.Request.
+*/
+type PurchasesDeleteParams struct {
+
+	/* IfMatch.
+
+	   Only change the purchase if its ETag still matches
+	*/
+	IfMatch *string
+
+	/* PurchaseID.
+
+	   Purchase ID
+
+	   Format: uuid
+	*/
+	PurchaseID strfmt.UUID
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the purchases delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesDeleteParams) WithDefaults() *PurchasesDeleteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the purchases delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesDeleteParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the purchases delete params
+func (o *PurchasesDeleteParams) WithTimeout(timeout time.Duration) *PurchasesDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the purchases delete params
+func (o *PurchasesDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the purchases delete params
+func (o *PurchasesDeleteParams) WithContext(ctx context.Context) *PurchasesDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the purchases delete params
+func (o *PurchasesDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the purchases delete params
+func (o *PurchasesDeleteParams) WithHTTPClient(client *http.Client) *PurchasesDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the purchases delete params
+func (o *PurchasesDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIfMatch adds the ifMatch to the purchases delete params
+func (o *PurchasesDeleteParams) WithIfMatch(ifMatch *string) *PurchasesDeleteParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the purchases delete params
+func (o *PurchasesDeleteParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
+// WithPurchaseID adds the purchaseID to the purchases delete params
+func (o *PurchasesDeleteParams) WithPurchaseID(purchaseID strfmt.UUID) *PurchasesDeleteParams {
+	o.SetPurchaseID(purchaseID)
+	return o
+}
+
+// SetPurchaseID adds the purchaseId to the purchases delete params
+func (o *PurchasesDeleteParams) SetPurchaseID(purchaseID strfmt.UUID) {
+	o.PurchaseID = purchaseID
+}
+
+// WithReservationID adds the reservationID to the purchases delete params
+func (o *PurchasesDeleteParams) WithReservationID(reservationID strfmt.UUID) *PurchasesDeleteParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the purchases delete params
+func (o *PurchasesDeleteParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PurchasesDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
+	// path param purchaseID
+	if err := r.SetPathParam("purchaseID", o.PurchaseID.String()); err != nil {
+		return err
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,397 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// PurchasesDeleteReader is a Reader for the PurchasesDelete structure.
+type PurchasesDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PurchasesDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 204:
+		result := NewPurchasesDeleteNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPurchasesDeleteBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPurchasesDeleteNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 412:
+		result := NewPurchasesDeletePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPurchasesDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /reservations/{reservationID}/purchases/{purchaseID}] PurchasesDelete", response, response.Code())
+	}
+}
+
+// NewPurchasesDeleteNoContent creates a PurchasesDeleteNoContent with default headers values
+func NewPurchasesDeleteNoContent() *PurchasesDeleteNoContent {
+	return &PurchasesDeleteNoContent{}
+}
+
+/*
+PurchasesDeleteNoContent describes a response with status code 204, with default header values.
+
+No Content
+*/
+type PurchasesDeleteNoContent struct {
+}
+
+// IsSuccess returns true when this purchases delete no content response has a 2xx status code
+func (o *PurchasesDeleteNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this purchases delete no content response has a 3xx status code
+func (o *PurchasesDeleteNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases delete no content response has a 4xx status code
+func (o *PurchasesDeleteNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases delete no content response has a 5xx status code
+func (o *PurchasesDeleteNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases delete no content response a status code equal to that given
+func (o *PurchasesDeleteNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the purchases delete no content response
+func (o *PurchasesDeleteNoContent) Code() int {
+	return 204
+}
+
+func (o *PurchasesDeleteNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesDeleteNoContent", 204)
+}
+
+func (o *PurchasesDeleteNoContent) String() string {
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesDeleteNoContent", 204)
+}
+
+func (o *PurchasesDeleteNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewPurchasesDeleteBadRequest creates a PurchasesDeleteBadRequest with default headers values
+func NewPurchasesDeleteBadRequest() *PurchasesDeleteBadRequest {
+	return &PurchasesDeleteBadRequest{}
+}
+
+/*
+PurchasesDeleteBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type PurchasesDeleteBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases delete bad request response has a 2xx status code
+func (o *PurchasesDeleteBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases delete bad request response has a 3xx status code
+func (o *PurchasesDeleteBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases delete bad request response has a 4xx status code
+func (o *PurchasesDeleteBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases delete bad request response has a 5xx status code
+func (o *PurchasesDeleteBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases delete bad request response a status code equal to that given
+func (o *PurchasesDeleteBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the purchases delete bad request response
+func (o *PurchasesDeleteBadRequest) Code() int {
+	return 400
+}
+
+func (o *PurchasesDeleteBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesDeleteBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesDeleteBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesDeleteBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesDeleteBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesDeleteBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesDeleteNotFound creates a PurchasesDeleteNotFound with default headers values
+func NewPurchasesDeleteNotFound() *PurchasesDeleteNotFound {
+	return &PurchasesDeleteNotFound{}
+}
+
+/*
+PurchasesDeleteNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PurchasesDeleteNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases delete not found response has a 2xx status code
+func (o *PurchasesDeleteNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases delete not found response has a 3xx status code
+func (o *PurchasesDeleteNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases delete not found response has a 4xx status code
+func (o *PurchasesDeleteNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases delete not found response has a 5xx status code
+func (o *PurchasesDeleteNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases delete not found response a status code equal to that given
+func (o *PurchasesDeleteNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the purchases delete not found response
+func (o *PurchasesDeleteNotFound) Code() int {
+	return 404
+}
+
+func (o *PurchasesDeleteNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesDeleteNotFound %s", 404, payload)
+}
+
+func (o *PurchasesDeleteNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesDeleteNotFound %s", 404, payload)
+}
+
+func (o *PurchasesDeleteNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesDeleteNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesDeletePreconditionFailed creates a PurchasesDeletePreconditionFailed with default headers values
+func NewPurchasesDeletePreconditionFailed() *PurchasesDeletePreconditionFailed {
+	return &PurchasesDeletePreconditionFailed{}
+}
+
+/*
+PurchasesDeletePreconditionFailed describes a response with status code 412, with default header values.
+
+Precondition Failed
+*/
+type PurchasesDeletePreconditionFailed struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases delete precondition failed response has a 2xx status code
+func (o *PurchasesDeletePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases delete precondition failed response has a 3xx status code
+func (o *PurchasesDeletePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases delete precondition failed response has a 4xx status code
+func (o *PurchasesDeletePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases delete precondition failed response has a 5xx status code
+func (o *PurchasesDeletePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases delete precondition failed response a status code equal to that given
+func (o *PurchasesDeletePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the purchases delete precondition failed response
+func (o *PurchasesDeletePreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *PurchasesDeletePreconditionFailed) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesDeletePreconditionFailed %s", 412, payload)
+}
+
+func (o *PurchasesDeletePreconditionFailed) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesDeletePreconditionFailed %s", 412, payload)
+}
+
+func (o *PurchasesDeletePreconditionFailed) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesDeletePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesDeleteInternalServerError creates a PurchasesDeleteInternalServerError with default headers values
+func NewPurchasesDeleteInternalServerError() *PurchasesDeleteInternalServerError {
+	return &PurchasesDeleteInternalServerError{}
+}
+
+/*
+PurchasesDeleteInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type PurchasesDeleteInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases delete internal server error response has a 2xx status code
+func (o *PurchasesDeleteInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases delete internal server error response has a 3xx status code
+func (o *PurchasesDeleteInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases delete internal server error response has a 4xx status code
+func (o *PurchasesDeleteInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases delete internal server error response has a 5xx status code
+func (o *PurchasesDeleteInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this purchases delete internal server error response a status code equal to that given
+func (o *PurchasesDeleteInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the purchases delete internal server error response
+func (o *PurchasesDeleteInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PurchasesDeleteInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesDeleteInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesDeleteInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesDeleteInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesDeleteInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
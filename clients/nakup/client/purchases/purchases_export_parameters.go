@@ -0,0 +1,210 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPurchasesExportParams creates a new PurchasesExportParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPurchasesExportParams() *PurchasesExportParams {
+	return &PurchasesExportParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPurchasesExportParamsWithTimeout creates a new PurchasesExportParams object
+// with the ability to set a timeout on a request.
+func NewPurchasesExportParamsWithTimeout(timeout time.Duration) *PurchasesExportParams {
+	return &PurchasesExportParams{
+		timeout: timeout,
+	}
+}
+
+// NewPurchasesExportParamsWithContext creates a new PurchasesExportParams object
+// with the ability to set a context for a request.
+func NewPurchasesExportParamsWithContext(ctx context.Context) *PurchasesExportParams {
+	return &PurchasesExportParams{
+		Context: ctx,
+	}
+}
+
+// NewPurchasesExportParamsWithHTTPClient creates a new PurchasesExportParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPurchasesExportParamsWithHTTPClient(client *http.Client) *PurchasesExportParams {
+	return &PurchasesExportParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PurchasesExportParams contains all the parameters to send to the API endpoint
+
+	for the purchases export operation.
+
+	Typically these are written to a http.Request.
+*/
+type PurchasesExportParams struct {
+
+	/* Format.
+
+	   Export format
+
+	   Default: "csv"
+	*/
+	Format *string
+
+	/* Sort.
+
+	   Sort by one of the exported columns, prefixed with - for descending order
+	*/
+	Sort *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the purchases export params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesExportParams) WithDefaults() *PurchasesExportParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the purchases export params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesExportParams) SetDefaults() {
+	var (
+		formatDefault = string("csv")
+	)
+
+	val := PurchasesExportParams{
+		Format: &formatDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the purchases export params
+func (o *PurchasesExportParams) WithTimeout(timeout time.Duration) *PurchasesExportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the purchases export params
+func (o *PurchasesExportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the purchases export params
+func (o *PurchasesExportParams) WithContext(ctx context.Context) *PurchasesExportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the purchases export params
+func (o *PurchasesExportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the purchases export params
+func (o *PurchasesExportParams) WithHTTPClient(client *http.Client) *PurchasesExportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the purchases export params
+func (o *PurchasesExportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFormat adds the format to the purchases export params
+func (o *PurchasesExportParams) WithFormat(format *string) *PurchasesExportParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the purchases export params
+func (o *PurchasesExportParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithSort adds the sort to the purchases export params
+func (o *PurchasesExportParams) WithSort(sort *string) *PurchasesExportParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the purchases export params
+func (o *PurchasesExportParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PurchasesExportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
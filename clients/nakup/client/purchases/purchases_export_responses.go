@@ -0,0 +1,259 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// PurchasesExportReader is a Reader for the PurchasesExport structure.
+type PurchasesExportReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PurchasesExportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPurchasesExportOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPurchasesExportBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPurchasesExportInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /purchases/export] PurchasesExport", response, response.Code())
+	}
+}
+
+// NewPurchasesExportOK creates a PurchasesExportOK with default headers values
+func NewPurchasesExportOK(writer io.Writer) *PurchasesExportOK {
+	return &PurchasesExportOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+PurchasesExportOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type PurchasesExportOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this purchases export o k response has a 2xx status code
+func (o *PurchasesExportOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this purchases export o k response has a 3xx status code
+func (o *PurchasesExportOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases export o k response has a 4xx status code
+func (o *PurchasesExportOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases export o k response has a 5xx status code
+func (o *PurchasesExportOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases export o k response a status code equal to that given
+func (o *PurchasesExportOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the purchases export o k response
+func (o *PurchasesExportOK) Code() int {
+	return 200
+}
+
+func (o *PurchasesExportOK) Error() string {
+	return fmt.Sprintf("[GET /purchases/export][%d] purchasesExportOK", 200)
+}
+
+func (o *PurchasesExportOK) String() string {
+	return fmt.Sprintf("[GET /purchases/export][%d] purchasesExportOK", 200)
+}
+
+func (o *PurchasesExportOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *PurchasesExportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesExportBadRequest creates a PurchasesExportBadRequest with default headers values
+func NewPurchasesExportBadRequest() *PurchasesExportBadRequest {
+	return &PurchasesExportBadRequest{}
+}
+
+/*
+PurchasesExportBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type PurchasesExportBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases export bad request response has a 2xx status code
+func (o *PurchasesExportBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases export bad request response has a 3xx status code
+func (o *PurchasesExportBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases export bad request response has a 4xx status code
+func (o *PurchasesExportBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases export bad request response has a 5xx status code
+func (o *PurchasesExportBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases export bad request response a status code equal to that given
+func (o *PurchasesExportBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the purchases export bad request response
+func (o *PurchasesExportBadRequest) Code() int {
+	return 400
+}
+
+func (o *PurchasesExportBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /purchases/export][%d] purchasesExportBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesExportBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /purchases/export][%d] purchasesExportBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesExportBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesExportBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesExportInternalServerError creates a PurchasesExportInternalServerError with default headers values
+func NewPurchasesExportInternalServerError() *PurchasesExportInternalServerError {
+	return &PurchasesExportInternalServerError{}
+}
+
+/*
+PurchasesExportInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type PurchasesExportInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases export internal server error response has a 2xx status code
+func (o *PurchasesExportInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases export internal server error response has a 3xx status code
+func (o *PurchasesExportInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases export internal server error response has a 4xx status code
+func (o *PurchasesExportInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases export internal server error response has a 5xx status code
+func (o *PurchasesExportInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this purchases export internal server error response a status code equal to that given
+func (o *PurchasesExportInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the purchases export internal server error response
+func (o *PurchasesExportInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PurchasesExportInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /purchases/export][%d] purchasesExportInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesExportInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /purchases/export][%d] purchasesExportInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesExportInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesExportInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewPurchasesListParams creates a new PurchasesListParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPurchasesListParams() *PurchasesListParams {
+	return &PurchasesListParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPurchasesListParamsWithTimeout creates a new PurchasesListParams object
+// with the ability to set a timeout on a request.
+func NewPurchasesListParamsWithTimeout(timeout time.Duration) *PurchasesListParams {
+	return &PurchasesListParams{
+		timeout: timeout,
+	}
+}
+
+// NewPurchasesListParamsWithContext creates a new PurchasesListParams object
+// with the ability to set a context for a request.
+func NewPurchasesListParamsWithContext(ctx context.Context) *PurchasesListParams {
+	return &PurchasesListParams{
+		Context: ctx,
+	}
+}
+
+// NewPurchasesListParamsWithHTTPClient creates a new PurchasesListParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPurchasesListParamsWithHTTPClient(client *http.Client) *PurchasesListParams {
+	return &PurchasesListParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PurchasesListParams contains all the parameters to send to the API endpoint
+
+	for the purchases list operation.
+
+	Typically these are written to a http.Request.
+*/
+type PurchasesListParams struct {
+
+	/* Limit.
+
+	   Limit the number of responses
+
+	   Default: 10
+	*/
+	Limit *int64
+
+	/* Offset.
+
+	   Offset the first response
+	*/
+	Offset *int64
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	/* Sort.
+
+	   Sort results
+	*/
+	Sort *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the purchases list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesListParams) WithDefaults() *PurchasesListParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the purchases list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesListParams) SetDefaults() {
+	var (
+		limitDefault = int64(10)
+
+		offsetDefault = int64(0)
+	)
+
+	val := PurchasesListParams{
+		Limit:  &limitDefault,
+		Offset: &offsetDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the purchases list params
+func (o *PurchasesListParams) WithTimeout(timeout time.Duration) *PurchasesListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the purchases list params
+func (o *PurchasesListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the purchases list params
+func (o *PurchasesListParams) WithContext(ctx context.Context) *PurchasesListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the purchases list params
+func (o *PurchasesListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the purchases list params
+func (o *PurchasesListParams) WithHTTPClient(client *http.Client) *PurchasesListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the purchases list params
+func (o *PurchasesListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the purchases list params
+func (o *PurchasesListParams) WithLimit(limit *int64) *PurchasesListParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the purchases list params
+func (o *PurchasesListParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the purchases list params
+func (o *PurchasesListParams) WithOffset(offset *int64) *PurchasesListParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the purchases list params
+func (o *PurchasesListParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithReservationID adds the reservationID to the purchases list params
+func (o *PurchasesListParams) WithReservationID(reservationID strfmt.UUID) *PurchasesListParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the purchases list params
+func (o *PurchasesListParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WithSort adds the sort to the purchases list params
+func (o *PurchasesListParams) WithSort(sort *string) *PurchasesListParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the purchases list params
+func (o *PurchasesListParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PurchasesListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,511 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// PurchasesListReader is a Reader for the PurchasesList structure.
+type PurchasesListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PurchasesListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPurchasesListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPurchasesListBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPurchasesListNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPurchasesListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /reservations/{reservationID}/purchases] PurchasesList", response, response.Code())
+	}
+}
+
+// NewPurchasesListOK creates a PurchasesListOK with default headers values
+func NewPurchasesListOK() *PurchasesListOK {
+	return &PurchasesListOK{}
+}
+
+/*
+PurchasesListOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type PurchasesListOK struct {
+	Payload *PurchasesListOKBody
+}
+
+// IsSuccess returns true when this purchases list o k response has a 2xx status code
+func (o *PurchasesListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this purchases list o k response has a 3xx status code
+func (o *PurchasesListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases list o k response has a 4xx status code
+func (o *PurchasesListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases list o k response has a 5xx status code
+func (o *PurchasesListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases list o k response a status code equal to that given
+func (o *PurchasesListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the purchases list o k response
+func (o *PurchasesListOK) Code() int {
+	return 200
+}
+
+func (o *PurchasesListOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases][%d] purchasesListOK %s", 200, payload)
+}
+
+func (o *PurchasesListOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases][%d] purchasesListOK %s", 200, payload)
+}
+
+func (o *PurchasesListOK) GetPayload() *PurchasesListOKBody {
+	return o.Payload
+}
+
+func (o *PurchasesListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(PurchasesListOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesListBadRequest creates a PurchasesListBadRequest with default headers values
+func NewPurchasesListBadRequest() *PurchasesListBadRequest {
+	return &PurchasesListBadRequest{}
+}
+
+/*
+PurchasesListBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type PurchasesListBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases list bad request response has a 2xx status code
+func (o *PurchasesListBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases list bad request response has a 3xx status code
+func (o *PurchasesListBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases list bad request response has a 4xx status code
+func (o *PurchasesListBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases list bad request response has a 5xx status code
+func (o *PurchasesListBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases list bad request response a status code equal to that given
+func (o *PurchasesListBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the purchases list bad request response
+func (o *PurchasesListBadRequest) Code() int {
+	return 400
+}
+
+func (o *PurchasesListBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases][%d] purchasesListBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesListBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases][%d] purchasesListBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesListBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesListBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesListNotFound creates a PurchasesListNotFound with default headers values
+func NewPurchasesListNotFound() *PurchasesListNotFound {
+	return &PurchasesListNotFound{}
+}
+
+/*
+PurchasesListNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PurchasesListNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases list not found response has a 2xx status code
+func (o *PurchasesListNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases list not found response has a 3xx status code
+func (o *PurchasesListNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases list not found response has a 4xx status code
+func (o *PurchasesListNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases list not found response has a 5xx status code
+func (o *PurchasesListNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases list not found response a status code equal to that given
+func (o *PurchasesListNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the purchases list not found response
+func (o *PurchasesListNotFound) Code() int {
+	return 404
+}
+
+func (o *PurchasesListNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases][%d] purchasesListNotFound %s", 404, payload)
+}
+
+func (o *PurchasesListNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases][%d] purchasesListNotFound %s", 404, payload)
+}
+
+func (o *PurchasesListNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesListNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesListInternalServerError creates a PurchasesListInternalServerError with default headers values
+func NewPurchasesListInternalServerError() *PurchasesListInternalServerError {
+	return &PurchasesListInternalServerError{}
+}
+
+/*
+PurchasesListInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type PurchasesListInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases list internal server error response has a 2xx status code
+func (o *PurchasesListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases list internal server error response has a 3xx status code
+func (o *PurchasesListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases list internal server error response has a 4xx status code
+func (o *PurchasesListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases list internal server error response has a 5xx status code
+func (o *PurchasesListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this purchases list internal server error response a status code equal to that given
+func (o *PurchasesListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the purchases list internal server error response
+func (o *PurchasesListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PurchasesListInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases][%d] purchasesListInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesListInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases][%d] purchasesListInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesListInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+/*
+PurchasesListOKBody purchases list o k body
+swagger:model PurchasesListOKBody
+*/
+type PurchasesListOKBody struct {
+	models.RequestPaginatedResponse
+
+	// data
+	Data []*models.APIPurchaseResponse `json:"data"`
+}
+
+// UnmarshalJSON unmarshals this object from a JSON structure
+func (o *PurchasesListOKBody) UnmarshalJSON(raw []byte) error {
+	// PurchasesListOKBodyAO0
+	var purchasesListOKBodyAO0 models.RequestPaginatedResponse
+	if err := swag.ReadJSON(raw, &purchasesListOKBodyAO0); err != nil {
+		return err
+	}
+	o.RequestPaginatedResponse = purchasesListOKBodyAO0
+
+	// PurchasesListOKBodyAO1
+	var dataPurchasesListOKBodyAO1 struct {
+		Data []*models.APIPurchaseResponse `json:"data"`
+	}
+	if err := swag.ReadJSON(raw, &dataPurchasesListOKBodyAO1); err != nil {
+		return err
+	}
+
+	o.Data = dataPurchasesListOKBodyAO1.Data
+
+	return nil
+}
+
+// MarshalJSON marshals this object to a JSON structure
+func (o PurchasesListOKBody) MarshalJSON() ([]byte, error) {
+	_parts := make([][]byte, 0, 2)
+
+	purchasesListOKBodyAO0, err := swag.WriteJSON(o.RequestPaginatedResponse)
+	if err != nil {
+		return nil, err
+	}
+	_parts = append(_parts, purchasesListOKBodyAO0)
+	var dataPurchasesListOKBodyAO1 struct {
+		Data []*models.APIPurchaseResponse `json:"data"`
+	}
+
+	dataPurchasesListOKBodyAO1.Data = o.Data
+
+	jsonDataPurchasesListOKBodyAO1, errPurchasesListOKBodyAO1 := swag.WriteJSON(dataPurchasesListOKBodyAO1)
+	if errPurchasesListOKBodyAO1 != nil {
+		return nil, errPurchasesListOKBodyAO1
+	}
+	_parts = append(_parts, jsonDataPurchasesListOKBodyAO1)
+	return swag.ConcatJSON(_parts...), nil
+}
+
+// Validate validates this purchases list o k body
+func (o *PurchasesListOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.Validate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PurchasesListOKBody) validateData(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Data) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Data); i++ {
+		if swag.IsZero(o.Data[i]) { // not required
+			continue
+		}
+
+		if o.Data[i] != nil {
+			if err := o.Data[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("purchasesListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("purchasesListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this purchases list o k body based on the context it is used
+func (o *PurchasesListOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.ContextValidate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateData(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PurchasesListOKBody) contextValidateData(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Data); i++ {
+
+		if o.Data[i] != nil {
+
+			if swag.IsZero(o.Data[i]) { // not required
+				return nil
+			}
+
+			if err := o.Data[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("purchasesListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("purchasesListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *PurchasesListOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *PurchasesListOKBody) UnmarshalBinary(b []byte) error {
+	var res PurchasesListOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
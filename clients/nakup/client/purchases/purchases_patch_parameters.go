@@ -0,0 +1,226 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewPurchasesPatchParams creates a new PurchasesPatchParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPurchasesPatchParams() *PurchasesPatchParams {
+	return &PurchasesPatchParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPurchasesPatchParamsWithTimeout creates a new PurchasesPatchParams object
+// with the ability to set a timeout on a request.
+func NewPurchasesPatchParamsWithTimeout(timeout time.Duration) *PurchasesPatchParams {
+	return &PurchasesPatchParams{
+		timeout: timeout,
+	}
+}
+
+// NewPurchasesPatchParamsWithContext creates a new PurchasesPatchParams object
+// with the ability to set a context for a request.
+func NewPurchasesPatchParamsWithContext(ctx context.Context) *PurchasesPatchParams {
+	return &PurchasesPatchParams{
+		Context: ctx,
+	}
+}
+
+// NewPurchasesPatchParamsWithHTTPClient creates a new PurchasesPatchParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPurchasesPatchParamsWithHTTPClient(client *http.Client) *PurchasesPatchParams {
+	return &PurchasesPatchParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PurchasesPatchParams contains all the parameters to send to the API endpoint
+
+	for the purchases patch operation.
+
+	Typically these are written to a http.Request.
+*/
+type PurchasesPatchParams struct {
+
+	/* IfMatch.
+
+	   Only change the purchase if its ETag still matches
+	*/
+	IfMatch *string
+
+	/* PurchaseID.
+
+	   Purchase ID
+
+	   Format: uuid
+	*/
+	PurchaseID strfmt.UUID
+
+	/* Request.
+
+	   merge patch document
+	*/
+	Request *models.APIPurchaseRequest
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the purchases patch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesPatchParams) WithDefaults() *PurchasesPatchParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the purchases patch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesPatchParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the purchases patch params
+func (o *PurchasesPatchParams) WithTimeout(timeout time.Duration) *PurchasesPatchParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the purchases patch params
+func (o *PurchasesPatchParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the purchases patch params
+func (o *PurchasesPatchParams) WithContext(ctx context.Context) *PurchasesPatchParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the purchases patch params
+func (o *PurchasesPatchParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the purchases patch params
+func (o *PurchasesPatchParams) WithHTTPClient(client *http.Client) *PurchasesPatchParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the purchases patch params
+func (o *PurchasesPatchParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIfMatch adds the ifMatch to the purchases patch params
+func (o *PurchasesPatchParams) WithIfMatch(ifMatch *string) *PurchasesPatchParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the purchases patch params
+func (o *PurchasesPatchParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
+// WithPurchaseID adds the purchaseID to the purchases patch params
+func (o *PurchasesPatchParams) WithPurchaseID(purchaseID strfmt.UUID) *PurchasesPatchParams {
+	o.SetPurchaseID(purchaseID)
+	return o
+}
+
+// SetPurchaseID adds the purchaseId to the purchases patch params
+func (o *PurchasesPatchParams) SetPurchaseID(purchaseID strfmt.UUID) {
+	o.PurchaseID = purchaseID
+}
+
+// WithRequest adds the request to the purchases patch params
+func (o *PurchasesPatchParams) WithRequest(request *models.APIPurchaseRequest) *PurchasesPatchParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the purchases patch params
+func (o *PurchasesPatchParams) SetRequest(request *models.APIPurchaseRequest) {
+	o.Request = request
+}
+
+// WithReservationID adds the reservationID to the purchases patch params
+func (o *PurchasesPatchParams) WithReservationID(reservationID strfmt.UUID) *PurchasesPatchParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the purchases patch params
+func (o *PurchasesPatchParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PurchasesPatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
+	// path param purchaseID
+	if err := r.SetPathParam("purchaseID", o.PurchaseID.String()); err != nil {
+		return err
+	}
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,423 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// PurchasesPatchReader is a Reader for the PurchasesPatch structure.
+type PurchasesPatchReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PurchasesPatchReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPurchasesPatchOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPurchasesPatchBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPurchasesPatchNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 412:
+		result := NewPurchasesPatchPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPurchasesPatchInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PATCH /reservations/{reservationID}/purchases/{purchaseID}] PurchasesPatch", response, response.Code())
+	}
+}
+
+// NewPurchasesPatchOK creates a PurchasesPatchOK with default headers values
+func NewPurchasesPatchOK() *PurchasesPatchOK {
+	return &PurchasesPatchOK{}
+}
+
+/*
+PurchasesPatchOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type PurchasesPatchOK struct {
+
+	/* Version of the purchase
+	 */
+	ETag string
+
+	Payload *models.APIPurchaseResponse
+}
+
+// IsSuccess returns true when this purchases patch o k response has a 2xx status code
+func (o *PurchasesPatchOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this purchases patch o k response has a 3xx status code
+func (o *PurchasesPatchOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases patch o k response has a 4xx status code
+func (o *PurchasesPatchOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases patch o k response has a 5xx status code
+func (o *PurchasesPatchOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases patch o k response a status code equal to that given
+func (o *PurchasesPatchOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the purchases patch o k response
+func (o *PurchasesPatchOK) Code() int {
+	return 200
+}
+
+func (o *PurchasesPatchOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesPatchOK %s", 200, payload)
+}
+
+func (o *PurchasesPatchOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesPatchOK %s", 200, payload)
+}
+
+func (o *PurchasesPatchOK) GetPayload() *models.APIPurchaseResponse {
+	return o.Payload
+}
+
+func (o *PurchasesPatchOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.APIPurchaseResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesPatchBadRequest creates a PurchasesPatchBadRequest with default headers values
+func NewPurchasesPatchBadRequest() *PurchasesPatchBadRequest {
+	return &PurchasesPatchBadRequest{}
+}
+
+/*
+PurchasesPatchBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type PurchasesPatchBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases patch bad request response has a 2xx status code
+func (o *PurchasesPatchBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases patch bad request response has a 3xx status code
+func (o *PurchasesPatchBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases patch bad request response has a 4xx status code
+func (o *PurchasesPatchBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases patch bad request response has a 5xx status code
+func (o *PurchasesPatchBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases patch bad request response a status code equal to that given
+func (o *PurchasesPatchBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the purchases patch bad request response
+func (o *PurchasesPatchBadRequest) Code() int {
+	return 400
+}
+
+func (o *PurchasesPatchBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesPatchBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesPatchBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesPatchBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesPatchBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesPatchBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesPatchNotFound creates a PurchasesPatchNotFound with default headers values
+func NewPurchasesPatchNotFound() *PurchasesPatchNotFound {
+	return &PurchasesPatchNotFound{}
+}
+
+/*
+PurchasesPatchNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PurchasesPatchNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases patch not found response has a 2xx status code
+func (o *PurchasesPatchNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases patch not found response has a 3xx status code
+func (o *PurchasesPatchNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases patch not found response has a 4xx status code
+func (o *PurchasesPatchNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases patch not found response has a 5xx status code
+func (o *PurchasesPatchNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases patch not found response a status code equal to that given
+func (o *PurchasesPatchNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the purchases patch not found response
+func (o *PurchasesPatchNotFound) Code() int {
+	return 404
+}
+
+func (o *PurchasesPatchNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesPatchNotFound %s", 404, payload)
+}
+
+func (o *PurchasesPatchNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesPatchNotFound %s", 404, payload)
+}
+
+func (o *PurchasesPatchNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesPatchNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesPatchPreconditionFailed creates a PurchasesPatchPreconditionFailed with default headers values
+func NewPurchasesPatchPreconditionFailed() *PurchasesPatchPreconditionFailed {
+	return &PurchasesPatchPreconditionFailed{}
+}
+
+/*
+PurchasesPatchPreconditionFailed describes a response with status code 412, with default header values.
+
+Precondition Failed
+*/
+type PurchasesPatchPreconditionFailed struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases patch precondition failed response has a 2xx status code
+func (o *PurchasesPatchPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases patch precondition failed response has a 3xx status code
+func (o *PurchasesPatchPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases patch precondition failed response has a 4xx status code
+func (o *PurchasesPatchPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases patch precondition failed response has a 5xx status code
+func (o *PurchasesPatchPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases patch precondition failed response a status code equal to that given
+func (o *PurchasesPatchPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the purchases patch precondition failed response
+func (o *PurchasesPatchPreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *PurchasesPatchPreconditionFailed) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesPatchPreconditionFailed %s", 412, payload)
+}
+
+func (o *PurchasesPatchPreconditionFailed) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesPatchPreconditionFailed %s", 412, payload)
+}
+
+func (o *PurchasesPatchPreconditionFailed) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesPatchPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesPatchInternalServerError creates a PurchasesPatchInternalServerError with default headers values
+func NewPurchasesPatchInternalServerError() *PurchasesPatchInternalServerError {
+	return &PurchasesPatchInternalServerError{}
+}
+
+/*
+PurchasesPatchInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type PurchasesPatchInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases patch internal server error response has a 2xx status code
+func (o *PurchasesPatchInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases patch internal server error response has a 3xx status code
+func (o *PurchasesPatchInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases patch internal server error response has a 4xx status code
+func (o *PurchasesPatchInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases patch internal server error response has a 5xx status code
+func (o *PurchasesPatchInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this purchases patch internal server error response a status code equal to that given
+func (o *PurchasesPatchInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the purchases patch internal server error response
+func (o *PurchasesPatchInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PurchasesPatchInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesPatchInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesPatchInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesPatchInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesPatchInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesPatchInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
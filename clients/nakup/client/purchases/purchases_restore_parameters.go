@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPurchasesRestoreParams creates a new PurchasesRestoreParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPurchasesRestoreParams() *PurchasesRestoreParams {
+	return &PurchasesRestoreParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPurchasesRestoreParamsWithTimeout creates a new PurchasesRestoreParams object
+// with the ability to set a timeout on a request.
+func NewPurchasesRestoreParamsWithTimeout(timeout time.Duration) *PurchasesRestoreParams {
+	return &PurchasesRestoreParams{
+		timeout: timeout,
+	}
+}
+
+// NewPurchasesRestoreParamsWithContext creates a new PurchasesRestoreParams object
+// with the ability to set a context for a request.
+func NewPurchasesRestoreParamsWithContext(ctx context.Context) *PurchasesRestoreParams {
+	return &PurchasesRestoreParams{
+		Context: ctx,
+	}
+}
+
+// NewPurchasesRestoreParamsWithHTTPClient creates a new PurchasesRestoreParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPurchasesRestoreParamsWithHTTPClient(client *http.Client) *PurchasesRestoreParams {
+	return &PurchasesRestoreParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PurchasesRestoreParams contains all the parameters to send to the API endpoint
+
+	for the purchases restore operation.
+
+	Typically these are written to a http.Request.
+*/
+type PurchasesRestoreParams struct {
+
+	/* IdempotencyKey.
+
+	   Key that makes retries of the request safe
+	*/
+	IdempotencyKey *string
+
+	/* PurchaseID.
+
+	   Purchase ID
+
+	   Format: uuid
+	*/
+	PurchaseID strfmt.UUID
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the purchases restore params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesRestoreParams) WithDefaults() *PurchasesRestoreParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the purchases restore params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesRestoreParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the purchases restore params
+func (o *PurchasesRestoreParams) WithTimeout(timeout time.Duration) *PurchasesRestoreParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the purchases restore params
+func (o *PurchasesRestoreParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the purchases restore params
+func (o *PurchasesRestoreParams) WithContext(ctx context.Context) *PurchasesRestoreParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the purchases restore params
+func (o *PurchasesRestoreParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the purchases restore params
+func (o *PurchasesRestoreParams) WithHTTPClient(client *http.Client) *PurchasesRestoreParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the purchases restore params
+func (o *PurchasesRestoreParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIdempotencyKey adds the idempotencyKey to the purchases restore params
+func (o *PurchasesRestoreParams) WithIdempotencyKey(idempotencyKey *string) *PurchasesRestoreParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the purchases restore params
+func (o *PurchasesRestoreParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
+// WithPurchaseID adds the purchaseID to the purchases restore params
+func (o *PurchasesRestoreParams) WithPurchaseID(purchaseID strfmt.UUID) *PurchasesRestoreParams {
+	o.SetPurchaseID(purchaseID)
+	return o
+}
+
+// SetPurchaseID adds the purchaseId to the purchases restore params
+func (o *PurchasesRestoreParams) SetPurchaseID(purchaseID strfmt.UUID) {
+	o.PurchaseID = purchaseID
+}
+
+// WithReservationID adds the reservationID to the purchases restore params
+func (o *PurchasesRestoreParams) WithReservationID(reservationID strfmt.UUID) *PurchasesRestoreParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the purchases restore params
+func (o *PurchasesRestoreParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PurchasesRestoreParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+
+	// path param purchaseID
+	if err := r.SetPathParam("purchaseID", o.PurchaseID.String()); err != nil {
+		return err
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,411 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// PurchasesRestoreReader is a Reader for the PurchasesRestore structure.
+type PurchasesRestoreReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PurchasesRestoreReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPurchasesRestoreOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPurchasesRestoreBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPurchasesRestoreNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewPurchasesRestoreUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPurchasesRestoreInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /reservations/{reservationID}/purchases/{purchaseID}/restore] PurchasesRestore", response, response.Code())
+	}
+}
+
+// NewPurchasesRestoreOK creates a PurchasesRestoreOK with default headers values
+func NewPurchasesRestoreOK() *PurchasesRestoreOK {
+	return &PurchasesRestoreOK{}
+}
+
+/*
+PurchasesRestoreOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type PurchasesRestoreOK struct {
+	Payload *models.APIPurchaseResponse
+}
+
+// IsSuccess returns true when this purchases restore o k response has a 2xx status code
+func (o *PurchasesRestoreOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this purchases restore o k response has a 3xx status code
+func (o *PurchasesRestoreOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases restore o k response has a 4xx status code
+func (o *PurchasesRestoreOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases restore o k response has a 5xx status code
+func (o *PurchasesRestoreOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases restore o k response a status code equal to that given
+func (o *PurchasesRestoreOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the purchases restore o k response
+func (o *PurchasesRestoreOK) Code() int {
+	return 200
+}
+
+func (o *PurchasesRestoreOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases/{purchaseID}/restore][%d] purchasesRestoreOK %s", 200, payload)
+}
+
+func (o *PurchasesRestoreOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases/{purchaseID}/restore][%d] purchasesRestoreOK %s", 200, payload)
+}
+
+func (o *PurchasesRestoreOK) GetPayload() *models.APIPurchaseResponse {
+	return o.Payload
+}
+
+func (o *PurchasesRestoreOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIPurchaseResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesRestoreBadRequest creates a PurchasesRestoreBadRequest with default headers values
+func NewPurchasesRestoreBadRequest() *PurchasesRestoreBadRequest {
+	return &PurchasesRestoreBadRequest{}
+}
+
+/*
+PurchasesRestoreBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type PurchasesRestoreBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases restore bad request response has a 2xx status code
+func (o *PurchasesRestoreBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases restore bad request response has a 3xx status code
+func (o *PurchasesRestoreBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases restore bad request response has a 4xx status code
+func (o *PurchasesRestoreBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases restore bad request response has a 5xx status code
+func (o *PurchasesRestoreBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases restore bad request response a status code equal to that given
+func (o *PurchasesRestoreBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the purchases restore bad request response
+func (o *PurchasesRestoreBadRequest) Code() int {
+	return 400
+}
+
+func (o *PurchasesRestoreBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases/{purchaseID}/restore][%d] purchasesRestoreBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesRestoreBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases/{purchaseID}/restore][%d] purchasesRestoreBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesRestoreBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesRestoreBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesRestoreNotFound creates a PurchasesRestoreNotFound with default headers values
+func NewPurchasesRestoreNotFound() *PurchasesRestoreNotFound {
+	return &PurchasesRestoreNotFound{}
+}
+
+/*
+PurchasesRestoreNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PurchasesRestoreNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases restore not found response has a 2xx status code
+func (o *PurchasesRestoreNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases restore not found response has a 3xx status code
+func (o *PurchasesRestoreNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases restore not found response has a 4xx status code
+func (o *PurchasesRestoreNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases restore not found response has a 5xx status code
+func (o *PurchasesRestoreNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases restore not found response a status code equal to that given
+func (o *PurchasesRestoreNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the purchases restore not found response
+func (o *PurchasesRestoreNotFound) Code() int {
+	return 404
+}
+
+func (o *PurchasesRestoreNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases/{purchaseID}/restore][%d] purchasesRestoreNotFound %s", 404, payload)
+}
+
+func (o *PurchasesRestoreNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases/{purchaseID}/restore][%d] purchasesRestoreNotFound %s", 404, payload)
+}
+
+func (o *PurchasesRestoreNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesRestoreNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesRestoreUnprocessableEntity creates a PurchasesRestoreUnprocessableEntity with default headers values
+func NewPurchasesRestoreUnprocessableEntity() *PurchasesRestoreUnprocessableEntity {
+	return &PurchasesRestoreUnprocessableEntity{}
+}
+
+/*
+PurchasesRestoreUnprocessableEntity describes a response with status code 422, with default header values.
+
+Unprocessable Entity
+*/
+type PurchasesRestoreUnprocessableEntity struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases restore unprocessable entity response has a 2xx status code
+func (o *PurchasesRestoreUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases restore unprocessable entity response has a 3xx status code
+func (o *PurchasesRestoreUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases restore unprocessable entity response has a 4xx status code
+func (o *PurchasesRestoreUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases restore unprocessable entity response has a 5xx status code
+func (o *PurchasesRestoreUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases restore unprocessable entity response a status code equal to that given
+func (o *PurchasesRestoreUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the purchases restore unprocessable entity response
+func (o *PurchasesRestoreUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *PurchasesRestoreUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases/{purchaseID}/restore][%d] purchasesRestoreUnprocessableEntity %s", 422, payload)
+}
+
+func (o *PurchasesRestoreUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases/{purchaseID}/restore][%d] purchasesRestoreUnprocessableEntity %s", 422, payload)
+}
+
+func (o *PurchasesRestoreUnprocessableEntity) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesRestoreUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesRestoreInternalServerError creates a PurchasesRestoreInternalServerError with default headers values
+func NewPurchasesRestoreInternalServerError() *PurchasesRestoreInternalServerError {
+	return &PurchasesRestoreInternalServerError{}
+}
+
+/*
+PurchasesRestoreInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type PurchasesRestoreInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases restore internal server error response has a 2xx status code
+func (o *PurchasesRestoreInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases restore internal server error response has a 3xx status code
+func (o *PurchasesRestoreInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases restore internal server error response has a 4xx status code
+func (o *PurchasesRestoreInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases restore internal server error response has a 5xx status code
+func (o *PurchasesRestoreInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this purchases restore internal server error response a status code equal to that given
+func (o *PurchasesRestoreInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the purchases restore internal server error response
+func (o *PurchasesRestoreInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PurchasesRestoreInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases/{purchaseID}/restore][%d] purchasesRestoreInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesRestoreInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/purchases/{purchaseID}/restore][%d] purchasesRestoreInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesRestoreInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesRestoreInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
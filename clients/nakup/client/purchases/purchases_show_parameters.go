@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPurchasesShowParams creates a new PurchasesShowParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPurchasesShowParams() *PurchasesShowParams {
+	return &PurchasesShowParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPurchasesShowParamsWithTimeout creates a new PurchasesShowParams object
+// with the ability to set a timeout on a request.
+func NewPurchasesShowParamsWithTimeout(timeout time.Duration) *PurchasesShowParams {
+	return &PurchasesShowParams{
+		timeout: timeout,
+	}
+}
+
+// NewPurchasesShowParamsWithContext creates a new PurchasesShowParams object
+// with the ability to set a context for a request.
+func NewPurchasesShowParamsWithContext(ctx context.Context) *PurchasesShowParams {
+	return &PurchasesShowParams{
+		Context: ctx,
+	}
+}
+
+// NewPurchasesShowParamsWithHTTPClient creates a new PurchasesShowParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPurchasesShowParamsWithHTTPClient(client *http.Client) *PurchasesShowParams {
+	return &PurchasesShowParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PurchasesShowParams contains all the parameters to send to the API endpoint
+
+	for the purchases show operation.
+
+	Typically these are written to a http.Request.
+*/
+type PurchasesShowParams struct {
+
+	/* IfNoneMatch.
+
+	   Respond with 304 if the purchase's ETag still matches
+	*/
+	IfNoneMatch *string
+
+	/* PurchaseID.
+
+	   Purchase ID
+
+	   Format: uuid
+	*/
+	PurchaseID strfmt.UUID
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the purchases show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesShowParams) WithDefaults() *PurchasesShowParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the purchases show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesShowParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the purchases show params
+func (o *PurchasesShowParams) WithTimeout(timeout time.Duration) *PurchasesShowParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the purchases show params
+func (o *PurchasesShowParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the purchases show params
+func (o *PurchasesShowParams) WithContext(ctx context.Context) *PurchasesShowParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the purchases show params
+func (o *PurchasesShowParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the purchases show params
+func (o *PurchasesShowParams) WithHTTPClient(client *http.Client) *PurchasesShowParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the purchases show params
+func (o *PurchasesShowParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIfNoneMatch adds the ifNoneMatch to the purchases show params
+func (o *PurchasesShowParams) WithIfNoneMatch(ifNoneMatch *string) *PurchasesShowParams {
+	o.SetIfNoneMatch(ifNoneMatch)
+	return o
+}
+
+// SetIfNoneMatch adds the ifNoneMatch to the purchases show params
+func (o *PurchasesShowParams) SetIfNoneMatch(ifNoneMatch *string) {
+	o.IfNoneMatch = ifNoneMatch
+}
+
+// WithPurchaseID adds the purchaseID to the purchases show params
+func (o *PurchasesShowParams) WithPurchaseID(purchaseID strfmt.UUID) *PurchasesShowParams {
+	o.SetPurchaseID(purchaseID)
+	return o
+}
+
+// SetPurchaseID adds the purchaseId to the purchases show params
+func (o *PurchasesShowParams) SetPurchaseID(purchaseID strfmt.UUID) {
+	o.PurchaseID = purchaseID
+}
+
+// WithReservationID adds the reservationID to the purchases show params
+func (o *PurchasesShowParams) WithReservationID(reservationID strfmt.UUID) *PurchasesShowParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the purchases show params
+func (o *PurchasesShowParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PurchasesShowParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IfNoneMatch != nil {
+
+		// header param If-None-Match
+		if err := r.SetHeaderParam("If-None-Match", *o.IfNoneMatch); err != nil {
+			return err
+		}
+	}
+
+	// path param purchaseID
+	if err := r.SetPathParam("purchaseID", o.PurchaseID.String()); err != nil {
+		return err
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
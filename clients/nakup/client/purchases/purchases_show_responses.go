@@ -0,0 +1,409 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// PurchasesShowReader is a Reader for the PurchasesShow structure.
+type PurchasesShowReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PurchasesShowReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPurchasesShowOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 304:
+		result := NewPurchasesShowNotModified()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 400:
+		result := NewPurchasesShowBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPurchasesShowNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPurchasesShowInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /reservations/{reservationID}/purchases/{purchaseID}] PurchasesShow", response, response.Code())
+	}
+}
+
+// NewPurchasesShowOK creates a PurchasesShowOK with default headers values
+func NewPurchasesShowOK() *PurchasesShowOK {
+	return &PurchasesShowOK{}
+}
+
+/*
+PurchasesShowOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type PurchasesShowOK struct {
+
+	/* Version of the purchase
+	 */
+	ETag string
+
+	Payload *models.APIPurchaseResponse
+}
+
+// IsSuccess returns true when this purchases show o k response has a 2xx status code
+func (o *PurchasesShowOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this purchases show o k response has a 3xx status code
+func (o *PurchasesShowOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases show o k response has a 4xx status code
+func (o *PurchasesShowOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases show o k response has a 5xx status code
+func (o *PurchasesShowOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases show o k response a status code equal to that given
+func (o *PurchasesShowOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the purchases show o k response
+func (o *PurchasesShowOK) Code() int {
+	return 200
+}
+
+func (o *PurchasesShowOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesShowOK %s", 200, payload)
+}
+
+func (o *PurchasesShowOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesShowOK %s", 200, payload)
+}
+
+func (o *PurchasesShowOK) GetPayload() *models.APIPurchaseResponse {
+	return o.Payload
+}
+
+func (o *PurchasesShowOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.APIPurchaseResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesShowNotModified creates a PurchasesShowNotModified with default headers values
+func NewPurchasesShowNotModified() *PurchasesShowNotModified {
+	return &PurchasesShowNotModified{}
+}
+
+/*
+PurchasesShowNotModified describes a response with status code 304, with default header values.
+
+Not Modified
+*/
+type PurchasesShowNotModified struct {
+}
+
+// IsSuccess returns true when this purchases show not modified response has a 2xx status code
+func (o *PurchasesShowNotModified) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases show not modified response has a 3xx status code
+func (o *PurchasesShowNotModified) IsRedirect() bool {
+	return true
+}
+
+// IsClientError returns true when this purchases show not modified response has a 4xx status code
+func (o *PurchasesShowNotModified) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases show not modified response has a 5xx status code
+func (o *PurchasesShowNotModified) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases show not modified response a status code equal to that given
+func (o *PurchasesShowNotModified) IsCode(code int) bool {
+	return code == 304
+}
+
+// Code gets the status code for the purchases show not modified response
+func (o *PurchasesShowNotModified) Code() int {
+	return 304
+}
+
+func (o *PurchasesShowNotModified) Error() string {
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesShowNotModified", 304)
+}
+
+func (o *PurchasesShowNotModified) String() string {
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesShowNotModified", 304)
+}
+
+func (o *PurchasesShowNotModified) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewPurchasesShowBadRequest creates a PurchasesShowBadRequest with default headers values
+func NewPurchasesShowBadRequest() *PurchasesShowBadRequest {
+	return &PurchasesShowBadRequest{}
+}
+
+/*
+PurchasesShowBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type PurchasesShowBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases show bad request response has a 2xx status code
+func (o *PurchasesShowBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases show bad request response has a 3xx status code
+func (o *PurchasesShowBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases show bad request response has a 4xx status code
+func (o *PurchasesShowBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases show bad request response has a 5xx status code
+func (o *PurchasesShowBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases show bad request response a status code equal to that given
+func (o *PurchasesShowBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the purchases show bad request response
+func (o *PurchasesShowBadRequest) Code() int {
+	return 400
+}
+
+func (o *PurchasesShowBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesShowBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesShowBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesShowBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesShowBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesShowBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesShowNotFound creates a PurchasesShowNotFound with default headers values
+func NewPurchasesShowNotFound() *PurchasesShowNotFound {
+	return &PurchasesShowNotFound{}
+}
+
+/*
+PurchasesShowNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PurchasesShowNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases show not found response has a 2xx status code
+func (o *PurchasesShowNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases show not found response has a 3xx status code
+func (o *PurchasesShowNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases show not found response has a 4xx status code
+func (o *PurchasesShowNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases show not found response has a 5xx status code
+func (o *PurchasesShowNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases show not found response a status code equal to that given
+func (o *PurchasesShowNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the purchases show not found response
+func (o *PurchasesShowNotFound) Code() int {
+	return 404
+}
+
+func (o *PurchasesShowNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesShowNotFound %s", 404, payload)
+}
+
+func (o *PurchasesShowNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesShowNotFound %s", 404, payload)
+}
+
+func (o *PurchasesShowNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesShowNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesShowInternalServerError creates a PurchasesShowInternalServerError with default headers values
+func NewPurchasesShowInternalServerError() *PurchasesShowInternalServerError {
+	return &PurchasesShowInternalServerError{}
+}
+
+/*
+PurchasesShowInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type PurchasesShowInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases show internal server error response has a 2xx status code
+func (o *PurchasesShowInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases show internal server error response has a 3xx status code
+func (o *PurchasesShowInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases show internal server error response has a 4xx status code
+func (o *PurchasesShowInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases show internal server error response has a 5xx status code
+func (o *PurchasesShowInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this purchases show internal server error response a status code equal to that given
+func (o *PurchasesShowInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the purchases show internal server error response
+func (o *PurchasesShowInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PurchasesShowInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesShowInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesShowInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesShowInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesShowInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesShowInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewPurchasesUpdateParams creates a new PurchasesUpdateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPurchasesUpdateParams() *PurchasesUpdateParams {
+	return &PurchasesUpdateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPurchasesUpdateParamsWithTimeout creates a new PurchasesUpdateParams object
+// with the ability to set a timeout on a request.
+func NewPurchasesUpdateParamsWithTimeout(timeout time.Duration) *PurchasesUpdateParams {
+	return &PurchasesUpdateParams{
+		timeout: timeout,
+	}
+}
+
+// NewPurchasesUpdateParamsWithContext creates a new PurchasesUpdateParams object
+// with the ability to set a context for a request.
+func NewPurchasesUpdateParamsWithContext(ctx context.Context) *PurchasesUpdateParams {
+	return &PurchasesUpdateParams{
+		Context: ctx,
+	}
+}
+
+// NewPurchasesUpdateParamsWithHTTPClient creates a new PurchasesUpdateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPurchasesUpdateParamsWithHTTPClient(client *http.Client) *PurchasesUpdateParams {
+	return &PurchasesUpdateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PurchasesUpdateParams contains all the parameters to send to the API endpoint
+
+	for the purchases update operation.
+
+	Typically these are written to a http.Request.
+*/
+type PurchasesUpdateParams struct {
+
+	/* IfMatch.
+
+	   Only change the purchase if its ETag still matches
+	*/
+	IfMatch *string
+
+	/* PurchaseID.
+
+	   Purchase ID
+
+	   Format: uuid
+	*/
+	PurchaseID strfmt.UUID
+
+	/* Request.
+
+	   request body
+	*/
+	Request *models.APIPurchaseRequest
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the purchases update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesUpdateParams) WithDefaults() *PurchasesUpdateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the purchases update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PurchasesUpdateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the purchases update params
+func (o *PurchasesUpdateParams) WithTimeout(timeout time.Duration) *PurchasesUpdateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the purchases update params
+func (o *PurchasesUpdateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the purchases update params
+func (o *PurchasesUpdateParams) WithContext(ctx context.Context) *PurchasesUpdateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the purchases update params
+func (o *PurchasesUpdateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the purchases update params
+func (o *PurchasesUpdateParams) WithHTTPClient(client *http.Client) *PurchasesUpdateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the purchases update params
+func (o *PurchasesUpdateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIfMatch adds the ifMatch to the purchases update params
+func (o *PurchasesUpdateParams) WithIfMatch(ifMatch *string) *PurchasesUpdateParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the purchases update params
+func (o *PurchasesUpdateParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
+// WithPurchaseID adds the purchaseID to the purchases update params
+func (o *PurchasesUpdateParams) WithPurchaseID(purchaseID strfmt.UUID) *PurchasesUpdateParams {
+	o.SetPurchaseID(purchaseID)
+	return o
+}
+
+// SetPurchaseID adds the purchaseId to the purchases update params
+func (o *PurchasesUpdateParams) SetPurchaseID(purchaseID strfmt.UUID) {
+	o.PurchaseID = purchaseID
+}
+
+// WithRequest adds the request to the purchases update params
+func (o *PurchasesUpdateParams) WithRequest(request *models.APIPurchaseRequest) *PurchasesUpdateParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the purchases update params
+func (o *PurchasesUpdateParams) SetRequest(request *models.APIPurchaseRequest) {
+	o.Request = request
+}
+
+// WithReservationID adds the reservationID to the purchases update params
+func (o *PurchasesUpdateParams) WithReservationID(reservationID strfmt.UUID) *PurchasesUpdateParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the purchases update params
+func (o *PurchasesUpdateParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PurchasesUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
+	// path param purchaseID
+	if err := r.SetPathParam("purchaseID", o.PurchaseID.String()); err != nil {
+		return err
+	}
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
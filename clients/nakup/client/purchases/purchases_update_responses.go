@@ -0,0 +1,423 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package purchases
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// PurchasesUpdateReader is a Reader for the PurchasesUpdate structure.
+type PurchasesUpdateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PurchasesUpdateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPurchasesUpdateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPurchasesUpdateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPurchasesUpdateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 412:
+		result := NewPurchasesUpdatePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPurchasesUpdateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PUT /reservations/{reservationID}/purchases/{purchaseID}] PurchasesUpdate", response, response.Code())
+	}
+}
+
+// NewPurchasesUpdateOK creates a PurchasesUpdateOK with default headers values
+func NewPurchasesUpdateOK() *PurchasesUpdateOK {
+	return &PurchasesUpdateOK{}
+}
+
+/*
+PurchasesUpdateOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type PurchasesUpdateOK struct {
+
+	/* Version of the purchase
+	 */
+	ETag string
+
+	Payload *models.APIPurchaseResponse
+}
+
+// IsSuccess returns true when this purchases update o k response has a 2xx status code
+func (o *PurchasesUpdateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this purchases update o k response has a 3xx status code
+func (o *PurchasesUpdateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases update o k response has a 4xx status code
+func (o *PurchasesUpdateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases update o k response has a 5xx status code
+func (o *PurchasesUpdateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases update o k response a status code equal to that given
+func (o *PurchasesUpdateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the purchases update o k response
+func (o *PurchasesUpdateOK) Code() int {
+	return 200
+}
+
+func (o *PurchasesUpdateOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesUpdateOK %s", 200, payload)
+}
+
+func (o *PurchasesUpdateOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesUpdateOK %s", 200, payload)
+}
+
+func (o *PurchasesUpdateOK) GetPayload() *models.APIPurchaseResponse {
+	return o.Payload
+}
+
+func (o *PurchasesUpdateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.APIPurchaseResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesUpdateBadRequest creates a PurchasesUpdateBadRequest with default headers values
+func NewPurchasesUpdateBadRequest() *PurchasesUpdateBadRequest {
+	return &PurchasesUpdateBadRequest{}
+}
+
+/*
+PurchasesUpdateBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type PurchasesUpdateBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases update bad request response has a 2xx status code
+func (o *PurchasesUpdateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases update bad request response has a 3xx status code
+func (o *PurchasesUpdateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases update bad request response has a 4xx status code
+func (o *PurchasesUpdateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases update bad request response has a 5xx status code
+func (o *PurchasesUpdateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases update bad request response a status code equal to that given
+func (o *PurchasesUpdateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the purchases update bad request response
+func (o *PurchasesUpdateBadRequest) Code() int {
+	return 400
+}
+
+func (o *PurchasesUpdateBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesUpdateBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesUpdateBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesUpdateBadRequest %s", 400, payload)
+}
+
+func (o *PurchasesUpdateBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesUpdateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesUpdateNotFound creates a PurchasesUpdateNotFound with default headers values
+func NewPurchasesUpdateNotFound() *PurchasesUpdateNotFound {
+	return &PurchasesUpdateNotFound{}
+}
+
+/*
+PurchasesUpdateNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PurchasesUpdateNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases update not found response has a 2xx status code
+func (o *PurchasesUpdateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases update not found response has a 3xx status code
+func (o *PurchasesUpdateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases update not found response has a 4xx status code
+func (o *PurchasesUpdateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases update not found response has a 5xx status code
+func (o *PurchasesUpdateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases update not found response a status code equal to that given
+func (o *PurchasesUpdateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the purchases update not found response
+func (o *PurchasesUpdateNotFound) Code() int {
+	return 404
+}
+
+func (o *PurchasesUpdateNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesUpdateNotFound %s", 404, payload)
+}
+
+func (o *PurchasesUpdateNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesUpdateNotFound %s", 404, payload)
+}
+
+func (o *PurchasesUpdateNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesUpdateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesUpdatePreconditionFailed creates a PurchasesUpdatePreconditionFailed with default headers values
+func NewPurchasesUpdatePreconditionFailed() *PurchasesUpdatePreconditionFailed {
+	return &PurchasesUpdatePreconditionFailed{}
+}
+
+/*
+PurchasesUpdatePreconditionFailed describes a response with status code 412, with default header values.
+
+Precondition Failed
+*/
+type PurchasesUpdatePreconditionFailed struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases update precondition failed response has a 2xx status code
+func (o *PurchasesUpdatePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases update precondition failed response has a 3xx status code
+func (o *PurchasesUpdatePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases update precondition failed response has a 4xx status code
+func (o *PurchasesUpdatePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this purchases update precondition failed response has a 5xx status code
+func (o *PurchasesUpdatePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this purchases update precondition failed response a status code equal to that given
+func (o *PurchasesUpdatePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the purchases update precondition failed response
+func (o *PurchasesUpdatePreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *PurchasesUpdatePreconditionFailed) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesUpdatePreconditionFailed %s", 412, payload)
+}
+
+func (o *PurchasesUpdatePreconditionFailed) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesUpdatePreconditionFailed %s", 412, payload)
+}
+
+func (o *PurchasesUpdatePreconditionFailed) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesUpdatePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewPurchasesUpdateInternalServerError creates a PurchasesUpdateInternalServerError with default headers values
+func NewPurchasesUpdateInternalServerError() *PurchasesUpdateInternalServerError {
+	return &PurchasesUpdateInternalServerError{}
+}
+
+/*
+PurchasesUpdateInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type PurchasesUpdateInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this purchases update internal server error response has a 2xx status code
+func (o *PurchasesUpdateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this purchases update internal server error response has a 3xx status code
+func (o *PurchasesUpdateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this purchases update internal server error response has a 4xx status code
+func (o *PurchasesUpdateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this purchases update internal server error response has a 5xx status code
+func (o *PurchasesUpdateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this purchases update internal server error response a status code equal to that given
+func (o *PurchasesUpdateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the purchases update internal server error response
+func (o *PurchasesUpdateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PurchasesUpdateInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesUpdateInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesUpdateInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}/purchases/{purchaseID}][%d] purchasesUpdateInternalServerError %s", 500, payload)
+}
+
+func (o *PurchasesUpdateInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *PurchasesUpdateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
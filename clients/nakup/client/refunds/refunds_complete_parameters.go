@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package refunds
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewRefundsCompleteParams creates a new RefundsCompleteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRefundsCompleteParams() *RefundsCompleteParams {
+	return &RefundsCompleteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRefundsCompleteParamsWithTimeout creates a new RefundsCompleteParams object
+// with the ability to set a timeout on a request.
+func NewRefundsCompleteParamsWithTimeout(timeout time.Duration) *RefundsCompleteParams {
+	return &RefundsCompleteParams{
+		timeout: timeout,
+	}
+}
+
+// NewRefundsCompleteParamsWithContext creates a new RefundsCompleteParams object
+// with the ability to set a context for a request.
+func NewRefundsCompleteParamsWithContext(ctx context.Context) *RefundsCompleteParams {
+	return &RefundsCompleteParams{
+		Context: ctx,
+	}
+}
+
+// NewRefundsCompleteParamsWithHTTPClient creates a new RefundsCompleteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRefundsCompleteParamsWithHTTPClient(client *http.Client) *RefundsCompleteParams {
+	return &RefundsCompleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RefundsCompleteParams contains all the parameters to send to the API endpoint
+
+	for the refunds complete operation.
+
+	Typically these are written to a http.Request.
+*/
+type RefundsCompleteParams struct {
+
+	/* IdempotencyKey.
+
+	   Key that makes retries of the request safe
+	*/
+	IdempotencyKey *string
+
+	/* RefundID.
+
+	   Refund ID
+
+	   Format: uuid
+	*/
+	RefundID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the refunds complete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RefundsCompleteParams) WithDefaults() *RefundsCompleteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the refunds complete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RefundsCompleteParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the refunds complete params
+func (o *RefundsCompleteParams) WithTimeout(timeout time.Duration) *RefundsCompleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the refunds complete params
+func (o *RefundsCompleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the refunds complete params
+func (o *RefundsCompleteParams) WithContext(ctx context.Context) *RefundsCompleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the refunds complete params
+func (o *RefundsCompleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the refunds complete params
+func (o *RefundsCompleteParams) WithHTTPClient(client *http.Client) *RefundsCompleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the refunds complete params
+func (o *RefundsCompleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIdempotencyKey adds the idempotencyKey to the refunds complete params
+func (o *RefundsCompleteParams) WithIdempotencyKey(idempotencyKey *string) *RefundsCompleteParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the refunds complete params
+func (o *RefundsCompleteParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
+// WithRefundID adds the refundID to the refunds complete params
+func (o *RefundsCompleteParams) WithRefundID(refundID strfmt.UUID) *RefundsCompleteParams {
+	o.SetRefundID(refundID)
+	return o
+}
+
+// SetRefundID adds the refundId to the refunds complete params
+func (o *RefundsCompleteParams) SetRefundID(refundID strfmt.UUID) {
+	o.RefundID = refundID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RefundsCompleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+
+	// path param refundID
+	if err := r.SetPathParam("refundID", o.RefundID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,487 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package refunds
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// RefundsCompleteReader is a Reader for the RefundsComplete structure.
+type RefundsCompleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RefundsCompleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRefundsCompleteOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRefundsCompleteBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewRefundsCompleteForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRefundsCompleteNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewRefundsCompleteUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRefundsCompleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /refunds/{refundID}/complete] RefundsComplete", response, response.Code())
+	}
+}
+
+// NewRefundsCompleteOK creates a RefundsCompleteOK with default headers values
+func NewRefundsCompleteOK() *RefundsCompleteOK {
+	return &RefundsCompleteOK{}
+}
+
+/*
+RefundsCompleteOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type RefundsCompleteOK struct {
+	Payload *models.APIRefundResponse
+}
+
+// IsSuccess returns true when this refunds complete o k response has a 2xx status code
+func (o *RefundsCompleteOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this refunds complete o k response has a 3xx status code
+func (o *RefundsCompleteOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this refunds complete o k response has a 4xx status code
+func (o *RefundsCompleteOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this refunds complete o k response has a 5xx status code
+func (o *RefundsCompleteOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this refunds complete o k response a status code equal to that given
+func (o *RefundsCompleteOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the refunds complete o k response
+func (o *RefundsCompleteOK) Code() int {
+	return 200
+}
+
+func (o *RefundsCompleteOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteOK %s", 200, payload)
+}
+
+func (o *RefundsCompleteOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteOK %s", 200, payload)
+}
+
+func (o *RefundsCompleteOK) GetPayload() *models.APIRefundResponse {
+	return o.Payload
+}
+
+func (o *RefundsCompleteOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIRefundResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewRefundsCompleteBadRequest creates a RefundsCompleteBadRequest with default headers values
+func NewRefundsCompleteBadRequest() *RefundsCompleteBadRequest {
+	return &RefundsCompleteBadRequest{}
+}
+
+/*
+RefundsCompleteBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type RefundsCompleteBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this refunds complete bad request response has a 2xx status code
+func (o *RefundsCompleteBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this refunds complete bad request response has a 3xx status code
+func (o *RefundsCompleteBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this refunds complete bad request response has a 4xx status code
+func (o *RefundsCompleteBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this refunds complete bad request response has a 5xx status code
+func (o *RefundsCompleteBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this refunds complete bad request response a status code equal to that given
+func (o *RefundsCompleteBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the refunds complete bad request response
+func (o *RefundsCompleteBadRequest) Code() int {
+	return 400
+}
+
+func (o *RefundsCompleteBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteBadRequest %s", 400, payload)
+}
+
+func (o *RefundsCompleteBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteBadRequest %s", 400, payload)
+}
+
+func (o *RefundsCompleteBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *RefundsCompleteBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewRefundsCompleteForbidden creates a RefundsCompleteForbidden with default headers values
+func NewRefundsCompleteForbidden() *RefundsCompleteForbidden {
+	return &RefundsCompleteForbidden{}
+}
+
+/*
+RefundsCompleteForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type RefundsCompleteForbidden struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this refunds complete forbidden response has a 2xx status code
+func (o *RefundsCompleteForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this refunds complete forbidden response has a 3xx status code
+func (o *RefundsCompleteForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this refunds complete forbidden response has a 4xx status code
+func (o *RefundsCompleteForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this refunds complete forbidden response has a 5xx status code
+func (o *RefundsCompleteForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this refunds complete forbidden response a status code equal to that given
+func (o *RefundsCompleteForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the refunds complete forbidden response
+func (o *RefundsCompleteForbidden) Code() int {
+	return 403
+}
+
+func (o *RefundsCompleteForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteForbidden %s", 403, payload)
+}
+
+func (o *RefundsCompleteForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteForbidden %s", 403, payload)
+}
+
+func (o *RefundsCompleteForbidden) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *RefundsCompleteForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewRefundsCompleteNotFound creates a RefundsCompleteNotFound with default headers values
+func NewRefundsCompleteNotFound() *RefundsCompleteNotFound {
+	return &RefundsCompleteNotFound{}
+}
+
+/*
+RefundsCompleteNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type RefundsCompleteNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this refunds complete not found response has a 2xx status code
+func (o *RefundsCompleteNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this refunds complete not found response has a 3xx status code
+func (o *RefundsCompleteNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this refunds complete not found response has a 4xx status code
+func (o *RefundsCompleteNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this refunds complete not found response has a 5xx status code
+func (o *RefundsCompleteNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this refunds complete not found response a status code equal to that given
+func (o *RefundsCompleteNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the refunds complete not found response
+func (o *RefundsCompleteNotFound) Code() int {
+	return 404
+}
+
+func (o *RefundsCompleteNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteNotFound %s", 404, payload)
+}
+
+func (o *RefundsCompleteNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteNotFound %s", 404, payload)
+}
+
+func (o *RefundsCompleteNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *RefundsCompleteNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewRefundsCompleteUnprocessableEntity creates a RefundsCompleteUnprocessableEntity with default headers values
+func NewRefundsCompleteUnprocessableEntity() *RefundsCompleteUnprocessableEntity {
+	return &RefundsCompleteUnprocessableEntity{}
+}
+
+/*
+RefundsCompleteUnprocessableEntity describes a response with status code 422, with default header values.
+
+Unprocessable Entity
+*/
+type RefundsCompleteUnprocessableEntity struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this refunds complete unprocessable entity response has a 2xx status code
+func (o *RefundsCompleteUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this refunds complete unprocessable entity response has a 3xx status code
+func (o *RefundsCompleteUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this refunds complete unprocessable entity response has a 4xx status code
+func (o *RefundsCompleteUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this refunds complete unprocessable entity response has a 5xx status code
+func (o *RefundsCompleteUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this refunds complete unprocessable entity response a status code equal to that given
+func (o *RefundsCompleteUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the refunds complete unprocessable entity response
+func (o *RefundsCompleteUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *RefundsCompleteUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteUnprocessableEntity %s", 422, payload)
+}
+
+func (o *RefundsCompleteUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteUnprocessableEntity %s", 422, payload)
+}
+
+func (o *RefundsCompleteUnprocessableEntity) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *RefundsCompleteUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewRefundsCompleteInternalServerError creates a RefundsCompleteInternalServerError with default headers values
+func NewRefundsCompleteInternalServerError() *RefundsCompleteInternalServerError {
+	return &RefundsCompleteInternalServerError{}
+}
+
+/*
+RefundsCompleteInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type RefundsCompleteInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this refunds complete internal server error response has a 2xx status code
+func (o *RefundsCompleteInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this refunds complete internal server error response has a 3xx status code
+func (o *RefundsCompleteInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this refunds complete internal server error response has a 4xx status code
+func (o *RefundsCompleteInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this refunds complete internal server error response has a 5xx status code
+func (o *RefundsCompleteInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this refunds complete internal server error response a status code equal to that given
+func (o *RefundsCompleteInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the refunds complete internal server error response
+func (o *RefundsCompleteInternalServerError) Code() int {
+	return 500
+}
+
+func (o *RefundsCompleteInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteInternalServerError %s", 500, payload)
+}
+
+func (o *RefundsCompleteInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /refunds/{refundID}/complete][%d] refundsCompleteInternalServerError %s", 500, payload)
+}
+
+func (o *RefundsCompleteInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *RefundsCompleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package refunds
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewRefundsListParams creates a new RefundsListParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRefundsListParams() *RefundsListParams {
+	return &RefundsListParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRefundsListParamsWithTimeout creates a new RefundsListParams object
+// with the ability to set a timeout on a request.
+func NewRefundsListParamsWithTimeout(timeout time.Duration) *RefundsListParams {
+	return &RefundsListParams{
+		timeout: timeout,
+	}
+}
+
+// NewRefundsListParamsWithContext creates a new RefundsListParams object
+// with the ability to set a context for a request.
+func NewRefundsListParamsWithContext(ctx context.Context) *RefundsListParams {
+	return &RefundsListParams{
+		Context: ctx,
+	}
+}
+
+// NewRefundsListParamsWithHTTPClient creates a new RefundsListParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRefundsListParamsWithHTTPClient(client *http.Client) *RefundsListParams {
+	return &RefundsListParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RefundsListParams contains all the parameters to send to the API endpoint
+
+	for the refunds list operation.
+
+	Typically these are written to a http.Request.
+*/
+type RefundsListParams struct {
+
+	/* Limit.
+
+	   Limit the number of responses
+
+	   Default: 10
+	*/
+	Limit *int64
+
+	/* Offset.
+
+	   Offset the first response
+	*/
+	Offset *int64
+
+	/* Sort.
+
+	   Sort results
+	*/
+	Sort *string
+
+	/* Status.
+
+	   Only list refunds with this status
+	*/
+	Status *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the refunds list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RefundsListParams) WithDefaults() *RefundsListParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the refunds list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RefundsListParams) SetDefaults() {
+	var (
+		limitDefault = int64(10)
+
+		offsetDefault = int64(0)
+	)
+
+	val := RefundsListParams{
+		Limit:  &limitDefault,
+		Offset: &offsetDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the refunds list params
+func (o *RefundsListParams) WithTimeout(timeout time.Duration) *RefundsListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the refunds list params
+func (o *RefundsListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the refunds list params
+func (o *RefundsListParams) WithContext(ctx context.Context) *RefundsListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the refunds list params
+func (o *RefundsListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the refunds list params
+func (o *RefundsListParams) WithHTTPClient(client *http.Client) *RefundsListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the refunds list params
+func (o *RefundsListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the refunds list params
+func (o *RefundsListParams) WithLimit(limit *int64) *RefundsListParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the refunds list params
+func (o *RefundsListParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the refunds list params
+func (o *RefundsListParams) WithOffset(offset *int64) *RefundsListParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the refunds list params
+func (o *RefundsListParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithSort adds the sort to the refunds list params
+func (o *RefundsListParams) WithSort(sort *string) *RefundsListParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the refunds list params
+func (o *RefundsListParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WithStatus adds the status to the refunds list params
+func (o *RefundsListParams) WithStatus(status *string) *RefundsListParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the refunds list params
+func (o *RefundsListParams) SetStatus(status *string) {
+	o.Status = status
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RefundsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
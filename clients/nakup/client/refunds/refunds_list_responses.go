@@ -0,0 +1,435 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package refunds
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// RefundsListReader is a Reader for the RefundsList structure.
+type RefundsListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RefundsListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRefundsListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRefundsListBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRefundsListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /refunds] RefundsList", response, response.Code())
+	}
+}
+
+// NewRefundsListOK creates a RefundsListOK with default headers values
+func NewRefundsListOK() *RefundsListOK {
+	return &RefundsListOK{}
+}
+
+/*
+RefundsListOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type RefundsListOK struct {
+	Payload *RefundsListOKBody
+}
+
+// IsSuccess returns true when this refunds list o k response has a 2xx status code
+func (o *RefundsListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this refunds list o k response has a 3xx status code
+func (o *RefundsListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this refunds list o k response has a 4xx status code
+func (o *RefundsListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this refunds list o k response has a 5xx status code
+func (o *RefundsListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this refunds list o k response a status code equal to that given
+func (o *RefundsListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the refunds list o k response
+func (o *RefundsListOK) Code() int {
+	return 200
+}
+
+func (o *RefundsListOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /refunds][%d] refundsListOK %s", 200, payload)
+}
+
+func (o *RefundsListOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /refunds][%d] refundsListOK %s", 200, payload)
+}
+
+func (o *RefundsListOK) GetPayload() *RefundsListOKBody {
+	return o.Payload
+}
+
+func (o *RefundsListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(RefundsListOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewRefundsListBadRequest creates a RefundsListBadRequest with default headers values
+func NewRefundsListBadRequest() *RefundsListBadRequest {
+	return &RefundsListBadRequest{}
+}
+
+/*
+RefundsListBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type RefundsListBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this refunds list bad request response has a 2xx status code
+func (o *RefundsListBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this refunds list bad request response has a 3xx status code
+func (o *RefundsListBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this refunds list bad request response has a 4xx status code
+func (o *RefundsListBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this refunds list bad request response has a 5xx status code
+func (o *RefundsListBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this refunds list bad request response a status code equal to that given
+func (o *RefundsListBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the refunds list bad request response
+func (o *RefundsListBadRequest) Code() int {
+	return 400
+}
+
+func (o *RefundsListBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /refunds][%d] refundsListBadRequest %s", 400, payload)
+}
+
+func (o *RefundsListBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /refunds][%d] refundsListBadRequest %s", 400, payload)
+}
+
+func (o *RefundsListBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *RefundsListBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewRefundsListInternalServerError creates a RefundsListInternalServerError with default headers values
+func NewRefundsListInternalServerError() *RefundsListInternalServerError {
+	return &RefundsListInternalServerError{}
+}
+
+/*
+RefundsListInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type RefundsListInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this refunds list internal server error response has a 2xx status code
+func (o *RefundsListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this refunds list internal server error response has a 3xx status code
+func (o *RefundsListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this refunds list internal server error response has a 4xx status code
+func (o *RefundsListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this refunds list internal server error response has a 5xx status code
+func (o *RefundsListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this refunds list internal server error response a status code equal to that given
+func (o *RefundsListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the refunds list internal server error response
+func (o *RefundsListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *RefundsListInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /refunds][%d] refundsListInternalServerError %s", 500, payload)
+}
+
+func (o *RefundsListInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /refunds][%d] refundsListInternalServerError %s", 500, payload)
+}
+
+func (o *RefundsListInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *RefundsListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+/*
+RefundsListOKBody refunds list o k body
+swagger:model RefundsListOKBody
+*/
+type RefundsListOKBody struct {
+	models.RequestPaginatedResponse
+
+	// data
+	Data []*models.APIRefundResponse `json:"data"`
+}
+
+// UnmarshalJSON unmarshals this object from a JSON structure
+func (o *RefundsListOKBody) UnmarshalJSON(raw []byte) error {
+	// RefundsListOKBodyAO0
+	var refundsListOKBodyAO0 models.RequestPaginatedResponse
+	if err := swag.ReadJSON(raw, &refundsListOKBodyAO0); err != nil {
+		return err
+	}
+	o.RequestPaginatedResponse = refundsListOKBodyAO0
+
+	// RefundsListOKBodyAO1
+	var dataRefundsListOKBodyAO1 struct {
+		Data []*models.APIRefundResponse `json:"data"`
+	}
+	if err := swag.ReadJSON(raw, &dataRefundsListOKBodyAO1); err != nil {
+		return err
+	}
+
+	o.Data = dataRefundsListOKBodyAO1.Data
+
+	return nil
+}
+
+// MarshalJSON marshals this object to a JSON structure
+func (o RefundsListOKBody) MarshalJSON() ([]byte, error) {
+	_parts := make([][]byte, 0, 2)
+
+	refundsListOKBodyAO0, err := swag.WriteJSON(o.RequestPaginatedResponse)
+	if err != nil {
+		return nil, err
+	}
+	_parts = append(_parts, refundsListOKBodyAO0)
+	var dataRefundsListOKBodyAO1 struct {
+		Data []*models.APIRefundResponse `json:"data"`
+	}
+
+	dataRefundsListOKBodyAO1.Data = o.Data
+
+	jsonDataRefundsListOKBodyAO1, errRefundsListOKBodyAO1 := swag.WriteJSON(dataRefundsListOKBodyAO1)
+	if errRefundsListOKBodyAO1 != nil {
+		return nil, errRefundsListOKBodyAO1
+	}
+	_parts = append(_parts, jsonDataRefundsListOKBodyAO1)
+	return swag.ConcatJSON(_parts...), nil
+}
+
+// Validate validates this refunds list o k body
+func (o *RefundsListOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.Validate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *RefundsListOKBody) validateData(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Data) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Data); i++ {
+		if swag.IsZero(o.Data[i]) { // not required
+			continue
+		}
+
+		if o.Data[i] != nil {
+			if err := o.Data[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("refundsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("refundsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this refunds list o k body based on the context it is used
+func (o *RefundsListOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.ContextValidate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateData(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *RefundsListOKBody) contextValidateData(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Data); i++ {
+
+		if o.Data[i] != nil {
+
+			if swag.IsZero(o.Data[i]) { // not required
+				return nil
+			}
+
+			if err := o.Data[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("refundsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("refundsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *RefundsListOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *RefundsListOKBody) UnmarshalBinary(b []byte) error {
+	var res RefundsListOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,233 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new reports API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+// New creates a new reports API client with basic auth credentials.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - user: user for basic authentication header.
+// - password: password for basic authentication header.
+func NewClientWithBasicAuth(host, basePath, scheme, user, password string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BasicAuth(user, password)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+// New creates a new reports API client with a bearer token for authentication.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - bearerToken: bearer token for Bearer authentication header.
+func NewClientWithBearerToken(host, basePath, scheme, bearerToken string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BearerToken(bearerToken)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+/*
+Client for reports API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption may be used to customize the behavior of Client methods.
+type ClientOption func(*runtime.ClientOperation)
+
+// This client is generated with a few options you might find useful for your swagger spec.
+//
+// Feel free to add you own set of options.
+
+// WithAccept allows the client to force the Accept header
+// to negotiate a specific Producer from the server.
+//
+// You may use this option to set arbitrary extensions to your MIME media type.
+func WithAccept(mime string) ClientOption {
+	return func(r *runtime.ClientOperation) {
+		r.ProducesMediaTypes = []string{mime}
+	}
+}
+
+// WithAcceptApplicationJSON sets the Accept header to "application/json".
+func WithAcceptApplicationJSON(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/json"}
+}
+
+// WithAcceptImageSvgXML sets the Accept header to "image/svg+xml".
+func WithAcceptImageSvgXML(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"image/svg+xml"}
+}
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	ReportsHeatmap(params *ReportsHeatmapParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReportsHeatmapOK, error)
+
+	ReportsMovies(params *ReportsMoviesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReportsMoviesOK, error)
+
+	ReportsOccupancy(params *ReportsOccupancyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReportsOccupancyOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+ReportsHeatmap seats popularity heatmap
+
+Bookings per seat of a room for screenings starting within the date range. Every booking is scored by how early it was made relative to the other bookings of the same screening: the earliest booking scores 1 and a booking made right at the screening start scores 0.
+*/
+func (a *Client) ReportsHeatmap(params *ReportsHeatmapParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReportsHeatmapOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReportsHeatmapParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReportsHeatmap",
+		Method:             "GET",
+		PathPattern:        "/reports/heatmap",
+		ProducesMediaTypes: []string{"application/json", "image/svg+xml"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReportsHeatmapReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReportsHeatmapOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReportsHeatmap: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReportsMovies revenues and admissions per movie
+
+Admissions, ticket revenue and concession revenue per movie for screenings starting within the date range. Employees only see the screenings of their theaters.
+*/
+func (a *Client) ReportsMovies(params *ReportsMoviesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReportsMoviesOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReportsMoviesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReportsMovies",
+		Method:             "GET",
+		PathPattern:        "/reports/movies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReportsMoviesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReportsMoviesOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReportsMovies: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReportsOccupancy seats occupancy
+
+Sold seats versus room capacity for a single time slot or for all screenings starting within the date range, aggregated per room and per weekday and hour. Employees only see the screenings of their theaters.
+*/
+func (a *Client) ReportsOccupancy(params *ReportsOccupancyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReportsOccupancyOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReportsOccupancyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReportsOccupancy",
+		Method:             "GET",
+		PathPattern:        "/reports/occupancy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReportsOccupancyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReportsOccupancyOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReportsOccupancy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReportsHeatmapParams creates a new ReportsHeatmapParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReportsHeatmapParams() *ReportsHeatmapParams {
+	return &ReportsHeatmapParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReportsHeatmapParamsWithTimeout creates a new ReportsHeatmapParams object
+// with the ability to set a timeout on a request.
+func NewReportsHeatmapParamsWithTimeout(timeout time.Duration) *ReportsHeatmapParams {
+	return &ReportsHeatmapParams{
+		timeout: timeout,
+	}
+}
+
+// NewReportsHeatmapParamsWithContext creates a new ReportsHeatmapParams object
+// with the ability to set a context for a request.
+func NewReportsHeatmapParamsWithContext(ctx context.Context) *ReportsHeatmapParams {
+	return &ReportsHeatmapParams{
+		Context: ctx,
+	}
+}
+
+// NewReportsHeatmapParamsWithHTTPClient creates a new ReportsHeatmapParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReportsHeatmapParamsWithHTTPClient(client *http.Client) *ReportsHeatmapParams {
+	return &ReportsHeatmapParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReportsHeatmapParams contains all the parameters to send to the API endpoint
+
+	for the reports heatmap operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReportsHeatmapParams struct {
+
+	/* Format.
+
+	   Response format
+
+	   Default: "json"
+	*/
+	Format *string
+
+	/* From.
+
+	   First screening date (YYYY-MM-DD)
+
+	   Format: date
+	*/
+	From strfmt.Date
+
+	/* RoomID.
+
+	   Room ID
+
+	   Format: uuid
+	*/
+	RoomID strfmt.UUID
+
+	/* TheaterID.
+
+	   Theater ID
+
+	   Format: uuid
+	*/
+	TheaterID strfmt.UUID
+
+	/* To.
+
+	   Last screening date (YYYY-MM-DD)
+
+	   Format: date
+	*/
+	To strfmt.Date
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reports heatmap params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReportsHeatmapParams) WithDefaults() *ReportsHeatmapParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reports heatmap params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReportsHeatmapParams) SetDefaults() {
+	var (
+		formatDefault = string("json")
+	)
+
+	val := ReportsHeatmapParams{
+		Format: &formatDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the reports heatmap params
+func (o *ReportsHeatmapParams) WithTimeout(timeout time.Duration) *ReportsHeatmapParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reports heatmap params
+func (o *ReportsHeatmapParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reports heatmap params
+func (o *ReportsHeatmapParams) WithContext(ctx context.Context) *ReportsHeatmapParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reports heatmap params
+func (o *ReportsHeatmapParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reports heatmap params
+func (o *ReportsHeatmapParams) WithHTTPClient(client *http.Client) *ReportsHeatmapParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reports heatmap params
+func (o *ReportsHeatmapParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFormat adds the format to the reports heatmap params
+func (o *ReportsHeatmapParams) WithFormat(format *string) *ReportsHeatmapParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the reports heatmap params
+func (o *ReportsHeatmapParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithFrom adds the from to the reports heatmap params
+func (o *ReportsHeatmapParams) WithFrom(from strfmt.Date) *ReportsHeatmapParams {
+	o.SetFrom(from)
+	return o
+}
+
+// SetFrom adds the from to the reports heatmap params
+func (o *ReportsHeatmapParams) SetFrom(from strfmt.Date) {
+	o.From = from
+}
+
+// WithRoomID adds the roomID to the reports heatmap params
+func (o *ReportsHeatmapParams) WithRoomID(roomID strfmt.UUID) *ReportsHeatmapParams {
+	o.SetRoomID(roomID)
+	return o
+}
+
+// SetRoomID adds the roomId to the reports heatmap params
+func (o *ReportsHeatmapParams) SetRoomID(roomID strfmt.UUID) {
+	o.RoomID = roomID
+}
+
+// WithTheaterID adds the theaterID to the reports heatmap params
+func (o *ReportsHeatmapParams) WithTheaterID(theaterID strfmt.UUID) *ReportsHeatmapParams {
+	o.SetTheaterID(theaterID)
+	return o
+}
+
+// SetTheaterID adds the theaterId to the reports heatmap params
+func (o *ReportsHeatmapParams) SetTheaterID(theaterID strfmt.UUID) {
+	o.TheaterID = theaterID
+}
+
+// WithTo adds the to to the reports heatmap params
+func (o *ReportsHeatmapParams) WithTo(to strfmt.Date) *ReportsHeatmapParams {
+	o.SetTo(to)
+	return o
+}
+
+// SetTo adds the to to the reports heatmap params
+func (o *ReportsHeatmapParams) SetTo(to strfmt.Date) {
+	o.To = to
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReportsHeatmapParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	// query param from
+	qrFrom := o.From
+	qFrom := qrFrom.String()
+	if qFrom != "" {
+
+		if err := r.SetQueryParam("from", qFrom); err != nil {
+			return err
+		}
+	}
+
+	// query param room_id
+	qrRoomID := o.RoomID
+	qRoomID := qrRoomID.String()
+	if qRoomID != "" {
+
+		if err := r.SetQueryParam("room_id", qRoomID); err != nil {
+			return err
+		}
+	}
+
+	// query param theater_id
+	qrTheaterID := o.TheaterID
+	qTheaterID := qrTheaterID.String()
+	if qTheaterID != "" {
+
+		if err := r.SetQueryParam("theater_id", qTheaterID); err != nil {
+			return err
+		}
+	}
+
+	// query param to
+	qrTo := o.To
+	qTo := qrTo.String()
+	if qTo != "" {
+
+		if err := r.SetQueryParam("to", qTo); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
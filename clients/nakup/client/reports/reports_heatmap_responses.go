@@ -0,0 +1,487 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReportsHeatmapReader is a Reader for the ReportsHeatmap structure.
+type ReportsHeatmapReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReportsHeatmapReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReportsHeatmapOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReportsHeatmapBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReportsHeatmapForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReportsHeatmapNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReportsHeatmapInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewReportsHeatmapServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /reports/heatmap] ReportsHeatmap", response, response.Code())
+	}
+}
+
+// NewReportsHeatmapOK creates a ReportsHeatmapOK with default headers values
+func NewReportsHeatmapOK() *ReportsHeatmapOK {
+	return &ReportsHeatmapOK{}
+}
+
+/*
+ReportsHeatmapOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReportsHeatmapOK struct {
+	Payload *models.APIHeatmapResponse
+}
+
+// IsSuccess returns true when this reports heatmap o k response has a 2xx status code
+func (o *ReportsHeatmapOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reports heatmap o k response has a 3xx status code
+func (o *ReportsHeatmapOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports heatmap o k response has a 4xx status code
+func (o *ReportsHeatmapOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reports heatmap o k response has a 5xx status code
+func (o *ReportsHeatmapOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reports heatmap o k response a status code equal to that given
+func (o *ReportsHeatmapOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reports heatmap o k response
+func (o *ReportsHeatmapOK) Code() int {
+	return 200
+}
+
+func (o *ReportsHeatmapOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapOK %s", 200, payload)
+}
+
+func (o *ReportsHeatmapOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapOK %s", 200, payload)
+}
+
+func (o *ReportsHeatmapOK) GetPayload() *models.APIHeatmapResponse {
+	return o.Payload
+}
+
+func (o *ReportsHeatmapOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIHeatmapResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReportsHeatmapBadRequest creates a ReportsHeatmapBadRequest with default headers values
+func NewReportsHeatmapBadRequest() *ReportsHeatmapBadRequest {
+	return &ReportsHeatmapBadRequest{}
+}
+
+/*
+ReportsHeatmapBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReportsHeatmapBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reports heatmap bad request response has a 2xx status code
+func (o *ReportsHeatmapBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reports heatmap bad request response has a 3xx status code
+func (o *ReportsHeatmapBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports heatmap bad request response has a 4xx status code
+func (o *ReportsHeatmapBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reports heatmap bad request response has a 5xx status code
+func (o *ReportsHeatmapBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reports heatmap bad request response a status code equal to that given
+func (o *ReportsHeatmapBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reports heatmap bad request response
+func (o *ReportsHeatmapBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReportsHeatmapBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapBadRequest %s", 400, payload)
+}
+
+func (o *ReportsHeatmapBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapBadRequest %s", 400, payload)
+}
+
+func (o *ReportsHeatmapBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReportsHeatmapBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReportsHeatmapForbidden creates a ReportsHeatmapForbidden with default headers values
+func NewReportsHeatmapForbidden() *ReportsHeatmapForbidden {
+	return &ReportsHeatmapForbidden{}
+}
+
+/*
+ReportsHeatmapForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReportsHeatmapForbidden struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reports heatmap forbidden response has a 2xx status code
+func (o *ReportsHeatmapForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reports heatmap forbidden response has a 3xx status code
+func (o *ReportsHeatmapForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports heatmap forbidden response has a 4xx status code
+func (o *ReportsHeatmapForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reports heatmap forbidden response has a 5xx status code
+func (o *ReportsHeatmapForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reports heatmap forbidden response a status code equal to that given
+func (o *ReportsHeatmapForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the reports heatmap forbidden response
+func (o *ReportsHeatmapForbidden) Code() int {
+	return 403
+}
+
+func (o *ReportsHeatmapForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapForbidden %s", 403, payload)
+}
+
+func (o *ReportsHeatmapForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapForbidden %s", 403, payload)
+}
+
+func (o *ReportsHeatmapForbidden) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReportsHeatmapForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReportsHeatmapNotFound creates a ReportsHeatmapNotFound with default headers values
+func NewReportsHeatmapNotFound() *ReportsHeatmapNotFound {
+	return &ReportsHeatmapNotFound{}
+}
+
+/*
+ReportsHeatmapNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ReportsHeatmapNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reports heatmap not found response has a 2xx status code
+func (o *ReportsHeatmapNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reports heatmap not found response has a 3xx status code
+func (o *ReportsHeatmapNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports heatmap not found response has a 4xx status code
+func (o *ReportsHeatmapNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reports heatmap not found response has a 5xx status code
+func (o *ReportsHeatmapNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reports heatmap not found response a status code equal to that given
+func (o *ReportsHeatmapNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the reports heatmap not found response
+func (o *ReportsHeatmapNotFound) Code() int {
+	return 404
+}
+
+func (o *ReportsHeatmapNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapNotFound %s", 404, payload)
+}
+
+func (o *ReportsHeatmapNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapNotFound %s", 404, payload)
+}
+
+func (o *ReportsHeatmapNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReportsHeatmapNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReportsHeatmapInternalServerError creates a ReportsHeatmapInternalServerError with default headers values
+func NewReportsHeatmapInternalServerError() *ReportsHeatmapInternalServerError {
+	return &ReportsHeatmapInternalServerError{}
+}
+
+/*
+ReportsHeatmapInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReportsHeatmapInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reports heatmap internal server error response has a 2xx status code
+func (o *ReportsHeatmapInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reports heatmap internal server error response has a 3xx status code
+func (o *ReportsHeatmapInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports heatmap internal server error response has a 4xx status code
+func (o *ReportsHeatmapInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reports heatmap internal server error response has a 5xx status code
+func (o *ReportsHeatmapInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reports heatmap internal server error response a status code equal to that given
+func (o *ReportsHeatmapInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reports heatmap internal server error response
+func (o *ReportsHeatmapInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReportsHeatmapInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapInternalServerError %s", 500, payload)
+}
+
+func (o *ReportsHeatmapInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapInternalServerError %s", 500, payload)
+}
+
+func (o *ReportsHeatmapInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReportsHeatmapInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReportsHeatmapServiceUnavailable creates a ReportsHeatmapServiceUnavailable with default headers values
+func NewReportsHeatmapServiceUnavailable() *ReportsHeatmapServiceUnavailable {
+	return &ReportsHeatmapServiceUnavailable{}
+}
+
+/*
+ReportsHeatmapServiceUnavailable describes a response with status code 503, with default header values.
+
+Service Unavailable
+*/
+type ReportsHeatmapServiceUnavailable struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reports heatmap service unavailable response has a 2xx status code
+func (o *ReportsHeatmapServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reports heatmap service unavailable response has a 3xx status code
+func (o *ReportsHeatmapServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports heatmap service unavailable response has a 4xx status code
+func (o *ReportsHeatmapServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reports heatmap service unavailable response has a 5xx status code
+func (o *ReportsHeatmapServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reports heatmap service unavailable response a status code equal to that given
+func (o *ReportsHeatmapServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the reports heatmap service unavailable response
+func (o *ReportsHeatmapServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *ReportsHeatmapServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReportsHeatmapServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/heatmap][%d] reportsHeatmapServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReportsHeatmapServiceUnavailable) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReportsHeatmapServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
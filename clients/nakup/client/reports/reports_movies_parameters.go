@@ -0,0 +1,187 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReportsMoviesParams creates a new ReportsMoviesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReportsMoviesParams() *ReportsMoviesParams {
+	return &ReportsMoviesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReportsMoviesParamsWithTimeout creates a new ReportsMoviesParams object
+// with the ability to set a timeout on a request.
+func NewReportsMoviesParamsWithTimeout(timeout time.Duration) *ReportsMoviesParams {
+	return &ReportsMoviesParams{
+		timeout: timeout,
+	}
+}
+
+// NewReportsMoviesParamsWithContext creates a new ReportsMoviesParams object
+// with the ability to set a context for a request.
+func NewReportsMoviesParamsWithContext(ctx context.Context) *ReportsMoviesParams {
+	return &ReportsMoviesParams{
+		Context: ctx,
+	}
+}
+
+// NewReportsMoviesParamsWithHTTPClient creates a new ReportsMoviesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReportsMoviesParamsWithHTTPClient(client *http.Client) *ReportsMoviesParams {
+	return &ReportsMoviesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReportsMoviesParams contains all the parameters to send to the API endpoint
+
+	for the reports movies operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReportsMoviesParams struct {
+
+	/* From.
+
+	   First screening date (YYYY-MM-DD)
+
+	   Format: date
+	*/
+	From strfmt.Date
+
+	/* To.
+
+	   Last screening date (YYYY-MM-DD)
+
+	   Format: date
+	*/
+	To strfmt.Date
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reports movies params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReportsMoviesParams) WithDefaults() *ReportsMoviesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reports movies params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReportsMoviesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reports movies params
+func (o *ReportsMoviesParams) WithTimeout(timeout time.Duration) *ReportsMoviesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reports movies params
+func (o *ReportsMoviesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reports movies params
+func (o *ReportsMoviesParams) WithContext(ctx context.Context) *ReportsMoviesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reports movies params
+func (o *ReportsMoviesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reports movies params
+func (o *ReportsMoviesParams) WithHTTPClient(client *http.Client) *ReportsMoviesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reports movies params
+func (o *ReportsMoviesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFrom adds the from to the reports movies params
+func (o *ReportsMoviesParams) WithFrom(from strfmt.Date) *ReportsMoviesParams {
+	o.SetFrom(from)
+	return o
+}
+
+// SetFrom adds the from to the reports movies params
+func (o *ReportsMoviesParams) SetFrom(from strfmt.Date) {
+	o.From = from
+}
+
+// WithTo adds the to to the reports movies params
+func (o *ReportsMoviesParams) WithTo(to strfmt.Date) *ReportsMoviesParams {
+	o.SetTo(to)
+	return o
+}
+
+// SetTo adds the to to the reports movies params
+func (o *ReportsMoviesParams) SetTo(to strfmt.Date) {
+	o.To = to
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReportsMoviesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// query param from
+	qrFrom := o.From
+	qFrom := qrFrom.String()
+	if qFrom != "" {
+
+		if err := r.SetQueryParam("from", qFrom); err != nil {
+			return err
+		}
+	}
+
+	// query param to
+	qrTo := o.To
+	qTo := qrTo.String()
+	if qTo != "" {
+
+		if err := r.SetQueryParam("to", qTo); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
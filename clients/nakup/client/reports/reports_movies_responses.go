@@ -0,0 +1,335 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReportsMoviesReader is a Reader for the ReportsMovies structure.
+type ReportsMoviesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReportsMoviesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReportsMoviesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReportsMoviesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReportsMoviesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewReportsMoviesServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /reports/movies] ReportsMovies", response, response.Code())
+	}
+}
+
+// NewReportsMoviesOK creates a ReportsMoviesOK with default headers values
+func NewReportsMoviesOK() *ReportsMoviesOK {
+	return &ReportsMoviesOK{}
+}
+
+/*
+ReportsMoviesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReportsMoviesOK struct {
+	Payload *models.APIMovieReportResponse
+}
+
+// IsSuccess returns true when this reports movies o k response has a 2xx status code
+func (o *ReportsMoviesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reports movies o k response has a 3xx status code
+func (o *ReportsMoviesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports movies o k response has a 4xx status code
+func (o *ReportsMoviesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reports movies o k response has a 5xx status code
+func (o *ReportsMoviesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reports movies o k response a status code equal to that given
+func (o *ReportsMoviesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reports movies o k response
+func (o *ReportsMoviesOK) Code() int {
+	return 200
+}
+
+func (o *ReportsMoviesOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/movies][%d] reportsMoviesOK %s", 200, payload)
+}
+
+func (o *ReportsMoviesOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/movies][%d] reportsMoviesOK %s", 200, payload)
+}
+
+func (o *ReportsMoviesOK) GetPayload() *models.APIMovieReportResponse {
+	return o.Payload
+}
+
+func (o *ReportsMoviesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIMovieReportResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReportsMoviesBadRequest creates a ReportsMoviesBadRequest with default headers values
+func NewReportsMoviesBadRequest() *ReportsMoviesBadRequest {
+	return &ReportsMoviesBadRequest{}
+}
+
+/*
+ReportsMoviesBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReportsMoviesBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reports movies bad request response has a 2xx status code
+func (o *ReportsMoviesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reports movies bad request response has a 3xx status code
+func (o *ReportsMoviesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports movies bad request response has a 4xx status code
+func (o *ReportsMoviesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reports movies bad request response has a 5xx status code
+func (o *ReportsMoviesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reports movies bad request response a status code equal to that given
+func (o *ReportsMoviesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reports movies bad request response
+func (o *ReportsMoviesBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReportsMoviesBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/movies][%d] reportsMoviesBadRequest %s", 400, payload)
+}
+
+func (o *ReportsMoviesBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/movies][%d] reportsMoviesBadRequest %s", 400, payload)
+}
+
+func (o *ReportsMoviesBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReportsMoviesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReportsMoviesInternalServerError creates a ReportsMoviesInternalServerError with default headers values
+func NewReportsMoviesInternalServerError() *ReportsMoviesInternalServerError {
+	return &ReportsMoviesInternalServerError{}
+}
+
+/*
+ReportsMoviesInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReportsMoviesInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reports movies internal server error response has a 2xx status code
+func (o *ReportsMoviesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reports movies internal server error response has a 3xx status code
+func (o *ReportsMoviesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports movies internal server error response has a 4xx status code
+func (o *ReportsMoviesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reports movies internal server error response has a 5xx status code
+func (o *ReportsMoviesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reports movies internal server error response a status code equal to that given
+func (o *ReportsMoviesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reports movies internal server error response
+func (o *ReportsMoviesInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReportsMoviesInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/movies][%d] reportsMoviesInternalServerError %s", 500, payload)
+}
+
+func (o *ReportsMoviesInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/movies][%d] reportsMoviesInternalServerError %s", 500, payload)
+}
+
+func (o *ReportsMoviesInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReportsMoviesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReportsMoviesServiceUnavailable creates a ReportsMoviesServiceUnavailable with default headers values
+func NewReportsMoviesServiceUnavailable() *ReportsMoviesServiceUnavailable {
+	return &ReportsMoviesServiceUnavailable{}
+}
+
+/*
+ReportsMoviesServiceUnavailable describes a response with status code 503, with default header values.
+
+Service Unavailable
+*/
+type ReportsMoviesServiceUnavailable struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reports movies service unavailable response has a 2xx status code
+func (o *ReportsMoviesServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reports movies service unavailable response has a 3xx status code
+func (o *ReportsMoviesServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports movies service unavailable response has a 4xx status code
+func (o *ReportsMoviesServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reports movies service unavailable response has a 5xx status code
+func (o *ReportsMoviesServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reports movies service unavailable response a status code equal to that given
+func (o *ReportsMoviesServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the reports movies service unavailable response
+func (o *ReportsMoviesServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *ReportsMoviesServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/movies][%d] reportsMoviesServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReportsMoviesServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/movies][%d] reportsMoviesServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReportsMoviesServiceUnavailable) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReportsMoviesServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
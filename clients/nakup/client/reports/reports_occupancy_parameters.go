@@ -0,0 +1,237 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReportsOccupancyParams creates a new ReportsOccupancyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReportsOccupancyParams() *ReportsOccupancyParams {
+	return &ReportsOccupancyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReportsOccupancyParamsWithTimeout creates a new ReportsOccupancyParams object
+// with the ability to set a timeout on a request.
+func NewReportsOccupancyParamsWithTimeout(timeout time.Duration) *ReportsOccupancyParams {
+	return &ReportsOccupancyParams{
+		timeout: timeout,
+	}
+}
+
+// NewReportsOccupancyParamsWithContext creates a new ReportsOccupancyParams object
+// with the ability to set a context for a request.
+func NewReportsOccupancyParamsWithContext(ctx context.Context) *ReportsOccupancyParams {
+	return &ReportsOccupancyParams{
+		Context: ctx,
+	}
+}
+
+// NewReportsOccupancyParamsWithHTTPClient creates a new ReportsOccupancyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReportsOccupancyParamsWithHTTPClient(client *http.Client) *ReportsOccupancyParams {
+	return &ReportsOccupancyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReportsOccupancyParams contains all the parameters to send to the API endpoint
+
+	for the reports occupancy operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReportsOccupancyParams struct {
+
+	/* From.
+
+	   First screening date (YYYY-MM-DD)
+
+	   Format: date
+	*/
+	From *strfmt.Date
+
+	/* TimeSlotID.
+
+	   Time slot ID, replaces the date range
+
+	   Format: uuid
+	*/
+	TimeSlotID *strfmt.UUID
+
+	/* To.
+
+	   Last screening date (YYYY-MM-DD)
+
+	   Format: date
+	*/
+	To *strfmt.Date
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reports occupancy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReportsOccupancyParams) WithDefaults() *ReportsOccupancyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reports occupancy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReportsOccupancyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reports occupancy params
+func (o *ReportsOccupancyParams) WithTimeout(timeout time.Duration) *ReportsOccupancyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reports occupancy params
+func (o *ReportsOccupancyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reports occupancy params
+func (o *ReportsOccupancyParams) WithContext(ctx context.Context) *ReportsOccupancyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reports occupancy params
+func (o *ReportsOccupancyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reports occupancy params
+func (o *ReportsOccupancyParams) WithHTTPClient(client *http.Client) *ReportsOccupancyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reports occupancy params
+func (o *ReportsOccupancyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFrom adds the from to the reports occupancy params
+func (o *ReportsOccupancyParams) WithFrom(from *strfmt.Date) *ReportsOccupancyParams {
+	o.SetFrom(from)
+	return o
+}
+
+// SetFrom adds the from to the reports occupancy params
+func (o *ReportsOccupancyParams) SetFrom(from *strfmt.Date) {
+	o.From = from
+}
+
+// WithTimeSlotID adds the timeSlotID to the reports occupancy params
+func (o *ReportsOccupancyParams) WithTimeSlotID(timeSlotID *strfmt.UUID) *ReportsOccupancyParams {
+	o.SetTimeSlotID(timeSlotID)
+	return o
+}
+
+// SetTimeSlotID adds the timeSlotId to the reports occupancy params
+func (o *ReportsOccupancyParams) SetTimeSlotID(timeSlotID *strfmt.UUID) {
+	o.TimeSlotID = timeSlotID
+}
+
+// WithTo adds the to to the reports occupancy params
+func (o *ReportsOccupancyParams) WithTo(to *strfmt.Date) *ReportsOccupancyParams {
+	o.SetTo(to)
+	return o
+}
+
+// SetTo adds the to to the reports occupancy params
+func (o *ReportsOccupancyParams) SetTo(to *strfmt.Date) {
+	o.To = to
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReportsOccupancyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.From != nil {
+
+		// query param from
+		var qrFrom strfmt.Date
+
+		if o.From != nil {
+			qrFrom = *o.From
+		}
+		qFrom := qrFrom.String()
+		if qFrom != "" {
+
+			if err := r.SetQueryParam("from", qFrom); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.TimeSlotID != nil {
+
+		// query param time_slot_id
+		var qrTimeSlotID strfmt.UUID
+
+		if o.TimeSlotID != nil {
+			qrTimeSlotID = *o.TimeSlotID
+		}
+		qTimeSlotID := qrTimeSlotID.String()
+		if qTimeSlotID != "" {
+
+			if err := r.SetQueryParam("time_slot_id", qTimeSlotID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.To != nil {
+
+		// query param to
+		var qrTo strfmt.Date
+
+		if o.To != nil {
+			qrTo = *o.To
+		}
+		qTo := qrTo.String()
+		if qTo != "" {
+
+			if err := r.SetQueryParam("to", qTo); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
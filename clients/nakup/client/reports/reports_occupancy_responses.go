@@ -0,0 +1,335 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reports
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReportsOccupancyReader is a Reader for the ReportsOccupancy structure.
+type ReportsOccupancyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReportsOccupancyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReportsOccupancyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReportsOccupancyBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReportsOccupancyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewReportsOccupancyServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /reports/occupancy] ReportsOccupancy", response, response.Code())
+	}
+}
+
+// NewReportsOccupancyOK creates a ReportsOccupancyOK with default headers values
+func NewReportsOccupancyOK() *ReportsOccupancyOK {
+	return &ReportsOccupancyOK{}
+}
+
+/*
+ReportsOccupancyOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReportsOccupancyOK struct {
+	Payload *models.APIOccupancyReportResponse
+}
+
+// IsSuccess returns true when this reports occupancy o k response has a 2xx status code
+func (o *ReportsOccupancyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reports occupancy o k response has a 3xx status code
+func (o *ReportsOccupancyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports occupancy o k response has a 4xx status code
+func (o *ReportsOccupancyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reports occupancy o k response has a 5xx status code
+func (o *ReportsOccupancyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reports occupancy o k response a status code equal to that given
+func (o *ReportsOccupancyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reports occupancy o k response
+func (o *ReportsOccupancyOK) Code() int {
+	return 200
+}
+
+func (o *ReportsOccupancyOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/occupancy][%d] reportsOccupancyOK %s", 200, payload)
+}
+
+func (o *ReportsOccupancyOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/occupancy][%d] reportsOccupancyOK %s", 200, payload)
+}
+
+func (o *ReportsOccupancyOK) GetPayload() *models.APIOccupancyReportResponse {
+	return o.Payload
+}
+
+func (o *ReportsOccupancyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIOccupancyReportResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReportsOccupancyBadRequest creates a ReportsOccupancyBadRequest with default headers values
+func NewReportsOccupancyBadRequest() *ReportsOccupancyBadRequest {
+	return &ReportsOccupancyBadRequest{}
+}
+
+/*
+ReportsOccupancyBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReportsOccupancyBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reports occupancy bad request response has a 2xx status code
+func (o *ReportsOccupancyBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reports occupancy bad request response has a 3xx status code
+func (o *ReportsOccupancyBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports occupancy bad request response has a 4xx status code
+func (o *ReportsOccupancyBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reports occupancy bad request response has a 5xx status code
+func (o *ReportsOccupancyBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reports occupancy bad request response a status code equal to that given
+func (o *ReportsOccupancyBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reports occupancy bad request response
+func (o *ReportsOccupancyBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReportsOccupancyBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/occupancy][%d] reportsOccupancyBadRequest %s", 400, payload)
+}
+
+func (o *ReportsOccupancyBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/occupancy][%d] reportsOccupancyBadRequest %s", 400, payload)
+}
+
+func (o *ReportsOccupancyBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReportsOccupancyBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReportsOccupancyInternalServerError creates a ReportsOccupancyInternalServerError with default headers values
+func NewReportsOccupancyInternalServerError() *ReportsOccupancyInternalServerError {
+	return &ReportsOccupancyInternalServerError{}
+}
+
+/*
+ReportsOccupancyInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReportsOccupancyInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reports occupancy internal server error response has a 2xx status code
+func (o *ReportsOccupancyInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reports occupancy internal server error response has a 3xx status code
+func (o *ReportsOccupancyInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports occupancy internal server error response has a 4xx status code
+func (o *ReportsOccupancyInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reports occupancy internal server error response has a 5xx status code
+func (o *ReportsOccupancyInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reports occupancy internal server error response a status code equal to that given
+func (o *ReportsOccupancyInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reports occupancy internal server error response
+func (o *ReportsOccupancyInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReportsOccupancyInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/occupancy][%d] reportsOccupancyInternalServerError %s", 500, payload)
+}
+
+func (o *ReportsOccupancyInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/occupancy][%d] reportsOccupancyInternalServerError %s", 500, payload)
+}
+
+func (o *ReportsOccupancyInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReportsOccupancyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReportsOccupancyServiceUnavailable creates a ReportsOccupancyServiceUnavailable with default headers values
+func NewReportsOccupancyServiceUnavailable() *ReportsOccupancyServiceUnavailable {
+	return &ReportsOccupancyServiceUnavailable{}
+}
+
+/*
+ReportsOccupancyServiceUnavailable describes a response with status code 503, with default header values.
+
+Service Unavailable
+*/
+type ReportsOccupancyServiceUnavailable struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reports occupancy service unavailable response has a 2xx status code
+func (o *ReportsOccupancyServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reports occupancy service unavailable response has a 3xx status code
+func (o *ReportsOccupancyServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reports occupancy service unavailable response has a 4xx status code
+func (o *ReportsOccupancyServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reports occupancy service unavailable response has a 5xx status code
+func (o *ReportsOccupancyServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reports occupancy service unavailable response a status code equal to that given
+func (o *ReportsOccupancyServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the reports occupancy service unavailable response
+func (o *ReportsOccupancyServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *ReportsOccupancyServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/occupancy][%d] reportsOccupancyServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReportsOccupancyServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reports/occupancy][%d] reportsOccupancyServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReportsOccupancyServiceUnavailable) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReportsOccupancyServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,293 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewMyReservationsListParams creates a new MyReservationsListParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewMyReservationsListParams() *MyReservationsListParams {
+	return &MyReservationsListParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewMyReservationsListParamsWithTimeout creates a new MyReservationsListParams object
+// with the ability to set a timeout on a request.
+func NewMyReservationsListParamsWithTimeout(timeout time.Duration) *MyReservationsListParams {
+	return &MyReservationsListParams{
+		timeout: timeout,
+	}
+}
+
+// NewMyReservationsListParamsWithContext creates a new MyReservationsListParams object
+// with the ability to set a context for a request.
+func NewMyReservationsListParamsWithContext(ctx context.Context) *MyReservationsListParams {
+	return &MyReservationsListParams{
+		Context: ctx,
+	}
+}
+
+// NewMyReservationsListParamsWithHTTPClient creates a new MyReservationsListParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewMyReservationsListParamsWithHTTPClient(client *http.Client) *MyReservationsListParams {
+	return &MyReservationsListParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+MyReservationsListParams contains all the parameters to send to the API endpoint
+
+	for the my reservations list operation.
+
+	Typically these are written to a http.Request.
+*/
+type MyReservationsListParams struct {
+
+	/* Expand.
+
+	   Embed details from spored
+	*/
+	Expand []string
+
+	/* Limit.
+
+	   Limit the number of responses
+
+	   Default: 10
+	*/
+	Limit *int64
+
+	/* Offset.
+
+	   Offset the first response
+	*/
+	Offset *int64
+
+	/* Sort.
+
+	   Sort results
+	*/
+	Sort *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the my reservations list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *MyReservationsListParams) WithDefaults() *MyReservationsListParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the my reservations list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *MyReservationsListParams) SetDefaults() {
+	var (
+		limitDefault = int64(10)
+
+		offsetDefault = int64(0)
+	)
+
+	val := MyReservationsListParams{
+		Limit:  &limitDefault,
+		Offset: &offsetDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the my reservations list params
+func (o *MyReservationsListParams) WithTimeout(timeout time.Duration) *MyReservationsListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the my reservations list params
+func (o *MyReservationsListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the my reservations list params
+func (o *MyReservationsListParams) WithContext(ctx context.Context) *MyReservationsListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the my reservations list params
+func (o *MyReservationsListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the my reservations list params
+func (o *MyReservationsListParams) WithHTTPClient(client *http.Client) *MyReservationsListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the my reservations list params
+func (o *MyReservationsListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithExpand adds the expand to the my reservations list params
+func (o *MyReservationsListParams) WithExpand(expand []string) *MyReservationsListParams {
+	o.SetExpand(expand)
+	return o
+}
+
+// SetExpand adds the expand to the my reservations list params
+func (o *MyReservationsListParams) SetExpand(expand []string) {
+	o.Expand = expand
+}
+
+// WithLimit adds the limit to the my reservations list params
+func (o *MyReservationsListParams) WithLimit(limit *int64) *MyReservationsListParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the my reservations list params
+func (o *MyReservationsListParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the my reservations list params
+func (o *MyReservationsListParams) WithOffset(offset *int64) *MyReservationsListParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the my reservations list params
+func (o *MyReservationsListParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithSort adds the sort to the my reservations list params
+func (o *MyReservationsListParams) WithSort(sort *string) *MyReservationsListParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the my reservations list params
+func (o *MyReservationsListParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *MyReservationsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Expand != nil {
+
+		// binding items for expand
+		joinedExpand := o.bindParamExpand(reg)
+
+		// query array param expand
+		if err := r.SetQueryParam("expand", joinedExpand...); err != nil {
+			return err
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamMyReservationsList binds the parameter expand
+func (o *MyReservationsListParams) bindParamExpand(formats strfmt.Registry) []string {
+	expandIR := o.Expand
+
+	var expandIC []string
+	for _, expandIIR := range expandIR { // explode []string
+
+		expandIIV := expandIIR // string as string
+		expandIC = append(expandIC, expandIIV)
+	}
+
+	// items.CollectionFormat: "csv"
+	expandIS := swag.JoinByFormat(expandIC, "csv")
+
+	return expandIS
+}
@@ -0,0 +1,511 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// MyReservationsListReader is a Reader for the MyReservationsList structure.
+type MyReservationsListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *MyReservationsListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewMyReservationsListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewMyReservationsListBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewMyReservationsListUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewMyReservationsListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /reservations/my] MyReservationsList", response, response.Code())
+	}
+}
+
+// NewMyReservationsListOK creates a MyReservationsListOK with default headers values
+func NewMyReservationsListOK() *MyReservationsListOK {
+	return &MyReservationsListOK{}
+}
+
+/*
+MyReservationsListOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type MyReservationsListOK struct {
+	Payload *MyReservationsListOKBody
+}
+
+// IsSuccess returns true when this my reservations list o k response has a 2xx status code
+func (o *MyReservationsListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this my reservations list o k response has a 3xx status code
+func (o *MyReservationsListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this my reservations list o k response has a 4xx status code
+func (o *MyReservationsListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this my reservations list o k response has a 5xx status code
+func (o *MyReservationsListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this my reservations list o k response a status code equal to that given
+func (o *MyReservationsListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the my reservations list o k response
+func (o *MyReservationsListOK) Code() int {
+	return 200
+}
+
+func (o *MyReservationsListOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/my][%d] myReservationsListOK %s", 200, payload)
+}
+
+func (o *MyReservationsListOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/my][%d] myReservationsListOK %s", 200, payload)
+}
+
+func (o *MyReservationsListOK) GetPayload() *MyReservationsListOKBody {
+	return o.Payload
+}
+
+func (o *MyReservationsListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(MyReservationsListOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewMyReservationsListBadRequest creates a MyReservationsListBadRequest with default headers values
+func NewMyReservationsListBadRequest() *MyReservationsListBadRequest {
+	return &MyReservationsListBadRequest{}
+}
+
+/*
+MyReservationsListBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type MyReservationsListBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this my reservations list bad request response has a 2xx status code
+func (o *MyReservationsListBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this my reservations list bad request response has a 3xx status code
+func (o *MyReservationsListBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this my reservations list bad request response has a 4xx status code
+func (o *MyReservationsListBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this my reservations list bad request response has a 5xx status code
+func (o *MyReservationsListBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this my reservations list bad request response a status code equal to that given
+func (o *MyReservationsListBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the my reservations list bad request response
+func (o *MyReservationsListBadRequest) Code() int {
+	return 400
+}
+
+func (o *MyReservationsListBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/my][%d] myReservationsListBadRequest %s", 400, payload)
+}
+
+func (o *MyReservationsListBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/my][%d] myReservationsListBadRequest %s", 400, payload)
+}
+
+func (o *MyReservationsListBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *MyReservationsListBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewMyReservationsListUnauthorized creates a MyReservationsListUnauthorized with default headers values
+func NewMyReservationsListUnauthorized() *MyReservationsListUnauthorized {
+	return &MyReservationsListUnauthorized{}
+}
+
+/*
+MyReservationsListUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type MyReservationsListUnauthorized struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this my reservations list unauthorized response has a 2xx status code
+func (o *MyReservationsListUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this my reservations list unauthorized response has a 3xx status code
+func (o *MyReservationsListUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this my reservations list unauthorized response has a 4xx status code
+func (o *MyReservationsListUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this my reservations list unauthorized response has a 5xx status code
+func (o *MyReservationsListUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this my reservations list unauthorized response a status code equal to that given
+func (o *MyReservationsListUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the my reservations list unauthorized response
+func (o *MyReservationsListUnauthorized) Code() int {
+	return 401
+}
+
+func (o *MyReservationsListUnauthorized) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/my][%d] myReservationsListUnauthorized %s", 401, payload)
+}
+
+func (o *MyReservationsListUnauthorized) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/my][%d] myReservationsListUnauthorized %s", 401, payload)
+}
+
+func (o *MyReservationsListUnauthorized) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *MyReservationsListUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewMyReservationsListInternalServerError creates a MyReservationsListInternalServerError with default headers values
+func NewMyReservationsListInternalServerError() *MyReservationsListInternalServerError {
+	return &MyReservationsListInternalServerError{}
+}
+
+/*
+MyReservationsListInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type MyReservationsListInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this my reservations list internal server error response has a 2xx status code
+func (o *MyReservationsListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this my reservations list internal server error response has a 3xx status code
+func (o *MyReservationsListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this my reservations list internal server error response has a 4xx status code
+func (o *MyReservationsListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this my reservations list internal server error response has a 5xx status code
+func (o *MyReservationsListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this my reservations list internal server error response a status code equal to that given
+func (o *MyReservationsListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the my reservations list internal server error response
+func (o *MyReservationsListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *MyReservationsListInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/my][%d] myReservationsListInternalServerError %s", 500, payload)
+}
+
+func (o *MyReservationsListInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/my][%d] myReservationsListInternalServerError %s", 500, payload)
+}
+
+func (o *MyReservationsListInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *MyReservationsListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+/*
+MyReservationsListOKBody my reservations list o k body
+swagger:model MyReservationsListOKBody
+*/
+type MyReservationsListOKBody struct {
+	models.RequestPaginatedResponse
+
+	// data
+	Data []*models.APIReservationResponse `json:"data"`
+}
+
+// UnmarshalJSON unmarshals this object from a JSON structure
+func (o *MyReservationsListOKBody) UnmarshalJSON(raw []byte) error {
+	// MyReservationsListOKBodyAO0
+	var myReservationsListOKBodyAO0 models.RequestPaginatedResponse
+	if err := swag.ReadJSON(raw, &myReservationsListOKBodyAO0); err != nil {
+		return err
+	}
+	o.RequestPaginatedResponse = myReservationsListOKBodyAO0
+
+	// MyReservationsListOKBodyAO1
+	var dataMyReservationsListOKBodyAO1 struct {
+		Data []*models.APIReservationResponse `json:"data"`
+	}
+	if err := swag.ReadJSON(raw, &dataMyReservationsListOKBodyAO1); err != nil {
+		return err
+	}
+
+	o.Data = dataMyReservationsListOKBodyAO1.Data
+
+	return nil
+}
+
+// MarshalJSON marshals this object to a JSON structure
+func (o MyReservationsListOKBody) MarshalJSON() ([]byte, error) {
+	_parts := make([][]byte, 0, 2)
+
+	myReservationsListOKBodyAO0, err := swag.WriteJSON(o.RequestPaginatedResponse)
+	if err != nil {
+		return nil, err
+	}
+	_parts = append(_parts, myReservationsListOKBodyAO0)
+	var dataMyReservationsListOKBodyAO1 struct {
+		Data []*models.APIReservationResponse `json:"data"`
+	}
+
+	dataMyReservationsListOKBodyAO1.Data = o.Data
+
+	jsonDataMyReservationsListOKBodyAO1, errMyReservationsListOKBodyAO1 := swag.WriteJSON(dataMyReservationsListOKBodyAO1)
+	if errMyReservationsListOKBodyAO1 != nil {
+		return nil, errMyReservationsListOKBodyAO1
+	}
+	_parts = append(_parts, jsonDataMyReservationsListOKBodyAO1)
+	return swag.ConcatJSON(_parts...), nil
+}
+
+// Validate validates this my reservations list o k body
+func (o *MyReservationsListOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.Validate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *MyReservationsListOKBody) validateData(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Data) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Data); i++ {
+		if swag.IsZero(o.Data[i]) { // not required
+			continue
+		}
+
+		if o.Data[i] != nil {
+			if err := o.Data[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("myReservationsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("myReservationsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this my reservations list o k body based on the context it is used
+func (o *MyReservationsListOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.ContextValidate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateData(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *MyReservationsListOKBody) contextValidateData(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Data); i++ {
+
+		if o.Data[i] != nil {
+
+			if swag.IsZero(o.Data[i]) { // not required
+				return nil
+			}
+
+			if err := o.Data[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("myReservationsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("myReservationsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *MyReservationsListOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *MyReservationsListOKBody) UnmarshalBinary(b []byte) error {
+	var res MyReservationsListOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,511 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReservationAuditListReader is a Reader for the ReservationAuditList structure.
+type ReservationAuditListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReservationAuditListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReservationAuditListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReservationAuditListBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReservationAuditListNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReservationAuditListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /reservations/{reservationID}/audit] ReservationAuditList", response, response.Code())
+	}
+}
+
+// NewReservationAuditListOK creates a ReservationAuditListOK with default headers values
+func NewReservationAuditListOK() *ReservationAuditListOK {
+	return &ReservationAuditListOK{}
+}
+
+/*
+ReservationAuditListOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReservationAuditListOK struct {
+	Payload *ReservationAuditListOKBody
+}
+
+// IsSuccess returns true when this reservation audit list o k response has a 2xx status code
+func (o *ReservationAuditListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reservation audit list o k response has a 3xx status code
+func (o *ReservationAuditListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservation audit list o k response has a 4xx status code
+func (o *ReservationAuditListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservation audit list o k response has a 5xx status code
+func (o *ReservationAuditListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservation audit list o k response a status code equal to that given
+func (o *ReservationAuditListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reservation audit list o k response
+func (o *ReservationAuditListOK) Code() int {
+	return 200
+}
+
+func (o *ReservationAuditListOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/audit][%d] reservationAuditListOK %s", 200, payload)
+}
+
+func (o *ReservationAuditListOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/audit][%d] reservationAuditListOK %s", 200, payload)
+}
+
+func (o *ReservationAuditListOK) GetPayload() *ReservationAuditListOKBody {
+	return o.Payload
+}
+
+func (o *ReservationAuditListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(ReservationAuditListOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationAuditListBadRequest creates a ReservationAuditListBadRequest with default headers values
+func NewReservationAuditListBadRequest() *ReservationAuditListBadRequest {
+	return &ReservationAuditListBadRequest{}
+}
+
+/*
+ReservationAuditListBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReservationAuditListBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservation audit list bad request response has a 2xx status code
+func (o *ReservationAuditListBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservation audit list bad request response has a 3xx status code
+func (o *ReservationAuditListBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservation audit list bad request response has a 4xx status code
+func (o *ReservationAuditListBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservation audit list bad request response has a 5xx status code
+func (o *ReservationAuditListBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservation audit list bad request response a status code equal to that given
+func (o *ReservationAuditListBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reservation audit list bad request response
+func (o *ReservationAuditListBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReservationAuditListBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/audit][%d] reservationAuditListBadRequest %s", 400, payload)
+}
+
+func (o *ReservationAuditListBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/audit][%d] reservationAuditListBadRequest %s", 400, payload)
+}
+
+func (o *ReservationAuditListBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationAuditListBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationAuditListNotFound creates a ReservationAuditListNotFound with default headers values
+func NewReservationAuditListNotFound() *ReservationAuditListNotFound {
+	return &ReservationAuditListNotFound{}
+}
+
+/*
+ReservationAuditListNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ReservationAuditListNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservation audit list not found response has a 2xx status code
+func (o *ReservationAuditListNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservation audit list not found response has a 3xx status code
+func (o *ReservationAuditListNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservation audit list not found response has a 4xx status code
+func (o *ReservationAuditListNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservation audit list not found response has a 5xx status code
+func (o *ReservationAuditListNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservation audit list not found response a status code equal to that given
+func (o *ReservationAuditListNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the reservation audit list not found response
+func (o *ReservationAuditListNotFound) Code() int {
+	return 404
+}
+
+func (o *ReservationAuditListNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/audit][%d] reservationAuditListNotFound %s", 404, payload)
+}
+
+func (o *ReservationAuditListNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/audit][%d] reservationAuditListNotFound %s", 404, payload)
+}
+
+func (o *ReservationAuditListNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationAuditListNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationAuditListInternalServerError creates a ReservationAuditListInternalServerError with default headers values
+func NewReservationAuditListInternalServerError() *ReservationAuditListInternalServerError {
+	return &ReservationAuditListInternalServerError{}
+}
+
+/*
+ReservationAuditListInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReservationAuditListInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservation audit list internal server error response has a 2xx status code
+func (o *ReservationAuditListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservation audit list internal server error response has a 3xx status code
+func (o *ReservationAuditListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservation audit list internal server error response has a 4xx status code
+func (o *ReservationAuditListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservation audit list internal server error response has a 5xx status code
+func (o *ReservationAuditListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservation audit list internal server error response a status code equal to that given
+func (o *ReservationAuditListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reservation audit list internal server error response
+func (o *ReservationAuditListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReservationAuditListInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/audit][%d] reservationAuditListInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationAuditListInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}/audit][%d] reservationAuditListInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationAuditListInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationAuditListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+/*
+ReservationAuditListOKBody reservation audit list o k body
+swagger:model ReservationAuditListOKBody
+*/
+type ReservationAuditListOKBody struct {
+	models.RequestPaginatedResponse
+
+	// data
+	Data []*models.APIAuditEntryResponse `json:"data"`
+}
+
+// UnmarshalJSON unmarshals this object from a JSON structure
+func (o *ReservationAuditListOKBody) UnmarshalJSON(raw []byte) error {
+	// ReservationAuditListOKBodyAO0
+	var reservationAuditListOKBodyAO0 models.RequestPaginatedResponse
+	if err := swag.ReadJSON(raw, &reservationAuditListOKBodyAO0); err != nil {
+		return err
+	}
+	o.RequestPaginatedResponse = reservationAuditListOKBodyAO0
+
+	// ReservationAuditListOKBodyAO1
+	var dataReservationAuditListOKBodyAO1 struct {
+		Data []*models.APIAuditEntryResponse `json:"data"`
+	}
+	if err := swag.ReadJSON(raw, &dataReservationAuditListOKBodyAO1); err != nil {
+		return err
+	}
+
+	o.Data = dataReservationAuditListOKBodyAO1.Data
+
+	return nil
+}
+
+// MarshalJSON marshals this object to a JSON structure
+func (o ReservationAuditListOKBody) MarshalJSON() ([]byte, error) {
+	_parts := make([][]byte, 0, 2)
+
+	reservationAuditListOKBodyAO0, err := swag.WriteJSON(o.RequestPaginatedResponse)
+	if err != nil {
+		return nil, err
+	}
+	_parts = append(_parts, reservationAuditListOKBodyAO0)
+	var dataReservationAuditListOKBodyAO1 struct {
+		Data []*models.APIAuditEntryResponse `json:"data"`
+	}
+
+	dataReservationAuditListOKBodyAO1.Data = o.Data
+
+	jsonDataReservationAuditListOKBodyAO1, errReservationAuditListOKBodyAO1 := swag.WriteJSON(dataReservationAuditListOKBodyAO1)
+	if errReservationAuditListOKBodyAO1 != nil {
+		return nil, errReservationAuditListOKBodyAO1
+	}
+	_parts = append(_parts, jsonDataReservationAuditListOKBodyAO1)
+	return swag.ConcatJSON(_parts...), nil
+}
+
+// Validate validates this reservation audit list o k body
+func (o *ReservationAuditListOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.Validate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ReservationAuditListOKBody) validateData(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Data) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Data); i++ {
+		if swag.IsZero(o.Data[i]) { // not required
+			continue
+		}
+
+		if o.Data[i] != nil {
+			if err := o.Data[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("reservationAuditListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("reservationAuditListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this reservation audit list o k body based on the context it is used
+func (o *ReservationAuditListOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.ContextValidate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateData(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ReservationAuditListOKBody) contextValidateData(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Data); i++ {
+
+		if o.Data[i] != nil {
+
+			if swag.IsZero(o.Data[i]) { // not required
+				return nil
+			}
+
+			if err := o.Data[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("reservationAuditListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("reservationAuditListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ReservationAuditListOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ReservationAuditListOKBody) UnmarshalBinary(b []byte) error {
+	var res ReservationAuditListOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
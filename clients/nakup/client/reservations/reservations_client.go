@@ -0,0 +1,691 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new reservations API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+// New creates a new reservations API client with basic auth credentials.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - user: user for basic authentication header.
+// - password: password for basic authentication header.
+func NewClientWithBasicAuth(host, basePath, scheme, user, password string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BasicAuth(user, password)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+// New creates a new reservations API client with a bearer token for authentication.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - bearerToken: bearer token for Bearer authentication header.
+func NewClientWithBearerToken(host, basePath, scheme, bearerToken string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BearerToken(bearerToken)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+/*
+Client for reservations API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption may be used to customize the behavior of Client methods.
+type ClientOption func(*runtime.ClientOperation)
+
+// This client is generated with a few options you might find useful for your swagger spec.
+//
+// Feel free to add you own set of options.
+
+// WithContentType allows the client to force the Content-Type header
+// to negotiate a specific Consumer from the server.
+//
+// You may use this option to set arbitrary extensions to your MIME media type.
+func WithContentType(mime string) ClientOption {
+	return func(r *runtime.ClientOperation) {
+		r.ConsumesMediaTypes = []string{mime}
+	}
+}
+
+// WithContentTypeApplicationJSON sets the Content-Type header to "application/json".
+func WithContentTypeApplicationJSON(r *runtime.ClientOperation) {
+	r.ConsumesMediaTypes = []string{"application/json"}
+}
+
+// WithContentTypeApplicationMergePatchJSON sets the Content-Type header to "application/merge-patch+json".
+func WithContentTypeApplicationMergePatchJSON(r *runtime.ClientOperation) {
+	r.ConsumesMediaTypes = []string{"application/merge-patch+json"}
+}
+
+// WithAccept allows the client to force the Accept header
+// to negotiate a specific Producer from the server.
+//
+// You may use this option to set arbitrary extensions to your MIME media type.
+func WithAccept(mime string) ClientOption {
+	return func(r *runtime.ClientOperation) {
+		r.ProducesMediaTypes = []string{mime}
+	}
+}
+
+// WithAcceptApplicationJSON sets the Accept header to "application/json".
+func WithAcceptApplicationJSON(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/json"}
+}
+
+// WithAcceptApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet sets the Accept header to "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet".
+func WithAcceptApplicationVndOpenxmlformatsOfficedocumentSpreadsheetmlSheet(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"}
+}
+
+// WithAcceptTextCsv sets the Accept header to "text/csv".
+func WithAcceptTextCsv(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"text/csv"}
+}
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	MyReservationsList(params *MyReservationsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*MyReservationsListOK, error)
+
+	ReservationAuditList(params *ReservationAuditListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationAuditListOK, error)
+
+	ReservationsConsistencyCheck(params *ReservationsConsistencyCheckParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsConsistencyCheckOK, error)
+
+	ReservationsConsistencyFix(params *ReservationsConsistencyFixParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsConsistencyFixOK, error)
+
+	ReservationsCreate(params *ReservationsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsCreateCreated, error)
+
+	ReservationsDelete(params *ReservationsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsDeleteNoContent, error)
+
+	ReservationsExport(params *ReservationsExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ReservationsExportOK, error)
+
+	ReservationsList(params *ReservationsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsListOK, error)
+
+	ReservationsPatch(params *ReservationsPatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsPatchOK, error)
+
+	ReservationsRestore(params *ReservationsRestoreParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsRestoreOK, error)
+
+	ReservationsShow(params *ReservationsShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsShowOK, error)
+
+	ReservationsUpdate(params *ReservationsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsUpdateOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+MyReservationsList lists my reservations
+
+List reservations for the currently authenticated user
+*/
+func (a *Client) MyReservationsList(params *MyReservationsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*MyReservationsListOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewMyReservationsListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "MyReservationsList",
+		Method:             "GET",
+		PathPattern:        "/reservations/my",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &MyReservationsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*MyReservationsListOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for MyReservationsList: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReservationAuditList lists reservation history
+
+List every change of a reservation and its purchases, oldest first, together with who made it
+*/
+func (a *Client) ReservationAuditList(params *ReservationAuditListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationAuditListOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReservationAuditListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReservationAuditList",
+		Method:             "GET",
+		PathPattern:        "/reservations/{reservationID}/audit",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReservationAuditListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReservationAuditListOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReservationAuditList: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReservationsConsistencyCheck checks reservation consistency
+
+Resolve the time slot and room of every reservation in spored and report reservations whose time slot no longer exists or whose seat is outside of the room
+*/
+func (a *Client) ReservationsConsistencyCheck(params *ReservationsConsistencyCheckParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsConsistencyCheckOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReservationsConsistencyCheckParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReservationsConsistencyCheck",
+		Method:             "GET",
+		PathPattern:        "/reservations/consistency",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReservationsConsistencyCheckReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReservationsConsistencyCheckOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReservationsConsistencyCheck: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReservationsConsistencyFix fixes reservation consistency
+
+Run the consistency check and fix every reported reservation. In cancel mode all of them are cancelled and flagged for refund. In move mode reservations whose seat is outside of the room are moved to the nearest free seat and the rest are cancelled and flagged for refund.
+*/
+func (a *Client) ReservationsConsistencyFix(params *ReservationsConsistencyFixParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsConsistencyFixOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReservationsConsistencyFixParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReservationsConsistencyFix",
+		Method:             "POST",
+		PathPattern:        "/reservations/consistency/fix",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReservationsConsistencyFixReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReservationsConsistencyFixOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReservationsConsistencyFix: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReservationsCreate creates reservation
+
+Create reservation. Reservations created with an API key record the key instead of a user.
+*/
+func (a *Client) ReservationsCreate(params *ReservationsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsCreateCreated, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReservationsCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReservationsCreate",
+		Method:             "POST",
+		PathPattern:        "/reservations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReservationsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReservationsCreateCreated)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReservationsCreate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReservationsDelete deletes reservation
+
+Delete reservation
+*/
+func (a *Client) ReservationsDelete(params *ReservationsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsDeleteNoContent, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReservationsDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReservationsDelete",
+		Method:             "DELETE",
+		PathPattern:        "/reservations/{reservationID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReservationsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReservationsDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReservationsDelete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReservationsExport exports reservations
+
+Stream all reservations as a CSV or XLSX file, including purchase totals per reservation
+*/
+func (a *Client) ReservationsExport(params *ReservationsExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ReservationsExportOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReservationsExportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReservationsExport",
+		Method:             "GET",
+		PathPattern:        "/reservations/export",
+		ProducesMediaTypes: []string{"text/csv", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReservationsExportReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReservationsExportOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReservationsExport: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReservationsList lists reservations
+
+List reservations
+*/
+func (a *Client) ReservationsList(params *ReservationsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsListOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReservationsListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReservationsList",
+		Method:             "GET",
+		PathPattern:        "/reservations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReservationsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReservationsListOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReservationsList: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReservationsPatch partiallies update reservation
+
+Change some fields of a reservation with a JSON Merge Patch document. The seat is only validated again when the time slot, theater, room, row or column changes.
+*/
+func (a *Client) ReservationsPatch(params *ReservationsPatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsPatchOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReservationsPatchParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReservationsPatch",
+		Method:             "PATCH",
+		PathPattern:        "/reservations/{reservationID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/merge-patch+json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReservationsPatchReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReservationsPatchOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReservationsPatch: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReservationsRestore restores reservation
+
+Restore a deleted reservation together with the purchases that were deleted with it. Fails if the seat has been reserved again or the reservation was refunded.
+*/
+func (a *Client) ReservationsRestore(params *ReservationsRestoreParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsRestoreOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReservationsRestoreParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReservationsRestore",
+		Method:             "POST",
+		PathPattern:        "/reservations/{reservationID}/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReservationsRestoreReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReservationsRestoreOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReservationsRestore: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReservationsShow shows reservation
+
+Show reservation
+*/
+func (a *Client) ReservationsShow(params *ReservationsShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsShowOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReservationsShowParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReservationsShow",
+		Method:             "GET",
+		PathPattern:        "/reservations/{reservationID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReservationsShowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReservationsShowOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReservationsShow: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReservationsUpdate updates reservation
+
+Update reservation
+*/
+func (a *Client) ReservationsUpdate(params *ReservationsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReservationsUpdateOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReservationsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReservationsUpdate",
+		Method:             "PUT",
+		PathPattern:        "/reservations/{reservationID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReservationsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReservationsUpdateOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReservationsUpdate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
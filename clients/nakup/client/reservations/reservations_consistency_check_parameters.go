@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReservationsConsistencyCheckParams creates a new ReservationsConsistencyCheckParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReservationsConsistencyCheckParams() *ReservationsConsistencyCheckParams {
+	return &ReservationsConsistencyCheckParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReservationsConsistencyCheckParamsWithTimeout creates a new ReservationsConsistencyCheckParams object
+// with the ability to set a timeout on a request.
+func NewReservationsConsistencyCheckParamsWithTimeout(timeout time.Duration) *ReservationsConsistencyCheckParams {
+	return &ReservationsConsistencyCheckParams{
+		timeout: timeout,
+	}
+}
+
+// NewReservationsConsistencyCheckParamsWithContext creates a new ReservationsConsistencyCheckParams object
+// with the ability to set a context for a request.
+func NewReservationsConsistencyCheckParamsWithContext(ctx context.Context) *ReservationsConsistencyCheckParams {
+	return &ReservationsConsistencyCheckParams{
+		Context: ctx,
+	}
+}
+
+// NewReservationsConsistencyCheckParamsWithHTTPClient creates a new ReservationsConsistencyCheckParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReservationsConsistencyCheckParamsWithHTTPClient(client *http.Client) *ReservationsConsistencyCheckParams {
+	return &ReservationsConsistencyCheckParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReservationsConsistencyCheckParams contains all the parameters to send to the API endpoint
+
+	for the reservations consistency check operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReservationsConsistencyCheckParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reservations consistency check params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsConsistencyCheckParams) WithDefaults() *ReservationsConsistencyCheckParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reservations consistency check params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsConsistencyCheckParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reservations consistency check params
+func (o *ReservationsConsistencyCheckParams) WithTimeout(timeout time.Duration) *ReservationsConsistencyCheckParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reservations consistency check params
+func (o *ReservationsConsistencyCheckParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reservations consistency check params
+func (o *ReservationsConsistencyCheckParams) WithContext(ctx context.Context) *ReservationsConsistencyCheckParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reservations consistency check params
+func (o *ReservationsConsistencyCheckParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reservations consistency check params
+func (o *ReservationsConsistencyCheckParams) WithHTTPClient(client *http.Client) *ReservationsConsistencyCheckParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reservations consistency check params
+func (o *ReservationsConsistencyCheckParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReservationsConsistencyCheckParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
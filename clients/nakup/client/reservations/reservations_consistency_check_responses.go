@@ -0,0 +1,259 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReservationsConsistencyCheckReader is a Reader for the ReservationsConsistencyCheck structure.
+type ReservationsConsistencyCheckReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReservationsConsistencyCheckReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReservationsConsistencyCheckOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 500:
+		result := NewReservationsConsistencyCheckInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewReservationsConsistencyCheckServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /reservations/consistency] ReservationsConsistencyCheck", response, response.Code())
+	}
+}
+
+// NewReservationsConsistencyCheckOK creates a ReservationsConsistencyCheckOK with default headers values
+func NewReservationsConsistencyCheckOK() *ReservationsConsistencyCheckOK {
+	return &ReservationsConsistencyCheckOK{}
+}
+
+/*
+ReservationsConsistencyCheckOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReservationsConsistencyCheckOK struct {
+	Payload *models.APIConsistencyReportResponse
+}
+
+// IsSuccess returns true when this reservations consistency check o k response has a 2xx status code
+func (o *ReservationsConsistencyCheckOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reservations consistency check o k response has a 3xx status code
+func (o *ReservationsConsistencyCheckOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations consistency check o k response has a 4xx status code
+func (o *ReservationsConsistencyCheckOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations consistency check o k response has a 5xx status code
+func (o *ReservationsConsistencyCheckOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations consistency check o k response a status code equal to that given
+func (o *ReservationsConsistencyCheckOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reservations consistency check o k response
+func (o *ReservationsConsistencyCheckOK) Code() int {
+	return 200
+}
+
+func (o *ReservationsConsistencyCheckOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/consistency][%d] reservationsConsistencyCheckOK %s", 200, payload)
+}
+
+func (o *ReservationsConsistencyCheckOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/consistency][%d] reservationsConsistencyCheckOK %s", 200, payload)
+}
+
+func (o *ReservationsConsistencyCheckOK) GetPayload() *models.APIConsistencyReportResponse {
+	return o.Payload
+}
+
+func (o *ReservationsConsistencyCheckOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIConsistencyReportResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsConsistencyCheckInternalServerError creates a ReservationsConsistencyCheckInternalServerError with default headers values
+func NewReservationsConsistencyCheckInternalServerError() *ReservationsConsistencyCheckInternalServerError {
+	return &ReservationsConsistencyCheckInternalServerError{}
+}
+
+/*
+ReservationsConsistencyCheckInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReservationsConsistencyCheckInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations consistency check internal server error response has a 2xx status code
+func (o *ReservationsConsistencyCheckInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations consistency check internal server error response has a 3xx status code
+func (o *ReservationsConsistencyCheckInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations consistency check internal server error response has a 4xx status code
+func (o *ReservationsConsistencyCheckInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations consistency check internal server error response has a 5xx status code
+func (o *ReservationsConsistencyCheckInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations consistency check internal server error response a status code equal to that given
+func (o *ReservationsConsistencyCheckInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reservations consistency check internal server error response
+func (o *ReservationsConsistencyCheckInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReservationsConsistencyCheckInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/consistency][%d] reservationsConsistencyCheckInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsConsistencyCheckInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/consistency][%d] reservationsConsistencyCheckInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsConsistencyCheckInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsConsistencyCheckInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsConsistencyCheckServiceUnavailable creates a ReservationsConsistencyCheckServiceUnavailable with default headers values
+func NewReservationsConsistencyCheckServiceUnavailable() *ReservationsConsistencyCheckServiceUnavailable {
+	return &ReservationsConsistencyCheckServiceUnavailable{}
+}
+
+/*
+ReservationsConsistencyCheckServiceUnavailable describes a response with status code 503, with default header values.
+
+Service Unavailable
+*/
+type ReservationsConsistencyCheckServiceUnavailable struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations consistency check service unavailable response has a 2xx status code
+func (o *ReservationsConsistencyCheckServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations consistency check service unavailable response has a 3xx status code
+func (o *ReservationsConsistencyCheckServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations consistency check service unavailable response has a 4xx status code
+func (o *ReservationsConsistencyCheckServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations consistency check service unavailable response has a 5xx status code
+func (o *ReservationsConsistencyCheckServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations consistency check service unavailable response a status code equal to that given
+func (o *ReservationsConsistencyCheckServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the reservations consistency check service unavailable response
+func (o *ReservationsConsistencyCheckServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *ReservationsConsistencyCheckServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/consistency][%d] reservationsConsistencyCheckServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReservationsConsistencyCheckServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/consistency][%d] reservationsConsistencyCheckServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReservationsConsistencyCheckServiceUnavailable) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsConsistencyCheckServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
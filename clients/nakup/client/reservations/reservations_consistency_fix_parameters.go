@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewReservationsConsistencyFixParams creates a new ReservationsConsistencyFixParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReservationsConsistencyFixParams() *ReservationsConsistencyFixParams {
+	return &ReservationsConsistencyFixParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReservationsConsistencyFixParamsWithTimeout creates a new ReservationsConsistencyFixParams object
+// with the ability to set a timeout on a request.
+func NewReservationsConsistencyFixParamsWithTimeout(timeout time.Duration) *ReservationsConsistencyFixParams {
+	return &ReservationsConsistencyFixParams{
+		timeout: timeout,
+	}
+}
+
+// NewReservationsConsistencyFixParamsWithContext creates a new ReservationsConsistencyFixParams object
+// with the ability to set a context for a request.
+func NewReservationsConsistencyFixParamsWithContext(ctx context.Context) *ReservationsConsistencyFixParams {
+	return &ReservationsConsistencyFixParams{
+		Context: ctx,
+	}
+}
+
+// NewReservationsConsistencyFixParamsWithHTTPClient creates a new ReservationsConsistencyFixParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReservationsConsistencyFixParamsWithHTTPClient(client *http.Client) *ReservationsConsistencyFixParams {
+	return &ReservationsConsistencyFixParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReservationsConsistencyFixParams contains all the parameters to send to the API endpoint
+
+	for the reservations consistency fix operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReservationsConsistencyFixParams struct {
+
+	/* IdempotencyKey.
+
+	   Key that makes retries of the request safe
+	*/
+	IdempotencyKey *string
+
+	/* Request.
+
+	   request body
+	*/
+	Request *models.APIConsistencyFixRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reservations consistency fix params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsConsistencyFixParams) WithDefaults() *ReservationsConsistencyFixParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reservations consistency fix params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsConsistencyFixParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reservations consistency fix params
+func (o *ReservationsConsistencyFixParams) WithTimeout(timeout time.Duration) *ReservationsConsistencyFixParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reservations consistency fix params
+func (o *ReservationsConsistencyFixParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reservations consistency fix params
+func (o *ReservationsConsistencyFixParams) WithContext(ctx context.Context) *ReservationsConsistencyFixParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reservations consistency fix params
+func (o *ReservationsConsistencyFixParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reservations consistency fix params
+func (o *ReservationsConsistencyFixParams) WithHTTPClient(client *http.Client) *ReservationsConsistencyFixParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reservations consistency fix params
+func (o *ReservationsConsistencyFixParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIdempotencyKey adds the idempotencyKey to the reservations consistency fix params
+func (o *ReservationsConsistencyFixParams) WithIdempotencyKey(idempotencyKey *string) *ReservationsConsistencyFixParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the reservations consistency fix params
+func (o *ReservationsConsistencyFixParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
+// WithRequest adds the request to the reservations consistency fix params
+func (o *ReservationsConsistencyFixParams) WithRequest(request *models.APIConsistencyFixRequest) *ReservationsConsistencyFixParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the reservations consistency fix params
+func (o *ReservationsConsistencyFixParams) SetRequest(request *models.APIConsistencyFixRequest) {
+	o.Request = request
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReservationsConsistencyFixParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
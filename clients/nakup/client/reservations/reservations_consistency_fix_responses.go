@@ -0,0 +1,411 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReservationsConsistencyFixReader is a Reader for the ReservationsConsistencyFix structure.
+type ReservationsConsistencyFixReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReservationsConsistencyFixReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReservationsConsistencyFixOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReservationsConsistencyFixBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReservationsConsistencyFixUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReservationsConsistencyFixInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewReservationsConsistencyFixServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /reservations/consistency/fix] ReservationsConsistencyFix", response, response.Code())
+	}
+}
+
+// NewReservationsConsistencyFixOK creates a ReservationsConsistencyFixOK with default headers values
+func NewReservationsConsistencyFixOK() *ReservationsConsistencyFixOK {
+	return &ReservationsConsistencyFixOK{}
+}
+
+/*
+ReservationsConsistencyFixOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReservationsConsistencyFixOK struct {
+	Payload *models.APIConsistencyReportResponse
+}
+
+// IsSuccess returns true when this reservations consistency fix o k response has a 2xx status code
+func (o *ReservationsConsistencyFixOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reservations consistency fix o k response has a 3xx status code
+func (o *ReservationsConsistencyFixOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations consistency fix o k response has a 4xx status code
+func (o *ReservationsConsistencyFixOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations consistency fix o k response has a 5xx status code
+func (o *ReservationsConsistencyFixOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations consistency fix o k response a status code equal to that given
+func (o *ReservationsConsistencyFixOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reservations consistency fix o k response
+func (o *ReservationsConsistencyFixOK) Code() int {
+	return 200
+}
+
+func (o *ReservationsConsistencyFixOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/consistency/fix][%d] reservationsConsistencyFixOK %s", 200, payload)
+}
+
+func (o *ReservationsConsistencyFixOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/consistency/fix][%d] reservationsConsistencyFixOK %s", 200, payload)
+}
+
+func (o *ReservationsConsistencyFixOK) GetPayload() *models.APIConsistencyReportResponse {
+	return o.Payload
+}
+
+func (o *ReservationsConsistencyFixOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIConsistencyReportResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsConsistencyFixBadRequest creates a ReservationsConsistencyFixBadRequest with default headers values
+func NewReservationsConsistencyFixBadRequest() *ReservationsConsistencyFixBadRequest {
+	return &ReservationsConsistencyFixBadRequest{}
+}
+
+/*
+ReservationsConsistencyFixBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReservationsConsistencyFixBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations consistency fix bad request response has a 2xx status code
+func (o *ReservationsConsistencyFixBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations consistency fix bad request response has a 3xx status code
+func (o *ReservationsConsistencyFixBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations consistency fix bad request response has a 4xx status code
+func (o *ReservationsConsistencyFixBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations consistency fix bad request response has a 5xx status code
+func (o *ReservationsConsistencyFixBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations consistency fix bad request response a status code equal to that given
+func (o *ReservationsConsistencyFixBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reservations consistency fix bad request response
+func (o *ReservationsConsistencyFixBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReservationsConsistencyFixBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/consistency/fix][%d] reservationsConsistencyFixBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsConsistencyFixBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/consistency/fix][%d] reservationsConsistencyFixBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsConsistencyFixBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsConsistencyFixBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsConsistencyFixUnprocessableEntity creates a ReservationsConsistencyFixUnprocessableEntity with default headers values
+func NewReservationsConsistencyFixUnprocessableEntity() *ReservationsConsistencyFixUnprocessableEntity {
+	return &ReservationsConsistencyFixUnprocessableEntity{}
+}
+
+/*
+ReservationsConsistencyFixUnprocessableEntity describes a response with status code 422, with default header values.
+
+Unprocessable Entity
+*/
+type ReservationsConsistencyFixUnprocessableEntity struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations consistency fix unprocessable entity response has a 2xx status code
+func (o *ReservationsConsistencyFixUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations consistency fix unprocessable entity response has a 3xx status code
+func (o *ReservationsConsistencyFixUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations consistency fix unprocessable entity response has a 4xx status code
+func (o *ReservationsConsistencyFixUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations consistency fix unprocessable entity response has a 5xx status code
+func (o *ReservationsConsistencyFixUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations consistency fix unprocessable entity response a status code equal to that given
+func (o *ReservationsConsistencyFixUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the reservations consistency fix unprocessable entity response
+func (o *ReservationsConsistencyFixUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReservationsConsistencyFixUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/consistency/fix][%d] reservationsConsistencyFixUnprocessableEntity %s", 422, payload)
+}
+
+func (o *ReservationsConsistencyFixUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/consistency/fix][%d] reservationsConsistencyFixUnprocessableEntity %s", 422, payload)
+}
+
+func (o *ReservationsConsistencyFixUnprocessableEntity) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsConsistencyFixUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsConsistencyFixInternalServerError creates a ReservationsConsistencyFixInternalServerError with default headers values
+func NewReservationsConsistencyFixInternalServerError() *ReservationsConsistencyFixInternalServerError {
+	return &ReservationsConsistencyFixInternalServerError{}
+}
+
+/*
+ReservationsConsistencyFixInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReservationsConsistencyFixInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations consistency fix internal server error response has a 2xx status code
+func (o *ReservationsConsistencyFixInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations consistency fix internal server error response has a 3xx status code
+func (o *ReservationsConsistencyFixInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations consistency fix internal server error response has a 4xx status code
+func (o *ReservationsConsistencyFixInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations consistency fix internal server error response has a 5xx status code
+func (o *ReservationsConsistencyFixInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations consistency fix internal server error response a status code equal to that given
+func (o *ReservationsConsistencyFixInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reservations consistency fix internal server error response
+func (o *ReservationsConsistencyFixInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReservationsConsistencyFixInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/consistency/fix][%d] reservationsConsistencyFixInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsConsistencyFixInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/consistency/fix][%d] reservationsConsistencyFixInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsConsistencyFixInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsConsistencyFixInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsConsistencyFixServiceUnavailable creates a ReservationsConsistencyFixServiceUnavailable with default headers values
+func NewReservationsConsistencyFixServiceUnavailable() *ReservationsConsistencyFixServiceUnavailable {
+	return &ReservationsConsistencyFixServiceUnavailable{}
+}
+
+/*
+ReservationsConsistencyFixServiceUnavailable describes a response with status code 503, with default header values.
+
+Service Unavailable
+*/
+type ReservationsConsistencyFixServiceUnavailable struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations consistency fix service unavailable response has a 2xx status code
+func (o *ReservationsConsistencyFixServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations consistency fix service unavailable response has a 3xx status code
+func (o *ReservationsConsistencyFixServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations consistency fix service unavailable response has a 4xx status code
+func (o *ReservationsConsistencyFixServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations consistency fix service unavailable response has a 5xx status code
+func (o *ReservationsConsistencyFixServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations consistency fix service unavailable response a status code equal to that given
+func (o *ReservationsConsistencyFixServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the reservations consistency fix service unavailable response
+func (o *ReservationsConsistencyFixServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *ReservationsConsistencyFixServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/consistency/fix][%d] reservationsConsistencyFixServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReservationsConsistencyFixServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/consistency/fix][%d] reservationsConsistencyFixServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReservationsConsistencyFixServiceUnavailable) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsConsistencyFixServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewReservationsCreateParams creates a new ReservationsCreateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReservationsCreateParams() *ReservationsCreateParams {
+	return &ReservationsCreateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReservationsCreateParamsWithTimeout creates a new ReservationsCreateParams object
+// with the ability to set a timeout on a request.
+func NewReservationsCreateParamsWithTimeout(timeout time.Duration) *ReservationsCreateParams {
+	return &ReservationsCreateParams{
+		timeout: timeout,
+	}
+}
+
+// NewReservationsCreateParamsWithContext creates a new ReservationsCreateParams object
+// with the ability to set a context for a request.
+func NewReservationsCreateParamsWithContext(ctx context.Context) *ReservationsCreateParams {
+	return &ReservationsCreateParams{
+		Context: ctx,
+	}
+}
+
+// NewReservationsCreateParamsWithHTTPClient creates a new ReservationsCreateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReservationsCreateParamsWithHTTPClient(client *http.Client) *ReservationsCreateParams {
+	return &ReservationsCreateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReservationsCreateParams contains all the parameters to send to the API endpoint
+
+	for the reservations create operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReservationsCreateParams struct {
+
+	/* IdempotencyKey.
+
+	   Key that makes retries of the request safe
+	*/
+	IdempotencyKey *string
+
+	/* Request.
+
+	   request body
+	*/
+	Request *models.APIReservationRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reservations create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsCreateParams) WithDefaults() *ReservationsCreateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reservations create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsCreateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reservations create params
+func (o *ReservationsCreateParams) WithTimeout(timeout time.Duration) *ReservationsCreateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reservations create params
+func (o *ReservationsCreateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reservations create params
+func (o *ReservationsCreateParams) WithContext(ctx context.Context) *ReservationsCreateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reservations create params
+func (o *ReservationsCreateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reservations create params
+func (o *ReservationsCreateParams) WithHTTPClient(client *http.Client) *ReservationsCreateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reservations create params
+func (o *ReservationsCreateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIdempotencyKey adds the idempotencyKey to the reservations create params
+func (o *ReservationsCreateParams) WithIdempotencyKey(idempotencyKey *string) *ReservationsCreateParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the reservations create params
+func (o *ReservationsCreateParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
+// WithRequest adds the request to the reservations create params
+func (o *ReservationsCreateParams) WithRequest(request *models.APIReservationRequest) *ReservationsCreateParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the reservations create params
+func (o *ReservationsCreateParams) SetRequest(request *models.APIReservationRequest) {
+	o.Request = request
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReservationsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
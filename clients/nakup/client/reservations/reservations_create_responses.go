@@ -0,0 +1,639 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReservationsCreateReader is a Reader for the ReservationsCreate structure.
+type ReservationsCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReservationsCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 201:
+		result := NewReservationsCreateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReservationsCreateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewReservationsCreateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReservationsCreateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReservationsCreateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReservationsCreateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReservationsCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewReservationsCreateServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /reservations] ReservationsCreate", response, response.Code())
+	}
+}
+
+// NewReservationsCreateCreated creates a ReservationsCreateCreated with default headers values
+func NewReservationsCreateCreated() *ReservationsCreateCreated {
+	return &ReservationsCreateCreated{}
+}
+
+/*
+ReservationsCreateCreated describes a response with status code 201, with default header values.
+
+Created
+*/
+type ReservationsCreateCreated struct {
+	Payload *models.APIReservationResponse
+}
+
+// IsSuccess returns true when this reservations create created response has a 2xx status code
+func (o *ReservationsCreateCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reservations create created response has a 3xx status code
+func (o *ReservationsCreateCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations create created response has a 4xx status code
+func (o *ReservationsCreateCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations create created response has a 5xx status code
+func (o *ReservationsCreateCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations create created response a status code equal to that given
+func (o *ReservationsCreateCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the reservations create created response
+func (o *ReservationsCreateCreated) Code() int {
+	return 201
+}
+
+func (o *ReservationsCreateCreated) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateCreated %s", 201, payload)
+}
+
+func (o *ReservationsCreateCreated) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateCreated %s", 201, payload)
+}
+
+func (o *ReservationsCreateCreated) GetPayload() *models.APIReservationResponse {
+	return o.Payload
+}
+
+func (o *ReservationsCreateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIReservationResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsCreateBadRequest creates a ReservationsCreateBadRequest with default headers values
+func NewReservationsCreateBadRequest() *ReservationsCreateBadRequest {
+	return &ReservationsCreateBadRequest{}
+}
+
+/*
+ReservationsCreateBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReservationsCreateBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations create bad request response has a 2xx status code
+func (o *ReservationsCreateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations create bad request response has a 3xx status code
+func (o *ReservationsCreateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations create bad request response has a 4xx status code
+func (o *ReservationsCreateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations create bad request response has a 5xx status code
+func (o *ReservationsCreateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations create bad request response a status code equal to that given
+func (o *ReservationsCreateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reservations create bad request response
+func (o *ReservationsCreateBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReservationsCreateBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsCreateBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsCreateBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsCreateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsCreateUnauthorized creates a ReservationsCreateUnauthorized with default headers values
+func NewReservationsCreateUnauthorized() *ReservationsCreateUnauthorized {
+	return &ReservationsCreateUnauthorized{}
+}
+
+/*
+ReservationsCreateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type ReservationsCreateUnauthorized struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations create unauthorized response has a 2xx status code
+func (o *ReservationsCreateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations create unauthorized response has a 3xx status code
+func (o *ReservationsCreateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations create unauthorized response has a 4xx status code
+func (o *ReservationsCreateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations create unauthorized response has a 5xx status code
+func (o *ReservationsCreateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations create unauthorized response a status code equal to that given
+func (o *ReservationsCreateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the reservations create unauthorized response
+func (o *ReservationsCreateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReservationsCreateUnauthorized) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateUnauthorized %s", 401, payload)
+}
+
+func (o *ReservationsCreateUnauthorized) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateUnauthorized %s", 401, payload)
+}
+
+func (o *ReservationsCreateUnauthorized) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsCreateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsCreateForbidden creates a ReservationsCreateForbidden with default headers values
+func NewReservationsCreateForbidden() *ReservationsCreateForbidden {
+	return &ReservationsCreateForbidden{}
+}
+
+/*
+ReservationsCreateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReservationsCreateForbidden struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations create forbidden response has a 2xx status code
+func (o *ReservationsCreateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations create forbidden response has a 3xx status code
+func (o *ReservationsCreateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations create forbidden response has a 4xx status code
+func (o *ReservationsCreateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations create forbidden response has a 5xx status code
+func (o *ReservationsCreateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations create forbidden response a status code equal to that given
+func (o *ReservationsCreateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the reservations create forbidden response
+func (o *ReservationsCreateForbidden) Code() int {
+	return 403
+}
+
+func (o *ReservationsCreateForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateForbidden %s", 403, payload)
+}
+
+func (o *ReservationsCreateForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateForbidden %s", 403, payload)
+}
+
+func (o *ReservationsCreateForbidden) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsCreateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsCreateNotFound creates a ReservationsCreateNotFound with default headers values
+func NewReservationsCreateNotFound() *ReservationsCreateNotFound {
+	return &ReservationsCreateNotFound{}
+}
+
+/*
+ReservationsCreateNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ReservationsCreateNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations create not found response has a 2xx status code
+func (o *ReservationsCreateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations create not found response has a 3xx status code
+func (o *ReservationsCreateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations create not found response has a 4xx status code
+func (o *ReservationsCreateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations create not found response has a 5xx status code
+func (o *ReservationsCreateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations create not found response a status code equal to that given
+func (o *ReservationsCreateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the reservations create not found response
+func (o *ReservationsCreateNotFound) Code() int {
+	return 404
+}
+
+func (o *ReservationsCreateNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateNotFound %s", 404, payload)
+}
+
+func (o *ReservationsCreateNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateNotFound %s", 404, payload)
+}
+
+func (o *ReservationsCreateNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsCreateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsCreateUnprocessableEntity creates a ReservationsCreateUnprocessableEntity with default headers values
+func NewReservationsCreateUnprocessableEntity() *ReservationsCreateUnprocessableEntity {
+	return &ReservationsCreateUnprocessableEntity{}
+}
+
+/*
+ReservationsCreateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Unprocessable Entity
+*/
+type ReservationsCreateUnprocessableEntity struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations create unprocessable entity response has a 2xx status code
+func (o *ReservationsCreateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations create unprocessable entity response has a 3xx status code
+func (o *ReservationsCreateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations create unprocessable entity response has a 4xx status code
+func (o *ReservationsCreateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations create unprocessable entity response has a 5xx status code
+func (o *ReservationsCreateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations create unprocessable entity response a status code equal to that given
+func (o *ReservationsCreateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the reservations create unprocessable entity response
+func (o *ReservationsCreateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReservationsCreateUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateUnprocessableEntity %s", 422, payload)
+}
+
+func (o *ReservationsCreateUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateUnprocessableEntity %s", 422, payload)
+}
+
+func (o *ReservationsCreateUnprocessableEntity) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsCreateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsCreateInternalServerError creates a ReservationsCreateInternalServerError with default headers values
+func NewReservationsCreateInternalServerError() *ReservationsCreateInternalServerError {
+	return &ReservationsCreateInternalServerError{}
+}
+
+/*
+ReservationsCreateInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReservationsCreateInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations create internal server error response has a 2xx status code
+func (o *ReservationsCreateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations create internal server error response has a 3xx status code
+func (o *ReservationsCreateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations create internal server error response has a 4xx status code
+func (o *ReservationsCreateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations create internal server error response has a 5xx status code
+func (o *ReservationsCreateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations create internal server error response a status code equal to that given
+func (o *ReservationsCreateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reservations create internal server error response
+func (o *ReservationsCreateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReservationsCreateInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsCreateInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsCreateInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsCreateServiceUnavailable creates a ReservationsCreateServiceUnavailable with default headers values
+func NewReservationsCreateServiceUnavailable() *ReservationsCreateServiceUnavailable {
+	return &ReservationsCreateServiceUnavailable{}
+}
+
+/*
+ReservationsCreateServiceUnavailable describes a response with status code 503, with default header values.
+
+Service Unavailable
+*/
+type ReservationsCreateServiceUnavailable struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations create service unavailable response has a 2xx status code
+func (o *ReservationsCreateServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations create service unavailable response has a 3xx status code
+func (o *ReservationsCreateServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations create service unavailable response has a 4xx status code
+func (o *ReservationsCreateServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations create service unavailable response has a 5xx status code
+func (o *ReservationsCreateServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations create service unavailable response a status code equal to that given
+func (o *ReservationsCreateServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the reservations create service unavailable response
+func (o *ReservationsCreateServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *ReservationsCreateServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReservationsCreateServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations][%d] reservationsCreateServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReservationsCreateServiceUnavailable) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsCreateServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReservationsDeleteParams creates a new ReservationsDeleteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReservationsDeleteParams() *ReservationsDeleteParams {
+	return &ReservationsDeleteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReservationsDeleteParamsWithTimeout creates a new ReservationsDeleteParams object
+// with the ability to set a timeout on a request.
+func NewReservationsDeleteParamsWithTimeout(timeout time.Duration) *ReservationsDeleteParams {
+	return &ReservationsDeleteParams{
+		timeout: timeout,
+	}
+}
+
+// NewReservationsDeleteParamsWithContext creates a new ReservationsDeleteParams object
+// with the ability to set a context for a request.
+func NewReservationsDeleteParamsWithContext(ctx context.Context) *ReservationsDeleteParams {
+	return &ReservationsDeleteParams{
+		Context: ctx,
+	}
+}
+
+// NewReservationsDeleteParamsWithHTTPClient creates a new ReservationsDeleteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReservationsDeleteParamsWithHTTPClient(client *http.Client) *ReservationsDeleteParams {
+	return &ReservationsDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReservationsDeleteParams contains all the parameters to send to the API endpoint
+
+	for the reservations delete operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReservationsDeleteParams struct {
+
+	/* IfMatch.
+
+	   Only change the reservation if its ETag still matches
+	*/
+	IfMatch *string
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reservations delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsDeleteParams) WithDefaults() *ReservationsDeleteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reservations delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsDeleteParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reservations delete params
+func (o *ReservationsDeleteParams) WithTimeout(timeout time.Duration) *ReservationsDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reservations delete params
+func (o *ReservationsDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reservations delete params
+func (o *ReservationsDeleteParams) WithContext(ctx context.Context) *ReservationsDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reservations delete params
+func (o *ReservationsDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reservations delete params
+func (o *ReservationsDeleteParams) WithHTTPClient(client *http.Client) *ReservationsDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reservations delete params
+func (o *ReservationsDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIfMatch adds the ifMatch to the reservations delete params
+func (o *ReservationsDeleteParams) WithIfMatch(ifMatch *string) *ReservationsDeleteParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the reservations delete params
+func (o *ReservationsDeleteParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
+// WithReservationID adds the reservationID to the reservations delete params
+func (o *ReservationsDeleteParams) WithReservationID(reservationID strfmt.UUID) *ReservationsDeleteParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the reservations delete params
+func (o *ReservationsDeleteParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReservationsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
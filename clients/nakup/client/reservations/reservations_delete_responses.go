@@ -0,0 +1,397 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReservationsDeleteReader is a Reader for the ReservationsDelete structure.
+type ReservationsDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReservationsDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 204:
+		result := NewReservationsDeleteNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReservationsDeleteBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReservationsDeleteNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 412:
+		result := NewReservationsDeletePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReservationsDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /reservations/{reservationID}] ReservationsDelete", response, response.Code())
+	}
+}
+
+// NewReservationsDeleteNoContent creates a ReservationsDeleteNoContent with default headers values
+func NewReservationsDeleteNoContent() *ReservationsDeleteNoContent {
+	return &ReservationsDeleteNoContent{}
+}
+
+/*
+ReservationsDeleteNoContent describes a response with status code 204, with default header values.
+
+No Content
+*/
+type ReservationsDeleteNoContent struct {
+}
+
+// IsSuccess returns true when this reservations delete no content response has a 2xx status code
+func (o *ReservationsDeleteNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reservations delete no content response has a 3xx status code
+func (o *ReservationsDeleteNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations delete no content response has a 4xx status code
+func (o *ReservationsDeleteNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations delete no content response has a 5xx status code
+func (o *ReservationsDeleteNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations delete no content response a status code equal to that given
+func (o *ReservationsDeleteNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the reservations delete no content response
+func (o *ReservationsDeleteNoContent) Code() int {
+	return 204
+}
+
+func (o *ReservationsDeleteNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}][%d] reservationsDeleteNoContent", 204)
+}
+
+func (o *ReservationsDeleteNoContent) String() string {
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}][%d] reservationsDeleteNoContent", 204)
+}
+
+func (o *ReservationsDeleteNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReservationsDeleteBadRequest creates a ReservationsDeleteBadRequest with default headers values
+func NewReservationsDeleteBadRequest() *ReservationsDeleteBadRequest {
+	return &ReservationsDeleteBadRequest{}
+}
+
+/*
+ReservationsDeleteBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReservationsDeleteBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations delete bad request response has a 2xx status code
+func (o *ReservationsDeleteBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations delete bad request response has a 3xx status code
+func (o *ReservationsDeleteBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations delete bad request response has a 4xx status code
+func (o *ReservationsDeleteBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations delete bad request response has a 5xx status code
+func (o *ReservationsDeleteBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations delete bad request response a status code equal to that given
+func (o *ReservationsDeleteBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reservations delete bad request response
+func (o *ReservationsDeleteBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReservationsDeleteBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}][%d] reservationsDeleteBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsDeleteBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}][%d] reservationsDeleteBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsDeleteBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsDeleteBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsDeleteNotFound creates a ReservationsDeleteNotFound with default headers values
+func NewReservationsDeleteNotFound() *ReservationsDeleteNotFound {
+	return &ReservationsDeleteNotFound{}
+}
+
+/*
+ReservationsDeleteNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ReservationsDeleteNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations delete not found response has a 2xx status code
+func (o *ReservationsDeleteNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations delete not found response has a 3xx status code
+func (o *ReservationsDeleteNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations delete not found response has a 4xx status code
+func (o *ReservationsDeleteNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations delete not found response has a 5xx status code
+func (o *ReservationsDeleteNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations delete not found response a status code equal to that given
+func (o *ReservationsDeleteNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the reservations delete not found response
+func (o *ReservationsDeleteNotFound) Code() int {
+	return 404
+}
+
+func (o *ReservationsDeleteNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}][%d] reservationsDeleteNotFound %s", 404, payload)
+}
+
+func (o *ReservationsDeleteNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}][%d] reservationsDeleteNotFound %s", 404, payload)
+}
+
+func (o *ReservationsDeleteNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsDeleteNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsDeletePreconditionFailed creates a ReservationsDeletePreconditionFailed with default headers values
+func NewReservationsDeletePreconditionFailed() *ReservationsDeletePreconditionFailed {
+	return &ReservationsDeletePreconditionFailed{}
+}
+
+/*
+ReservationsDeletePreconditionFailed describes a response with status code 412, with default header values.
+
+Precondition Failed
+*/
+type ReservationsDeletePreconditionFailed struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations delete precondition failed response has a 2xx status code
+func (o *ReservationsDeletePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations delete precondition failed response has a 3xx status code
+func (o *ReservationsDeletePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations delete precondition failed response has a 4xx status code
+func (o *ReservationsDeletePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations delete precondition failed response has a 5xx status code
+func (o *ReservationsDeletePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations delete precondition failed response a status code equal to that given
+func (o *ReservationsDeletePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the reservations delete precondition failed response
+func (o *ReservationsDeletePreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ReservationsDeletePreconditionFailed) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}][%d] reservationsDeletePreconditionFailed %s", 412, payload)
+}
+
+func (o *ReservationsDeletePreconditionFailed) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}][%d] reservationsDeletePreconditionFailed %s", 412, payload)
+}
+
+func (o *ReservationsDeletePreconditionFailed) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsDeletePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsDeleteInternalServerError creates a ReservationsDeleteInternalServerError with default headers values
+func NewReservationsDeleteInternalServerError() *ReservationsDeleteInternalServerError {
+	return &ReservationsDeleteInternalServerError{}
+}
+
+/*
+ReservationsDeleteInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReservationsDeleteInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations delete internal server error response has a 2xx status code
+func (o *ReservationsDeleteInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations delete internal server error response has a 3xx status code
+func (o *ReservationsDeleteInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations delete internal server error response has a 4xx status code
+func (o *ReservationsDeleteInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations delete internal server error response has a 5xx status code
+func (o *ReservationsDeleteInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations delete internal server error response a status code equal to that given
+func (o *ReservationsDeleteInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reservations delete internal server error response
+func (o *ReservationsDeleteInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReservationsDeleteInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}][%d] reservationsDeleteInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsDeleteInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /reservations/{reservationID}][%d] reservationsDeleteInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsDeleteInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
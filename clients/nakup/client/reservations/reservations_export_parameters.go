@@ -0,0 +1,210 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReservationsExportParams creates a new ReservationsExportParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReservationsExportParams() *ReservationsExportParams {
+	return &ReservationsExportParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReservationsExportParamsWithTimeout creates a new ReservationsExportParams object
+// with the ability to set a timeout on a request.
+func NewReservationsExportParamsWithTimeout(timeout time.Duration) *ReservationsExportParams {
+	return &ReservationsExportParams{
+		timeout: timeout,
+	}
+}
+
+// NewReservationsExportParamsWithContext creates a new ReservationsExportParams object
+// with the ability to set a context for a request.
+func NewReservationsExportParamsWithContext(ctx context.Context) *ReservationsExportParams {
+	return &ReservationsExportParams{
+		Context: ctx,
+	}
+}
+
+// NewReservationsExportParamsWithHTTPClient creates a new ReservationsExportParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReservationsExportParamsWithHTTPClient(client *http.Client) *ReservationsExportParams {
+	return &ReservationsExportParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReservationsExportParams contains all the parameters to send to the API endpoint
+
+	for the reservations export operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReservationsExportParams struct {
+
+	/* Format.
+
+	   Export format
+
+	   Default: "csv"
+	*/
+	Format *string
+
+	/* Sort.
+
+	   Sort by one of the exported columns, prefixed with - for descending order
+	*/
+	Sort *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reservations export params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsExportParams) WithDefaults() *ReservationsExportParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reservations export params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsExportParams) SetDefaults() {
+	var (
+		formatDefault = string("csv")
+	)
+
+	val := ReservationsExportParams{
+		Format: &formatDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the reservations export params
+func (o *ReservationsExportParams) WithTimeout(timeout time.Duration) *ReservationsExportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reservations export params
+func (o *ReservationsExportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reservations export params
+func (o *ReservationsExportParams) WithContext(ctx context.Context) *ReservationsExportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reservations export params
+func (o *ReservationsExportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reservations export params
+func (o *ReservationsExportParams) WithHTTPClient(client *http.Client) *ReservationsExportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reservations export params
+func (o *ReservationsExportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFormat adds the format to the reservations export params
+func (o *ReservationsExportParams) WithFormat(format *string) *ReservationsExportParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the reservations export params
+func (o *ReservationsExportParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithSort adds the sort to the reservations export params
+func (o *ReservationsExportParams) WithSort(sort *string) *ReservationsExportParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the reservations export params
+func (o *ReservationsExportParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReservationsExportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
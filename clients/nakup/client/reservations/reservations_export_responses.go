@@ -0,0 +1,259 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReservationsExportReader is a Reader for the ReservationsExport structure.
+type ReservationsExportReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReservationsExportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReservationsExportOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReservationsExportBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReservationsExportInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /reservations/export] ReservationsExport", response, response.Code())
+	}
+}
+
+// NewReservationsExportOK creates a ReservationsExportOK with default headers values
+func NewReservationsExportOK(writer io.Writer) *ReservationsExportOK {
+	return &ReservationsExportOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+ReservationsExportOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReservationsExportOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this reservations export o k response has a 2xx status code
+func (o *ReservationsExportOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reservations export o k response has a 3xx status code
+func (o *ReservationsExportOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations export o k response has a 4xx status code
+func (o *ReservationsExportOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations export o k response has a 5xx status code
+func (o *ReservationsExportOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations export o k response a status code equal to that given
+func (o *ReservationsExportOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reservations export o k response
+func (o *ReservationsExportOK) Code() int {
+	return 200
+}
+
+func (o *ReservationsExportOK) Error() string {
+	return fmt.Sprintf("[GET /reservations/export][%d] reservationsExportOK", 200)
+}
+
+func (o *ReservationsExportOK) String() string {
+	return fmt.Sprintf("[GET /reservations/export][%d] reservationsExportOK", 200)
+}
+
+func (o *ReservationsExportOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *ReservationsExportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsExportBadRequest creates a ReservationsExportBadRequest with default headers values
+func NewReservationsExportBadRequest() *ReservationsExportBadRequest {
+	return &ReservationsExportBadRequest{}
+}
+
+/*
+ReservationsExportBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReservationsExportBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations export bad request response has a 2xx status code
+func (o *ReservationsExportBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations export bad request response has a 3xx status code
+func (o *ReservationsExportBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations export bad request response has a 4xx status code
+func (o *ReservationsExportBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations export bad request response has a 5xx status code
+func (o *ReservationsExportBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations export bad request response a status code equal to that given
+func (o *ReservationsExportBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reservations export bad request response
+func (o *ReservationsExportBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReservationsExportBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/export][%d] reservationsExportBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsExportBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/export][%d] reservationsExportBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsExportBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsExportBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsExportInternalServerError creates a ReservationsExportInternalServerError with default headers values
+func NewReservationsExportInternalServerError() *ReservationsExportInternalServerError {
+	return &ReservationsExportInternalServerError{}
+}
+
+/*
+ReservationsExportInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReservationsExportInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations export internal server error response has a 2xx status code
+func (o *ReservationsExportInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations export internal server error response has a 3xx status code
+func (o *ReservationsExportInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations export internal server error response has a 4xx status code
+func (o *ReservationsExportInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations export internal server error response has a 5xx status code
+func (o *ReservationsExportInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations export internal server error response a status code equal to that given
+func (o *ReservationsExportInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reservations export internal server error response
+func (o *ReservationsExportInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReservationsExportInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/export][%d] reservationsExportInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsExportInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/export][%d] reservationsExportInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsExportInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsExportInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
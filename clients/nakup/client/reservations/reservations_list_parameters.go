@@ -0,0 +1,395 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewReservationsListParams creates a new ReservationsListParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReservationsListParams() *ReservationsListParams {
+	return &ReservationsListParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReservationsListParamsWithTimeout creates a new ReservationsListParams object
+// with the ability to set a timeout on a request.
+func NewReservationsListParamsWithTimeout(timeout time.Duration) *ReservationsListParams {
+	return &ReservationsListParams{
+		timeout: timeout,
+	}
+}
+
+// NewReservationsListParamsWithContext creates a new ReservationsListParams object
+// with the ability to set a context for a request.
+func NewReservationsListParamsWithContext(ctx context.Context) *ReservationsListParams {
+	return &ReservationsListParams{
+		Context: ctx,
+	}
+}
+
+// NewReservationsListParamsWithHTTPClient creates a new ReservationsListParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReservationsListParamsWithHTTPClient(client *http.Client) *ReservationsListParams {
+	return &ReservationsListParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReservationsListParams contains all the parameters to send to the API endpoint
+
+	for the reservations list operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReservationsListParams struct {
+
+	/* Count.
+
+	   Include the total with cursor pagination
+	*/
+	Count *bool
+
+	/* Cursor.
+
+	   Cursor of the page to return, implies cursor pagination
+	*/
+	Cursor *string
+
+	/* Expand.
+
+	   Embed details from spored
+	*/
+	Expand []string
+
+	/* Limit.
+
+	   Limit the number of responses
+
+	   Default: 10
+	*/
+	Limit *int64
+
+	/* Offset.
+
+	   Offset the first response
+	*/
+	Offset *int64
+
+	/* Pagination.
+
+	   Page with cursors instead of offsets
+	*/
+	Pagination *string
+
+	/* Sort.
+
+	   Sort results, only by created_at or updated_at with cursor pagination
+	*/
+	Sort *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reservations list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsListParams) WithDefaults() *ReservationsListParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reservations list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsListParams) SetDefaults() {
+	var (
+		limitDefault = int64(10)
+
+		offsetDefault = int64(0)
+	)
+
+	val := ReservationsListParams{
+		Limit:  &limitDefault,
+		Offset: &offsetDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the reservations list params
+func (o *ReservationsListParams) WithTimeout(timeout time.Duration) *ReservationsListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reservations list params
+func (o *ReservationsListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reservations list params
+func (o *ReservationsListParams) WithContext(ctx context.Context) *ReservationsListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reservations list params
+func (o *ReservationsListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reservations list params
+func (o *ReservationsListParams) WithHTTPClient(client *http.Client) *ReservationsListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reservations list params
+func (o *ReservationsListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCount adds the count to the reservations list params
+func (o *ReservationsListParams) WithCount(count *bool) *ReservationsListParams {
+	o.SetCount(count)
+	return o
+}
+
+// SetCount adds the count to the reservations list params
+func (o *ReservationsListParams) SetCount(count *bool) {
+	o.Count = count
+}
+
+// WithCursor adds the cursor to the reservations list params
+func (o *ReservationsListParams) WithCursor(cursor *string) *ReservationsListParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the reservations list params
+func (o *ReservationsListParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithExpand adds the expand to the reservations list params
+func (o *ReservationsListParams) WithExpand(expand []string) *ReservationsListParams {
+	o.SetExpand(expand)
+	return o
+}
+
+// SetExpand adds the expand to the reservations list params
+func (o *ReservationsListParams) SetExpand(expand []string) {
+	o.Expand = expand
+}
+
+// WithLimit adds the limit to the reservations list params
+func (o *ReservationsListParams) WithLimit(limit *int64) *ReservationsListParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the reservations list params
+func (o *ReservationsListParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the reservations list params
+func (o *ReservationsListParams) WithOffset(offset *int64) *ReservationsListParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the reservations list params
+func (o *ReservationsListParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithPagination adds the pagination to the reservations list params
+func (o *ReservationsListParams) WithPagination(pagination *string) *ReservationsListParams {
+	o.SetPagination(pagination)
+	return o
+}
+
+// SetPagination adds the pagination to the reservations list params
+func (o *ReservationsListParams) SetPagination(pagination *string) {
+	o.Pagination = pagination
+}
+
+// WithSort adds the sort to the reservations list params
+func (o *ReservationsListParams) WithSort(sort *string) *ReservationsListParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the reservations list params
+func (o *ReservationsListParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReservationsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Count != nil {
+
+		// query param count
+		var qrCount bool
+
+		if o.Count != nil {
+			qrCount = *o.Count
+		}
+		qCount := swag.FormatBool(qrCount)
+		if qCount != "" {
+
+			if err := r.SetQueryParam("count", qCount); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Expand != nil {
+
+		// binding items for expand
+		joinedExpand := o.bindParamExpand(reg)
+
+		// query array param expand
+		if err := r.SetQueryParam("expand", joinedExpand...); err != nil {
+			return err
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Pagination != nil {
+
+		// query param pagination
+		var qrPagination string
+
+		if o.Pagination != nil {
+			qrPagination = *o.Pagination
+		}
+		qPagination := qrPagination
+		if qPagination != "" {
+
+			if err := r.SetQueryParam("pagination", qPagination); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamReservationsList binds the parameter expand
+func (o *ReservationsListParams) bindParamExpand(formats strfmt.Registry) []string {
+	expandIR := o.Expand
+
+	var expandIC []string
+	for _, expandIIR := range expandIR { // explode []string
+
+		expandIIV := expandIIR // string as string
+		expandIC = append(expandIC, expandIIV)
+	}
+
+	// items.CollectionFormat: "csv"
+	expandIS := swag.JoinByFormat(expandIC, "csv")
+
+	return expandIS
+}
@@ -0,0 +1,511 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReservationsListReader is a Reader for the ReservationsList structure.
+type ReservationsListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReservationsListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReservationsListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReservationsListBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReservationsListNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReservationsListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /reservations] ReservationsList", response, response.Code())
+	}
+}
+
+// NewReservationsListOK creates a ReservationsListOK with default headers values
+func NewReservationsListOK() *ReservationsListOK {
+	return &ReservationsListOK{}
+}
+
+/*
+ReservationsListOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReservationsListOK struct {
+	Payload *ReservationsListOKBody
+}
+
+// IsSuccess returns true when this reservations list o k response has a 2xx status code
+func (o *ReservationsListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reservations list o k response has a 3xx status code
+func (o *ReservationsListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations list o k response has a 4xx status code
+func (o *ReservationsListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations list o k response has a 5xx status code
+func (o *ReservationsListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations list o k response a status code equal to that given
+func (o *ReservationsListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reservations list o k response
+func (o *ReservationsListOK) Code() int {
+	return 200
+}
+
+func (o *ReservationsListOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations][%d] reservationsListOK %s", 200, payload)
+}
+
+func (o *ReservationsListOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations][%d] reservationsListOK %s", 200, payload)
+}
+
+func (o *ReservationsListOK) GetPayload() *ReservationsListOKBody {
+	return o.Payload
+}
+
+func (o *ReservationsListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(ReservationsListOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsListBadRequest creates a ReservationsListBadRequest with default headers values
+func NewReservationsListBadRequest() *ReservationsListBadRequest {
+	return &ReservationsListBadRequest{}
+}
+
+/*
+ReservationsListBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReservationsListBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations list bad request response has a 2xx status code
+func (o *ReservationsListBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations list bad request response has a 3xx status code
+func (o *ReservationsListBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations list bad request response has a 4xx status code
+func (o *ReservationsListBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations list bad request response has a 5xx status code
+func (o *ReservationsListBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations list bad request response a status code equal to that given
+func (o *ReservationsListBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reservations list bad request response
+func (o *ReservationsListBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReservationsListBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations][%d] reservationsListBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsListBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations][%d] reservationsListBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsListBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsListBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsListNotFound creates a ReservationsListNotFound with default headers values
+func NewReservationsListNotFound() *ReservationsListNotFound {
+	return &ReservationsListNotFound{}
+}
+
+/*
+ReservationsListNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ReservationsListNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations list not found response has a 2xx status code
+func (o *ReservationsListNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations list not found response has a 3xx status code
+func (o *ReservationsListNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations list not found response has a 4xx status code
+func (o *ReservationsListNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations list not found response has a 5xx status code
+func (o *ReservationsListNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations list not found response a status code equal to that given
+func (o *ReservationsListNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the reservations list not found response
+func (o *ReservationsListNotFound) Code() int {
+	return 404
+}
+
+func (o *ReservationsListNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations][%d] reservationsListNotFound %s", 404, payload)
+}
+
+func (o *ReservationsListNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations][%d] reservationsListNotFound %s", 404, payload)
+}
+
+func (o *ReservationsListNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsListNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsListInternalServerError creates a ReservationsListInternalServerError with default headers values
+func NewReservationsListInternalServerError() *ReservationsListInternalServerError {
+	return &ReservationsListInternalServerError{}
+}
+
+/*
+ReservationsListInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReservationsListInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations list internal server error response has a 2xx status code
+func (o *ReservationsListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations list internal server error response has a 3xx status code
+func (o *ReservationsListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations list internal server error response has a 4xx status code
+func (o *ReservationsListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations list internal server error response has a 5xx status code
+func (o *ReservationsListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations list internal server error response a status code equal to that given
+func (o *ReservationsListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reservations list internal server error response
+func (o *ReservationsListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReservationsListInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations][%d] reservationsListInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsListInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations][%d] reservationsListInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsListInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+/*
+ReservationsListOKBody reservations list o k body
+swagger:model ReservationsListOKBody
+*/
+type ReservationsListOKBody struct {
+	models.APICursorPaginatedResponse
+
+	// data
+	Data []*models.APIReservationResponse `json:"data"`
+}
+
+// UnmarshalJSON unmarshals this object from a JSON structure
+func (o *ReservationsListOKBody) UnmarshalJSON(raw []byte) error {
+	// ReservationsListOKBodyAO0
+	var reservationsListOKBodyAO0 models.APICursorPaginatedResponse
+	if err := swag.ReadJSON(raw, &reservationsListOKBodyAO0); err != nil {
+		return err
+	}
+	o.APICursorPaginatedResponse = reservationsListOKBodyAO0
+
+	// ReservationsListOKBodyAO1
+	var dataReservationsListOKBodyAO1 struct {
+		Data []*models.APIReservationResponse `json:"data"`
+	}
+	if err := swag.ReadJSON(raw, &dataReservationsListOKBodyAO1); err != nil {
+		return err
+	}
+
+	o.Data = dataReservationsListOKBodyAO1.Data
+
+	return nil
+}
+
+// MarshalJSON marshals this object to a JSON structure
+func (o ReservationsListOKBody) MarshalJSON() ([]byte, error) {
+	_parts := make([][]byte, 0, 2)
+
+	reservationsListOKBodyAO0, err := swag.WriteJSON(o.APICursorPaginatedResponse)
+	if err != nil {
+		return nil, err
+	}
+	_parts = append(_parts, reservationsListOKBodyAO0)
+	var dataReservationsListOKBodyAO1 struct {
+		Data []*models.APIReservationResponse `json:"data"`
+	}
+
+	dataReservationsListOKBodyAO1.Data = o.Data
+
+	jsonDataReservationsListOKBodyAO1, errReservationsListOKBodyAO1 := swag.WriteJSON(dataReservationsListOKBodyAO1)
+	if errReservationsListOKBodyAO1 != nil {
+		return nil, errReservationsListOKBodyAO1
+	}
+	_parts = append(_parts, jsonDataReservationsListOKBodyAO1)
+	return swag.ConcatJSON(_parts...), nil
+}
+
+// Validate validates this reservations list o k body
+func (o *ReservationsListOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.APICursorPaginatedResponse
+	if err := o.APICursorPaginatedResponse.Validate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ReservationsListOKBody) validateData(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Data) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Data); i++ {
+		if swag.IsZero(o.Data[i]) { // not required
+			continue
+		}
+
+		if o.Data[i] != nil {
+			if err := o.Data[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("reservationsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("reservationsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this reservations list o k body based on the context it is used
+func (o *ReservationsListOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.APICursorPaginatedResponse
+	if err := o.APICursorPaginatedResponse.ContextValidate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateData(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ReservationsListOKBody) contextValidateData(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Data); i++ {
+
+		if o.Data[i] != nil {
+
+			if swag.IsZero(o.Data[i]) { // not required
+				return nil
+			}
+
+			if err := o.Data[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("reservationsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("reservationsListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ReservationsListOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ReservationsListOKBody) UnmarshalBinary(b []byte) error {
+	var res ReservationsListOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewReservationsPatchParams creates a new ReservationsPatchParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReservationsPatchParams() *ReservationsPatchParams {
+	return &ReservationsPatchParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReservationsPatchParamsWithTimeout creates a new ReservationsPatchParams object
+// with the ability to set a timeout on a request.
+func NewReservationsPatchParamsWithTimeout(timeout time.Duration) *ReservationsPatchParams {
+	return &ReservationsPatchParams{
+		timeout: timeout,
+	}
+}
+
+// NewReservationsPatchParamsWithContext creates a new ReservationsPatchParams object
+// with the ability to set a context for a request.
+func NewReservationsPatchParamsWithContext(ctx context.Context) *ReservationsPatchParams {
+	return &ReservationsPatchParams{
+		Context: ctx,
+	}
+}
+
+// NewReservationsPatchParamsWithHTTPClient creates a new ReservationsPatchParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReservationsPatchParamsWithHTTPClient(client *http.Client) *ReservationsPatchParams {
+	return &ReservationsPatchParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReservationsPatchParams contains all the parameters to send to the API endpoint
+
+	for the reservations patch operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReservationsPatchParams struct {
+
+	/* IfMatch.
+
+	   Only change the reservation if its ETag still matches
+	*/
+	IfMatch *string
+
+	/* Request.
+
+	   merge patch document
+	*/
+	Request *models.APIReservationRequest
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reservations patch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsPatchParams) WithDefaults() *ReservationsPatchParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reservations patch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsPatchParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reservations patch params
+func (o *ReservationsPatchParams) WithTimeout(timeout time.Duration) *ReservationsPatchParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reservations patch params
+func (o *ReservationsPatchParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reservations patch params
+func (o *ReservationsPatchParams) WithContext(ctx context.Context) *ReservationsPatchParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reservations patch params
+func (o *ReservationsPatchParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reservations patch params
+func (o *ReservationsPatchParams) WithHTTPClient(client *http.Client) *ReservationsPatchParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reservations patch params
+func (o *ReservationsPatchParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIfMatch adds the ifMatch to the reservations patch params
+func (o *ReservationsPatchParams) WithIfMatch(ifMatch *string) *ReservationsPatchParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the reservations patch params
+func (o *ReservationsPatchParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
+// WithRequest adds the request to the reservations patch params
+func (o *ReservationsPatchParams) WithRequest(request *models.APIReservationRequest) *ReservationsPatchParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the reservations patch params
+func (o *ReservationsPatchParams) SetRequest(request *models.APIReservationRequest) {
+	o.Request = request
+}
+
+// WithReservationID adds the reservationID to the reservations patch params
+func (o *ReservationsPatchParams) WithReservationID(reservationID strfmt.UUID) *ReservationsPatchParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the reservations patch params
+func (o *ReservationsPatchParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReservationsPatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
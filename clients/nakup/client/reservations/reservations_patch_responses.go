@@ -0,0 +1,575 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReservationsPatchReader is a Reader for the ReservationsPatch structure.
+type ReservationsPatchReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReservationsPatchReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReservationsPatchOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReservationsPatchBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReservationsPatchForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReservationsPatchNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 412:
+		result := NewReservationsPatchPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReservationsPatchInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewReservationsPatchServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PATCH /reservations/{reservationID}] ReservationsPatch", response, response.Code())
+	}
+}
+
+// NewReservationsPatchOK creates a ReservationsPatchOK with default headers values
+func NewReservationsPatchOK() *ReservationsPatchOK {
+	return &ReservationsPatchOK{}
+}
+
+/*
+ReservationsPatchOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReservationsPatchOK struct {
+
+	/* Version of the reservation
+	 */
+	ETag string
+
+	Payload *models.APIReservationResponse
+}
+
+// IsSuccess returns true when this reservations patch o k response has a 2xx status code
+func (o *ReservationsPatchOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reservations patch o k response has a 3xx status code
+func (o *ReservationsPatchOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations patch o k response has a 4xx status code
+func (o *ReservationsPatchOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations patch o k response has a 5xx status code
+func (o *ReservationsPatchOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations patch o k response a status code equal to that given
+func (o *ReservationsPatchOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reservations patch o k response
+func (o *ReservationsPatchOK) Code() int {
+	return 200
+}
+
+func (o *ReservationsPatchOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchOK %s", 200, payload)
+}
+
+func (o *ReservationsPatchOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchOK %s", 200, payload)
+}
+
+func (o *ReservationsPatchOK) GetPayload() *models.APIReservationResponse {
+	return o.Payload
+}
+
+func (o *ReservationsPatchOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.APIReservationResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsPatchBadRequest creates a ReservationsPatchBadRequest with default headers values
+func NewReservationsPatchBadRequest() *ReservationsPatchBadRequest {
+	return &ReservationsPatchBadRequest{}
+}
+
+/*
+ReservationsPatchBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReservationsPatchBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations patch bad request response has a 2xx status code
+func (o *ReservationsPatchBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations patch bad request response has a 3xx status code
+func (o *ReservationsPatchBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations patch bad request response has a 4xx status code
+func (o *ReservationsPatchBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations patch bad request response has a 5xx status code
+func (o *ReservationsPatchBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations patch bad request response a status code equal to that given
+func (o *ReservationsPatchBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reservations patch bad request response
+func (o *ReservationsPatchBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReservationsPatchBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsPatchBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsPatchBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsPatchBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsPatchForbidden creates a ReservationsPatchForbidden with default headers values
+func NewReservationsPatchForbidden() *ReservationsPatchForbidden {
+	return &ReservationsPatchForbidden{}
+}
+
+/*
+ReservationsPatchForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReservationsPatchForbidden struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations patch forbidden response has a 2xx status code
+func (o *ReservationsPatchForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations patch forbidden response has a 3xx status code
+func (o *ReservationsPatchForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations patch forbidden response has a 4xx status code
+func (o *ReservationsPatchForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations patch forbidden response has a 5xx status code
+func (o *ReservationsPatchForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations patch forbidden response a status code equal to that given
+func (o *ReservationsPatchForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the reservations patch forbidden response
+func (o *ReservationsPatchForbidden) Code() int {
+	return 403
+}
+
+func (o *ReservationsPatchForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchForbidden %s", 403, payload)
+}
+
+func (o *ReservationsPatchForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchForbidden %s", 403, payload)
+}
+
+func (o *ReservationsPatchForbidden) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsPatchForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsPatchNotFound creates a ReservationsPatchNotFound with default headers values
+func NewReservationsPatchNotFound() *ReservationsPatchNotFound {
+	return &ReservationsPatchNotFound{}
+}
+
+/*
+ReservationsPatchNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ReservationsPatchNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations patch not found response has a 2xx status code
+func (o *ReservationsPatchNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations patch not found response has a 3xx status code
+func (o *ReservationsPatchNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations patch not found response has a 4xx status code
+func (o *ReservationsPatchNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations patch not found response has a 5xx status code
+func (o *ReservationsPatchNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations patch not found response a status code equal to that given
+func (o *ReservationsPatchNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the reservations patch not found response
+func (o *ReservationsPatchNotFound) Code() int {
+	return 404
+}
+
+func (o *ReservationsPatchNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchNotFound %s", 404, payload)
+}
+
+func (o *ReservationsPatchNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchNotFound %s", 404, payload)
+}
+
+func (o *ReservationsPatchNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsPatchNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsPatchPreconditionFailed creates a ReservationsPatchPreconditionFailed with default headers values
+func NewReservationsPatchPreconditionFailed() *ReservationsPatchPreconditionFailed {
+	return &ReservationsPatchPreconditionFailed{}
+}
+
+/*
+ReservationsPatchPreconditionFailed describes a response with status code 412, with default header values.
+
+Precondition Failed
+*/
+type ReservationsPatchPreconditionFailed struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations patch precondition failed response has a 2xx status code
+func (o *ReservationsPatchPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations patch precondition failed response has a 3xx status code
+func (o *ReservationsPatchPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations patch precondition failed response has a 4xx status code
+func (o *ReservationsPatchPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations patch precondition failed response has a 5xx status code
+func (o *ReservationsPatchPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations patch precondition failed response a status code equal to that given
+func (o *ReservationsPatchPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the reservations patch precondition failed response
+func (o *ReservationsPatchPreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ReservationsPatchPreconditionFailed) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchPreconditionFailed %s", 412, payload)
+}
+
+func (o *ReservationsPatchPreconditionFailed) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchPreconditionFailed %s", 412, payload)
+}
+
+func (o *ReservationsPatchPreconditionFailed) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsPatchPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsPatchInternalServerError creates a ReservationsPatchInternalServerError with default headers values
+func NewReservationsPatchInternalServerError() *ReservationsPatchInternalServerError {
+	return &ReservationsPatchInternalServerError{}
+}
+
+/*
+ReservationsPatchInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReservationsPatchInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations patch internal server error response has a 2xx status code
+func (o *ReservationsPatchInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations patch internal server error response has a 3xx status code
+func (o *ReservationsPatchInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations patch internal server error response has a 4xx status code
+func (o *ReservationsPatchInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations patch internal server error response has a 5xx status code
+func (o *ReservationsPatchInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations patch internal server error response a status code equal to that given
+func (o *ReservationsPatchInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reservations patch internal server error response
+func (o *ReservationsPatchInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReservationsPatchInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsPatchInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsPatchInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsPatchInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsPatchServiceUnavailable creates a ReservationsPatchServiceUnavailable with default headers values
+func NewReservationsPatchServiceUnavailable() *ReservationsPatchServiceUnavailable {
+	return &ReservationsPatchServiceUnavailable{}
+}
+
+/*
+ReservationsPatchServiceUnavailable describes a response with status code 503, with default header values.
+
+Service Unavailable
+*/
+type ReservationsPatchServiceUnavailable struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations patch service unavailable response has a 2xx status code
+func (o *ReservationsPatchServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations patch service unavailable response has a 3xx status code
+func (o *ReservationsPatchServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations patch service unavailable response has a 4xx status code
+func (o *ReservationsPatchServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations patch service unavailable response has a 5xx status code
+func (o *ReservationsPatchServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations patch service unavailable response a status code equal to that given
+func (o *ReservationsPatchServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the reservations patch service unavailable response
+func (o *ReservationsPatchServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *ReservationsPatchServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReservationsPatchServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /reservations/{reservationID}][%d] reservationsPatchServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReservationsPatchServiceUnavailable) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsPatchServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
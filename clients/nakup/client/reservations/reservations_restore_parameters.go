@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReservationsRestoreParams creates a new ReservationsRestoreParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReservationsRestoreParams() *ReservationsRestoreParams {
+	return &ReservationsRestoreParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReservationsRestoreParamsWithTimeout creates a new ReservationsRestoreParams object
+// with the ability to set a timeout on a request.
+func NewReservationsRestoreParamsWithTimeout(timeout time.Duration) *ReservationsRestoreParams {
+	return &ReservationsRestoreParams{
+		timeout: timeout,
+	}
+}
+
+// NewReservationsRestoreParamsWithContext creates a new ReservationsRestoreParams object
+// with the ability to set a context for a request.
+func NewReservationsRestoreParamsWithContext(ctx context.Context) *ReservationsRestoreParams {
+	return &ReservationsRestoreParams{
+		Context: ctx,
+	}
+}
+
+// NewReservationsRestoreParamsWithHTTPClient creates a new ReservationsRestoreParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReservationsRestoreParamsWithHTTPClient(client *http.Client) *ReservationsRestoreParams {
+	return &ReservationsRestoreParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReservationsRestoreParams contains all the parameters to send to the API endpoint
+
+	for the reservations restore operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReservationsRestoreParams struct {
+
+	/* IdempotencyKey.
+
+	   Key that makes retries of the request safe
+	*/
+	IdempotencyKey *string
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reservations restore params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsRestoreParams) WithDefaults() *ReservationsRestoreParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reservations restore params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsRestoreParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reservations restore params
+func (o *ReservationsRestoreParams) WithTimeout(timeout time.Duration) *ReservationsRestoreParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reservations restore params
+func (o *ReservationsRestoreParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reservations restore params
+func (o *ReservationsRestoreParams) WithContext(ctx context.Context) *ReservationsRestoreParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reservations restore params
+func (o *ReservationsRestoreParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reservations restore params
+func (o *ReservationsRestoreParams) WithHTTPClient(client *http.Client) *ReservationsRestoreParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reservations restore params
+func (o *ReservationsRestoreParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIdempotencyKey adds the idempotencyKey to the reservations restore params
+func (o *ReservationsRestoreParams) WithIdempotencyKey(idempotencyKey *string) *ReservationsRestoreParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the reservations restore params
+func (o *ReservationsRestoreParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
+// WithReservationID adds the reservationID to the reservations restore params
+func (o *ReservationsRestoreParams) WithReservationID(reservationID strfmt.UUID) *ReservationsRestoreParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the reservations restore params
+func (o *ReservationsRestoreParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReservationsRestoreParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,487 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReservationsRestoreReader is a Reader for the ReservationsRestore structure.
+type ReservationsRestoreReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReservationsRestoreReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReservationsRestoreOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReservationsRestoreBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReservationsRestoreNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewReservationsRestoreConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReservationsRestoreUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReservationsRestoreInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /reservations/{reservationID}/restore] ReservationsRestore", response, response.Code())
+	}
+}
+
+// NewReservationsRestoreOK creates a ReservationsRestoreOK with default headers values
+func NewReservationsRestoreOK() *ReservationsRestoreOK {
+	return &ReservationsRestoreOK{}
+}
+
+/*
+ReservationsRestoreOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReservationsRestoreOK struct {
+	Payload *models.APIReservationResponse
+}
+
+// IsSuccess returns true when this reservations restore o k response has a 2xx status code
+func (o *ReservationsRestoreOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reservations restore o k response has a 3xx status code
+func (o *ReservationsRestoreOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations restore o k response has a 4xx status code
+func (o *ReservationsRestoreOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations restore o k response has a 5xx status code
+func (o *ReservationsRestoreOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations restore o k response a status code equal to that given
+func (o *ReservationsRestoreOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reservations restore o k response
+func (o *ReservationsRestoreOK) Code() int {
+	return 200
+}
+
+func (o *ReservationsRestoreOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreOK %s", 200, payload)
+}
+
+func (o *ReservationsRestoreOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreOK %s", 200, payload)
+}
+
+func (o *ReservationsRestoreOK) GetPayload() *models.APIReservationResponse {
+	return o.Payload
+}
+
+func (o *ReservationsRestoreOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIReservationResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsRestoreBadRequest creates a ReservationsRestoreBadRequest with default headers values
+func NewReservationsRestoreBadRequest() *ReservationsRestoreBadRequest {
+	return &ReservationsRestoreBadRequest{}
+}
+
+/*
+ReservationsRestoreBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReservationsRestoreBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations restore bad request response has a 2xx status code
+func (o *ReservationsRestoreBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations restore bad request response has a 3xx status code
+func (o *ReservationsRestoreBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations restore bad request response has a 4xx status code
+func (o *ReservationsRestoreBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations restore bad request response has a 5xx status code
+func (o *ReservationsRestoreBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations restore bad request response a status code equal to that given
+func (o *ReservationsRestoreBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reservations restore bad request response
+func (o *ReservationsRestoreBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReservationsRestoreBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsRestoreBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsRestoreBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsRestoreBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsRestoreNotFound creates a ReservationsRestoreNotFound with default headers values
+func NewReservationsRestoreNotFound() *ReservationsRestoreNotFound {
+	return &ReservationsRestoreNotFound{}
+}
+
+/*
+ReservationsRestoreNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ReservationsRestoreNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations restore not found response has a 2xx status code
+func (o *ReservationsRestoreNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations restore not found response has a 3xx status code
+func (o *ReservationsRestoreNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations restore not found response has a 4xx status code
+func (o *ReservationsRestoreNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations restore not found response has a 5xx status code
+func (o *ReservationsRestoreNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations restore not found response a status code equal to that given
+func (o *ReservationsRestoreNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the reservations restore not found response
+func (o *ReservationsRestoreNotFound) Code() int {
+	return 404
+}
+
+func (o *ReservationsRestoreNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreNotFound %s", 404, payload)
+}
+
+func (o *ReservationsRestoreNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreNotFound %s", 404, payload)
+}
+
+func (o *ReservationsRestoreNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsRestoreNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsRestoreConflict creates a ReservationsRestoreConflict with default headers values
+func NewReservationsRestoreConflict() *ReservationsRestoreConflict {
+	return &ReservationsRestoreConflict{}
+}
+
+/*
+ReservationsRestoreConflict describes a response with status code 409, with default header values.
+
+Conflict
+*/
+type ReservationsRestoreConflict struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations restore conflict response has a 2xx status code
+func (o *ReservationsRestoreConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations restore conflict response has a 3xx status code
+func (o *ReservationsRestoreConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations restore conflict response has a 4xx status code
+func (o *ReservationsRestoreConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations restore conflict response has a 5xx status code
+func (o *ReservationsRestoreConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations restore conflict response a status code equal to that given
+func (o *ReservationsRestoreConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the reservations restore conflict response
+func (o *ReservationsRestoreConflict) Code() int {
+	return 409
+}
+
+func (o *ReservationsRestoreConflict) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreConflict %s", 409, payload)
+}
+
+func (o *ReservationsRestoreConflict) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreConflict %s", 409, payload)
+}
+
+func (o *ReservationsRestoreConflict) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsRestoreConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsRestoreUnprocessableEntity creates a ReservationsRestoreUnprocessableEntity with default headers values
+func NewReservationsRestoreUnprocessableEntity() *ReservationsRestoreUnprocessableEntity {
+	return &ReservationsRestoreUnprocessableEntity{}
+}
+
+/*
+ReservationsRestoreUnprocessableEntity describes a response with status code 422, with default header values.
+
+Unprocessable Entity
+*/
+type ReservationsRestoreUnprocessableEntity struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations restore unprocessable entity response has a 2xx status code
+func (o *ReservationsRestoreUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations restore unprocessable entity response has a 3xx status code
+func (o *ReservationsRestoreUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations restore unprocessable entity response has a 4xx status code
+func (o *ReservationsRestoreUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations restore unprocessable entity response has a 5xx status code
+func (o *ReservationsRestoreUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations restore unprocessable entity response a status code equal to that given
+func (o *ReservationsRestoreUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the reservations restore unprocessable entity response
+func (o *ReservationsRestoreUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReservationsRestoreUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreUnprocessableEntity %s", 422, payload)
+}
+
+func (o *ReservationsRestoreUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreUnprocessableEntity %s", 422, payload)
+}
+
+func (o *ReservationsRestoreUnprocessableEntity) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsRestoreUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsRestoreInternalServerError creates a ReservationsRestoreInternalServerError with default headers values
+func NewReservationsRestoreInternalServerError() *ReservationsRestoreInternalServerError {
+	return &ReservationsRestoreInternalServerError{}
+}
+
+/*
+ReservationsRestoreInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReservationsRestoreInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations restore internal server error response has a 2xx status code
+func (o *ReservationsRestoreInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations restore internal server error response has a 3xx status code
+func (o *ReservationsRestoreInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations restore internal server error response has a 4xx status code
+func (o *ReservationsRestoreInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations restore internal server error response has a 5xx status code
+func (o *ReservationsRestoreInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations restore internal server error response a status code equal to that given
+func (o *ReservationsRestoreInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reservations restore internal server error response
+func (o *ReservationsRestoreInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReservationsRestoreInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsRestoreInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /reservations/{reservationID}/restore][%d] reservationsRestoreInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsRestoreInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsRestoreInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
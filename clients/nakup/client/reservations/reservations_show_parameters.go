@@ -0,0 +1,224 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewReservationsShowParams creates a new ReservationsShowParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReservationsShowParams() *ReservationsShowParams {
+	return &ReservationsShowParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReservationsShowParamsWithTimeout creates a new ReservationsShowParams object
+// with the ability to set a timeout on a request.
+func NewReservationsShowParamsWithTimeout(timeout time.Duration) *ReservationsShowParams {
+	return &ReservationsShowParams{
+		timeout: timeout,
+	}
+}
+
+// NewReservationsShowParamsWithContext creates a new ReservationsShowParams object
+// with the ability to set a context for a request.
+func NewReservationsShowParamsWithContext(ctx context.Context) *ReservationsShowParams {
+	return &ReservationsShowParams{
+		Context: ctx,
+	}
+}
+
+// NewReservationsShowParamsWithHTTPClient creates a new ReservationsShowParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReservationsShowParamsWithHTTPClient(client *http.Client) *ReservationsShowParams {
+	return &ReservationsShowParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReservationsShowParams contains all the parameters to send to the API endpoint
+
+	for the reservations show operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReservationsShowParams struct {
+
+	/* IfNoneMatch.
+
+	   Respond with 304 if the reservation's ETag still matches
+	*/
+	IfNoneMatch *string
+
+	/* Expand.
+
+	   Embed details from spored
+	*/
+	Expand []string
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reservations show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsShowParams) WithDefaults() *ReservationsShowParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reservations show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsShowParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reservations show params
+func (o *ReservationsShowParams) WithTimeout(timeout time.Duration) *ReservationsShowParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reservations show params
+func (o *ReservationsShowParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reservations show params
+func (o *ReservationsShowParams) WithContext(ctx context.Context) *ReservationsShowParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reservations show params
+func (o *ReservationsShowParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reservations show params
+func (o *ReservationsShowParams) WithHTTPClient(client *http.Client) *ReservationsShowParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reservations show params
+func (o *ReservationsShowParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIfNoneMatch adds the ifNoneMatch to the reservations show params
+func (o *ReservationsShowParams) WithIfNoneMatch(ifNoneMatch *string) *ReservationsShowParams {
+	o.SetIfNoneMatch(ifNoneMatch)
+	return o
+}
+
+// SetIfNoneMatch adds the ifNoneMatch to the reservations show params
+func (o *ReservationsShowParams) SetIfNoneMatch(ifNoneMatch *string) {
+	o.IfNoneMatch = ifNoneMatch
+}
+
+// WithExpand adds the expand to the reservations show params
+func (o *ReservationsShowParams) WithExpand(expand []string) *ReservationsShowParams {
+	o.SetExpand(expand)
+	return o
+}
+
+// SetExpand adds the expand to the reservations show params
+func (o *ReservationsShowParams) SetExpand(expand []string) {
+	o.Expand = expand
+}
+
+// WithReservationID adds the reservationID to the reservations show params
+func (o *ReservationsShowParams) WithReservationID(reservationID strfmt.UUID) *ReservationsShowParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the reservations show params
+func (o *ReservationsShowParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReservationsShowParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IfNoneMatch != nil {
+
+		// header param If-None-Match
+		if err := r.SetHeaderParam("If-None-Match", *o.IfNoneMatch); err != nil {
+			return err
+		}
+	}
+
+	if o.Expand != nil {
+
+		// binding items for expand
+		joinedExpand := o.bindParamExpand(reg)
+
+		// query array param expand
+		if err := r.SetQueryParam("expand", joinedExpand...); err != nil {
+			return err
+		}
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamReservationsShow binds the parameter expand
+func (o *ReservationsShowParams) bindParamExpand(formats strfmt.Registry) []string {
+	expandIR := o.Expand
+
+	var expandIC []string
+	for _, expandIIR := range expandIR { // explode []string
+
+		expandIIV := expandIIR // string as string
+		expandIC = append(expandIC, expandIIV)
+	}
+
+	// items.CollectionFormat: "csv"
+	expandIS := swag.JoinByFormat(expandIC, "csv")
+
+	return expandIS
+}
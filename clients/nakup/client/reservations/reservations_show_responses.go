@@ -0,0 +1,409 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReservationsShowReader is a Reader for the ReservationsShow structure.
+type ReservationsShowReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReservationsShowReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReservationsShowOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 304:
+		result := NewReservationsShowNotModified()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 400:
+		result := NewReservationsShowBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReservationsShowNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReservationsShowInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /reservations/{reservationID}] ReservationsShow", response, response.Code())
+	}
+}
+
+// NewReservationsShowOK creates a ReservationsShowOK with default headers values
+func NewReservationsShowOK() *ReservationsShowOK {
+	return &ReservationsShowOK{}
+}
+
+/*
+ReservationsShowOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReservationsShowOK struct {
+
+	/* Version of the reservation
+	 */
+	ETag string
+
+	Payload *models.APIReservationResponse
+}
+
+// IsSuccess returns true when this reservations show o k response has a 2xx status code
+func (o *ReservationsShowOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reservations show o k response has a 3xx status code
+func (o *ReservationsShowOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations show o k response has a 4xx status code
+func (o *ReservationsShowOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations show o k response has a 5xx status code
+func (o *ReservationsShowOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations show o k response a status code equal to that given
+func (o *ReservationsShowOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reservations show o k response
+func (o *ReservationsShowOK) Code() int {
+	return 200
+}
+
+func (o *ReservationsShowOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}][%d] reservationsShowOK %s", 200, payload)
+}
+
+func (o *ReservationsShowOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}][%d] reservationsShowOK %s", 200, payload)
+}
+
+func (o *ReservationsShowOK) GetPayload() *models.APIReservationResponse {
+	return o.Payload
+}
+
+func (o *ReservationsShowOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.APIReservationResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsShowNotModified creates a ReservationsShowNotModified with default headers values
+func NewReservationsShowNotModified() *ReservationsShowNotModified {
+	return &ReservationsShowNotModified{}
+}
+
+/*
+ReservationsShowNotModified describes a response with status code 304, with default header values.
+
+Not Modified
+*/
+type ReservationsShowNotModified struct {
+}
+
+// IsSuccess returns true when this reservations show not modified response has a 2xx status code
+func (o *ReservationsShowNotModified) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations show not modified response has a 3xx status code
+func (o *ReservationsShowNotModified) IsRedirect() bool {
+	return true
+}
+
+// IsClientError returns true when this reservations show not modified response has a 4xx status code
+func (o *ReservationsShowNotModified) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations show not modified response has a 5xx status code
+func (o *ReservationsShowNotModified) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations show not modified response a status code equal to that given
+func (o *ReservationsShowNotModified) IsCode(code int) bool {
+	return code == 304
+}
+
+// Code gets the status code for the reservations show not modified response
+func (o *ReservationsShowNotModified) Code() int {
+	return 304
+}
+
+func (o *ReservationsShowNotModified) Error() string {
+	return fmt.Sprintf("[GET /reservations/{reservationID}][%d] reservationsShowNotModified", 304)
+}
+
+func (o *ReservationsShowNotModified) String() string {
+	return fmt.Sprintf("[GET /reservations/{reservationID}][%d] reservationsShowNotModified", 304)
+}
+
+func (o *ReservationsShowNotModified) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReservationsShowBadRequest creates a ReservationsShowBadRequest with default headers values
+func NewReservationsShowBadRequest() *ReservationsShowBadRequest {
+	return &ReservationsShowBadRequest{}
+}
+
+/*
+ReservationsShowBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReservationsShowBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations show bad request response has a 2xx status code
+func (o *ReservationsShowBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations show bad request response has a 3xx status code
+func (o *ReservationsShowBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations show bad request response has a 4xx status code
+func (o *ReservationsShowBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations show bad request response has a 5xx status code
+func (o *ReservationsShowBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations show bad request response a status code equal to that given
+func (o *ReservationsShowBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reservations show bad request response
+func (o *ReservationsShowBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReservationsShowBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}][%d] reservationsShowBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsShowBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}][%d] reservationsShowBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsShowBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsShowBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsShowNotFound creates a ReservationsShowNotFound with default headers values
+func NewReservationsShowNotFound() *ReservationsShowNotFound {
+	return &ReservationsShowNotFound{}
+}
+
+/*
+ReservationsShowNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ReservationsShowNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations show not found response has a 2xx status code
+func (o *ReservationsShowNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations show not found response has a 3xx status code
+func (o *ReservationsShowNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations show not found response has a 4xx status code
+func (o *ReservationsShowNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations show not found response has a 5xx status code
+func (o *ReservationsShowNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations show not found response a status code equal to that given
+func (o *ReservationsShowNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the reservations show not found response
+func (o *ReservationsShowNotFound) Code() int {
+	return 404
+}
+
+func (o *ReservationsShowNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}][%d] reservationsShowNotFound %s", 404, payload)
+}
+
+func (o *ReservationsShowNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}][%d] reservationsShowNotFound %s", 404, payload)
+}
+
+func (o *ReservationsShowNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsShowNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsShowInternalServerError creates a ReservationsShowInternalServerError with default headers values
+func NewReservationsShowInternalServerError() *ReservationsShowInternalServerError {
+	return &ReservationsShowInternalServerError{}
+}
+
+/*
+ReservationsShowInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReservationsShowInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations show internal server error response has a 2xx status code
+func (o *ReservationsShowInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations show internal server error response has a 3xx status code
+func (o *ReservationsShowInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations show internal server error response has a 4xx status code
+func (o *ReservationsShowInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations show internal server error response has a 5xx status code
+func (o *ReservationsShowInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations show internal server error response a status code equal to that given
+func (o *ReservationsShowInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reservations show internal server error response
+func (o *ReservationsShowInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReservationsShowInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}][%d] reservationsShowInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsShowInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /reservations/{reservationID}][%d] reservationsShowInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsShowInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsShowInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
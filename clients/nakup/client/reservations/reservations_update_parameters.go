@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewReservationsUpdateParams creates a new ReservationsUpdateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReservationsUpdateParams() *ReservationsUpdateParams {
+	return &ReservationsUpdateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReservationsUpdateParamsWithTimeout creates a new ReservationsUpdateParams object
+// with the ability to set a timeout on a request.
+func NewReservationsUpdateParamsWithTimeout(timeout time.Duration) *ReservationsUpdateParams {
+	return &ReservationsUpdateParams{
+		timeout: timeout,
+	}
+}
+
+// NewReservationsUpdateParamsWithContext creates a new ReservationsUpdateParams object
+// with the ability to set a context for a request.
+func NewReservationsUpdateParamsWithContext(ctx context.Context) *ReservationsUpdateParams {
+	return &ReservationsUpdateParams{
+		Context: ctx,
+	}
+}
+
+// NewReservationsUpdateParamsWithHTTPClient creates a new ReservationsUpdateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReservationsUpdateParamsWithHTTPClient(client *http.Client) *ReservationsUpdateParams {
+	return &ReservationsUpdateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReservationsUpdateParams contains all the parameters to send to the API endpoint
+
+	for the reservations update operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReservationsUpdateParams struct {
+
+	/* IfMatch.
+
+	   Only change the reservation if its ETag still matches
+	*/
+	IfMatch *string
+
+	/* Request.
+
+	   request body
+	*/
+	Request *models.APIReservationRequest
+
+	/* ReservationID.
+
+	   Reservation ID
+
+	   Format: uuid
+	*/
+	ReservationID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reservations update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsUpdateParams) WithDefaults() *ReservationsUpdateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reservations update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReservationsUpdateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reservations update params
+func (o *ReservationsUpdateParams) WithTimeout(timeout time.Duration) *ReservationsUpdateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reservations update params
+func (o *ReservationsUpdateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reservations update params
+func (o *ReservationsUpdateParams) WithContext(ctx context.Context) *ReservationsUpdateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reservations update params
+func (o *ReservationsUpdateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reservations update params
+func (o *ReservationsUpdateParams) WithHTTPClient(client *http.Client) *ReservationsUpdateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reservations update params
+func (o *ReservationsUpdateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIfMatch adds the ifMatch to the reservations update params
+func (o *ReservationsUpdateParams) WithIfMatch(ifMatch *string) *ReservationsUpdateParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the reservations update params
+func (o *ReservationsUpdateParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
+// WithRequest adds the request to the reservations update params
+func (o *ReservationsUpdateParams) WithRequest(request *models.APIReservationRequest) *ReservationsUpdateParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the reservations update params
+func (o *ReservationsUpdateParams) SetRequest(request *models.APIReservationRequest) {
+	o.Request = request
+}
+
+// WithReservationID adds the reservationID to the reservations update params
+func (o *ReservationsUpdateParams) WithReservationID(reservationID strfmt.UUID) *ReservationsUpdateParams {
+	o.SetReservationID(reservationID)
+	return o
+}
+
+// SetReservationID adds the reservationId to the reservations update params
+func (o *ReservationsUpdateParams) SetReservationID(reservationID strfmt.UUID) {
+	o.ReservationID = reservationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReservationsUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	// path param reservationID
+	if err := r.SetPathParam("reservationID", o.ReservationID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,499 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package reservations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// ReservationsUpdateReader is a Reader for the ReservationsUpdate structure.
+type ReservationsUpdateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReservationsUpdateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReservationsUpdateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReservationsUpdateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReservationsUpdateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 412:
+		result := NewReservationsUpdatePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReservationsUpdateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewReservationsUpdateServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PUT /reservations/{reservationID}] ReservationsUpdate", response, response.Code())
+	}
+}
+
+// NewReservationsUpdateOK creates a ReservationsUpdateOK with default headers values
+func NewReservationsUpdateOK() *ReservationsUpdateOK {
+	return &ReservationsUpdateOK{}
+}
+
+/*
+ReservationsUpdateOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReservationsUpdateOK struct {
+
+	/* Version of the reservation
+	 */
+	ETag string
+
+	Payload *models.APIReservationResponse
+}
+
+// IsSuccess returns true when this reservations update o k response has a 2xx status code
+func (o *ReservationsUpdateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reservations update o k response has a 3xx status code
+func (o *ReservationsUpdateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations update o k response has a 4xx status code
+func (o *ReservationsUpdateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations update o k response has a 5xx status code
+func (o *ReservationsUpdateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations update o k response a status code equal to that given
+func (o *ReservationsUpdateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reservations update o k response
+func (o *ReservationsUpdateOK) Code() int {
+	return 200
+}
+
+func (o *ReservationsUpdateOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdateOK %s", 200, payload)
+}
+
+func (o *ReservationsUpdateOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdateOK %s", 200, payload)
+}
+
+func (o *ReservationsUpdateOK) GetPayload() *models.APIReservationResponse {
+	return o.Payload
+}
+
+func (o *ReservationsUpdateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.APIReservationResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsUpdateBadRequest creates a ReservationsUpdateBadRequest with default headers values
+func NewReservationsUpdateBadRequest() *ReservationsUpdateBadRequest {
+	return &ReservationsUpdateBadRequest{}
+}
+
+/*
+ReservationsUpdateBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type ReservationsUpdateBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations update bad request response has a 2xx status code
+func (o *ReservationsUpdateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations update bad request response has a 3xx status code
+func (o *ReservationsUpdateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations update bad request response has a 4xx status code
+func (o *ReservationsUpdateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations update bad request response has a 5xx status code
+func (o *ReservationsUpdateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations update bad request response a status code equal to that given
+func (o *ReservationsUpdateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the reservations update bad request response
+func (o *ReservationsUpdateBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReservationsUpdateBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdateBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsUpdateBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdateBadRequest %s", 400, payload)
+}
+
+func (o *ReservationsUpdateBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsUpdateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsUpdateNotFound creates a ReservationsUpdateNotFound with default headers values
+func NewReservationsUpdateNotFound() *ReservationsUpdateNotFound {
+	return &ReservationsUpdateNotFound{}
+}
+
+/*
+ReservationsUpdateNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type ReservationsUpdateNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations update not found response has a 2xx status code
+func (o *ReservationsUpdateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations update not found response has a 3xx status code
+func (o *ReservationsUpdateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations update not found response has a 4xx status code
+func (o *ReservationsUpdateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations update not found response has a 5xx status code
+func (o *ReservationsUpdateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations update not found response a status code equal to that given
+func (o *ReservationsUpdateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the reservations update not found response
+func (o *ReservationsUpdateNotFound) Code() int {
+	return 404
+}
+
+func (o *ReservationsUpdateNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdateNotFound %s", 404, payload)
+}
+
+func (o *ReservationsUpdateNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdateNotFound %s", 404, payload)
+}
+
+func (o *ReservationsUpdateNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsUpdateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsUpdatePreconditionFailed creates a ReservationsUpdatePreconditionFailed with default headers values
+func NewReservationsUpdatePreconditionFailed() *ReservationsUpdatePreconditionFailed {
+	return &ReservationsUpdatePreconditionFailed{}
+}
+
+/*
+ReservationsUpdatePreconditionFailed describes a response with status code 412, with default header values.
+
+Precondition Failed
+*/
+type ReservationsUpdatePreconditionFailed struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations update precondition failed response has a 2xx status code
+func (o *ReservationsUpdatePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations update precondition failed response has a 3xx status code
+func (o *ReservationsUpdatePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations update precondition failed response has a 4xx status code
+func (o *ReservationsUpdatePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reservations update precondition failed response has a 5xx status code
+func (o *ReservationsUpdatePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reservations update precondition failed response a status code equal to that given
+func (o *ReservationsUpdatePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the reservations update precondition failed response
+func (o *ReservationsUpdatePreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ReservationsUpdatePreconditionFailed) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdatePreconditionFailed %s", 412, payload)
+}
+
+func (o *ReservationsUpdatePreconditionFailed) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdatePreconditionFailed %s", 412, payload)
+}
+
+func (o *ReservationsUpdatePreconditionFailed) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsUpdatePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsUpdateInternalServerError creates a ReservationsUpdateInternalServerError with default headers values
+func NewReservationsUpdateInternalServerError() *ReservationsUpdateInternalServerError {
+	return &ReservationsUpdateInternalServerError{}
+}
+
+/*
+ReservationsUpdateInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ReservationsUpdateInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations update internal server error response has a 2xx status code
+func (o *ReservationsUpdateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations update internal server error response has a 3xx status code
+func (o *ReservationsUpdateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations update internal server error response has a 4xx status code
+func (o *ReservationsUpdateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations update internal server error response has a 5xx status code
+func (o *ReservationsUpdateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations update internal server error response a status code equal to that given
+func (o *ReservationsUpdateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the reservations update internal server error response
+func (o *ReservationsUpdateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReservationsUpdateInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdateInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsUpdateInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdateInternalServerError %s", 500, payload)
+}
+
+func (o *ReservationsUpdateInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsUpdateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReservationsUpdateServiceUnavailable creates a ReservationsUpdateServiceUnavailable with default headers values
+func NewReservationsUpdateServiceUnavailable() *ReservationsUpdateServiceUnavailable {
+	return &ReservationsUpdateServiceUnavailable{}
+}
+
+/*
+ReservationsUpdateServiceUnavailable describes a response with status code 503, with default header values.
+
+Service Unavailable
+*/
+type ReservationsUpdateServiceUnavailable struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this reservations update service unavailable response has a 2xx status code
+func (o *ReservationsUpdateServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reservations update service unavailable response has a 3xx status code
+func (o *ReservationsUpdateServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reservations update service unavailable response has a 4xx status code
+func (o *ReservationsUpdateServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reservations update service unavailable response has a 5xx status code
+func (o *ReservationsUpdateServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this reservations update service unavailable response a status code equal to that given
+func (o *ReservationsUpdateServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the reservations update service unavailable response
+func (o *ReservationsUpdateServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *ReservationsUpdateServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdateServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReservationsUpdateServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /reservations/{reservationID}][%d] reservationsUpdateServiceUnavailable %s", 503, payload)
+}
+
+func (o *ReservationsUpdateServiceUnavailable) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *ReservationsUpdateServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package seats
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewSeatHoldsCreateParams creates a new SeatHoldsCreateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSeatHoldsCreateParams() *SeatHoldsCreateParams {
+	return &SeatHoldsCreateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSeatHoldsCreateParamsWithTimeout creates a new SeatHoldsCreateParams object
+// with the ability to set a timeout on a request.
+func NewSeatHoldsCreateParamsWithTimeout(timeout time.Duration) *SeatHoldsCreateParams {
+	return &SeatHoldsCreateParams{
+		timeout: timeout,
+	}
+}
+
+// NewSeatHoldsCreateParamsWithContext creates a new SeatHoldsCreateParams object
+// with the ability to set a context for a request.
+func NewSeatHoldsCreateParamsWithContext(ctx context.Context) *SeatHoldsCreateParams {
+	return &SeatHoldsCreateParams{
+		Context: ctx,
+	}
+}
+
+// NewSeatHoldsCreateParamsWithHTTPClient creates a new SeatHoldsCreateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSeatHoldsCreateParamsWithHTTPClient(client *http.Client) *SeatHoldsCreateParams {
+	return &SeatHoldsCreateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SeatHoldsCreateParams contains all the parameters to send to the API endpoint
+
+	for the seat holds create operation.
+
+	Typically these are written to a http.Request.
+*/
+type SeatHoldsCreateParams struct {
+
+	/* IdempotencyKey.
+
+	   Key that makes retries of the request safe
+	*/
+	IdempotencyKey *string
+
+	/* Request.
+
+	   request body
+	*/
+	Request *models.APISeatHoldRequest
+
+	/* TimeSlotID.
+
+	   Time slot ID
+
+	   Format: uuid
+	*/
+	TimeSlotID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the seat holds create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SeatHoldsCreateParams) WithDefaults() *SeatHoldsCreateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the seat holds create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SeatHoldsCreateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the seat holds create params
+func (o *SeatHoldsCreateParams) WithTimeout(timeout time.Duration) *SeatHoldsCreateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the seat holds create params
+func (o *SeatHoldsCreateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the seat holds create params
+func (o *SeatHoldsCreateParams) WithContext(ctx context.Context) *SeatHoldsCreateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the seat holds create params
+func (o *SeatHoldsCreateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the seat holds create params
+func (o *SeatHoldsCreateParams) WithHTTPClient(client *http.Client) *SeatHoldsCreateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the seat holds create params
+func (o *SeatHoldsCreateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIdempotencyKey adds the idempotencyKey to the seat holds create params
+func (o *SeatHoldsCreateParams) WithIdempotencyKey(idempotencyKey *string) *SeatHoldsCreateParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the seat holds create params
+func (o *SeatHoldsCreateParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
+// WithRequest adds the request to the seat holds create params
+func (o *SeatHoldsCreateParams) WithRequest(request *models.APISeatHoldRequest) *SeatHoldsCreateParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the seat holds create params
+func (o *SeatHoldsCreateParams) SetRequest(request *models.APISeatHoldRequest) {
+	o.Request = request
+}
+
+// WithTimeSlotID adds the timeSlotID to the seat holds create params
+func (o *SeatHoldsCreateParams) WithTimeSlotID(timeSlotID strfmt.UUID) *SeatHoldsCreateParams {
+	o.SetTimeSlotID(timeSlotID)
+	return o
+}
+
+// SetTimeSlotID adds the timeSlotId to the seat holds create params
+func (o *SeatHoldsCreateParams) SetTimeSlotID(timeSlotID strfmt.UUID) {
+	o.TimeSlotID = timeSlotID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SeatHoldsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	// path param timeSlotID
+	if err := r.SetPathParam("timeSlotID", o.TimeSlotID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
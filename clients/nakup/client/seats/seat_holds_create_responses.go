@@ -0,0 +1,639 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package seats
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// SeatHoldsCreateReader is a Reader for the SeatHoldsCreate structure.
+type SeatHoldsCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SeatHoldsCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 201:
+		result := NewSeatHoldsCreateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSeatHoldsCreateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewSeatHoldsCreateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSeatHoldsCreateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSeatHoldsCreateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSeatHoldsCreateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSeatHoldsCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewSeatHoldsCreateServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /timeslots/{timeSlotID}/holds] SeatHoldsCreate", response, response.Code())
+	}
+}
+
+// NewSeatHoldsCreateCreated creates a SeatHoldsCreateCreated with default headers values
+func NewSeatHoldsCreateCreated() *SeatHoldsCreateCreated {
+	return &SeatHoldsCreateCreated{}
+}
+
+/*
+SeatHoldsCreateCreated describes a response with status code 201, with default header values.
+
+Created
+*/
+type SeatHoldsCreateCreated struct {
+	Payload *models.APISeatHoldResponse
+}
+
+// IsSuccess returns true when this seat holds create created response has a 2xx status code
+func (o *SeatHoldsCreateCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this seat holds create created response has a 3xx status code
+func (o *SeatHoldsCreateCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds create created response has a 4xx status code
+func (o *SeatHoldsCreateCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this seat holds create created response has a 5xx status code
+func (o *SeatHoldsCreateCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat holds create created response a status code equal to that given
+func (o *SeatHoldsCreateCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the seat holds create created response
+func (o *SeatHoldsCreateCreated) Code() int {
+	return 201
+}
+
+func (o *SeatHoldsCreateCreated) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateCreated %s", 201, payload)
+}
+
+func (o *SeatHoldsCreateCreated) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateCreated %s", 201, payload)
+}
+
+func (o *SeatHoldsCreateCreated) GetPayload() *models.APISeatHoldResponse {
+	return o.Payload
+}
+
+func (o *SeatHoldsCreateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APISeatHoldResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatHoldsCreateBadRequest creates a SeatHoldsCreateBadRequest with default headers values
+func NewSeatHoldsCreateBadRequest() *SeatHoldsCreateBadRequest {
+	return &SeatHoldsCreateBadRequest{}
+}
+
+/*
+SeatHoldsCreateBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type SeatHoldsCreateBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds create bad request response has a 2xx status code
+func (o *SeatHoldsCreateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds create bad request response has a 3xx status code
+func (o *SeatHoldsCreateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds create bad request response has a 4xx status code
+func (o *SeatHoldsCreateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat holds create bad request response has a 5xx status code
+func (o *SeatHoldsCreateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat holds create bad request response a status code equal to that given
+func (o *SeatHoldsCreateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the seat holds create bad request response
+func (o *SeatHoldsCreateBadRequest) Code() int {
+	return 400
+}
+
+func (o *SeatHoldsCreateBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateBadRequest %s", 400, payload)
+}
+
+func (o *SeatHoldsCreateBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateBadRequest %s", 400, payload)
+}
+
+func (o *SeatHoldsCreateBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsCreateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatHoldsCreateUnauthorized creates a SeatHoldsCreateUnauthorized with default headers values
+func NewSeatHoldsCreateUnauthorized() *SeatHoldsCreateUnauthorized {
+	return &SeatHoldsCreateUnauthorized{}
+}
+
+/*
+SeatHoldsCreateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type SeatHoldsCreateUnauthorized struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds create unauthorized response has a 2xx status code
+func (o *SeatHoldsCreateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds create unauthorized response has a 3xx status code
+func (o *SeatHoldsCreateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds create unauthorized response has a 4xx status code
+func (o *SeatHoldsCreateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat holds create unauthorized response has a 5xx status code
+func (o *SeatHoldsCreateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat holds create unauthorized response a status code equal to that given
+func (o *SeatHoldsCreateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the seat holds create unauthorized response
+func (o *SeatHoldsCreateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SeatHoldsCreateUnauthorized) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateUnauthorized %s", 401, payload)
+}
+
+func (o *SeatHoldsCreateUnauthorized) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateUnauthorized %s", 401, payload)
+}
+
+func (o *SeatHoldsCreateUnauthorized) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsCreateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatHoldsCreateForbidden creates a SeatHoldsCreateForbidden with default headers values
+func NewSeatHoldsCreateForbidden() *SeatHoldsCreateForbidden {
+	return &SeatHoldsCreateForbidden{}
+}
+
+/*
+SeatHoldsCreateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SeatHoldsCreateForbidden struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds create forbidden response has a 2xx status code
+func (o *SeatHoldsCreateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds create forbidden response has a 3xx status code
+func (o *SeatHoldsCreateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds create forbidden response has a 4xx status code
+func (o *SeatHoldsCreateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat holds create forbidden response has a 5xx status code
+func (o *SeatHoldsCreateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat holds create forbidden response a status code equal to that given
+func (o *SeatHoldsCreateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the seat holds create forbidden response
+func (o *SeatHoldsCreateForbidden) Code() int {
+	return 403
+}
+
+func (o *SeatHoldsCreateForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateForbidden %s", 403, payload)
+}
+
+func (o *SeatHoldsCreateForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateForbidden %s", 403, payload)
+}
+
+func (o *SeatHoldsCreateForbidden) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsCreateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatHoldsCreateNotFound creates a SeatHoldsCreateNotFound with default headers values
+func NewSeatHoldsCreateNotFound() *SeatHoldsCreateNotFound {
+	return &SeatHoldsCreateNotFound{}
+}
+
+/*
+SeatHoldsCreateNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type SeatHoldsCreateNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds create not found response has a 2xx status code
+func (o *SeatHoldsCreateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds create not found response has a 3xx status code
+func (o *SeatHoldsCreateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds create not found response has a 4xx status code
+func (o *SeatHoldsCreateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat holds create not found response has a 5xx status code
+func (o *SeatHoldsCreateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat holds create not found response a status code equal to that given
+func (o *SeatHoldsCreateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the seat holds create not found response
+func (o *SeatHoldsCreateNotFound) Code() int {
+	return 404
+}
+
+func (o *SeatHoldsCreateNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateNotFound %s", 404, payload)
+}
+
+func (o *SeatHoldsCreateNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateNotFound %s", 404, payload)
+}
+
+func (o *SeatHoldsCreateNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsCreateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatHoldsCreateUnprocessableEntity creates a SeatHoldsCreateUnprocessableEntity with default headers values
+func NewSeatHoldsCreateUnprocessableEntity() *SeatHoldsCreateUnprocessableEntity {
+	return &SeatHoldsCreateUnprocessableEntity{}
+}
+
+/*
+SeatHoldsCreateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Unprocessable Entity
+*/
+type SeatHoldsCreateUnprocessableEntity struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds create unprocessable entity response has a 2xx status code
+func (o *SeatHoldsCreateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds create unprocessable entity response has a 3xx status code
+func (o *SeatHoldsCreateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds create unprocessable entity response has a 4xx status code
+func (o *SeatHoldsCreateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat holds create unprocessable entity response has a 5xx status code
+func (o *SeatHoldsCreateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat holds create unprocessable entity response a status code equal to that given
+func (o *SeatHoldsCreateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the seat holds create unprocessable entity response
+func (o *SeatHoldsCreateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SeatHoldsCreateUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateUnprocessableEntity %s", 422, payload)
+}
+
+func (o *SeatHoldsCreateUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateUnprocessableEntity %s", 422, payload)
+}
+
+func (o *SeatHoldsCreateUnprocessableEntity) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsCreateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatHoldsCreateInternalServerError creates a SeatHoldsCreateInternalServerError with default headers values
+func NewSeatHoldsCreateInternalServerError() *SeatHoldsCreateInternalServerError {
+	return &SeatHoldsCreateInternalServerError{}
+}
+
+/*
+SeatHoldsCreateInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type SeatHoldsCreateInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds create internal server error response has a 2xx status code
+func (o *SeatHoldsCreateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds create internal server error response has a 3xx status code
+func (o *SeatHoldsCreateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds create internal server error response has a 4xx status code
+func (o *SeatHoldsCreateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this seat holds create internal server error response has a 5xx status code
+func (o *SeatHoldsCreateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this seat holds create internal server error response a status code equal to that given
+func (o *SeatHoldsCreateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the seat holds create internal server error response
+func (o *SeatHoldsCreateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SeatHoldsCreateInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateInternalServerError %s", 500, payload)
+}
+
+func (o *SeatHoldsCreateInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateInternalServerError %s", 500, payload)
+}
+
+func (o *SeatHoldsCreateInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatHoldsCreateServiceUnavailable creates a SeatHoldsCreateServiceUnavailable with default headers values
+func NewSeatHoldsCreateServiceUnavailable() *SeatHoldsCreateServiceUnavailable {
+	return &SeatHoldsCreateServiceUnavailable{}
+}
+
+/*
+SeatHoldsCreateServiceUnavailable describes a response with status code 503, with default header values.
+
+Service Unavailable
+*/
+type SeatHoldsCreateServiceUnavailable struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds create service unavailable response has a 2xx status code
+func (o *SeatHoldsCreateServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds create service unavailable response has a 3xx status code
+func (o *SeatHoldsCreateServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds create service unavailable response has a 4xx status code
+func (o *SeatHoldsCreateServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this seat holds create service unavailable response has a 5xx status code
+func (o *SeatHoldsCreateServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this seat holds create service unavailable response a status code equal to that given
+func (o *SeatHoldsCreateServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the seat holds create service unavailable response
+func (o *SeatHoldsCreateServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *SeatHoldsCreateServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateServiceUnavailable %s", 503, payload)
+}
+
+func (o *SeatHoldsCreateServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/holds][%d] seatHoldsCreateServiceUnavailable %s", 503, payload)
+}
+
+func (o *SeatHoldsCreateServiceUnavailable) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsCreateServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
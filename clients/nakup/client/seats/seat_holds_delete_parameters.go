@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package seats
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSeatHoldsDeleteParams creates a new SeatHoldsDeleteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSeatHoldsDeleteParams() *SeatHoldsDeleteParams {
+	return &SeatHoldsDeleteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSeatHoldsDeleteParamsWithTimeout creates a new SeatHoldsDeleteParams object
+// with the ability to set a timeout on a request.
+func NewSeatHoldsDeleteParamsWithTimeout(timeout time.Duration) *SeatHoldsDeleteParams {
+	return &SeatHoldsDeleteParams{
+		timeout: timeout,
+	}
+}
+
+// NewSeatHoldsDeleteParamsWithContext creates a new SeatHoldsDeleteParams object
+// with the ability to set a context for a request.
+func NewSeatHoldsDeleteParamsWithContext(ctx context.Context) *SeatHoldsDeleteParams {
+	return &SeatHoldsDeleteParams{
+		Context: ctx,
+	}
+}
+
+// NewSeatHoldsDeleteParamsWithHTTPClient creates a new SeatHoldsDeleteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSeatHoldsDeleteParamsWithHTTPClient(client *http.Client) *SeatHoldsDeleteParams {
+	return &SeatHoldsDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SeatHoldsDeleteParams contains all the parameters to send to the API endpoint
+
+	for the seat holds delete operation.
+
+	Typically these are written to a http.Request.
+*/
+type SeatHoldsDeleteParams struct {
+
+	/* HoldID.
+
+	   Seat hold ID
+
+	   Format: uuid
+	*/
+	HoldID strfmt.UUID
+
+	/* TimeSlotID.
+
+	   Time slot ID
+
+	   Format: uuid
+	*/
+	TimeSlotID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the seat holds delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SeatHoldsDeleteParams) WithDefaults() *SeatHoldsDeleteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the seat holds delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SeatHoldsDeleteParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the seat holds delete params
+func (o *SeatHoldsDeleteParams) WithTimeout(timeout time.Duration) *SeatHoldsDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the seat holds delete params
+func (o *SeatHoldsDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the seat holds delete params
+func (o *SeatHoldsDeleteParams) WithContext(ctx context.Context) *SeatHoldsDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the seat holds delete params
+func (o *SeatHoldsDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the seat holds delete params
+func (o *SeatHoldsDeleteParams) WithHTTPClient(client *http.Client) *SeatHoldsDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the seat holds delete params
+func (o *SeatHoldsDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithHoldID adds the holdID to the seat holds delete params
+func (o *SeatHoldsDeleteParams) WithHoldID(holdID strfmt.UUID) *SeatHoldsDeleteParams {
+	o.SetHoldID(holdID)
+	return o
+}
+
+// SetHoldID adds the holdId to the seat holds delete params
+func (o *SeatHoldsDeleteParams) SetHoldID(holdID strfmt.UUID) {
+	o.HoldID = holdID
+}
+
+// WithTimeSlotID adds the timeSlotID to the seat holds delete params
+func (o *SeatHoldsDeleteParams) WithTimeSlotID(timeSlotID strfmt.UUID) *SeatHoldsDeleteParams {
+	o.SetTimeSlotID(timeSlotID)
+	return o
+}
+
+// SetTimeSlotID adds the timeSlotId to the seat holds delete params
+func (o *SeatHoldsDeleteParams) SetTimeSlotID(timeSlotID strfmt.UUID) {
+	o.TimeSlotID = timeSlotID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SeatHoldsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param holdID
+	if err := r.SetPathParam("holdID", o.HoldID.String()); err != nil {
+		return err
+	}
+
+	// path param timeSlotID
+	if err := r.SetPathParam("timeSlotID", o.TimeSlotID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
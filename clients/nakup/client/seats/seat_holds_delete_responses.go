@@ -0,0 +1,473 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package seats
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// SeatHoldsDeleteReader is a Reader for the SeatHoldsDelete structure.
+type SeatHoldsDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SeatHoldsDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 204:
+		result := NewSeatHoldsDeleteNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSeatHoldsDeleteBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewSeatHoldsDeleteUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSeatHoldsDeleteForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSeatHoldsDeleteNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSeatHoldsDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /timeslots/{timeSlotID}/holds/{holdID}] SeatHoldsDelete", response, response.Code())
+	}
+}
+
+// NewSeatHoldsDeleteNoContent creates a SeatHoldsDeleteNoContent with default headers values
+func NewSeatHoldsDeleteNoContent() *SeatHoldsDeleteNoContent {
+	return &SeatHoldsDeleteNoContent{}
+}
+
+/*
+SeatHoldsDeleteNoContent describes a response with status code 204, with default header values.
+
+No Content
+*/
+type SeatHoldsDeleteNoContent struct {
+}
+
+// IsSuccess returns true when this seat holds delete no content response has a 2xx status code
+func (o *SeatHoldsDeleteNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this seat holds delete no content response has a 3xx status code
+func (o *SeatHoldsDeleteNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds delete no content response has a 4xx status code
+func (o *SeatHoldsDeleteNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this seat holds delete no content response has a 5xx status code
+func (o *SeatHoldsDeleteNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat holds delete no content response a status code equal to that given
+func (o *SeatHoldsDeleteNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the seat holds delete no content response
+func (o *SeatHoldsDeleteNoContent) Code() int {
+	return 204
+}
+
+func (o *SeatHoldsDeleteNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteNoContent", 204)
+}
+
+func (o *SeatHoldsDeleteNoContent) String() string {
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteNoContent", 204)
+}
+
+func (o *SeatHoldsDeleteNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSeatHoldsDeleteBadRequest creates a SeatHoldsDeleteBadRequest with default headers values
+func NewSeatHoldsDeleteBadRequest() *SeatHoldsDeleteBadRequest {
+	return &SeatHoldsDeleteBadRequest{}
+}
+
+/*
+SeatHoldsDeleteBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type SeatHoldsDeleteBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds delete bad request response has a 2xx status code
+func (o *SeatHoldsDeleteBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds delete bad request response has a 3xx status code
+func (o *SeatHoldsDeleteBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds delete bad request response has a 4xx status code
+func (o *SeatHoldsDeleteBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat holds delete bad request response has a 5xx status code
+func (o *SeatHoldsDeleteBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat holds delete bad request response a status code equal to that given
+func (o *SeatHoldsDeleteBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the seat holds delete bad request response
+func (o *SeatHoldsDeleteBadRequest) Code() int {
+	return 400
+}
+
+func (o *SeatHoldsDeleteBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteBadRequest %s", 400, payload)
+}
+
+func (o *SeatHoldsDeleteBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteBadRequest %s", 400, payload)
+}
+
+func (o *SeatHoldsDeleteBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsDeleteBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatHoldsDeleteUnauthorized creates a SeatHoldsDeleteUnauthorized with default headers values
+func NewSeatHoldsDeleteUnauthorized() *SeatHoldsDeleteUnauthorized {
+	return &SeatHoldsDeleteUnauthorized{}
+}
+
+/*
+SeatHoldsDeleteUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type SeatHoldsDeleteUnauthorized struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds delete unauthorized response has a 2xx status code
+func (o *SeatHoldsDeleteUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds delete unauthorized response has a 3xx status code
+func (o *SeatHoldsDeleteUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds delete unauthorized response has a 4xx status code
+func (o *SeatHoldsDeleteUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat holds delete unauthorized response has a 5xx status code
+func (o *SeatHoldsDeleteUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat holds delete unauthorized response a status code equal to that given
+func (o *SeatHoldsDeleteUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the seat holds delete unauthorized response
+func (o *SeatHoldsDeleteUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SeatHoldsDeleteUnauthorized) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteUnauthorized %s", 401, payload)
+}
+
+func (o *SeatHoldsDeleteUnauthorized) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteUnauthorized %s", 401, payload)
+}
+
+func (o *SeatHoldsDeleteUnauthorized) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsDeleteUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatHoldsDeleteForbidden creates a SeatHoldsDeleteForbidden with default headers values
+func NewSeatHoldsDeleteForbidden() *SeatHoldsDeleteForbidden {
+	return &SeatHoldsDeleteForbidden{}
+}
+
+/*
+SeatHoldsDeleteForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SeatHoldsDeleteForbidden struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds delete forbidden response has a 2xx status code
+func (o *SeatHoldsDeleteForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds delete forbidden response has a 3xx status code
+func (o *SeatHoldsDeleteForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds delete forbidden response has a 4xx status code
+func (o *SeatHoldsDeleteForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat holds delete forbidden response has a 5xx status code
+func (o *SeatHoldsDeleteForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat holds delete forbidden response a status code equal to that given
+func (o *SeatHoldsDeleteForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the seat holds delete forbidden response
+func (o *SeatHoldsDeleteForbidden) Code() int {
+	return 403
+}
+
+func (o *SeatHoldsDeleteForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteForbidden %s", 403, payload)
+}
+
+func (o *SeatHoldsDeleteForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteForbidden %s", 403, payload)
+}
+
+func (o *SeatHoldsDeleteForbidden) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsDeleteForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatHoldsDeleteNotFound creates a SeatHoldsDeleteNotFound with default headers values
+func NewSeatHoldsDeleteNotFound() *SeatHoldsDeleteNotFound {
+	return &SeatHoldsDeleteNotFound{}
+}
+
+/*
+SeatHoldsDeleteNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type SeatHoldsDeleteNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds delete not found response has a 2xx status code
+func (o *SeatHoldsDeleteNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds delete not found response has a 3xx status code
+func (o *SeatHoldsDeleteNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds delete not found response has a 4xx status code
+func (o *SeatHoldsDeleteNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat holds delete not found response has a 5xx status code
+func (o *SeatHoldsDeleteNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat holds delete not found response a status code equal to that given
+func (o *SeatHoldsDeleteNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the seat holds delete not found response
+func (o *SeatHoldsDeleteNotFound) Code() int {
+	return 404
+}
+
+func (o *SeatHoldsDeleteNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteNotFound %s", 404, payload)
+}
+
+func (o *SeatHoldsDeleteNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteNotFound %s", 404, payload)
+}
+
+func (o *SeatHoldsDeleteNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsDeleteNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatHoldsDeleteInternalServerError creates a SeatHoldsDeleteInternalServerError with default headers values
+func NewSeatHoldsDeleteInternalServerError() *SeatHoldsDeleteInternalServerError {
+	return &SeatHoldsDeleteInternalServerError{}
+}
+
+/*
+SeatHoldsDeleteInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type SeatHoldsDeleteInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat holds delete internal server error response has a 2xx status code
+func (o *SeatHoldsDeleteInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat holds delete internal server error response has a 3xx status code
+func (o *SeatHoldsDeleteInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat holds delete internal server error response has a 4xx status code
+func (o *SeatHoldsDeleteInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this seat holds delete internal server error response has a 5xx status code
+func (o *SeatHoldsDeleteInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this seat holds delete internal server error response a status code equal to that given
+func (o *SeatHoldsDeleteInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the seat holds delete internal server error response
+func (o *SeatHoldsDeleteInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SeatHoldsDeleteInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteInternalServerError %s", 500, payload)
+}
+
+func (o *SeatHoldsDeleteInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /timeslots/{timeSlotID}/holds/{holdID}][%d] seatHoldsDeleteInternalServerError %s", 500, payload)
+}
+
+func (o *SeatHoldsDeleteInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatHoldsDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
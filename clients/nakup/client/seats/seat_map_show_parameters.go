@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package seats
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSeatMapShowParams creates a new SeatMapShowParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSeatMapShowParams() *SeatMapShowParams {
+	return &SeatMapShowParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSeatMapShowParamsWithTimeout creates a new SeatMapShowParams object
+// with the ability to set a timeout on a request.
+func NewSeatMapShowParamsWithTimeout(timeout time.Duration) *SeatMapShowParams {
+	return &SeatMapShowParams{
+		timeout: timeout,
+	}
+}
+
+// NewSeatMapShowParamsWithContext creates a new SeatMapShowParams object
+// with the ability to set a context for a request.
+func NewSeatMapShowParamsWithContext(ctx context.Context) *SeatMapShowParams {
+	return &SeatMapShowParams{
+		Context: ctx,
+	}
+}
+
+// NewSeatMapShowParamsWithHTTPClient creates a new SeatMapShowParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSeatMapShowParamsWithHTTPClient(client *http.Client) *SeatMapShowParams {
+	return &SeatMapShowParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SeatMapShowParams contains all the parameters to send to the API endpoint
+
+	for the seat map show operation.
+
+	Typically these are written to a http.Request.
+*/
+type SeatMapShowParams struct {
+
+	/* TimeSlotID.
+
+	   Time slot ID
+
+	   Format: uuid
+	*/
+	TimeSlotID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the seat map show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SeatMapShowParams) WithDefaults() *SeatMapShowParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the seat map show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SeatMapShowParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the seat map show params
+func (o *SeatMapShowParams) WithTimeout(timeout time.Duration) *SeatMapShowParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the seat map show params
+func (o *SeatMapShowParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the seat map show params
+func (o *SeatMapShowParams) WithContext(ctx context.Context) *SeatMapShowParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the seat map show params
+func (o *SeatMapShowParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the seat map show params
+func (o *SeatMapShowParams) WithHTTPClient(client *http.Client) *SeatMapShowParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the seat map show params
+func (o *SeatMapShowParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithTimeSlotID adds the timeSlotID to the seat map show params
+func (o *SeatMapShowParams) WithTimeSlotID(timeSlotID strfmt.UUID) *SeatMapShowParams {
+	o.SetTimeSlotID(timeSlotID)
+	return o
+}
+
+// SetTimeSlotID adds the timeSlotId to the seat map show params
+func (o *SeatMapShowParams) SetTimeSlotID(timeSlotID strfmt.UUID) {
+	o.TimeSlotID = timeSlotID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SeatMapShowParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param timeSlotID
+	if err := r.SetPathParam("timeSlotID", o.TimeSlotID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,411 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package seats
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// SeatMapShowReader is a Reader for the SeatMapShow structure.
+type SeatMapShowReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SeatMapShowReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSeatMapShowOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSeatMapShowBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewSeatMapShowUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSeatMapShowForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSeatMapShowInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /timeslots/{timeSlotID}/seats] SeatMapShow", response, response.Code())
+	}
+}
+
+// NewSeatMapShowOK creates a SeatMapShowOK with default headers values
+func NewSeatMapShowOK() *SeatMapShowOK {
+	return &SeatMapShowOK{}
+}
+
+/*
+SeatMapShowOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type SeatMapShowOK struct {
+	Payload *models.APISeatMapResponse
+}
+
+// IsSuccess returns true when this seat map show o k response has a 2xx status code
+func (o *SeatMapShowOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this seat map show o k response has a 3xx status code
+func (o *SeatMapShowOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map show o k response has a 4xx status code
+func (o *SeatMapShowOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this seat map show o k response has a 5xx status code
+func (o *SeatMapShowOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map show o k response a status code equal to that given
+func (o *SeatMapShowOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the seat map show o k response
+func (o *SeatMapShowOK) Code() int {
+	return 200
+}
+
+func (o *SeatMapShowOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats][%d] seatMapShowOK %s", 200, payload)
+}
+
+func (o *SeatMapShowOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats][%d] seatMapShowOK %s", 200, payload)
+}
+
+func (o *SeatMapShowOK) GetPayload() *models.APISeatMapResponse {
+	return o.Payload
+}
+
+func (o *SeatMapShowOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APISeatMapResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapShowBadRequest creates a SeatMapShowBadRequest with default headers values
+func NewSeatMapShowBadRequest() *SeatMapShowBadRequest {
+	return &SeatMapShowBadRequest{}
+}
+
+/*
+SeatMapShowBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type SeatMapShowBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map show bad request response has a 2xx status code
+func (o *SeatMapShowBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map show bad request response has a 3xx status code
+func (o *SeatMapShowBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map show bad request response has a 4xx status code
+func (o *SeatMapShowBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat map show bad request response has a 5xx status code
+func (o *SeatMapShowBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map show bad request response a status code equal to that given
+func (o *SeatMapShowBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the seat map show bad request response
+func (o *SeatMapShowBadRequest) Code() int {
+	return 400
+}
+
+func (o *SeatMapShowBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats][%d] seatMapShowBadRequest %s", 400, payload)
+}
+
+func (o *SeatMapShowBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats][%d] seatMapShowBadRequest %s", 400, payload)
+}
+
+func (o *SeatMapShowBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapShowBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapShowUnauthorized creates a SeatMapShowUnauthorized with default headers values
+func NewSeatMapShowUnauthorized() *SeatMapShowUnauthorized {
+	return &SeatMapShowUnauthorized{}
+}
+
+/*
+SeatMapShowUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type SeatMapShowUnauthorized struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map show unauthorized response has a 2xx status code
+func (o *SeatMapShowUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map show unauthorized response has a 3xx status code
+func (o *SeatMapShowUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map show unauthorized response has a 4xx status code
+func (o *SeatMapShowUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat map show unauthorized response has a 5xx status code
+func (o *SeatMapShowUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map show unauthorized response a status code equal to that given
+func (o *SeatMapShowUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the seat map show unauthorized response
+func (o *SeatMapShowUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SeatMapShowUnauthorized) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats][%d] seatMapShowUnauthorized %s", 401, payload)
+}
+
+func (o *SeatMapShowUnauthorized) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats][%d] seatMapShowUnauthorized %s", 401, payload)
+}
+
+func (o *SeatMapShowUnauthorized) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapShowUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapShowForbidden creates a SeatMapShowForbidden with default headers values
+func NewSeatMapShowForbidden() *SeatMapShowForbidden {
+	return &SeatMapShowForbidden{}
+}
+
+/*
+SeatMapShowForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SeatMapShowForbidden struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map show forbidden response has a 2xx status code
+func (o *SeatMapShowForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map show forbidden response has a 3xx status code
+func (o *SeatMapShowForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map show forbidden response has a 4xx status code
+func (o *SeatMapShowForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat map show forbidden response has a 5xx status code
+func (o *SeatMapShowForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map show forbidden response a status code equal to that given
+func (o *SeatMapShowForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the seat map show forbidden response
+func (o *SeatMapShowForbidden) Code() int {
+	return 403
+}
+
+func (o *SeatMapShowForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats][%d] seatMapShowForbidden %s", 403, payload)
+}
+
+func (o *SeatMapShowForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats][%d] seatMapShowForbidden %s", 403, payload)
+}
+
+func (o *SeatMapShowForbidden) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapShowForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapShowInternalServerError creates a SeatMapShowInternalServerError with default headers values
+func NewSeatMapShowInternalServerError() *SeatMapShowInternalServerError {
+	return &SeatMapShowInternalServerError{}
+}
+
+/*
+SeatMapShowInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type SeatMapShowInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map show internal server error response has a 2xx status code
+func (o *SeatMapShowInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map show internal server error response has a 3xx status code
+func (o *SeatMapShowInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map show internal server error response has a 4xx status code
+func (o *SeatMapShowInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this seat map show internal server error response has a 5xx status code
+func (o *SeatMapShowInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this seat map show internal server error response a status code equal to that given
+func (o *SeatMapShowInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the seat map show internal server error response
+func (o *SeatMapShowInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SeatMapShowInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats][%d] seatMapShowInternalServerError %s", 500, payload)
+}
+
+func (o *SeatMapShowInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats][%d] seatMapShowInternalServerError %s", 500, payload)
+}
+
+func (o *SeatMapShowInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapShowInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
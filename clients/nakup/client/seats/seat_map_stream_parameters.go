@@ -0,0 +1,187 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package seats
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSeatMapStreamParams creates a new SeatMapStreamParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSeatMapStreamParams() *SeatMapStreamParams {
+	return &SeatMapStreamParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSeatMapStreamParamsWithTimeout creates a new SeatMapStreamParams object
+// with the ability to set a timeout on a request.
+func NewSeatMapStreamParamsWithTimeout(timeout time.Duration) *SeatMapStreamParams {
+	return &SeatMapStreamParams{
+		timeout: timeout,
+	}
+}
+
+// NewSeatMapStreamParamsWithContext creates a new SeatMapStreamParams object
+// with the ability to set a context for a request.
+func NewSeatMapStreamParamsWithContext(ctx context.Context) *SeatMapStreamParams {
+	return &SeatMapStreamParams{
+		Context: ctx,
+	}
+}
+
+// NewSeatMapStreamParamsWithHTTPClient creates a new SeatMapStreamParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSeatMapStreamParamsWithHTTPClient(client *http.Client) *SeatMapStreamParams {
+	return &SeatMapStreamParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SeatMapStreamParams contains all the parameters to send to the API endpoint
+
+	for the seat map stream operation.
+
+	Typically these are written to a http.Request.
+*/
+type SeatMapStreamParams struct {
+
+	/* Ticket.
+
+	   Stream ticket, for clients such as EventSource that cannot send headers
+	*/
+	Ticket *string
+
+	/* TimeSlotID.
+
+	   Time slot ID
+
+	   Format: uuid
+	*/
+	TimeSlotID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the seat map stream params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SeatMapStreamParams) WithDefaults() *SeatMapStreamParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the seat map stream params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SeatMapStreamParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the seat map stream params
+func (o *SeatMapStreamParams) WithTimeout(timeout time.Duration) *SeatMapStreamParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the seat map stream params
+func (o *SeatMapStreamParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the seat map stream params
+func (o *SeatMapStreamParams) WithContext(ctx context.Context) *SeatMapStreamParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the seat map stream params
+func (o *SeatMapStreamParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the seat map stream params
+func (o *SeatMapStreamParams) WithHTTPClient(client *http.Client) *SeatMapStreamParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the seat map stream params
+func (o *SeatMapStreamParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithTicket adds the ticket to the seat map stream params
+func (o *SeatMapStreamParams) WithTicket(ticket *string) *SeatMapStreamParams {
+	o.SetTicket(ticket)
+	return o
+}
+
+// SetTicket adds the ticket to the seat map stream params
+func (o *SeatMapStreamParams) SetTicket(ticket *string) {
+	o.Ticket = ticket
+}
+
+// WithTimeSlotID adds the timeSlotID to the seat map stream params
+func (o *SeatMapStreamParams) WithTimeSlotID(timeSlotID strfmt.UUID) *SeatMapStreamParams {
+	o.SetTimeSlotID(timeSlotID)
+	return o
+}
+
+// SetTimeSlotID adds the timeSlotId to the seat map stream params
+func (o *SeatMapStreamParams) SetTimeSlotID(timeSlotID strfmt.UUID) {
+	o.TimeSlotID = timeSlotID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SeatMapStreamParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Ticket != nil {
+
+		// query param ticket
+		var qrTicket string
+
+		if o.Ticket != nil {
+			qrTicket = *o.Ticket
+		}
+		qTicket := qrTicket
+		if qTicket != "" {
+
+			if err := r.SetQueryParam("ticket", qTicket); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param timeSlotID
+	if err := r.SetPathParam("timeSlotID", o.TimeSlotID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
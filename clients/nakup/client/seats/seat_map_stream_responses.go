@@ -0,0 +1,411 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package seats
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// SeatMapStreamReader is a Reader for the SeatMapStream structure.
+type SeatMapStreamReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SeatMapStreamReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSeatMapStreamOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSeatMapStreamBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewSeatMapStreamUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSeatMapStreamForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSeatMapStreamInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /timeslots/{timeSlotID}/seats/stream] SeatMapStream", response, response.Code())
+	}
+}
+
+// NewSeatMapStreamOK creates a SeatMapStreamOK with default headers values
+func NewSeatMapStreamOK() *SeatMapStreamOK {
+	return &SeatMapStreamOK{}
+}
+
+/*
+SeatMapStreamOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type SeatMapStreamOK struct {
+	Payload *models.APISeatChangeResponse
+}
+
+// IsSuccess returns true when this seat map stream o k response has a 2xx status code
+func (o *SeatMapStreamOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this seat map stream o k response has a 3xx status code
+func (o *SeatMapStreamOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map stream o k response has a 4xx status code
+func (o *SeatMapStreamOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this seat map stream o k response has a 5xx status code
+func (o *SeatMapStreamOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map stream o k response a status code equal to that given
+func (o *SeatMapStreamOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the seat map stream o k response
+func (o *SeatMapStreamOK) Code() int {
+	return 200
+}
+
+func (o *SeatMapStreamOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats/stream][%d] seatMapStreamOK %s", 200, payload)
+}
+
+func (o *SeatMapStreamOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats/stream][%d] seatMapStreamOK %s", 200, payload)
+}
+
+func (o *SeatMapStreamOK) GetPayload() *models.APISeatChangeResponse {
+	return o.Payload
+}
+
+func (o *SeatMapStreamOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APISeatChangeResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapStreamBadRequest creates a SeatMapStreamBadRequest with default headers values
+func NewSeatMapStreamBadRequest() *SeatMapStreamBadRequest {
+	return &SeatMapStreamBadRequest{}
+}
+
+/*
+SeatMapStreamBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type SeatMapStreamBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map stream bad request response has a 2xx status code
+func (o *SeatMapStreamBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map stream bad request response has a 3xx status code
+func (o *SeatMapStreamBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map stream bad request response has a 4xx status code
+func (o *SeatMapStreamBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat map stream bad request response has a 5xx status code
+func (o *SeatMapStreamBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map stream bad request response a status code equal to that given
+func (o *SeatMapStreamBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the seat map stream bad request response
+func (o *SeatMapStreamBadRequest) Code() int {
+	return 400
+}
+
+func (o *SeatMapStreamBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats/stream][%d] seatMapStreamBadRequest %s", 400, payload)
+}
+
+func (o *SeatMapStreamBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats/stream][%d] seatMapStreamBadRequest %s", 400, payload)
+}
+
+func (o *SeatMapStreamBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapStreamBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapStreamUnauthorized creates a SeatMapStreamUnauthorized with default headers values
+func NewSeatMapStreamUnauthorized() *SeatMapStreamUnauthorized {
+	return &SeatMapStreamUnauthorized{}
+}
+
+/*
+SeatMapStreamUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type SeatMapStreamUnauthorized struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map stream unauthorized response has a 2xx status code
+func (o *SeatMapStreamUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map stream unauthorized response has a 3xx status code
+func (o *SeatMapStreamUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map stream unauthorized response has a 4xx status code
+func (o *SeatMapStreamUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat map stream unauthorized response has a 5xx status code
+func (o *SeatMapStreamUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map stream unauthorized response a status code equal to that given
+func (o *SeatMapStreamUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the seat map stream unauthorized response
+func (o *SeatMapStreamUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SeatMapStreamUnauthorized) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats/stream][%d] seatMapStreamUnauthorized %s", 401, payload)
+}
+
+func (o *SeatMapStreamUnauthorized) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats/stream][%d] seatMapStreamUnauthorized %s", 401, payload)
+}
+
+func (o *SeatMapStreamUnauthorized) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapStreamUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapStreamForbidden creates a SeatMapStreamForbidden with default headers values
+func NewSeatMapStreamForbidden() *SeatMapStreamForbidden {
+	return &SeatMapStreamForbidden{}
+}
+
+/*
+SeatMapStreamForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SeatMapStreamForbidden struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map stream forbidden response has a 2xx status code
+func (o *SeatMapStreamForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map stream forbidden response has a 3xx status code
+func (o *SeatMapStreamForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map stream forbidden response has a 4xx status code
+func (o *SeatMapStreamForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat map stream forbidden response has a 5xx status code
+func (o *SeatMapStreamForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map stream forbidden response a status code equal to that given
+func (o *SeatMapStreamForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the seat map stream forbidden response
+func (o *SeatMapStreamForbidden) Code() int {
+	return 403
+}
+
+func (o *SeatMapStreamForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats/stream][%d] seatMapStreamForbidden %s", 403, payload)
+}
+
+func (o *SeatMapStreamForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats/stream][%d] seatMapStreamForbidden %s", 403, payload)
+}
+
+func (o *SeatMapStreamForbidden) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapStreamForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapStreamInternalServerError creates a SeatMapStreamInternalServerError with default headers values
+func NewSeatMapStreamInternalServerError() *SeatMapStreamInternalServerError {
+	return &SeatMapStreamInternalServerError{}
+}
+
+/*
+SeatMapStreamInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type SeatMapStreamInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map stream internal server error response has a 2xx status code
+func (o *SeatMapStreamInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map stream internal server error response has a 3xx status code
+func (o *SeatMapStreamInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map stream internal server error response has a 4xx status code
+func (o *SeatMapStreamInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this seat map stream internal server error response has a 5xx status code
+func (o *SeatMapStreamInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this seat map stream internal server error response a status code equal to that given
+func (o *SeatMapStreamInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the seat map stream internal server error response
+func (o *SeatMapStreamInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SeatMapStreamInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats/stream][%d] seatMapStreamInternalServerError %s", 500, payload)
+}
+
+func (o *SeatMapStreamInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /timeslots/{timeSlotID}/seats/stream][%d] seatMapStreamInternalServerError %s", 500, payload)
+}
+
+func (o *SeatMapStreamInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapStreamInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
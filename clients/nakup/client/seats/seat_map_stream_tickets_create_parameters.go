@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package seats
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSeatMapStreamTicketsCreateParams creates a new SeatMapStreamTicketsCreateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSeatMapStreamTicketsCreateParams() *SeatMapStreamTicketsCreateParams {
+	return &SeatMapStreamTicketsCreateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSeatMapStreamTicketsCreateParamsWithTimeout creates a new SeatMapStreamTicketsCreateParams object
+// with the ability to set a timeout on a request.
+func NewSeatMapStreamTicketsCreateParamsWithTimeout(timeout time.Duration) *SeatMapStreamTicketsCreateParams {
+	return &SeatMapStreamTicketsCreateParams{
+		timeout: timeout,
+	}
+}
+
+// NewSeatMapStreamTicketsCreateParamsWithContext creates a new SeatMapStreamTicketsCreateParams object
+// with the ability to set a context for a request.
+func NewSeatMapStreamTicketsCreateParamsWithContext(ctx context.Context) *SeatMapStreamTicketsCreateParams {
+	return &SeatMapStreamTicketsCreateParams{
+		Context: ctx,
+	}
+}
+
+// NewSeatMapStreamTicketsCreateParamsWithHTTPClient creates a new SeatMapStreamTicketsCreateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSeatMapStreamTicketsCreateParamsWithHTTPClient(client *http.Client) *SeatMapStreamTicketsCreateParams {
+	return &SeatMapStreamTicketsCreateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SeatMapStreamTicketsCreateParams contains all the parameters to send to the API endpoint
+
+	for the seat map stream tickets create operation.
+
+	Typically these are written to a http.Request.
+*/
+type SeatMapStreamTicketsCreateParams struct {
+
+	/* TimeSlotID.
+
+	   Time slot ID
+
+	   Format: uuid
+	*/
+	TimeSlotID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the seat map stream tickets create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SeatMapStreamTicketsCreateParams) WithDefaults() *SeatMapStreamTicketsCreateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the seat map stream tickets create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SeatMapStreamTicketsCreateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the seat map stream tickets create params
+func (o *SeatMapStreamTicketsCreateParams) WithTimeout(timeout time.Duration) *SeatMapStreamTicketsCreateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the seat map stream tickets create params
+func (o *SeatMapStreamTicketsCreateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the seat map stream tickets create params
+func (o *SeatMapStreamTicketsCreateParams) WithContext(ctx context.Context) *SeatMapStreamTicketsCreateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the seat map stream tickets create params
+func (o *SeatMapStreamTicketsCreateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the seat map stream tickets create params
+func (o *SeatMapStreamTicketsCreateParams) WithHTTPClient(client *http.Client) *SeatMapStreamTicketsCreateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the seat map stream tickets create params
+func (o *SeatMapStreamTicketsCreateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithTimeSlotID adds the timeSlotID to the seat map stream tickets create params
+func (o *SeatMapStreamTicketsCreateParams) WithTimeSlotID(timeSlotID strfmt.UUID) *SeatMapStreamTicketsCreateParams {
+	o.SetTimeSlotID(timeSlotID)
+	return o
+}
+
+// SetTimeSlotID adds the timeSlotId to the seat map stream tickets create params
+func (o *SeatMapStreamTicketsCreateParams) SetTimeSlotID(timeSlotID strfmt.UUID) {
+	o.TimeSlotID = timeSlotID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SeatMapStreamTicketsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param timeSlotID
+	if err := r.SetPathParam("timeSlotID", o.TimeSlotID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,411 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package seats
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// SeatMapStreamTicketsCreateReader is a Reader for the SeatMapStreamTicketsCreate structure.
+type SeatMapStreamTicketsCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SeatMapStreamTicketsCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 201:
+		result := NewSeatMapStreamTicketsCreateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSeatMapStreamTicketsCreateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewSeatMapStreamTicketsCreateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSeatMapStreamTicketsCreateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSeatMapStreamTicketsCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /timeslots/{timeSlotID}/seats/stream/tickets] SeatMapStreamTicketsCreate", response, response.Code())
+	}
+}
+
+// NewSeatMapStreamTicketsCreateCreated creates a SeatMapStreamTicketsCreateCreated with default headers values
+func NewSeatMapStreamTicketsCreateCreated() *SeatMapStreamTicketsCreateCreated {
+	return &SeatMapStreamTicketsCreateCreated{}
+}
+
+/*
+SeatMapStreamTicketsCreateCreated describes a response with status code 201, with default header values.
+
+Created
+*/
+type SeatMapStreamTicketsCreateCreated struct {
+	Payload *models.APISeatMapStreamTicketResponse
+}
+
+// IsSuccess returns true when this seat map stream tickets create created response has a 2xx status code
+func (o *SeatMapStreamTicketsCreateCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this seat map stream tickets create created response has a 3xx status code
+func (o *SeatMapStreamTicketsCreateCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map stream tickets create created response has a 4xx status code
+func (o *SeatMapStreamTicketsCreateCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this seat map stream tickets create created response has a 5xx status code
+func (o *SeatMapStreamTicketsCreateCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map stream tickets create created response a status code equal to that given
+func (o *SeatMapStreamTicketsCreateCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the seat map stream tickets create created response
+func (o *SeatMapStreamTicketsCreateCreated) Code() int {
+	return 201
+}
+
+func (o *SeatMapStreamTicketsCreateCreated) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/seats/stream/tickets][%d] seatMapStreamTicketsCreateCreated %s", 201, payload)
+}
+
+func (o *SeatMapStreamTicketsCreateCreated) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/seats/stream/tickets][%d] seatMapStreamTicketsCreateCreated %s", 201, payload)
+}
+
+func (o *SeatMapStreamTicketsCreateCreated) GetPayload() *models.APISeatMapStreamTicketResponse {
+	return o.Payload
+}
+
+func (o *SeatMapStreamTicketsCreateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APISeatMapStreamTicketResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapStreamTicketsCreateBadRequest creates a SeatMapStreamTicketsCreateBadRequest with default headers values
+func NewSeatMapStreamTicketsCreateBadRequest() *SeatMapStreamTicketsCreateBadRequest {
+	return &SeatMapStreamTicketsCreateBadRequest{}
+}
+
+/*
+SeatMapStreamTicketsCreateBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type SeatMapStreamTicketsCreateBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map stream tickets create bad request response has a 2xx status code
+func (o *SeatMapStreamTicketsCreateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map stream tickets create bad request response has a 3xx status code
+func (o *SeatMapStreamTicketsCreateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map stream tickets create bad request response has a 4xx status code
+func (o *SeatMapStreamTicketsCreateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat map stream tickets create bad request response has a 5xx status code
+func (o *SeatMapStreamTicketsCreateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map stream tickets create bad request response a status code equal to that given
+func (o *SeatMapStreamTicketsCreateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the seat map stream tickets create bad request response
+func (o *SeatMapStreamTicketsCreateBadRequest) Code() int {
+	return 400
+}
+
+func (o *SeatMapStreamTicketsCreateBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/seats/stream/tickets][%d] seatMapStreamTicketsCreateBadRequest %s", 400, payload)
+}
+
+func (o *SeatMapStreamTicketsCreateBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/seats/stream/tickets][%d] seatMapStreamTicketsCreateBadRequest %s", 400, payload)
+}
+
+func (o *SeatMapStreamTicketsCreateBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapStreamTicketsCreateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapStreamTicketsCreateUnauthorized creates a SeatMapStreamTicketsCreateUnauthorized with default headers values
+func NewSeatMapStreamTicketsCreateUnauthorized() *SeatMapStreamTicketsCreateUnauthorized {
+	return &SeatMapStreamTicketsCreateUnauthorized{}
+}
+
+/*
+SeatMapStreamTicketsCreateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type SeatMapStreamTicketsCreateUnauthorized struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map stream tickets create unauthorized response has a 2xx status code
+func (o *SeatMapStreamTicketsCreateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map stream tickets create unauthorized response has a 3xx status code
+func (o *SeatMapStreamTicketsCreateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map stream tickets create unauthorized response has a 4xx status code
+func (o *SeatMapStreamTicketsCreateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat map stream tickets create unauthorized response has a 5xx status code
+func (o *SeatMapStreamTicketsCreateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map stream tickets create unauthorized response a status code equal to that given
+func (o *SeatMapStreamTicketsCreateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the seat map stream tickets create unauthorized response
+func (o *SeatMapStreamTicketsCreateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SeatMapStreamTicketsCreateUnauthorized) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/seats/stream/tickets][%d] seatMapStreamTicketsCreateUnauthorized %s", 401, payload)
+}
+
+func (o *SeatMapStreamTicketsCreateUnauthorized) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/seats/stream/tickets][%d] seatMapStreamTicketsCreateUnauthorized %s", 401, payload)
+}
+
+func (o *SeatMapStreamTicketsCreateUnauthorized) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapStreamTicketsCreateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapStreamTicketsCreateForbidden creates a SeatMapStreamTicketsCreateForbidden with default headers values
+func NewSeatMapStreamTicketsCreateForbidden() *SeatMapStreamTicketsCreateForbidden {
+	return &SeatMapStreamTicketsCreateForbidden{}
+}
+
+/*
+SeatMapStreamTicketsCreateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SeatMapStreamTicketsCreateForbidden struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map stream tickets create forbidden response has a 2xx status code
+func (o *SeatMapStreamTicketsCreateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map stream tickets create forbidden response has a 3xx status code
+func (o *SeatMapStreamTicketsCreateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map stream tickets create forbidden response has a 4xx status code
+func (o *SeatMapStreamTicketsCreateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this seat map stream tickets create forbidden response has a 5xx status code
+func (o *SeatMapStreamTicketsCreateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this seat map stream tickets create forbidden response a status code equal to that given
+func (o *SeatMapStreamTicketsCreateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the seat map stream tickets create forbidden response
+func (o *SeatMapStreamTicketsCreateForbidden) Code() int {
+	return 403
+}
+
+func (o *SeatMapStreamTicketsCreateForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/seats/stream/tickets][%d] seatMapStreamTicketsCreateForbidden %s", 403, payload)
+}
+
+func (o *SeatMapStreamTicketsCreateForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/seats/stream/tickets][%d] seatMapStreamTicketsCreateForbidden %s", 403, payload)
+}
+
+func (o *SeatMapStreamTicketsCreateForbidden) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapStreamTicketsCreateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSeatMapStreamTicketsCreateInternalServerError creates a SeatMapStreamTicketsCreateInternalServerError with default headers values
+func NewSeatMapStreamTicketsCreateInternalServerError() *SeatMapStreamTicketsCreateInternalServerError {
+	return &SeatMapStreamTicketsCreateInternalServerError{}
+}
+
+/*
+SeatMapStreamTicketsCreateInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type SeatMapStreamTicketsCreateInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this seat map stream tickets create internal server error response has a 2xx status code
+func (o *SeatMapStreamTicketsCreateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this seat map stream tickets create internal server error response has a 3xx status code
+func (o *SeatMapStreamTicketsCreateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this seat map stream tickets create internal server error response has a 4xx status code
+func (o *SeatMapStreamTicketsCreateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this seat map stream tickets create internal server error response has a 5xx status code
+func (o *SeatMapStreamTicketsCreateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this seat map stream tickets create internal server error response a status code equal to that given
+func (o *SeatMapStreamTicketsCreateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the seat map stream tickets create internal server error response
+func (o *SeatMapStreamTicketsCreateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SeatMapStreamTicketsCreateInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/seats/stream/tickets][%d] seatMapStreamTicketsCreateInternalServerError %s", 500, payload)
+}
+
+func (o *SeatMapStreamTicketsCreateInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /timeslots/{timeSlotID}/seats/stream/tickets][%d] seatMapStreamTicketsCreateInternalServerError %s", 500, payload)
+}
+
+func (o *SeatMapStreamTicketsCreateInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SeatMapStreamTicketsCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
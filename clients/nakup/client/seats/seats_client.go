@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package seats
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new seats API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+// New creates a new seats API client with basic auth credentials.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - user: user for basic authentication header.
+// - password: password for basic authentication header.
+func NewClientWithBasicAuth(host, basePath, scheme, user, password string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BasicAuth(user, password)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+// New creates a new seats API client with a bearer token for authentication.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - bearerToken: bearer token for Bearer authentication header.
+func NewClientWithBearerToken(host, basePath, scheme, bearerToken string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BearerToken(bearerToken)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+/*
+Client for seats API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption may be used to customize the behavior of Client methods.
+type ClientOption func(*runtime.ClientOperation)
+
+// This client is generated with a few options you might find useful for your swagger spec.
+//
+// Feel free to add you own set of options.
+
+// WithAccept allows the client to force the Accept header
+// to negotiate a specific Producer from the server.
+//
+// You may use this option to set arbitrary extensions to your MIME media type.
+func WithAccept(mime string) ClientOption {
+	return func(r *runtime.ClientOperation) {
+		r.ProducesMediaTypes = []string{mime}
+	}
+}
+
+// WithAcceptApplicationJSON sets the Accept header to "application/json".
+func WithAcceptApplicationJSON(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/json"}
+}
+
+// WithAcceptTextEventStream sets the Accept header to "text/event-stream".
+func WithAcceptTextEventStream(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"text/event-stream"}
+}
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	SeatHoldsCreate(params *SeatHoldsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SeatHoldsCreateCreated, error)
+
+	SeatHoldsDelete(params *SeatHoldsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SeatHoldsDeleteNoContent, error)
+
+	SeatMapShow(params *SeatMapShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SeatMapShowOK, error)
+
+	SeatMapStream(params *SeatMapStreamParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SeatMapStreamOK, error)
+
+	SeatMapStreamTicketsCreate(params *SeatMapStreamTicketsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SeatMapStreamTicketsCreateCreated, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+SeatHoldsCreate holds seat
+
+Hold a seat of a time slot while the customer completes the reservation. Nobody else can hold or reserve the seat until the hold expires, is released or is used up by reserving the seat. Holding a seat again renews the hold.
+*/
+func (a *Client) SeatHoldsCreate(params *SeatHoldsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SeatHoldsCreateCreated, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewSeatHoldsCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SeatHoldsCreate",
+		Method:             "POST",
+		PathPattern:        "/timeslots/{timeSlotID}/holds",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SeatHoldsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*SeatHoldsCreateCreated)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SeatHoldsCreate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SeatHoldsDelete releases seat hold
+
+Release a seat held by the user or API key making the request
+*/
+func (a *Client) SeatHoldsDelete(params *SeatHoldsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SeatHoldsDeleteNoContent, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewSeatHoldsDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SeatHoldsDelete",
+		Method:             "DELETE",
+		PathPattern:        "/timeslots/{timeSlotID}/holds/{holdID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SeatHoldsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*SeatHoldsDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SeatHoldsDelete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SeatMapShow shows seat map
+
+List the seats that are already reserved for a time slot and the seats that are held while a reservation is being made
+*/
+func (a *Client) SeatMapShow(params *SeatMapShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SeatMapShowOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewSeatMapShowParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SeatMapShow",
+		Method:             "GET",
+		PathPattern:        "/timeslots/{timeSlotID}/seats",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SeatMapShowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*SeatMapShowOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SeatMapShow: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SeatMapStream streams seat map
+
+Stream the reserved and held seats of a time slot as Server-Sent Events. The stream starts with a `snapshot` event carrying a SeatMapResponse, followed by a `seat` event carrying a SeatChangeResponse whenever a seat is reserved, held or released through any replica, including when a hold expires. Clients should reconnect when the stream ends. Browsers, whose EventSource cannot send headers, pass a stream ticket as `ticket` in the query instead.
+*/
+func (a *Client) SeatMapStream(params *SeatMapStreamParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SeatMapStreamOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewSeatMapStreamParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SeatMapStream",
+		Method:             "GET",
+		PathPattern:        "/timeslots/{timeSlotID}/seats/stream",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SeatMapStreamReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*SeatMapStreamOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SeatMapStream: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SeatMapStreamTicketsCreate creates seat stream ticket
+
+Issue a ticket that opens the seat stream of a time slot once, as the user or API key making the request, for clients such as EventSource that cannot send headers. The ticket expires after 30 seconds.
+*/
+func (a *Client) SeatMapStreamTicketsCreate(params *SeatMapStreamTicketsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SeatMapStreamTicketsCreateCreated, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewSeatMapStreamTicketsCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SeatMapStreamTicketsCreate",
+		Method:             "POST",
+		PathPattern:        "/timeslots/{timeSlotID}/seats/stream/tickets",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SeatMapStreamTicketsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*SeatMapStreamTicketsCreateCreated)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SeatMapStreamTicketsCreate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package spored
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSporedCachePurgeParams creates a new SporedCachePurgeParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSporedCachePurgeParams() *SporedCachePurgeParams {
+	return &SporedCachePurgeParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSporedCachePurgeParamsWithTimeout creates a new SporedCachePurgeParams object
+// with the ability to set a timeout on a request.
+func NewSporedCachePurgeParamsWithTimeout(timeout time.Duration) *SporedCachePurgeParams {
+	return &SporedCachePurgeParams{
+		timeout: timeout,
+	}
+}
+
+// NewSporedCachePurgeParamsWithContext creates a new SporedCachePurgeParams object
+// with the ability to set a context for a request.
+func NewSporedCachePurgeParamsWithContext(ctx context.Context) *SporedCachePurgeParams {
+	return &SporedCachePurgeParams{
+		Context: ctx,
+	}
+}
+
+// NewSporedCachePurgeParamsWithHTTPClient creates a new SporedCachePurgeParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSporedCachePurgeParamsWithHTTPClient(client *http.Client) *SporedCachePurgeParams {
+	return &SporedCachePurgeParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SporedCachePurgeParams contains all the parameters to send to the API endpoint
+
+	for the spored cache purge operation.
+
+	Typically these are written to a http.Request.
+*/
+type SporedCachePurgeParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the spored cache purge params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SporedCachePurgeParams) WithDefaults() *SporedCachePurgeParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the spored cache purge params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SporedCachePurgeParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the spored cache purge params
+func (o *SporedCachePurgeParams) WithTimeout(timeout time.Duration) *SporedCachePurgeParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the spored cache purge params
+func (o *SporedCachePurgeParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the spored cache purge params
+func (o *SporedCachePurgeParams) WithContext(ctx context.Context) *SporedCachePurgeParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the spored cache purge params
+func (o *SporedCachePurgeParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the spored cache purge params
+func (o *SporedCachePurgeParams) WithHTTPClient(client *http.Client) *SporedCachePurgeParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the spored cache purge params
+func (o *SporedCachePurgeParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SporedCachePurgeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
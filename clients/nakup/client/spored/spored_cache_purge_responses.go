@@ -0,0 +1,245 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package spored
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// SporedCachePurgeReader is a Reader for the SporedCachePurge structure.
+type SporedCachePurgeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SporedCachePurgeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 204:
+		result := NewSporedCachePurgeNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewSporedCachePurgeNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSporedCachePurgeInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /spored/cache] SporedCachePurge", response, response.Code())
+	}
+}
+
+// NewSporedCachePurgeNoContent creates a SporedCachePurgeNoContent with default headers values
+func NewSporedCachePurgeNoContent() *SporedCachePurgeNoContent {
+	return &SporedCachePurgeNoContent{}
+}
+
+/*
+SporedCachePurgeNoContent describes a response with status code 204, with default header values.
+
+No Content
+*/
+type SporedCachePurgeNoContent struct {
+}
+
+// IsSuccess returns true when this spored cache purge no content response has a 2xx status code
+func (o *SporedCachePurgeNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this spored cache purge no content response has a 3xx status code
+func (o *SporedCachePurgeNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored cache purge no content response has a 4xx status code
+func (o *SporedCachePurgeNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this spored cache purge no content response has a 5xx status code
+func (o *SporedCachePurgeNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this spored cache purge no content response a status code equal to that given
+func (o *SporedCachePurgeNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the spored cache purge no content response
+func (o *SporedCachePurgeNoContent) Code() int {
+	return 204
+}
+
+func (o *SporedCachePurgeNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /spored/cache][%d] sporedCachePurgeNoContent", 204)
+}
+
+func (o *SporedCachePurgeNoContent) String() string {
+	return fmt.Sprintf("[DELETE /spored/cache][%d] sporedCachePurgeNoContent", 204)
+}
+
+func (o *SporedCachePurgeNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSporedCachePurgeNotFound creates a SporedCachePurgeNotFound with default headers values
+func NewSporedCachePurgeNotFound() *SporedCachePurgeNotFound {
+	return &SporedCachePurgeNotFound{}
+}
+
+/*
+SporedCachePurgeNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type SporedCachePurgeNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this spored cache purge not found response has a 2xx status code
+func (o *SporedCachePurgeNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this spored cache purge not found response has a 3xx status code
+func (o *SporedCachePurgeNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored cache purge not found response has a 4xx status code
+func (o *SporedCachePurgeNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this spored cache purge not found response has a 5xx status code
+func (o *SporedCachePurgeNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this spored cache purge not found response a status code equal to that given
+func (o *SporedCachePurgeNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the spored cache purge not found response
+func (o *SporedCachePurgeNotFound) Code() int {
+	return 404
+}
+
+func (o *SporedCachePurgeNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /spored/cache][%d] sporedCachePurgeNotFound %s", 404, payload)
+}
+
+func (o *SporedCachePurgeNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /spored/cache][%d] sporedCachePurgeNotFound %s", 404, payload)
+}
+
+func (o *SporedCachePurgeNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SporedCachePurgeNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSporedCachePurgeInternalServerError creates a SporedCachePurgeInternalServerError with default headers values
+func NewSporedCachePurgeInternalServerError() *SporedCachePurgeInternalServerError {
+	return &SporedCachePurgeInternalServerError{}
+}
+
+/*
+SporedCachePurgeInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type SporedCachePurgeInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this spored cache purge internal server error response has a 2xx status code
+func (o *SporedCachePurgeInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this spored cache purge internal server error response has a 3xx status code
+func (o *SporedCachePurgeInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored cache purge internal server error response has a 4xx status code
+func (o *SporedCachePurgeInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this spored cache purge internal server error response has a 5xx status code
+func (o *SporedCachePurgeInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this spored cache purge internal server error response a status code equal to that given
+func (o *SporedCachePurgeInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the spored cache purge internal server error response
+func (o *SporedCachePurgeInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SporedCachePurgeInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /spored/cache][%d] sporedCachePurgeInternalServerError %s", 500, payload)
+}
+
+func (o *SporedCachePurgeInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /spored/cache][%d] sporedCachePurgeInternalServerError %s", 500, payload)
+}
+
+func (o *SporedCachePurgeInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SporedCachePurgeInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package spored
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSporedCacheStatsParams creates a new SporedCacheStatsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSporedCacheStatsParams() *SporedCacheStatsParams {
+	return &SporedCacheStatsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSporedCacheStatsParamsWithTimeout creates a new SporedCacheStatsParams object
+// with the ability to set a timeout on a request.
+func NewSporedCacheStatsParamsWithTimeout(timeout time.Duration) *SporedCacheStatsParams {
+	return &SporedCacheStatsParams{
+		timeout: timeout,
+	}
+}
+
+// NewSporedCacheStatsParamsWithContext creates a new SporedCacheStatsParams object
+// with the ability to set a context for a request.
+func NewSporedCacheStatsParamsWithContext(ctx context.Context) *SporedCacheStatsParams {
+	return &SporedCacheStatsParams{
+		Context: ctx,
+	}
+}
+
+// NewSporedCacheStatsParamsWithHTTPClient creates a new SporedCacheStatsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSporedCacheStatsParamsWithHTTPClient(client *http.Client) *SporedCacheStatsParams {
+	return &SporedCacheStatsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SporedCacheStatsParams contains all the parameters to send to the API endpoint
+
+	for the spored cache stats operation.
+
+	Typically these are written to a http.Request.
+*/
+type SporedCacheStatsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the spored cache stats params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SporedCacheStatsParams) WithDefaults() *SporedCacheStatsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the spored cache stats params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SporedCacheStatsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the spored cache stats params
+func (o *SporedCacheStatsParams) WithTimeout(timeout time.Duration) *SporedCacheStatsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the spored cache stats params
+func (o *SporedCacheStatsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the spored cache stats params
+func (o *SporedCacheStatsParams) WithContext(ctx context.Context) *SporedCacheStatsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the spored cache stats params
+func (o *SporedCacheStatsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the spored cache stats params
+func (o *SporedCacheStatsParams) WithHTTPClient(client *http.Client) *SporedCacheStatsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the spored cache stats params
+func (o *SporedCacheStatsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SporedCacheStatsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package spored
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// SporedCacheStatsReader is a Reader for the SporedCacheStats structure.
+type SporedCacheStatsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SporedCacheStatsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSporedCacheStatsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewSporedCacheStatsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSporedCacheStatsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /spored/cache] SporedCacheStats", response, response.Code())
+	}
+}
+
+// NewSporedCacheStatsOK creates a SporedCacheStatsOK with default headers values
+func NewSporedCacheStatsOK() *SporedCacheStatsOK {
+	return &SporedCacheStatsOK{}
+}
+
+/*
+SporedCacheStatsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type SporedCacheStatsOK struct {
+	Payload *models.APISporedCacheStatsResponse
+}
+
+// IsSuccess returns true when this spored cache stats o k response has a 2xx status code
+func (o *SporedCacheStatsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this spored cache stats o k response has a 3xx status code
+func (o *SporedCacheStatsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored cache stats o k response has a 4xx status code
+func (o *SporedCacheStatsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this spored cache stats o k response has a 5xx status code
+func (o *SporedCacheStatsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this spored cache stats o k response a status code equal to that given
+func (o *SporedCacheStatsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the spored cache stats o k response
+func (o *SporedCacheStatsOK) Code() int {
+	return 200
+}
+
+func (o *SporedCacheStatsOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /spored/cache][%d] sporedCacheStatsOK %s", 200, payload)
+}
+
+func (o *SporedCacheStatsOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /spored/cache][%d] sporedCacheStatsOK %s", 200, payload)
+}
+
+func (o *SporedCacheStatsOK) GetPayload() *models.APISporedCacheStatsResponse {
+	return o.Payload
+}
+
+func (o *SporedCacheStatsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APISporedCacheStatsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSporedCacheStatsNotFound creates a SporedCacheStatsNotFound with default headers values
+func NewSporedCacheStatsNotFound() *SporedCacheStatsNotFound {
+	return &SporedCacheStatsNotFound{}
+}
+
+/*
+SporedCacheStatsNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type SporedCacheStatsNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this spored cache stats not found response has a 2xx status code
+func (o *SporedCacheStatsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this spored cache stats not found response has a 3xx status code
+func (o *SporedCacheStatsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored cache stats not found response has a 4xx status code
+func (o *SporedCacheStatsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this spored cache stats not found response has a 5xx status code
+func (o *SporedCacheStatsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this spored cache stats not found response a status code equal to that given
+func (o *SporedCacheStatsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the spored cache stats not found response
+func (o *SporedCacheStatsNotFound) Code() int {
+	return 404
+}
+
+func (o *SporedCacheStatsNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /spored/cache][%d] sporedCacheStatsNotFound %s", 404, payload)
+}
+
+func (o *SporedCacheStatsNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /spored/cache][%d] sporedCacheStatsNotFound %s", 404, payload)
+}
+
+func (o *SporedCacheStatsNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SporedCacheStatsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSporedCacheStatsInternalServerError creates a SporedCacheStatsInternalServerError with default headers values
+func NewSporedCacheStatsInternalServerError() *SporedCacheStatsInternalServerError {
+	return &SporedCacheStatsInternalServerError{}
+}
+
+/*
+SporedCacheStatsInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type SporedCacheStatsInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this spored cache stats internal server error response has a 2xx status code
+func (o *SporedCacheStatsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this spored cache stats internal server error response has a 3xx status code
+func (o *SporedCacheStatsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored cache stats internal server error response has a 4xx status code
+func (o *SporedCacheStatsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this spored cache stats internal server error response has a 5xx status code
+func (o *SporedCacheStatsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this spored cache stats internal server error response a status code equal to that given
+func (o *SporedCacheStatsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the spored cache stats internal server error response
+func (o *SporedCacheStatsInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SporedCacheStatsInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /spored/cache][%d] sporedCacheStatsInternalServerError %s", 500, payload)
+}
+
+func (o *SporedCacheStatsInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /spored/cache][%d] sporedCacheStatsInternalServerError %s", 500, payload)
+}
+
+func (o *SporedCacheStatsInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SporedCacheStatsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package spored
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new spored API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+// New creates a new spored API client with basic auth credentials.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - user: user for basic authentication header.
+// - password: password for basic authentication header.
+func NewClientWithBasicAuth(host, basePath, scheme, user, password string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BasicAuth(user, password)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+// New creates a new spored API client with a bearer token for authentication.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - bearerToken: bearer token for Bearer authentication header.
+func NewClientWithBearerToken(host, basePath, scheme, bearerToken string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BearerToken(bearerToken)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+/*
+Client for spored API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption may be used to customize the behavior of Client methods.
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	SporedCachePurge(params *SporedCachePurgeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SporedCachePurgeNoContent, error)
+
+	SporedCacheStats(params *SporedCacheStatsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SporedCacheStatsOK, error)
+
+	SporedEventsReceive(params *SporedEventsReceiveParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SporedEventsReceiveOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+SporedCachePurge purges spored cache
+
+Drop every cached spored lookup on every replica
+*/
+func (a *Client) SporedCachePurge(params *SporedCachePurgeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SporedCachePurgeNoContent, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewSporedCachePurgeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SporedCachePurge",
+		Method:             "DELETE",
+		PathPattern:        "/spored/cache",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SporedCachePurgeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*SporedCachePurgeNoContent)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SporedCachePurge: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SporedCacheStats sporeds cache statistics
+
+Hits, misses and size of the cache in front of spored lookups. Negative hits are lookups answered from cached "not found" responses, fetches are requests that were actually sent to spored after concurrent misses were merged.
+*/
+func (a *Client) SporedCacheStats(params *SporedCacheStatsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SporedCacheStatsOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewSporedCacheStatsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SporedCacheStats",
+		Method:             "GET",
+		PathPattern:        "/spored/cache",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SporedCacheStatsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*SporedCacheStatsOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SporedCacheStats: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SporedEventsReceive receives spored event
+
+Apply a time slot change made in spored to its reservations. When a time slot is deleted all of its reservations are cancelled and flagged for refund. When a time slot is updated its reservations are moved to the (possibly new) room and start time and the ones whose seat does not exist in that room anymore are cancelled and flagged for refund.
+*/
+func (a *Client) SporedEventsReceive(params *SporedEventsReceiveParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SporedEventsReceiveOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewSporedEventsReceiveParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SporedEventsReceive",
+		Method:             "POST",
+		PathPattern:        "/spored/events",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SporedEventsReceiveReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*SporedEventsReceiveOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SporedEventsReceive: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
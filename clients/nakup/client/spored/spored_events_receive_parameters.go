@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package spored
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewSporedEventsReceiveParams creates a new SporedEventsReceiveParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSporedEventsReceiveParams() *SporedEventsReceiveParams {
+	return &SporedEventsReceiveParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSporedEventsReceiveParamsWithTimeout creates a new SporedEventsReceiveParams object
+// with the ability to set a timeout on a request.
+func NewSporedEventsReceiveParamsWithTimeout(timeout time.Duration) *SporedEventsReceiveParams {
+	return &SporedEventsReceiveParams{
+		timeout: timeout,
+	}
+}
+
+// NewSporedEventsReceiveParamsWithContext creates a new SporedEventsReceiveParams object
+// with the ability to set a context for a request.
+func NewSporedEventsReceiveParamsWithContext(ctx context.Context) *SporedEventsReceiveParams {
+	return &SporedEventsReceiveParams{
+		Context: ctx,
+	}
+}
+
+// NewSporedEventsReceiveParamsWithHTTPClient creates a new SporedEventsReceiveParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSporedEventsReceiveParamsWithHTTPClient(client *http.Client) *SporedEventsReceiveParams {
+	return &SporedEventsReceiveParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SporedEventsReceiveParams contains all the parameters to send to the API endpoint
+
+	for the spored events receive operation.
+
+	Typically these are written to a http.Request.
+*/
+type SporedEventsReceiveParams struct {
+
+	/* IdempotencyKey.
+
+	   Key that makes retries of the request safe
+	*/
+	IdempotencyKey *string
+
+	/* Request.
+
+	   request body
+	*/
+	Request *models.APISporedEventRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the spored events receive params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SporedEventsReceiveParams) WithDefaults() *SporedEventsReceiveParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the spored events receive params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SporedEventsReceiveParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the spored events receive params
+func (o *SporedEventsReceiveParams) WithTimeout(timeout time.Duration) *SporedEventsReceiveParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the spored events receive params
+func (o *SporedEventsReceiveParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the spored events receive params
+func (o *SporedEventsReceiveParams) WithContext(ctx context.Context) *SporedEventsReceiveParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the spored events receive params
+func (o *SporedEventsReceiveParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the spored events receive params
+func (o *SporedEventsReceiveParams) WithHTTPClient(client *http.Client) *SporedEventsReceiveParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the spored events receive params
+func (o *SporedEventsReceiveParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIdempotencyKey adds the idempotencyKey to the spored events receive params
+func (o *SporedEventsReceiveParams) WithIdempotencyKey(idempotencyKey *string) *SporedEventsReceiveParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the spored events receive params
+func (o *SporedEventsReceiveParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
+// WithRequest adds the request to the spored events receive params
+func (o *SporedEventsReceiveParams) WithRequest(request *models.APISporedEventRequest) *SporedEventsReceiveParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the spored events receive params
+func (o *SporedEventsReceiveParams) SetRequest(request *models.APISporedEventRequest) {
+	o.Request = request
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SporedEventsReceiveParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
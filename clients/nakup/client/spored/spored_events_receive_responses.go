@@ -0,0 +1,639 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package spored
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// SporedEventsReceiveReader is a Reader for the SporedEventsReceive structure.
+type SporedEventsReceiveReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SporedEventsReceiveReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSporedEventsReceiveOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSporedEventsReceiveBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewSporedEventsReceiveUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSporedEventsReceiveForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSporedEventsReceiveNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSporedEventsReceiveUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSporedEventsReceiveInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewSporedEventsReceiveServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /spored/events] SporedEventsReceive", response, response.Code())
+	}
+}
+
+// NewSporedEventsReceiveOK creates a SporedEventsReceiveOK with default headers values
+func NewSporedEventsReceiveOK() *SporedEventsReceiveOK {
+	return &SporedEventsReceiveOK{}
+}
+
+/*
+SporedEventsReceiveOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type SporedEventsReceiveOK struct {
+	Payload *models.APISporedEventResponse
+}
+
+// IsSuccess returns true when this spored events receive o k response has a 2xx status code
+func (o *SporedEventsReceiveOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this spored events receive o k response has a 3xx status code
+func (o *SporedEventsReceiveOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored events receive o k response has a 4xx status code
+func (o *SporedEventsReceiveOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this spored events receive o k response has a 5xx status code
+func (o *SporedEventsReceiveOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this spored events receive o k response a status code equal to that given
+func (o *SporedEventsReceiveOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the spored events receive o k response
+func (o *SporedEventsReceiveOK) Code() int {
+	return 200
+}
+
+func (o *SporedEventsReceiveOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveOK %s", 200, payload)
+}
+
+func (o *SporedEventsReceiveOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveOK %s", 200, payload)
+}
+
+func (o *SporedEventsReceiveOK) GetPayload() *models.APISporedEventResponse {
+	return o.Payload
+}
+
+func (o *SporedEventsReceiveOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APISporedEventResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSporedEventsReceiveBadRequest creates a SporedEventsReceiveBadRequest with default headers values
+func NewSporedEventsReceiveBadRequest() *SporedEventsReceiveBadRequest {
+	return &SporedEventsReceiveBadRequest{}
+}
+
+/*
+SporedEventsReceiveBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type SporedEventsReceiveBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this spored events receive bad request response has a 2xx status code
+func (o *SporedEventsReceiveBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this spored events receive bad request response has a 3xx status code
+func (o *SporedEventsReceiveBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored events receive bad request response has a 4xx status code
+func (o *SporedEventsReceiveBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this spored events receive bad request response has a 5xx status code
+func (o *SporedEventsReceiveBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this spored events receive bad request response a status code equal to that given
+func (o *SporedEventsReceiveBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the spored events receive bad request response
+func (o *SporedEventsReceiveBadRequest) Code() int {
+	return 400
+}
+
+func (o *SporedEventsReceiveBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveBadRequest %s", 400, payload)
+}
+
+func (o *SporedEventsReceiveBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveBadRequest %s", 400, payload)
+}
+
+func (o *SporedEventsReceiveBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SporedEventsReceiveBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSporedEventsReceiveUnauthorized creates a SporedEventsReceiveUnauthorized with default headers values
+func NewSporedEventsReceiveUnauthorized() *SporedEventsReceiveUnauthorized {
+	return &SporedEventsReceiveUnauthorized{}
+}
+
+/*
+SporedEventsReceiveUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type SporedEventsReceiveUnauthorized struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this spored events receive unauthorized response has a 2xx status code
+func (o *SporedEventsReceiveUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this spored events receive unauthorized response has a 3xx status code
+func (o *SporedEventsReceiveUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored events receive unauthorized response has a 4xx status code
+func (o *SporedEventsReceiveUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this spored events receive unauthorized response has a 5xx status code
+func (o *SporedEventsReceiveUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this spored events receive unauthorized response a status code equal to that given
+func (o *SporedEventsReceiveUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the spored events receive unauthorized response
+func (o *SporedEventsReceiveUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SporedEventsReceiveUnauthorized) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveUnauthorized %s", 401, payload)
+}
+
+func (o *SporedEventsReceiveUnauthorized) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveUnauthorized %s", 401, payload)
+}
+
+func (o *SporedEventsReceiveUnauthorized) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SporedEventsReceiveUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSporedEventsReceiveForbidden creates a SporedEventsReceiveForbidden with default headers values
+func NewSporedEventsReceiveForbidden() *SporedEventsReceiveForbidden {
+	return &SporedEventsReceiveForbidden{}
+}
+
+/*
+SporedEventsReceiveForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SporedEventsReceiveForbidden struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this spored events receive forbidden response has a 2xx status code
+func (o *SporedEventsReceiveForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this spored events receive forbidden response has a 3xx status code
+func (o *SporedEventsReceiveForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored events receive forbidden response has a 4xx status code
+func (o *SporedEventsReceiveForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this spored events receive forbidden response has a 5xx status code
+func (o *SporedEventsReceiveForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this spored events receive forbidden response a status code equal to that given
+func (o *SporedEventsReceiveForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the spored events receive forbidden response
+func (o *SporedEventsReceiveForbidden) Code() int {
+	return 403
+}
+
+func (o *SporedEventsReceiveForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveForbidden %s", 403, payload)
+}
+
+func (o *SporedEventsReceiveForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveForbidden %s", 403, payload)
+}
+
+func (o *SporedEventsReceiveForbidden) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SporedEventsReceiveForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSporedEventsReceiveNotFound creates a SporedEventsReceiveNotFound with default headers values
+func NewSporedEventsReceiveNotFound() *SporedEventsReceiveNotFound {
+	return &SporedEventsReceiveNotFound{}
+}
+
+/*
+SporedEventsReceiveNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type SporedEventsReceiveNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this spored events receive not found response has a 2xx status code
+func (o *SporedEventsReceiveNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this spored events receive not found response has a 3xx status code
+func (o *SporedEventsReceiveNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored events receive not found response has a 4xx status code
+func (o *SporedEventsReceiveNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this spored events receive not found response has a 5xx status code
+func (o *SporedEventsReceiveNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this spored events receive not found response a status code equal to that given
+func (o *SporedEventsReceiveNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the spored events receive not found response
+func (o *SporedEventsReceiveNotFound) Code() int {
+	return 404
+}
+
+func (o *SporedEventsReceiveNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveNotFound %s", 404, payload)
+}
+
+func (o *SporedEventsReceiveNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveNotFound %s", 404, payload)
+}
+
+func (o *SporedEventsReceiveNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SporedEventsReceiveNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSporedEventsReceiveUnprocessableEntity creates a SporedEventsReceiveUnprocessableEntity with default headers values
+func NewSporedEventsReceiveUnprocessableEntity() *SporedEventsReceiveUnprocessableEntity {
+	return &SporedEventsReceiveUnprocessableEntity{}
+}
+
+/*
+SporedEventsReceiveUnprocessableEntity describes a response with status code 422, with default header values.
+
+Unprocessable Entity
+*/
+type SporedEventsReceiveUnprocessableEntity struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this spored events receive unprocessable entity response has a 2xx status code
+func (o *SporedEventsReceiveUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this spored events receive unprocessable entity response has a 3xx status code
+func (o *SporedEventsReceiveUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored events receive unprocessable entity response has a 4xx status code
+func (o *SporedEventsReceiveUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this spored events receive unprocessable entity response has a 5xx status code
+func (o *SporedEventsReceiveUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this spored events receive unprocessable entity response a status code equal to that given
+func (o *SporedEventsReceiveUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the spored events receive unprocessable entity response
+func (o *SporedEventsReceiveUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SporedEventsReceiveUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveUnprocessableEntity %s", 422, payload)
+}
+
+func (o *SporedEventsReceiveUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveUnprocessableEntity %s", 422, payload)
+}
+
+func (o *SporedEventsReceiveUnprocessableEntity) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SporedEventsReceiveUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSporedEventsReceiveInternalServerError creates a SporedEventsReceiveInternalServerError with default headers values
+func NewSporedEventsReceiveInternalServerError() *SporedEventsReceiveInternalServerError {
+	return &SporedEventsReceiveInternalServerError{}
+}
+
+/*
+SporedEventsReceiveInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type SporedEventsReceiveInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this spored events receive internal server error response has a 2xx status code
+func (o *SporedEventsReceiveInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this spored events receive internal server error response has a 3xx status code
+func (o *SporedEventsReceiveInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored events receive internal server error response has a 4xx status code
+func (o *SporedEventsReceiveInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this spored events receive internal server error response has a 5xx status code
+func (o *SporedEventsReceiveInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this spored events receive internal server error response a status code equal to that given
+func (o *SporedEventsReceiveInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the spored events receive internal server error response
+func (o *SporedEventsReceiveInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SporedEventsReceiveInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveInternalServerError %s", 500, payload)
+}
+
+func (o *SporedEventsReceiveInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveInternalServerError %s", 500, payload)
+}
+
+func (o *SporedEventsReceiveInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SporedEventsReceiveInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSporedEventsReceiveServiceUnavailable creates a SporedEventsReceiveServiceUnavailable with default headers values
+func NewSporedEventsReceiveServiceUnavailable() *SporedEventsReceiveServiceUnavailable {
+	return &SporedEventsReceiveServiceUnavailable{}
+}
+
+/*
+SporedEventsReceiveServiceUnavailable describes a response with status code 503, with default header values.
+
+Service Unavailable
+*/
+type SporedEventsReceiveServiceUnavailable struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this spored events receive service unavailable response has a 2xx status code
+func (o *SporedEventsReceiveServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this spored events receive service unavailable response has a 3xx status code
+func (o *SporedEventsReceiveServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this spored events receive service unavailable response has a 4xx status code
+func (o *SporedEventsReceiveServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this spored events receive service unavailable response has a 5xx status code
+func (o *SporedEventsReceiveServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this spored events receive service unavailable response a status code equal to that given
+func (o *SporedEventsReceiveServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the spored events receive service unavailable response
+func (o *SporedEventsReceiveServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *SporedEventsReceiveServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveServiceUnavailable %s", 503, payload)
+}
+
+func (o *SporedEventsReceiveServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /spored/events][%d] sporedEventsReceiveServiceUnavailable %s", 503, payload)
+}
+
+func (o *SporedEventsReceiveServiceUnavailable) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *SporedEventsReceiveServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package staff
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new staff API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+// New creates a new staff API client with basic auth credentials.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - user: user for basic authentication header.
+// - password: password for basic authentication header.
+func NewClientWithBasicAuth(host, basePath, scheme, user, password string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BasicAuth(user, password)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+// New creates a new staff API client with a bearer token for authentication.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - bearerToken: bearer token for Bearer authentication header.
+func NewClientWithBearerToken(host, basePath, scheme, bearerToken string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BearerToken(bearerToken)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+/*
+Client for staff API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption may be used to customize the behavior of Client methods.
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	StaffTheatersShow(params *StaffTheatersShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StaffTheatersShowOK, error)
+
+	StaffTheatersUpdate(params *StaffTheatersUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StaffTheatersUpdateOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+StaffTheatersShow shows staff theaters
+
+List the theaters an employee is assigned to
+*/
+func (a *Client) StaffTheatersShow(params *StaffTheatersShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StaffTheatersShowOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewStaffTheatersShowParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "StaffTheatersShow",
+		Method:             "GET",
+		PathPattern:        "/staff/{userID}/theaters",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &StaffTheatersShowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*StaffTheatersShowOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for StaffTheatersShow: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+StaffTheatersUpdate updates staff theaters
+
+Replace the theaters an employee is assigned to. Employees can only manage reservations of their theaters, admins are not limited.
+*/
+func (a *Client) StaffTheatersUpdate(params *StaffTheatersUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*StaffTheatersUpdateOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewStaffTheatersUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "StaffTheatersUpdate",
+		Method:             "PUT",
+		PathPattern:        "/staff/{userID}/theaters",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &StaffTheatersUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*StaffTheatersUpdateOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for StaffTheatersUpdate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package staff
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewStaffTheatersShowParams creates a new StaffTheatersShowParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewStaffTheatersShowParams() *StaffTheatersShowParams {
+	return &StaffTheatersShowParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewStaffTheatersShowParamsWithTimeout creates a new StaffTheatersShowParams object
+// with the ability to set a timeout on a request.
+func NewStaffTheatersShowParamsWithTimeout(timeout time.Duration) *StaffTheatersShowParams {
+	return &StaffTheatersShowParams{
+		timeout: timeout,
+	}
+}
+
+// NewStaffTheatersShowParamsWithContext creates a new StaffTheatersShowParams object
+// with the ability to set a context for a request.
+func NewStaffTheatersShowParamsWithContext(ctx context.Context) *StaffTheatersShowParams {
+	return &StaffTheatersShowParams{
+		Context: ctx,
+	}
+}
+
+// NewStaffTheatersShowParamsWithHTTPClient creates a new StaffTheatersShowParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewStaffTheatersShowParamsWithHTTPClient(client *http.Client) *StaffTheatersShowParams {
+	return &StaffTheatersShowParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+StaffTheatersShowParams contains all the parameters to send to the API endpoint
+
+	for the staff theaters show operation.
+
+	Typically these are written to a http.Request.
+*/
+type StaffTheatersShowParams struct {
+
+	/* UserID.
+
+	   User ID
+
+	   Format: uuid
+	*/
+	UserID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the staff theaters show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StaffTheatersShowParams) WithDefaults() *StaffTheatersShowParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the staff theaters show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StaffTheatersShowParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the staff theaters show params
+func (o *StaffTheatersShowParams) WithTimeout(timeout time.Duration) *StaffTheatersShowParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the staff theaters show params
+func (o *StaffTheatersShowParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the staff theaters show params
+func (o *StaffTheatersShowParams) WithContext(ctx context.Context) *StaffTheatersShowParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the staff theaters show params
+func (o *StaffTheatersShowParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the staff theaters show params
+func (o *StaffTheatersShowParams) WithHTTPClient(client *http.Client) *StaffTheatersShowParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the staff theaters show params
+func (o *StaffTheatersShowParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithUserID adds the userID to the staff theaters show params
+func (o *StaffTheatersShowParams) WithUserID(userID strfmt.UUID) *StaffTheatersShowParams {
+	o.SetUserID(userID)
+	return o
+}
+
+// SetUserID adds the userId to the staff theaters show params
+func (o *StaffTheatersShowParams) SetUserID(userID strfmt.UUID) {
+	o.UserID = userID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *StaffTheatersShowParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param userID
+	if err := r.SetPathParam("userID", o.UserID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package staff
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// StaffTheatersShowReader is a Reader for the StaffTheatersShow structure.
+type StaffTheatersShowReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StaffTheatersShowReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewStaffTheatersShowOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewStaffTheatersShowBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewStaffTheatersShowInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /staff/{userID}/theaters] StaffTheatersShow", response, response.Code())
+	}
+}
+
+// NewStaffTheatersShowOK creates a StaffTheatersShowOK with default headers values
+func NewStaffTheatersShowOK() *StaffTheatersShowOK {
+	return &StaffTheatersShowOK{}
+}
+
+/*
+StaffTheatersShowOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type StaffTheatersShowOK struct {
+	Payload *models.APIStaffTheatersResponse
+}
+
+// IsSuccess returns true when this staff theaters show o k response has a 2xx status code
+func (o *StaffTheatersShowOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this staff theaters show o k response has a 3xx status code
+func (o *StaffTheatersShowOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this staff theaters show o k response has a 4xx status code
+func (o *StaffTheatersShowOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this staff theaters show o k response has a 5xx status code
+func (o *StaffTheatersShowOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this staff theaters show o k response a status code equal to that given
+func (o *StaffTheatersShowOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the staff theaters show o k response
+func (o *StaffTheatersShowOK) Code() int {
+	return 200
+}
+
+func (o *StaffTheatersShowOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /staff/{userID}/theaters][%d] staffTheatersShowOK %s", 200, payload)
+}
+
+func (o *StaffTheatersShowOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /staff/{userID}/theaters][%d] staffTheatersShowOK %s", 200, payload)
+}
+
+func (o *StaffTheatersShowOK) GetPayload() *models.APIStaffTheatersResponse {
+	return o.Payload
+}
+
+func (o *StaffTheatersShowOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIStaffTheatersResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewStaffTheatersShowBadRequest creates a StaffTheatersShowBadRequest with default headers values
+func NewStaffTheatersShowBadRequest() *StaffTheatersShowBadRequest {
+	return &StaffTheatersShowBadRequest{}
+}
+
+/*
+StaffTheatersShowBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type StaffTheatersShowBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this staff theaters show bad request response has a 2xx status code
+func (o *StaffTheatersShowBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this staff theaters show bad request response has a 3xx status code
+func (o *StaffTheatersShowBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this staff theaters show bad request response has a 4xx status code
+func (o *StaffTheatersShowBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this staff theaters show bad request response has a 5xx status code
+func (o *StaffTheatersShowBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this staff theaters show bad request response a status code equal to that given
+func (o *StaffTheatersShowBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the staff theaters show bad request response
+func (o *StaffTheatersShowBadRequest) Code() int {
+	return 400
+}
+
+func (o *StaffTheatersShowBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /staff/{userID}/theaters][%d] staffTheatersShowBadRequest %s", 400, payload)
+}
+
+func (o *StaffTheatersShowBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /staff/{userID}/theaters][%d] staffTheatersShowBadRequest %s", 400, payload)
+}
+
+func (o *StaffTheatersShowBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *StaffTheatersShowBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewStaffTheatersShowInternalServerError creates a StaffTheatersShowInternalServerError with default headers values
+func NewStaffTheatersShowInternalServerError() *StaffTheatersShowInternalServerError {
+	return &StaffTheatersShowInternalServerError{}
+}
+
+/*
+StaffTheatersShowInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type StaffTheatersShowInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this staff theaters show internal server error response has a 2xx status code
+func (o *StaffTheatersShowInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this staff theaters show internal server error response has a 3xx status code
+func (o *StaffTheatersShowInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this staff theaters show internal server error response has a 4xx status code
+func (o *StaffTheatersShowInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this staff theaters show internal server error response has a 5xx status code
+func (o *StaffTheatersShowInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this staff theaters show internal server error response a status code equal to that given
+func (o *StaffTheatersShowInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the staff theaters show internal server error response
+func (o *StaffTheatersShowInternalServerError) Code() int {
+	return 500
+}
+
+func (o *StaffTheatersShowInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /staff/{userID}/theaters][%d] staffTheatersShowInternalServerError %s", 500, payload)
+}
+
+func (o *StaffTheatersShowInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /staff/{userID}/theaters][%d] staffTheatersShowInternalServerError %s", 500, payload)
+}
+
+func (o *StaffTheatersShowInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *StaffTheatersShowInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
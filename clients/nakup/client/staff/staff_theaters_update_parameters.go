@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package staff
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewStaffTheatersUpdateParams creates a new StaffTheatersUpdateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewStaffTheatersUpdateParams() *StaffTheatersUpdateParams {
+	return &StaffTheatersUpdateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewStaffTheatersUpdateParamsWithTimeout creates a new StaffTheatersUpdateParams object
+// with the ability to set a timeout on a request.
+func NewStaffTheatersUpdateParamsWithTimeout(timeout time.Duration) *StaffTheatersUpdateParams {
+	return &StaffTheatersUpdateParams{
+		timeout: timeout,
+	}
+}
+
+// NewStaffTheatersUpdateParamsWithContext creates a new StaffTheatersUpdateParams object
+// with the ability to set a context for a request.
+func NewStaffTheatersUpdateParamsWithContext(ctx context.Context) *StaffTheatersUpdateParams {
+	return &StaffTheatersUpdateParams{
+		Context: ctx,
+	}
+}
+
+// NewStaffTheatersUpdateParamsWithHTTPClient creates a new StaffTheatersUpdateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewStaffTheatersUpdateParamsWithHTTPClient(client *http.Client) *StaffTheatersUpdateParams {
+	return &StaffTheatersUpdateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+StaffTheatersUpdateParams contains all the parameters to send to the API endpoint
+
+	for the staff theaters update operation.
+
+	Typically these are written to a http.Request.
+*/
+type StaffTheatersUpdateParams struct {
+
+	/* Request.
+
+	   request body
+	*/
+	Request *models.APIStaffTheatersRequest
+
+	/* UserID.
+
+	   User ID
+
+	   Format: uuid
+	*/
+	UserID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the staff theaters update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StaffTheatersUpdateParams) WithDefaults() *StaffTheatersUpdateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the staff theaters update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StaffTheatersUpdateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the staff theaters update params
+func (o *StaffTheatersUpdateParams) WithTimeout(timeout time.Duration) *StaffTheatersUpdateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the staff theaters update params
+func (o *StaffTheatersUpdateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the staff theaters update params
+func (o *StaffTheatersUpdateParams) WithContext(ctx context.Context) *StaffTheatersUpdateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the staff theaters update params
+func (o *StaffTheatersUpdateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the staff theaters update params
+func (o *StaffTheatersUpdateParams) WithHTTPClient(client *http.Client) *StaffTheatersUpdateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the staff theaters update params
+func (o *StaffTheatersUpdateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRequest adds the request to the staff theaters update params
+func (o *StaffTheatersUpdateParams) WithRequest(request *models.APIStaffTheatersRequest) *StaffTheatersUpdateParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the staff theaters update params
+func (o *StaffTheatersUpdateParams) SetRequest(request *models.APIStaffTheatersRequest) {
+	o.Request = request
+}
+
+// WithUserID adds the userID to the staff theaters update params
+func (o *StaffTheatersUpdateParams) WithUserID(userID strfmt.UUID) *StaffTheatersUpdateParams {
+	o.SetUserID(userID)
+	return o
+}
+
+// SetUserID adds the userId to the staff theaters update params
+func (o *StaffTheatersUpdateParams) SetUserID(userID strfmt.UUID) {
+	o.UserID = userID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *StaffTheatersUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	// path param userID
+	if err := r.SetPathParam("userID", o.UserID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package staff
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// StaffTheatersUpdateReader is a Reader for the StaffTheatersUpdate structure.
+type StaffTheatersUpdateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StaffTheatersUpdateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewStaffTheatersUpdateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewStaffTheatersUpdateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewStaffTheatersUpdateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PUT /staff/{userID}/theaters] StaffTheatersUpdate", response, response.Code())
+	}
+}
+
+// NewStaffTheatersUpdateOK creates a StaffTheatersUpdateOK with default headers values
+func NewStaffTheatersUpdateOK() *StaffTheatersUpdateOK {
+	return &StaffTheatersUpdateOK{}
+}
+
+/*
+StaffTheatersUpdateOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type StaffTheatersUpdateOK struct {
+	Payload *models.APIStaffTheatersResponse
+}
+
+// IsSuccess returns true when this staff theaters update o k response has a 2xx status code
+func (o *StaffTheatersUpdateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this staff theaters update o k response has a 3xx status code
+func (o *StaffTheatersUpdateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this staff theaters update o k response has a 4xx status code
+func (o *StaffTheatersUpdateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this staff theaters update o k response has a 5xx status code
+func (o *StaffTheatersUpdateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this staff theaters update o k response a status code equal to that given
+func (o *StaffTheatersUpdateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the staff theaters update o k response
+func (o *StaffTheatersUpdateOK) Code() int {
+	return 200
+}
+
+func (o *StaffTheatersUpdateOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /staff/{userID}/theaters][%d] staffTheatersUpdateOK %s", 200, payload)
+}
+
+func (o *StaffTheatersUpdateOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /staff/{userID}/theaters][%d] staffTheatersUpdateOK %s", 200, payload)
+}
+
+func (o *StaffTheatersUpdateOK) GetPayload() *models.APIStaffTheatersResponse {
+	return o.Payload
+}
+
+func (o *StaffTheatersUpdateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIStaffTheatersResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewStaffTheatersUpdateBadRequest creates a StaffTheatersUpdateBadRequest with default headers values
+func NewStaffTheatersUpdateBadRequest() *StaffTheatersUpdateBadRequest {
+	return &StaffTheatersUpdateBadRequest{}
+}
+
+/*
+StaffTheatersUpdateBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type StaffTheatersUpdateBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this staff theaters update bad request response has a 2xx status code
+func (o *StaffTheatersUpdateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this staff theaters update bad request response has a 3xx status code
+func (o *StaffTheatersUpdateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this staff theaters update bad request response has a 4xx status code
+func (o *StaffTheatersUpdateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this staff theaters update bad request response has a 5xx status code
+func (o *StaffTheatersUpdateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this staff theaters update bad request response a status code equal to that given
+func (o *StaffTheatersUpdateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the staff theaters update bad request response
+func (o *StaffTheatersUpdateBadRequest) Code() int {
+	return 400
+}
+
+func (o *StaffTheatersUpdateBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /staff/{userID}/theaters][%d] staffTheatersUpdateBadRequest %s", 400, payload)
+}
+
+func (o *StaffTheatersUpdateBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /staff/{userID}/theaters][%d] staffTheatersUpdateBadRequest %s", 400, payload)
+}
+
+func (o *StaffTheatersUpdateBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *StaffTheatersUpdateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewStaffTheatersUpdateInternalServerError creates a StaffTheatersUpdateInternalServerError with default headers values
+func NewStaffTheatersUpdateInternalServerError() *StaffTheatersUpdateInternalServerError {
+	return &StaffTheatersUpdateInternalServerError{}
+}
+
+/*
+StaffTheatersUpdateInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type StaffTheatersUpdateInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this staff theaters update internal server error response has a 2xx status code
+func (o *StaffTheatersUpdateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this staff theaters update internal server error response has a 3xx status code
+func (o *StaffTheatersUpdateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this staff theaters update internal server error response has a 4xx status code
+func (o *StaffTheatersUpdateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this staff theaters update internal server error response has a 5xx status code
+func (o *StaffTheatersUpdateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this staff theaters update internal server error response a status code equal to that given
+func (o *StaffTheatersUpdateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the staff theaters update internal server error response
+func (o *StaffTheatersUpdateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *StaffTheatersUpdateInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /staff/{userID}/theaters][%d] staffTheatersUpdateInternalServerError %s", 500, payload)
+}
+
+func (o *StaffTheatersUpdateInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /staff/{userID}/theaters][%d] staffTheatersUpdateInternalServerError %s", 500, payload)
+}
+
+func (o *StaffTheatersUpdateInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *StaffTheatersUpdateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewWebhookDeliveriesListParams creates a new WebhookDeliveriesListParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewWebhookDeliveriesListParams() *WebhookDeliveriesListParams {
+	return &WebhookDeliveriesListParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewWebhookDeliveriesListParamsWithTimeout creates a new WebhookDeliveriesListParams object
+// with the ability to set a timeout on a request.
+func NewWebhookDeliveriesListParamsWithTimeout(timeout time.Duration) *WebhookDeliveriesListParams {
+	return &WebhookDeliveriesListParams{
+		timeout: timeout,
+	}
+}
+
+// NewWebhookDeliveriesListParamsWithContext creates a new WebhookDeliveriesListParams object
+// with the ability to set a context for a request.
+func NewWebhookDeliveriesListParamsWithContext(ctx context.Context) *WebhookDeliveriesListParams {
+	return &WebhookDeliveriesListParams{
+		Context: ctx,
+	}
+}
+
+// NewWebhookDeliveriesListParamsWithHTTPClient creates a new WebhookDeliveriesListParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewWebhookDeliveriesListParamsWithHTTPClient(client *http.Client) *WebhookDeliveriesListParams {
+	return &WebhookDeliveriesListParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+WebhookDeliveriesListParams contains all the parameters to send to the API endpoint
+
+	for the webhook deliveries list operation.
+
+	Typically these are written to a http.Request.
+*/
+type WebhookDeliveriesListParams struct {
+
+	/* Limit.
+
+	   Limit the number of responses
+
+	   Default: 10
+	*/
+	Limit *int64
+
+	/* Offset.
+
+	   Offset the first response
+	*/
+	Offset *int64
+
+	/* Sort.
+
+	   Sort results
+	*/
+	Sort *string
+
+	/* WebhookID.
+
+	   Webhook ID
+
+	   Format: uuid
+	*/
+	WebhookID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the webhook deliveries list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhookDeliveriesListParams) WithDefaults() *WebhookDeliveriesListParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the webhook deliveries list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhookDeliveriesListParams) SetDefaults() {
+	var (
+		limitDefault = int64(10)
+
+		offsetDefault = int64(0)
+	)
+
+	val := WebhookDeliveriesListParams{
+		Limit:  &limitDefault,
+		Offset: &offsetDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) WithTimeout(timeout time.Duration) *WebhookDeliveriesListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) WithContext(ctx context.Context) *WebhookDeliveriesListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) WithHTTPClient(client *http.Client) *WebhookDeliveriesListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) WithLimit(limit *int64) *WebhookDeliveriesListParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) WithOffset(offset *int64) *WebhookDeliveriesListParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithSort adds the sort to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) WithSort(sort *string) *WebhookDeliveriesListParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WithWebhookID adds the webhookID to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) WithWebhookID(webhookID strfmt.UUID) *WebhookDeliveriesListParams {
+	o.SetWebhookID(webhookID)
+	return o
+}
+
+// SetWebhookID adds the webhookId to the webhook deliveries list params
+func (o *WebhookDeliveriesListParams) SetWebhookID(webhookID strfmt.UUID) {
+	o.WebhookID = webhookID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *WebhookDeliveriesListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param webhookID
+	if err := r.SetPathParam("webhookID", o.WebhookID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
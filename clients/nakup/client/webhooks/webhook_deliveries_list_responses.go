@@ -0,0 +1,511 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// WebhookDeliveriesListReader is a Reader for the WebhookDeliveriesList structure.
+type WebhookDeliveriesListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *WebhookDeliveriesListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewWebhookDeliveriesListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewWebhookDeliveriesListBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewWebhookDeliveriesListNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewWebhookDeliveriesListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /webhooks/{webhookID}/deliveries] WebhookDeliveriesList", response, response.Code())
+	}
+}
+
+// NewWebhookDeliveriesListOK creates a WebhookDeliveriesListOK with default headers values
+func NewWebhookDeliveriesListOK() *WebhookDeliveriesListOK {
+	return &WebhookDeliveriesListOK{}
+}
+
+/*
+WebhookDeliveriesListOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type WebhookDeliveriesListOK struct {
+	Payload *WebhookDeliveriesListOKBody
+}
+
+// IsSuccess returns true when this webhook deliveries list o k response has a 2xx status code
+func (o *WebhookDeliveriesListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this webhook deliveries list o k response has a 3xx status code
+func (o *WebhookDeliveriesListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries list o k response has a 4xx status code
+func (o *WebhookDeliveriesListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhook deliveries list o k response has a 5xx status code
+func (o *WebhookDeliveriesListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhook deliveries list o k response a status code equal to that given
+func (o *WebhookDeliveriesListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the webhook deliveries list o k response
+func (o *WebhookDeliveriesListOK) Code() int {
+	return 200
+}
+
+func (o *WebhookDeliveriesListOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries][%d] webhookDeliveriesListOK %s", 200, payload)
+}
+
+func (o *WebhookDeliveriesListOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries][%d] webhookDeliveriesListOK %s", 200, payload)
+}
+
+func (o *WebhookDeliveriesListOK) GetPayload() *WebhookDeliveriesListOKBody {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(WebhookDeliveriesListOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhookDeliveriesListBadRequest creates a WebhookDeliveriesListBadRequest with default headers values
+func NewWebhookDeliveriesListBadRequest() *WebhookDeliveriesListBadRequest {
+	return &WebhookDeliveriesListBadRequest{}
+}
+
+/*
+WebhookDeliveriesListBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type WebhookDeliveriesListBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhook deliveries list bad request response has a 2xx status code
+func (o *WebhookDeliveriesListBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhook deliveries list bad request response has a 3xx status code
+func (o *WebhookDeliveriesListBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries list bad request response has a 4xx status code
+func (o *WebhookDeliveriesListBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhook deliveries list bad request response has a 5xx status code
+func (o *WebhookDeliveriesListBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhook deliveries list bad request response a status code equal to that given
+func (o *WebhookDeliveriesListBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the webhook deliveries list bad request response
+func (o *WebhookDeliveriesListBadRequest) Code() int {
+	return 400
+}
+
+func (o *WebhookDeliveriesListBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries][%d] webhookDeliveriesListBadRequest %s", 400, payload)
+}
+
+func (o *WebhookDeliveriesListBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries][%d] webhookDeliveriesListBadRequest %s", 400, payload)
+}
+
+func (o *WebhookDeliveriesListBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesListBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhookDeliveriesListNotFound creates a WebhookDeliveriesListNotFound with default headers values
+func NewWebhookDeliveriesListNotFound() *WebhookDeliveriesListNotFound {
+	return &WebhookDeliveriesListNotFound{}
+}
+
+/*
+WebhookDeliveriesListNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type WebhookDeliveriesListNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhook deliveries list not found response has a 2xx status code
+func (o *WebhookDeliveriesListNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhook deliveries list not found response has a 3xx status code
+func (o *WebhookDeliveriesListNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries list not found response has a 4xx status code
+func (o *WebhookDeliveriesListNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhook deliveries list not found response has a 5xx status code
+func (o *WebhookDeliveriesListNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhook deliveries list not found response a status code equal to that given
+func (o *WebhookDeliveriesListNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the webhook deliveries list not found response
+func (o *WebhookDeliveriesListNotFound) Code() int {
+	return 404
+}
+
+func (o *WebhookDeliveriesListNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries][%d] webhookDeliveriesListNotFound %s", 404, payload)
+}
+
+func (o *WebhookDeliveriesListNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries][%d] webhookDeliveriesListNotFound %s", 404, payload)
+}
+
+func (o *WebhookDeliveriesListNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesListNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhookDeliveriesListInternalServerError creates a WebhookDeliveriesListInternalServerError with default headers values
+func NewWebhookDeliveriesListInternalServerError() *WebhookDeliveriesListInternalServerError {
+	return &WebhookDeliveriesListInternalServerError{}
+}
+
+/*
+WebhookDeliveriesListInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type WebhookDeliveriesListInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhook deliveries list internal server error response has a 2xx status code
+func (o *WebhookDeliveriesListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhook deliveries list internal server error response has a 3xx status code
+func (o *WebhookDeliveriesListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries list internal server error response has a 4xx status code
+func (o *WebhookDeliveriesListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhook deliveries list internal server error response has a 5xx status code
+func (o *WebhookDeliveriesListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this webhook deliveries list internal server error response a status code equal to that given
+func (o *WebhookDeliveriesListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the webhook deliveries list internal server error response
+func (o *WebhookDeliveriesListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *WebhookDeliveriesListInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries][%d] webhookDeliveriesListInternalServerError %s", 500, payload)
+}
+
+func (o *WebhookDeliveriesListInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries][%d] webhookDeliveriesListInternalServerError %s", 500, payload)
+}
+
+func (o *WebhookDeliveriesListInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+/*
+WebhookDeliveriesListOKBody webhook deliveries list o k body
+swagger:model WebhookDeliveriesListOKBody
+*/
+type WebhookDeliveriesListOKBody struct {
+	models.RequestPaginatedResponse
+
+	// data
+	Data []*models.APIWebhookDeliveryResponse `json:"data"`
+}
+
+// UnmarshalJSON unmarshals this object from a JSON structure
+func (o *WebhookDeliveriesListOKBody) UnmarshalJSON(raw []byte) error {
+	// WebhookDeliveriesListOKBodyAO0
+	var webhookDeliveriesListOKBodyAO0 models.RequestPaginatedResponse
+	if err := swag.ReadJSON(raw, &webhookDeliveriesListOKBodyAO0); err != nil {
+		return err
+	}
+	o.RequestPaginatedResponse = webhookDeliveriesListOKBodyAO0
+
+	// WebhookDeliveriesListOKBodyAO1
+	var dataWebhookDeliveriesListOKBodyAO1 struct {
+		Data []*models.APIWebhookDeliveryResponse `json:"data"`
+	}
+	if err := swag.ReadJSON(raw, &dataWebhookDeliveriesListOKBodyAO1); err != nil {
+		return err
+	}
+
+	o.Data = dataWebhookDeliveriesListOKBodyAO1.Data
+
+	return nil
+}
+
+// MarshalJSON marshals this object to a JSON structure
+func (o WebhookDeliveriesListOKBody) MarshalJSON() ([]byte, error) {
+	_parts := make([][]byte, 0, 2)
+
+	webhookDeliveriesListOKBodyAO0, err := swag.WriteJSON(o.RequestPaginatedResponse)
+	if err != nil {
+		return nil, err
+	}
+	_parts = append(_parts, webhookDeliveriesListOKBodyAO0)
+	var dataWebhookDeliveriesListOKBodyAO1 struct {
+		Data []*models.APIWebhookDeliveryResponse `json:"data"`
+	}
+
+	dataWebhookDeliveriesListOKBodyAO1.Data = o.Data
+
+	jsonDataWebhookDeliveriesListOKBodyAO1, errWebhookDeliveriesListOKBodyAO1 := swag.WriteJSON(dataWebhookDeliveriesListOKBodyAO1)
+	if errWebhookDeliveriesListOKBodyAO1 != nil {
+		return nil, errWebhookDeliveriesListOKBodyAO1
+	}
+	_parts = append(_parts, jsonDataWebhookDeliveriesListOKBodyAO1)
+	return swag.ConcatJSON(_parts...), nil
+}
+
+// Validate validates this webhook deliveries list o k body
+func (o *WebhookDeliveriesListOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.Validate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *WebhookDeliveriesListOKBody) validateData(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Data) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Data); i++ {
+		if swag.IsZero(o.Data[i]) { // not required
+			continue
+		}
+
+		if o.Data[i] != nil {
+			if err := o.Data[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("webhookDeliveriesListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("webhookDeliveriesListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this webhook deliveries list o k body based on the context it is used
+func (o *WebhookDeliveriesListOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.ContextValidate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateData(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *WebhookDeliveriesListOKBody) contextValidateData(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Data); i++ {
+
+		if o.Data[i] != nil {
+
+			if swag.IsZero(o.Data[i]) { // not required
+				return nil
+			}
+
+			if err := o.Data[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("webhookDeliveriesListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("webhookDeliveriesListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *WebhookDeliveriesListOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *WebhookDeliveriesListOKBody) UnmarshalBinary(b []byte) error {
+	var res WebhookDeliveriesListOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewWebhookDeliveriesRedeliverParams creates a new WebhookDeliveriesRedeliverParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewWebhookDeliveriesRedeliverParams() *WebhookDeliveriesRedeliverParams {
+	return &WebhookDeliveriesRedeliverParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewWebhookDeliveriesRedeliverParamsWithTimeout creates a new WebhookDeliveriesRedeliverParams object
+// with the ability to set a timeout on a request.
+func NewWebhookDeliveriesRedeliverParamsWithTimeout(timeout time.Duration) *WebhookDeliveriesRedeliverParams {
+	return &WebhookDeliveriesRedeliverParams{
+		timeout: timeout,
+	}
+}
+
+// NewWebhookDeliveriesRedeliverParamsWithContext creates a new WebhookDeliveriesRedeliverParams object
+// with the ability to set a context for a request.
+func NewWebhookDeliveriesRedeliverParamsWithContext(ctx context.Context) *WebhookDeliveriesRedeliverParams {
+	return &WebhookDeliveriesRedeliverParams{
+		Context: ctx,
+	}
+}
+
+// NewWebhookDeliveriesRedeliverParamsWithHTTPClient creates a new WebhookDeliveriesRedeliverParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewWebhookDeliveriesRedeliverParamsWithHTTPClient(client *http.Client) *WebhookDeliveriesRedeliverParams {
+	return &WebhookDeliveriesRedeliverParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+WebhookDeliveriesRedeliverParams contains all the parameters to send to the API endpoint
+
+	for the webhook deliveries redeliver operation.
+
+	Typically these are written to a http.Request.
+*/
+type WebhookDeliveriesRedeliverParams struct {
+
+	/* IdempotencyKey.
+
+	   Key that makes retries of the request safe
+	*/
+	IdempotencyKey *string
+
+	/* DeliveryID.
+
+	   Delivery ID
+
+	   Format: uuid
+	*/
+	DeliveryID strfmt.UUID
+
+	/* WebhookID.
+
+	   Webhook ID
+
+	   Format: uuid
+	*/
+	WebhookID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the webhook deliveries redeliver params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhookDeliveriesRedeliverParams) WithDefaults() *WebhookDeliveriesRedeliverParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the webhook deliveries redeliver params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhookDeliveriesRedeliverParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) WithTimeout(timeout time.Duration) *WebhookDeliveriesRedeliverParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) WithContext(ctx context.Context) *WebhookDeliveriesRedeliverParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) WithHTTPClient(client *http.Client) *WebhookDeliveriesRedeliverParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIdempotencyKey adds the idempotencyKey to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) WithIdempotencyKey(idempotencyKey *string) *WebhookDeliveriesRedeliverParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
+// WithDeliveryID adds the deliveryID to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) WithDeliveryID(deliveryID strfmt.UUID) *WebhookDeliveriesRedeliverParams {
+	o.SetDeliveryID(deliveryID)
+	return o
+}
+
+// SetDeliveryID adds the deliveryId to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) SetDeliveryID(deliveryID strfmt.UUID) {
+	o.DeliveryID = deliveryID
+}
+
+// WithWebhookID adds the webhookID to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) WithWebhookID(webhookID strfmt.UUID) *WebhookDeliveriesRedeliverParams {
+	o.SetWebhookID(webhookID)
+	return o
+}
+
+// SetWebhookID adds the webhookId to the webhook deliveries redeliver params
+func (o *WebhookDeliveriesRedeliverParams) SetWebhookID(webhookID strfmt.UUID) {
+	o.WebhookID = webhookID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *WebhookDeliveriesRedeliverParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+
+	// path param deliveryID
+	if err := r.SetPathParam("deliveryID", o.DeliveryID.String()); err != nil {
+		return err
+	}
+
+	// path param webhookID
+	if err := r.SetPathParam("webhookID", o.WebhookID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
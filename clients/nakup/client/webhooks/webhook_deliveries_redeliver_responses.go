@@ -0,0 +1,411 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// WebhookDeliveriesRedeliverReader is a Reader for the WebhookDeliveriesRedeliver structure.
+type WebhookDeliveriesRedeliverReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *WebhookDeliveriesRedeliverReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 202:
+		result := NewWebhookDeliveriesRedeliverAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewWebhookDeliveriesRedeliverBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewWebhookDeliveriesRedeliverNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewWebhookDeliveriesRedeliverUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewWebhookDeliveriesRedeliverInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /webhooks/{webhookID}/deliveries/{deliveryID}/redeliver] WebhookDeliveriesRedeliver", response, response.Code())
+	}
+}
+
+// NewWebhookDeliveriesRedeliverAccepted creates a WebhookDeliveriesRedeliverAccepted with default headers values
+func NewWebhookDeliveriesRedeliverAccepted() *WebhookDeliveriesRedeliverAccepted {
+	return &WebhookDeliveriesRedeliverAccepted{}
+}
+
+/*
+WebhookDeliveriesRedeliverAccepted describes a response with status code 202, with default header values.
+
+Accepted
+*/
+type WebhookDeliveriesRedeliverAccepted struct {
+	Payload *models.APIWebhookDeliveryResponse
+}
+
+// IsSuccess returns true when this webhook deliveries redeliver accepted response has a 2xx status code
+func (o *WebhookDeliveriesRedeliverAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this webhook deliveries redeliver accepted response has a 3xx status code
+func (o *WebhookDeliveriesRedeliverAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries redeliver accepted response has a 4xx status code
+func (o *WebhookDeliveriesRedeliverAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhook deliveries redeliver accepted response has a 5xx status code
+func (o *WebhookDeliveriesRedeliverAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhook deliveries redeliver accepted response a status code equal to that given
+func (o *WebhookDeliveriesRedeliverAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the webhook deliveries redeliver accepted response
+func (o *WebhookDeliveriesRedeliverAccepted) Code() int {
+	return 202
+}
+
+func (o *WebhookDeliveriesRedeliverAccepted) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks/{webhookID}/deliveries/{deliveryID}/redeliver][%d] webhookDeliveriesRedeliverAccepted %s", 202, payload)
+}
+
+func (o *WebhookDeliveriesRedeliverAccepted) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks/{webhookID}/deliveries/{deliveryID}/redeliver][%d] webhookDeliveriesRedeliverAccepted %s", 202, payload)
+}
+
+func (o *WebhookDeliveriesRedeliverAccepted) GetPayload() *models.APIWebhookDeliveryResponse {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesRedeliverAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIWebhookDeliveryResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhookDeliveriesRedeliverBadRequest creates a WebhookDeliveriesRedeliverBadRequest with default headers values
+func NewWebhookDeliveriesRedeliverBadRequest() *WebhookDeliveriesRedeliverBadRequest {
+	return &WebhookDeliveriesRedeliverBadRequest{}
+}
+
+/*
+WebhookDeliveriesRedeliverBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type WebhookDeliveriesRedeliverBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhook deliveries redeliver bad request response has a 2xx status code
+func (o *WebhookDeliveriesRedeliverBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhook deliveries redeliver bad request response has a 3xx status code
+func (o *WebhookDeliveriesRedeliverBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries redeliver bad request response has a 4xx status code
+func (o *WebhookDeliveriesRedeliverBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhook deliveries redeliver bad request response has a 5xx status code
+func (o *WebhookDeliveriesRedeliverBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhook deliveries redeliver bad request response a status code equal to that given
+func (o *WebhookDeliveriesRedeliverBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the webhook deliveries redeliver bad request response
+func (o *WebhookDeliveriesRedeliverBadRequest) Code() int {
+	return 400
+}
+
+func (o *WebhookDeliveriesRedeliverBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks/{webhookID}/deliveries/{deliveryID}/redeliver][%d] webhookDeliveriesRedeliverBadRequest %s", 400, payload)
+}
+
+func (o *WebhookDeliveriesRedeliverBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks/{webhookID}/deliveries/{deliveryID}/redeliver][%d] webhookDeliveriesRedeliverBadRequest %s", 400, payload)
+}
+
+func (o *WebhookDeliveriesRedeliverBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesRedeliverBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhookDeliveriesRedeliverNotFound creates a WebhookDeliveriesRedeliverNotFound with default headers values
+func NewWebhookDeliveriesRedeliverNotFound() *WebhookDeliveriesRedeliverNotFound {
+	return &WebhookDeliveriesRedeliverNotFound{}
+}
+
+/*
+WebhookDeliveriesRedeliverNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type WebhookDeliveriesRedeliverNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhook deliveries redeliver not found response has a 2xx status code
+func (o *WebhookDeliveriesRedeliverNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhook deliveries redeliver not found response has a 3xx status code
+func (o *WebhookDeliveriesRedeliverNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries redeliver not found response has a 4xx status code
+func (o *WebhookDeliveriesRedeliverNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhook deliveries redeliver not found response has a 5xx status code
+func (o *WebhookDeliveriesRedeliverNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhook deliveries redeliver not found response a status code equal to that given
+func (o *WebhookDeliveriesRedeliverNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the webhook deliveries redeliver not found response
+func (o *WebhookDeliveriesRedeliverNotFound) Code() int {
+	return 404
+}
+
+func (o *WebhookDeliveriesRedeliverNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks/{webhookID}/deliveries/{deliveryID}/redeliver][%d] webhookDeliveriesRedeliverNotFound %s", 404, payload)
+}
+
+func (o *WebhookDeliveriesRedeliverNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks/{webhookID}/deliveries/{deliveryID}/redeliver][%d] webhookDeliveriesRedeliverNotFound %s", 404, payload)
+}
+
+func (o *WebhookDeliveriesRedeliverNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesRedeliverNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhookDeliveriesRedeliverUnprocessableEntity creates a WebhookDeliveriesRedeliverUnprocessableEntity with default headers values
+func NewWebhookDeliveriesRedeliverUnprocessableEntity() *WebhookDeliveriesRedeliverUnprocessableEntity {
+	return &WebhookDeliveriesRedeliverUnprocessableEntity{}
+}
+
+/*
+WebhookDeliveriesRedeliverUnprocessableEntity describes a response with status code 422, with default header values.
+
+Unprocessable Entity
+*/
+type WebhookDeliveriesRedeliverUnprocessableEntity struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhook deliveries redeliver unprocessable entity response has a 2xx status code
+func (o *WebhookDeliveriesRedeliverUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhook deliveries redeliver unprocessable entity response has a 3xx status code
+func (o *WebhookDeliveriesRedeliverUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries redeliver unprocessable entity response has a 4xx status code
+func (o *WebhookDeliveriesRedeliverUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhook deliveries redeliver unprocessable entity response has a 5xx status code
+func (o *WebhookDeliveriesRedeliverUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhook deliveries redeliver unprocessable entity response a status code equal to that given
+func (o *WebhookDeliveriesRedeliverUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the webhook deliveries redeliver unprocessable entity response
+func (o *WebhookDeliveriesRedeliverUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *WebhookDeliveriesRedeliverUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks/{webhookID}/deliveries/{deliveryID}/redeliver][%d] webhookDeliveriesRedeliverUnprocessableEntity %s", 422, payload)
+}
+
+func (o *WebhookDeliveriesRedeliverUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks/{webhookID}/deliveries/{deliveryID}/redeliver][%d] webhookDeliveriesRedeliverUnprocessableEntity %s", 422, payload)
+}
+
+func (o *WebhookDeliveriesRedeliverUnprocessableEntity) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesRedeliverUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhookDeliveriesRedeliverInternalServerError creates a WebhookDeliveriesRedeliverInternalServerError with default headers values
+func NewWebhookDeliveriesRedeliverInternalServerError() *WebhookDeliveriesRedeliverInternalServerError {
+	return &WebhookDeliveriesRedeliverInternalServerError{}
+}
+
+/*
+WebhookDeliveriesRedeliverInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type WebhookDeliveriesRedeliverInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhook deliveries redeliver internal server error response has a 2xx status code
+func (o *WebhookDeliveriesRedeliverInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhook deliveries redeliver internal server error response has a 3xx status code
+func (o *WebhookDeliveriesRedeliverInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries redeliver internal server error response has a 4xx status code
+func (o *WebhookDeliveriesRedeliverInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhook deliveries redeliver internal server error response has a 5xx status code
+func (o *WebhookDeliveriesRedeliverInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this webhook deliveries redeliver internal server error response a status code equal to that given
+func (o *WebhookDeliveriesRedeliverInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the webhook deliveries redeliver internal server error response
+func (o *WebhookDeliveriesRedeliverInternalServerError) Code() int {
+	return 500
+}
+
+func (o *WebhookDeliveriesRedeliverInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks/{webhookID}/deliveries/{deliveryID}/redeliver][%d] webhookDeliveriesRedeliverInternalServerError %s", 500, payload)
+}
+
+func (o *WebhookDeliveriesRedeliverInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks/{webhookID}/deliveries/{deliveryID}/redeliver][%d] webhookDeliveriesRedeliverInternalServerError %s", 500, payload)
+}
+
+func (o *WebhookDeliveriesRedeliverInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesRedeliverInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
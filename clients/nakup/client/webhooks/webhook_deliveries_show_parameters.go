@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewWebhookDeliveriesShowParams creates a new WebhookDeliveriesShowParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewWebhookDeliveriesShowParams() *WebhookDeliveriesShowParams {
+	return &WebhookDeliveriesShowParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewWebhookDeliveriesShowParamsWithTimeout creates a new WebhookDeliveriesShowParams object
+// with the ability to set a timeout on a request.
+func NewWebhookDeliveriesShowParamsWithTimeout(timeout time.Duration) *WebhookDeliveriesShowParams {
+	return &WebhookDeliveriesShowParams{
+		timeout: timeout,
+	}
+}
+
+// NewWebhookDeliveriesShowParamsWithContext creates a new WebhookDeliveriesShowParams object
+// with the ability to set a context for a request.
+func NewWebhookDeliveriesShowParamsWithContext(ctx context.Context) *WebhookDeliveriesShowParams {
+	return &WebhookDeliveriesShowParams{
+		Context: ctx,
+	}
+}
+
+// NewWebhookDeliveriesShowParamsWithHTTPClient creates a new WebhookDeliveriesShowParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewWebhookDeliveriesShowParamsWithHTTPClient(client *http.Client) *WebhookDeliveriesShowParams {
+	return &WebhookDeliveriesShowParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+WebhookDeliveriesShowParams contains all the parameters to send to the API endpoint
+
+	for the webhook deliveries show operation.
+
+	Typically these are written to a http.Request.
+*/
+type WebhookDeliveriesShowParams struct {
+
+	/* DeliveryID.
+
+	   Delivery ID
+
+	   Format: uuid
+	*/
+	DeliveryID strfmt.UUID
+
+	/* WebhookID.
+
+	   Webhook ID
+
+	   Format: uuid
+	*/
+	WebhookID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the webhook deliveries show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhookDeliveriesShowParams) WithDefaults() *WebhookDeliveriesShowParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the webhook deliveries show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhookDeliveriesShowParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the webhook deliveries show params
+func (o *WebhookDeliveriesShowParams) WithTimeout(timeout time.Duration) *WebhookDeliveriesShowParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the webhook deliveries show params
+func (o *WebhookDeliveriesShowParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the webhook deliveries show params
+func (o *WebhookDeliveriesShowParams) WithContext(ctx context.Context) *WebhookDeliveriesShowParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the webhook deliveries show params
+func (o *WebhookDeliveriesShowParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the webhook deliveries show params
+func (o *WebhookDeliveriesShowParams) WithHTTPClient(client *http.Client) *WebhookDeliveriesShowParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the webhook deliveries show params
+func (o *WebhookDeliveriesShowParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDeliveryID adds the deliveryID to the webhook deliveries show params
+func (o *WebhookDeliveriesShowParams) WithDeliveryID(deliveryID strfmt.UUID) *WebhookDeliveriesShowParams {
+	o.SetDeliveryID(deliveryID)
+	return o
+}
+
+// SetDeliveryID adds the deliveryId to the webhook deliveries show params
+func (o *WebhookDeliveriesShowParams) SetDeliveryID(deliveryID strfmt.UUID) {
+	o.DeliveryID = deliveryID
+}
+
+// WithWebhookID adds the webhookID to the webhook deliveries show params
+func (o *WebhookDeliveriesShowParams) WithWebhookID(webhookID strfmt.UUID) *WebhookDeliveriesShowParams {
+	o.SetWebhookID(webhookID)
+	return o
+}
+
+// SetWebhookID adds the webhookId to the webhook deliveries show params
+func (o *WebhookDeliveriesShowParams) SetWebhookID(webhookID strfmt.UUID) {
+	o.WebhookID = webhookID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *WebhookDeliveriesShowParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param deliveryID
+	if err := r.SetPathParam("deliveryID", o.DeliveryID.String()); err != nil {
+		return err
+	}
+
+	// path param webhookID
+	if err := r.SetPathParam("webhookID", o.WebhookID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// WebhookDeliveriesShowReader is a Reader for the WebhookDeliveriesShow structure.
+type WebhookDeliveriesShowReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *WebhookDeliveriesShowReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewWebhookDeliveriesShowOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewWebhookDeliveriesShowBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewWebhookDeliveriesShowNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewWebhookDeliveriesShowInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /webhooks/{webhookID}/deliveries/{deliveryID}] WebhookDeliveriesShow", response, response.Code())
+	}
+}
+
+// NewWebhookDeliveriesShowOK creates a WebhookDeliveriesShowOK with default headers values
+func NewWebhookDeliveriesShowOK() *WebhookDeliveriesShowOK {
+	return &WebhookDeliveriesShowOK{}
+}
+
+/*
+WebhookDeliveriesShowOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type WebhookDeliveriesShowOK struct {
+	Payload *models.APIWebhookDeliveryDetailResponse
+}
+
+// IsSuccess returns true when this webhook deliveries show o k response has a 2xx status code
+func (o *WebhookDeliveriesShowOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this webhook deliveries show o k response has a 3xx status code
+func (o *WebhookDeliveriesShowOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries show o k response has a 4xx status code
+func (o *WebhookDeliveriesShowOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhook deliveries show o k response has a 5xx status code
+func (o *WebhookDeliveriesShowOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhook deliveries show o k response a status code equal to that given
+func (o *WebhookDeliveriesShowOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the webhook deliveries show o k response
+func (o *WebhookDeliveriesShowOK) Code() int {
+	return 200
+}
+
+func (o *WebhookDeliveriesShowOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries/{deliveryID}][%d] webhookDeliveriesShowOK %s", 200, payload)
+}
+
+func (o *WebhookDeliveriesShowOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries/{deliveryID}][%d] webhookDeliveriesShowOK %s", 200, payload)
+}
+
+func (o *WebhookDeliveriesShowOK) GetPayload() *models.APIWebhookDeliveryDetailResponse {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesShowOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIWebhookDeliveryDetailResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhookDeliveriesShowBadRequest creates a WebhookDeliveriesShowBadRequest with default headers values
+func NewWebhookDeliveriesShowBadRequest() *WebhookDeliveriesShowBadRequest {
+	return &WebhookDeliveriesShowBadRequest{}
+}
+
+/*
+WebhookDeliveriesShowBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type WebhookDeliveriesShowBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhook deliveries show bad request response has a 2xx status code
+func (o *WebhookDeliveriesShowBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhook deliveries show bad request response has a 3xx status code
+func (o *WebhookDeliveriesShowBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries show bad request response has a 4xx status code
+func (o *WebhookDeliveriesShowBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhook deliveries show bad request response has a 5xx status code
+func (o *WebhookDeliveriesShowBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhook deliveries show bad request response a status code equal to that given
+func (o *WebhookDeliveriesShowBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the webhook deliveries show bad request response
+func (o *WebhookDeliveriesShowBadRequest) Code() int {
+	return 400
+}
+
+func (o *WebhookDeliveriesShowBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries/{deliveryID}][%d] webhookDeliveriesShowBadRequest %s", 400, payload)
+}
+
+func (o *WebhookDeliveriesShowBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries/{deliveryID}][%d] webhookDeliveriesShowBadRequest %s", 400, payload)
+}
+
+func (o *WebhookDeliveriesShowBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesShowBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhookDeliveriesShowNotFound creates a WebhookDeliveriesShowNotFound with default headers values
+func NewWebhookDeliveriesShowNotFound() *WebhookDeliveriesShowNotFound {
+	return &WebhookDeliveriesShowNotFound{}
+}
+
+/*
+WebhookDeliveriesShowNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type WebhookDeliveriesShowNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhook deliveries show not found response has a 2xx status code
+func (o *WebhookDeliveriesShowNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhook deliveries show not found response has a 3xx status code
+func (o *WebhookDeliveriesShowNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries show not found response has a 4xx status code
+func (o *WebhookDeliveriesShowNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhook deliveries show not found response has a 5xx status code
+func (o *WebhookDeliveriesShowNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhook deliveries show not found response a status code equal to that given
+func (o *WebhookDeliveriesShowNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the webhook deliveries show not found response
+func (o *WebhookDeliveriesShowNotFound) Code() int {
+	return 404
+}
+
+func (o *WebhookDeliveriesShowNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries/{deliveryID}][%d] webhookDeliveriesShowNotFound %s", 404, payload)
+}
+
+func (o *WebhookDeliveriesShowNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries/{deliveryID}][%d] webhookDeliveriesShowNotFound %s", 404, payload)
+}
+
+func (o *WebhookDeliveriesShowNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesShowNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhookDeliveriesShowInternalServerError creates a WebhookDeliveriesShowInternalServerError with default headers values
+func NewWebhookDeliveriesShowInternalServerError() *WebhookDeliveriesShowInternalServerError {
+	return &WebhookDeliveriesShowInternalServerError{}
+}
+
+/*
+WebhookDeliveriesShowInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type WebhookDeliveriesShowInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhook deliveries show internal server error response has a 2xx status code
+func (o *WebhookDeliveriesShowInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhook deliveries show internal server error response has a 3xx status code
+func (o *WebhookDeliveriesShowInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhook deliveries show internal server error response has a 4xx status code
+func (o *WebhookDeliveriesShowInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhook deliveries show internal server error response has a 5xx status code
+func (o *WebhookDeliveriesShowInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this webhook deliveries show internal server error response a status code equal to that given
+func (o *WebhookDeliveriesShowInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the webhook deliveries show internal server error response
+func (o *WebhookDeliveriesShowInternalServerError) Code() int {
+	return 500
+}
+
+func (o *WebhookDeliveriesShowInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries/{deliveryID}][%d] webhookDeliveriesShowInternalServerError %s", 500, payload)
+}
+
+func (o *WebhookDeliveriesShowInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}/deliveries/{deliveryID}][%d] webhookDeliveriesShowInternalServerError %s", 500, payload)
+}
+
+func (o *WebhookDeliveriesShowInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhookDeliveriesShowInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
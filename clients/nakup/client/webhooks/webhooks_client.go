@@ -0,0 +1,449 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new webhooks API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+// New creates a new webhooks API client with basic auth credentials.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - user: user for basic authentication header.
+// - password: password for basic authentication header.
+func NewClientWithBasicAuth(host, basePath, scheme, user, password string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BasicAuth(user, password)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+// New creates a new webhooks API client with a bearer token for authentication.
+// It takes the following parameters:
+// - host: http host (github.com).
+// - basePath: any base path for the API client ("/v1", "/v3").
+// - scheme: http scheme ("http", "https").
+// - bearerToken: bearer token for Bearer authentication header.
+func NewClientWithBearerToken(host, basePath, scheme, bearerToken string) ClientService {
+	transport := httptransport.New(host, basePath, []string{scheme})
+	transport.DefaultAuthentication = httptransport.BearerToken(bearerToken)
+	return &Client{transport: transport, formats: strfmt.Default}
+}
+
+/*
+Client for webhooks API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption may be used to customize the behavior of Client methods.
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	WebhookDeliveriesList(params *WebhookDeliveriesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhookDeliveriesListOK, error)
+
+	WebhookDeliveriesRedeliver(params *WebhookDeliveriesRedeliverParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhookDeliveriesRedeliverAccepted, error)
+
+	WebhookDeliveriesShow(params *WebhookDeliveriesShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhookDeliveriesShowOK, error)
+
+	WebhooksCreate(params *WebhooksCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhooksCreateCreated, error)
+
+	WebhooksDelete(params *WebhooksDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhooksDeleteNoContent, error)
+
+	WebhooksList(params *WebhooksListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhooksListOK, error)
+
+	WebhooksShow(params *WebhooksShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhooksShowOK, error)
+
+	WebhooksUpdate(params *WebhooksUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhooksUpdateOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+WebhookDeliveriesList lists webhook deliveries
+
+List deliveries of a webhook subscription
+*/
+func (a *Client) WebhookDeliveriesList(params *WebhookDeliveriesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhookDeliveriesListOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewWebhookDeliveriesListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "WebhookDeliveriesList",
+		Method:             "GET",
+		PathPattern:        "/webhooks/{webhookID}/deliveries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &WebhookDeliveriesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*WebhookDeliveriesListOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for WebhookDeliveriesList: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+WebhookDeliveriesRedeliver redelivers webhook
+
+Queue a delivery to be sent again right away, including deliveries in the dead-letter state
+*/
+func (a *Client) WebhookDeliveriesRedeliver(params *WebhookDeliveriesRedeliverParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhookDeliveriesRedeliverAccepted, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewWebhookDeliveriesRedeliverParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "WebhookDeliveriesRedeliver",
+		Method:             "POST",
+		PathPattern:        "/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &WebhookDeliveriesRedeliverReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*WebhookDeliveriesRedeliverAccepted)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for WebhookDeliveriesRedeliver: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+WebhookDeliveriesShow shows webhook delivery
+
+Show a webhook delivery with its payload and the log of every attempt
+*/
+func (a *Client) WebhookDeliveriesShow(params *WebhookDeliveriesShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhookDeliveriesShowOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewWebhookDeliveriesShowParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "WebhookDeliveriesShow",
+		Method:             "GET",
+		PathPattern:        "/webhooks/{webhookID}/deliveries/{deliveryID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &WebhookDeliveriesShowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*WebhookDeliveriesShowOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for WebhookDeliveriesShow: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+WebhooksCreate creates webhook subscription
+
+Subscribe a URL to reservation and purchase events. Deliveries are signed with HMAC-SHA256 using the secret.
+*/
+func (a *Client) WebhooksCreate(params *WebhooksCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhooksCreateCreated, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewWebhooksCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "WebhooksCreate",
+		Method:             "POST",
+		PathPattern:        "/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &WebhooksCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*WebhooksCreateCreated)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for WebhooksCreate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+WebhooksDelete deletes webhook subscription
+
+Delete webhook subscription together with its delivery logs
+*/
+func (a *Client) WebhooksDelete(params *WebhooksDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhooksDeleteNoContent, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewWebhooksDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "WebhooksDelete",
+		Method:             "DELETE",
+		PathPattern:        "/webhooks/{webhookID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &WebhooksDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*WebhooksDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for WebhooksDelete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+WebhooksList lists webhook subscriptions
+
+List webhook subscriptions
+*/
+func (a *Client) WebhooksList(params *WebhooksListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhooksListOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewWebhooksListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "WebhooksList",
+		Method:             "GET",
+		PathPattern:        "/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &WebhooksListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*WebhooksListOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for WebhooksList: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+WebhooksShow shows webhook subscription
+
+Show webhook subscription
+*/
+func (a *Client) WebhooksShow(params *WebhooksShowParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhooksShowOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewWebhooksShowParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "WebhooksShow",
+		Method:             "GET",
+		PathPattern:        "/webhooks/{webhookID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &WebhooksShowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*WebhooksShowOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for WebhooksShow: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+WebhooksUpdate updates webhook subscription
+
+Update webhook subscription
+*/
+func (a *Client) WebhooksUpdate(params *WebhooksUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*WebhooksUpdateOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewWebhooksUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "WebhooksUpdate",
+		Method:             "PUT",
+		PathPattern:        "/webhooks/{webhookID}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &WebhooksUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*WebhooksUpdateOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for WebhooksUpdate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
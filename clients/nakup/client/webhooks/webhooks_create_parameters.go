@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewWebhooksCreateParams creates a new WebhooksCreateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewWebhooksCreateParams() *WebhooksCreateParams {
+	return &WebhooksCreateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewWebhooksCreateParamsWithTimeout creates a new WebhooksCreateParams object
+// with the ability to set a timeout on a request.
+func NewWebhooksCreateParamsWithTimeout(timeout time.Duration) *WebhooksCreateParams {
+	return &WebhooksCreateParams{
+		timeout: timeout,
+	}
+}
+
+// NewWebhooksCreateParamsWithContext creates a new WebhooksCreateParams object
+// with the ability to set a context for a request.
+func NewWebhooksCreateParamsWithContext(ctx context.Context) *WebhooksCreateParams {
+	return &WebhooksCreateParams{
+		Context: ctx,
+	}
+}
+
+// NewWebhooksCreateParamsWithHTTPClient creates a new WebhooksCreateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewWebhooksCreateParamsWithHTTPClient(client *http.Client) *WebhooksCreateParams {
+	return &WebhooksCreateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+WebhooksCreateParams contains all the parameters to send to the API endpoint
+
+	for the webhooks create operation.
+
+	Typically these are written to a http.Request.
+*/
+type WebhooksCreateParams struct {
+
+	/* IdempotencyKey.
+
+	   Key that makes retries of the request safe
+	*/
+	IdempotencyKey *string
+
+	/* Request.
+
+	   request body
+	*/
+	Request *models.APIWebhookRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the webhooks create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhooksCreateParams) WithDefaults() *WebhooksCreateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the webhooks create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhooksCreateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the webhooks create params
+func (o *WebhooksCreateParams) WithTimeout(timeout time.Duration) *WebhooksCreateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the webhooks create params
+func (o *WebhooksCreateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the webhooks create params
+func (o *WebhooksCreateParams) WithContext(ctx context.Context) *WebhooksCreateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the webhooks create params
+func (o *WebhooksCreateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the webhooks create params
+func (o *WebhooksCreateParams) WithHTTPClient(client *http.Client) *WebhooksCreateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the webhooks create params
+func (o *WebhooksCreateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIdempotencyKey adds the idempotencyKey to the webhooks create params
+func (o *WebhooksCreateParams) WithIdempotencyKey(idempotencyKey *string) *WebhooksCreateParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the webhooks create params
+func (o *WebhooksCreateParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
+// WithRequest adds the request to the webhooks create params
+func (o *WebhooksCreateParams) WithRequest(request *models.APIWebhookRequest) *WebhooksCreateParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the webhooks create params
+func (o *WebhooksCreateParams) SetRequest(request *models.APIWebhookRequest) {
+	o.Request = request
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *WebhooksCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
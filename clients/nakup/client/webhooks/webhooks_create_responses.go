@@ -0,0 +1,335 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// WebhooksCreateReader is a Reader for the WebhooksCreate structure.
+type WebhooksCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *WebhooksCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 201:
+		result := NewWebhooksCreateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewWebhooksCreateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewWebhooksCreateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewWebhooksCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /webhooks] WebhooksCreate", response, response.Code())
+	}
+}
+
+// NewWebhooksCreateCreated creates a WebhooksCreateCreated with default headers values
+func NewWebhooksCreateCreated() *WebhooksCreateCreated {
+	return &WebhooksCreateCreated{}
+}
+
+/*
+WebhooksCreateCreated describes a response with status code 201, with default header values.
+
+Created
+*/
+type WebhooksCreateCreated struct {
+	Payload *models.APIWebhookResponse
+}
+
+// IsSuccess returns true when this webhooks create created response has a 2xx status code
+func (o *WebhooksCreateCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this webhooks create created response has a 3xx status code
+func (o *WebhooksCreateCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks create created response has a 4xx status code
+func (o *WebhooksCreateCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhooks create created response has a 5xx status code
+func (o *WebhooksCreateCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks create created response a status code equal to that given
+func (o *WebhooksCreateCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the webhooks create created response
+func (o *WebhooksCreateCreated) Code() int {
+	return 201
+}
+
+func (o *WebhooksCreateCreated) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks][%d] webhooksCreateCreated %s", 201, payload)
+}
+
+func (o *WebhooksCreateCreated) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks][%d] webhooksCreateCreated %s", 201, payload)
+}
+
+func (o *WebhooksCreateCreated) GetPayload() *models.APIWebhookResponse {
+	return o.Payload
+}
+
+func (o *WebhooksCreateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIWebhookResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksCreateBadRequest creates a WebhooksCreateBadRequest with default headers values
+func NewWebhooksCreateBadRequest() *WebhooksCreateBadRequest {
+	return &WebhooksCreateBadRequest{}
+}
+
+/*
+WebhooksCreateBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type WebhooksCreateBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks create bad request response has a 2xx status code
+func (o *WebhooksCreateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks create bad request response has a 3xx status code
+func (o *WebhooksCreateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks create bad request response has a 4xx status code
+func (o *WebhooksCreateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhooks create bad request response has a 5xx status code
+func (o *WebhooksCreateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks create bad request response a status code equal to that given
+func (o *WebhooksCreateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the webhooks create bad request response
+func (o *WebhooksCreateBadRequest) Code() int {
+	return 400
+}
+
+func (o *WebhooksCreateBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks][%d] webhooksCreateBadRequest %s", 400, payload)
+}
+
+func (o *WebhooksCreateBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks][%d] webhooksCreateBadRequest %s", 400, payload)
+}
+
+func (o *WebhooksCreateBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksCreateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksCreateUnprocessableEntity creates a WebhooksCreateUnprocessableEntity with default headers values
+func NewWebhooksCreateUnprocessableEntity() *WebhooksCreateUnprocessableEntity {
+	return &WebhooksCreateUnprocessableEntity{}
+}
+
+/*
+WebhooksCreateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Unprocessable Entity
+*/
+type WebhooksCreateUnprocessableEntity struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks create unprocessable entity response has a 2xx status code
+func (o *WebhooksCreateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks create unprocessable entity response has a 3xx status code
+func (o *WebhooksCreateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks create unprocessable entity response has a 4xx status code
+func (o *WebhooksCreateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhooks create unprocessable entity response has a 5xx status code
+func (o *WebhooksCreateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks create unprocessable entity response a status code equal to that given
+func (o *WebhooksCreateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the webhooks create unprocessable entity response
+func (o *WebhooksCreateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *WebhooksCreateUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks][%d] webhooksCreateUnprocessableEntity %s", 422, payload)
+}
+
+func (o *WebhooksCreateUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks][%d] webhooksCreateUnprocessableEntity %s", 422, payload)
+}
+
+func (o *WebhooksCreateUnprocessableEntity) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksCreateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksCreateInternalServerError creates a WebhooksCreateInternalServerError with default headers values
+func NewWebhooksCreateInternalServerError() *WebhooksCreateInternalServerError {
+	return &WebhooksCreateInternalServerError{}
+}
+
+/*
+WebhooksCreateInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type WebhooksCreateInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks create internal server error response has a 2xx status code
+func (o *WebhooksCreateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks create internal server error response has a 3xx status code
+func (o *WebhooksCreateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks create internal server error response has a 4xx status code
+func (o *WebhooksCreateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhooks create internal server error response has a 5xx status code
+func (o *WebhooksCreateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this webhooks create internal server error response a status code equal to that given
+func (o *WebhooksCreateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the webhooks create internal server error response
+func (o *WebhooksCreateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *WebhooksCreateInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks][%d] webhooksCreateInternalServerError %s", 500, payload)
+}
+
+func (o *WebhooksCreateInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /webhooks][%d] webhooksCreateInternalServerError %s", 500, payload)
+}
+
+func (o *WebhooksCreateInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewWebhooksDeleteParams creates a new WebhooksDeleteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewWebhooksDeleteParams() *WebhooksDeleteParams {
+	return &WebhooksDeleteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewWebhooksDeleteParamsWithTimeout creates a new WebhooksDeleteParams object
+// with the ability to set a timeout on a request.
+func NewWebhooksDeleteParamsWithTimeout(timeout time.Duration) *WebhooksDeleteParams {
+	return &WebhooksDeleteParams{
+		timeout: timeout,
+	}
+}
+
+// NewWebhooksDeleteParamsWithContext creates a new WebhooksDeleteParams object
+// with the ability to set a context for a request.
+func NewWebhooksDeleteParamsWithContext(ctx context.Context) *WebhooksDeleteParams {
+	return &WebhooksDeleteParams{
+		Context: ctx,
+	}
+}
+
+// NewWebhooksDeleteParamsWithHTTPClient creates a new WebhooksDeleteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewWebhooksDeleteParamsWithHTTPClient(client *http.Client) *WebhooksDeleteParams {
+	return &WebhooksDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+WebhooksDeleteParams contains all the parameters to send to the API endpoint
+
+	for the webhooks delete operation.
+
+	Typically these are written to a http.Request.
+*/
+type WebhooksDeleteParams struct {
+
+	/* WebhookID.
+
+	   Webhook ID
+
+	   Format: uuid
+	*/
+	WebhookID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the webhooks delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhooksDeleteParams) WithDefaults() *WebhooksDeleteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the webhooks delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhooksDeleteParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the webhooks delete params
+func (o *WebhooksDeleteParams) WithTimeout(timeout time.Duration) *WebhooksDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the webhooks delete params
+func (o *WebhooksDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the webhooks delete params
+func (o *WebhooksDeleteParams) WithContext(ctx context.Context) *WebhooksDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the webhooks delete params
+func (o *WebhooksDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the webhooks delete params
+func (o *WebhooksDeleteParams) WithHTTPClient(client *http.Client) *WebhooksDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the webhooks delete params
+func (o *WebhooksDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithWebhookID adds the webhookID to the webhooks delete params
+func (o *WebhooksDeleteParams) WithWebhookID(webhookID strfmt.UUID) *WebhooksDeleteParams {
+	o.SetWebhookID(webhookID)
+	return o
+}
+
+// SetWebhookID adds the webhookId to the webhooks delete params
+func (o *WebhooksDeleteParams) SetWebhookID(webhookID strfmt.UUID) {
+	o.WebhookID = webhookID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *WebhooksDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param webhookID
+	if err := r.SetPathParam("webhookID", o.WebhookID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
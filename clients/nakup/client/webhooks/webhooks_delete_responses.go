@@ -0,0 +1,321 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// WebhooksDeleteReader is a Reader for the WebhooksDelete structure.
+type WebhooksDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *WebhooksDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 204:
+		result := NewWebhooksDeleteNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewWebhooksDeleteBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewWebhooksDeleteNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewWebhooksDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /webhooks/{webhookID}] WebhooksDelete", response, response.Code())
+	}
+}
+
+// NewWebhooksDeleteNoContent creates a WebhooksDeleteNoContent with default headers values
+func NewWebhooksDeleteNoContent() *WebhooksDeleteNoContent {
+	return &WebhooksDeleteNoContent{}
+}
+
+/*
+WebhooksDeleteNoContent describes a response with status code 204, with default header values.
+
+No Content
+*/
+type WebhooksDeleteNoContent struct {
+}
+
+// IsSuccess returns true when this webhooks delete no content response has a 2xx status code
+func (o *WebhooksDeleteNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this webhooks delete no content response has a 3xx status code
+func (o *WebhooksDeleteNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks delete no content response has a 4xx status code
+func (o *WebhooksDeleteNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhooks delete no content response has a 5xx status code
+func (o *WebhooksDeleteNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks delete no content response a status code equal to that given
+func (o *WebhooksDeleteNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the webhooks delete no content response
+func (o *WebhooksDeleteNoContent) Code() int {
+	return 204
+}
+
+func (o *WebhooksDeleteNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /webhooks/{webhookID}][%d] webhooksDeleteNoContent", 204)
+}
+
+func (o *WebhooksDeleteNoContent) String() string {
+	return fmt.Sprintf("[DELETE /webhooks/{webhookID}][%d] webhooksDeleteNoContent", 204)
+}
+
+func (o *WebhooksDeleteNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewWebhooksDeleteBadRequest creates a WebhooksDeleteBadRequest with default headers values
+func NewWebhooksDeleteBadRequest() *WebhooksDeleteBadRequest {
+	return &WebhooksDeleteBadRequest{}
+}
+
+/*
+WebhooksDeleteBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type WebhooksDeleteBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks delete bad request response has a 2xx status code
+func (o *WebhooksDeleteBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks delete bad request response has a 3xx status code
+func (o *WebhooksDeleteBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks delete bad request response has a 4xx status code
+func (o *WebhooksDeleteBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhooks delete bad request response has a 5xx status code
+func (o *WebhooksDeleteBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks delete bad request response a status code equal to that given
+func (o *WebhooksDeleteBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the webhooks delete bad request response
+func (o *WebhooksDeleteBadRequest) Code() int {
+	return 400
+}
+
+func (o *WebhooksDeleteBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /webhooks/{webhookID}][%d] webhooksDeleteBadRequest %s", 400, payload)
+}
+
+func (o *WebhooksDeleteBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /webhooks/{webhookID}][%d] webhooksDeleteBadRequest %s", 400, payload)
+}
+
+func (o *WebhooksDeleteBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksDeleteBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksDeleteNotFound creates a WebhooksDeleteNotFound with default headers values
+func NewWebhooksDeleteNotFound() *WebhooksDeleteNotFound {
+	return &WebhooksDeleteNotFound{}
+}
+
+/*
+WebhooksDeleteNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type WebhooksDeleteNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks delete not found response has a 2xx status code
+func (o *WebhooksDeleteNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks delete not found response has a 3xx status code
+func (o *WebhooksDeleteNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks delete not found response has a 4xx status code
+func (o *WebhooksDeleteNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhooks delete not found response has a 5xx status code
+func (o *WebhooksDeleteNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks delete not found response a status code equal to that given
+func (o *WebhooksDeleteNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the webhooks delete not found response
+func (o *WebhooksDeleteNotFound) Code() int {
+	return 404
+}
+
+func (o *WebhooksDeleteNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /webhooks/{webhookID}][%d] webhooksDeleteNotFound %s", 404, payload)
+}
+
+func (o *WebhooksDeleteNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /webhooks/{webhookID}][%d] webhooksDeleteNotFound %s", 404, payload)
+}
+
+func (o *WebhooksDeleteNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksDeleteNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksDeleteInternalServerError creates a WebhooksDeleteInternalServerError with default headers values
+func NewWebhooksDeleteInternalServerError() *WebhooksDeleteInternalServerError {
+	return &WebhooksDeleteInternalServerError{}
+}
+
+/*
+WebhooksDeleteInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type WebhooksDeleteInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks delete internal server error response has a 2xx status code
+func (o *WebhooksDeleteInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks delete internal server error response has a 3xx status code
+func (o *WebhooksDeleteInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks delete internal server error response has a 4xx status code
+func (o *WebhooksDeleteInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhooks delete internal server error response has a 5xx status code
+func (o *WebhooksDeleteInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this webhooks delete internal server error response a status code equal to that given
+func (o *WebhooksDeleteInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the webhooks delete internal server error response
+func (o *WebhooksDeleteInternalServerError) Code() int {
+	return 500
+}
+
+func (o *WebhooksDeleteInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /webhooks/{webhookID}][%d] webhooksDeleteInternalServerError %s", 500, payload)
+}
+
+func (o *WebhooksDeleteInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /webhooks/{webhookID}][%d] webhooksDeleteInternalServerError %s", 500, payload)
+}
+
+func (o *WebhooksDeleteInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
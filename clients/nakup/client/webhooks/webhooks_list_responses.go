@@ -0,0 +1,435 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// WebhooksListReader is a Reader for the WebhooksList structure.
+type WebhooksListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *WebhooksListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewWebhooksListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewWebhooksListBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewWebhooksListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /webhooks] WebhooksList", response, response.Code())
+	}
+}
+
+// NewWebhooksListOK creates a WebhooksListOK with default headers values
+func NewWebhooksListOK() *WebhooksListOK {
+	return &WebhooksListOK{}
+}
+
+/*
+WebhooksListOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type WebhooksListOK struct {
+	Payload *WebhooksListOKBody
+}
+
+// IsSuccess returns true when this webhooks list o k response has a 2xx status code
+func (o *WebhooksListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this webhooks list o k response has a 3xx status code
+func (o *WebhooksListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks list o k response has a 4xx status code
+func (o *WebhooksListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhooks list o k response has a 5xx status code
+func (o *WebhooksListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks list o k response a status code equal to that given
+func (o *WebhooksListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the webhooks list o k response
+func (o *WebhooksListOK) Code() int {
+	return 200
+}
+
+func (o *WebhooksListOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks][%d] webhooksListOK %s", 200, payload)
+}
+
+func (o *WebhooksListOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks][%d] webhooksListOK %s", 200, payload)
+}
+
+func (o *WebhooksListOK) GetPayload() *WebhooksListOKBody {
+	return o.Payload
+}
+
+func (o *WebhooksListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(WebhooksListOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksListBadRequest creates a WebhooksListBadRequest with default headers values
+func NewWebhooksListBadRequest() *WebhooksListBadRequest {
+	return &WebhooksListBadRequest{}
+}
+
+/*
+WebhooksListBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type WebhooksListBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks list bad request response has a 2xx status code
+func (o *WebhooksListBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks list bad request response has a 3xx status code
+func (o *WebhooksListBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks list bad request response has a 4xx status code
+func (o *WebhooksListBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhooks list bad request response has a 5xx status code
+func (o *WebhooksListBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks list bad request response a status code equal to that given
+func (o *WebhooksListBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the webhooks list bad request response
+func (o *WebhooksListBadRequest) Code() int {
+	return 400
+}
+
+func (o *WebhooksListBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks][%d] webhooksListBadRequest %s", 400, payload)
+}
+
+func (o *WebhooksListBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks][%d] webhooksListBadRequest %s", 400, payload)
+}
+
+func (o *WebhooksListBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksListBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksListInternalServerError creates a WebhooksListInternalServerError with default headers values
+func NewWebhooksListInternalServerError() *WebhooksListInternalServerError {
+	return &WebhooksListInternalServerError{}
+}
+
+/*
+WebhooksListInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type WebhooksListInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks list internal server error response has a 2xx status code
+func (o *WebhooksListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks list internal server error response has a 3xx status code
+func (o *WebhooksListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks list internal server error response has a 4xx status code
+func (o *WebhooksListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhooks list internal server error response has a 5xx status code
+func (o *WebhooksListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this webhooks list internal server error response a status code equal to that given
+func (o *WebhooksListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the webhooks list internal server error response
+func (o *WebhooksListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *WebhooksListInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks][%d] webhooksListInternalServerError %s", 500, payload)
+}
+
+func (o *WebhooksListInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks][%d] webhooksListInternalServerError %s", 500, payload)
+}
+
+func (o *WebhooksListInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+/*
+WebhooksListOKBody webhooks list o k body
+swagger:model WebhooksListOKBody
+*/
+type WebhooksListOKBody struct {
+	models.RequestPaginatedResponse
+
+	// data
+	Data []*models.APIWebhookResponse `json:"data"`
+}
+
+// UnmarshalJSON unmarshals this object from a JSON structure
+func (o *WebhooksListOKBody) UnmarshalJSON(raw []byte) error {
+	// WebhooksListOKBodyAO0
+	var webhooksListOKBodyAO0 models.RequestPaginatedResponse
+	if err := swag.ReadJSON(raw, &webhooksListOKBodyAO0); err != nil {
+		return err
+	}
+	o.RequestPaginatedResponse = webhooksListOKBodyAO0
+
+	// WebhooksListOKBodyAO1
+	var dataWebhooksListOKBodyAO1 struct {
+		Data []*models.APIWebhookResponse `json:"data"`
+	}
+	if err := swag.ReadJSON(raw, &dataWebhooksListOKBodyAO1); err != nil {
+		return err
+	}
+
+	o.Data = dataWebhooksListOKBodyAO1.Data
+
+	return nil
+}
+
+// MarshalJSON marshals this object to a JSON structure
+func (o WebhooksListOKBody) MarshalJSON() ([]byte, error) {
+	_parts := make([][]byte, 0, 2)
+
+	webhooksListOKBodyAO0, err := swag.WriteJSON(o.RequestPaginatedResponse)
+	if err != nil {
+		return nil, err
+	}
+	_parts = append(_parts, webhooksListOKBodyAO0)
+	var dataWebhooksListOKBodyAO1 struct {
+		Data []*models.APIWebhookResponse `json:"data"`
+	}
+
+	dataWebhooksListOKBodyAO1.Data = o.Data
+
+	jsonDataWebhooksListOKBodyAO1, errWebhooksListOKBodyAO1 := swag.WriteJSON(dataWebhooksListOKBodyAO1)
+	if errWebhooksListOKBodyAO1 != nil {
+		return nil, errWebhooksListOKBodyAO1
+	}
+	_parts = append(_parts, jsonDataWebhooksListOKBodyAO1)
+	return swag.ConcatJSON(_parts...), nil
+}
+
+// Validate validates this webhooks list o k body
+func (o *WebhooksListOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.Validate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateData(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *WebhooksListOKBody) validateData(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Data) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Data); i++ {
+		if swag.IsZero(o.Data[i]) { // not required
+			continue
+		}
+
+		if o.Data[i] != nil {
+			if err := o.Data[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("webhooksListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("webhooksListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this webhooks list o k body based on the context it is used
+func (o *WebhooksListOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with models.RequestPaginatedResponse
+	if err := o.RequestPaginatedResponse.ContextValidate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateData(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *WebhooksListOKBody) contextValidateData(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Data); i++ {
+
+		if o.Data[i] != nil {
+
+			if swag.IsZero(o.Data[i]) { // not required
+				return nil
+			}
+
+			if err := o.Data[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("webhooksListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("webhooksListOK" + "." + "data" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *WebhooksListOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *WebhooksListOKBody) UnmarshalBinary(b []byte) error {
+	var res WebhooksListOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewWebhooksShowParams creates a new WebhooksShowParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewWebhooksShowParams() *WebhooksShowParams {
+	return &WebhooksShowParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewWebhooksShowParamsWithTimeout creates a new WebhooksShowParams object
+// with the ability to set a timeout on a request.
+func NewWebhooksShowParamsWithTimeout(timeout time.Duration) *WebhooksShowParams {
+	return &WebhooksShowParams{
+		timeout: timeout,
+	}
+}
+
+// NewWebhooksShowParamsWithContext creates a new WebhooksShowParams object
+// with the ability to set a context for a request.
+func NewWebhooksShowParamsWithContext(ctx context.Context) *WebhooksShowParams {
+	return &WebhooksShowParams{
+		Context: ctx,
+	}
+}
+
+// NewWebhooksShowParamsWithHTTPClient creates a new WebhooksShowParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewWebhooksShowParamsWithHTTPClient(client *http.Client) *WebhooksShowParams {
+	return &WebhooksShowParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+WebhooksShowParams contains all the parameters to send to the API endpoint
+
+	for the webhooks show operation.
+
+	Typically these are written to a http.Request.
+*/
+type WebhooksShowParams struct {
+
+	/* WebhookID.
+
+	   Webhook ID
+
+	   Format: uuid
+	*/
+	WebhookID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the webhooks show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhooksShowParams) WithDefaults() *WebhooksShowParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the webhooks show params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhooksShowParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the webhooks show params
+func (o *WebhooksShowParams) WithTimeout(timeout time.Duration) *WebhooksShowParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the webhooks show params
+func (o *WebhooksShowParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the webhooks show params
+func (o *WebhooksShowParams) WithContext(ctx context.Context) *WebhooksShowParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the webhooks show params
+func (o *WebhooksShowParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the webhooks show params
+func (o *WebhooksShowParams) WithHTTPClient(client *http.Client) *WebhooksShowParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the webhooks show params
+func (o *WebhooksShowParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithWebhookID adds the webhookID to the webhooks show params
+func (o *WebhooksShowParams) WithWebhookID(webhookID strfmt.UUID) *WebhooksShowParams {
+	o.SetWebhookID(webhookID)
+	return o
+}
+
+// SetWebhookID adds the webhookId to the webhooks show params
+func (o *WebhooksShowParams) SetWebhookID(webhookID strfmt.UUID) {
+	o.WebhookID = webhookID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *WebhooksShowParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param webhookID
+	if err := r.SetPathParam("webhookID", o.WebhookID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// WebhooksShowReader is a Reader for the WebhooksShow structure.
+type WebhooksShowReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *WebhooksShowReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewWebhooksShowOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewWebhooksShowBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewWebhooksShowNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewWebhooksShowInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /webhooks/{webhookID}] WebhooksShow", response, response.Code())
+	}
+}
+
+// NewWebhooksShowOK creates a WebhooksShowOK with default headers values
+func NewWebhooksShowOK() *WebhooksShowOK {
+	return &WebhooksShowOK{}
+}
+
+/*
+WebhooksShowOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type WebhooksShowOK struct {
+	Payload *models.APIWebhookResponse
+}
+
+// IsSuccess returns true when this webhooks show o k response has a 2xx status code
+func (o *WebhooksShowOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this webhooks show o k response has a 3xx status code
+func (o *WebhooksShowOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks show o k response has a 4xx status code
+func (o *WebhooksShowOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhooks show o k response has a 5xx status code
+func (o *WebhooksShowOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks show o k response a status code equal to that given
+func (o *WebhooksShowOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the webhooks show o k response
+func (o *WebhooksShowOK) Code() int {
+	return 200
+}
+
+func (o *WebhooksShowOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}][%d] webhooksShowOK %s", 200, payload)
+}
+
+func (o *WebhooksShowOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}][%d] webhooksShowOK %s", 200, payload)
+}
+
+func (o *WebhooksShowOK) GetPayload() *models.APIWebhookResponse {
+	return o.Payload
+}
+
+func (o *WebhooksShowOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIWebhookResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksShowBadRequest creates a WebhooksShowBadRequest with default headers values
+func NewWebhooksShowBadRequest() *WebhooksShowBadRequest {
+	return &WebhooksShowBadRequest{}
+}
+
+/*
+WebhooksShowBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type WebhooksShowBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks show bad request response has a 2xx status code
+func (o *WebhooksShowBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks show bad request response has a 3xx status code
+func (o *WebhooksShowBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks show bad request response has a 4xx status code
+func (o *WebhooksShowBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhooks show bad request response has a 5xx status code
+func (o *WebhooksShowBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks show bad request response a status code equal to that given
+func (o *WebhooksShowBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the webhooks show bad request response
+func (o *WebhooksShowBadRequest) Code() int {
+	return 400
+}
+
+func (o *WebhooksShowBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}][%d] webhooksShowBadRequest %s", 400, payload)
+}
+
+func (o *WebhooksShowBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}][%d] webhooksShowBadRequest %s", 400, payload)
+}
+
+func (o *WebhooksShowBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksShowBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksShowNotFound creates a WebhooksShowNotFound with default headers values
+func NewWebhooksShowNotFound() *WebhooksShowNotFound {
+	return &WebhooksShowNotFound{}
+}
+
+/*
+WebhooksShowNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type WebhooksShowNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks show not found response has a 2xx status code
+func (o *WebhooksShowNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks show not found response has a 3xx status code
+func (o *WebhooksShowNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks show not found response has a 4xx status code
+func (o *WebhooksShowNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhooks show not found response has a 5xx status code
+func (o *WebhooksShowNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks show not found response a status code equal to that given
+func (o *WebhooksShowNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the webhooks show not found response
+func (o *WebhooksShowNotFound) Code() int {
+	return 404
+}
+
+func (o *WebhooksShowNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}][%d] webhooksShowNotFound %s", 404, payload)
+}
+
+func (o *WebhooksShowNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}][%d] webhooksShowNotFound %s", 404, payload)
+}
+
+func (o *WebhooksShowNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksShowNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksShowInternalServerError creates a WebhooksShowInternalServerError with default headers values
+func NewWebhooksShowInternalServerError() *WebhooksShowInternalServerError {
+	return &WebhooksShowInternalServerError{}
+}
+
+/*
+WebhooksShowInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type WebhooksShowInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks show internal server error response has a 2xx status code
+func (o *WebhooksShowInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks show internal server error response has a 3xx status code
+func (o *WebhooksShowInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks show internal server error response has a 4xx status code
+func (o *WebhooksShowInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhooks show internal server error response has a 5xx status code
+func (o *WebhooksShowInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this webhooks show internal server error response a status code equal to that given
+func (o *WebhooksShowInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the webhooks show internal server error response
+func (o *WebhooksShowInternalServerError) Code() int {
+	return 500
+}
+
+func (o *WebhooksShowInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}][%d] webhooksShowInternalServerError %s", 500, payload)
+}
+
+func (o *WebhooksShowInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /webhooks/{webhookID}][%d] webhooksShowInternalServerError %s", 500, payload)
+}
+
+func (o *WebhooksShowInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksShowInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
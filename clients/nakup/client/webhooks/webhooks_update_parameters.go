@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// NewWebhooksUpdateParams creates a new WebhooksUpdateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewWebhooksUpdateParams() *WebhooksUpdateParams {
+	return &WebhooksUpdateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewWebhooksUpdateParamsWithTimeout creates a new WebhooksUpdateParams object
+// with the ability to set a timeout on a request.
+func NewWebhooksUpdateParamsWithTimeout(timeout time.Duration) *WebhooksUpdateParams {
+	return &WebhooksUpdateParams{
+		timeout: timeout,
+	}
+}
+
+// NewWebhooksUpdateParamsWithContext creates a new WebhooksUpdateParams object
+// with the ability to set a context for a request.
+func NewWebhooksUpdateParamsWithContext(ctx context.Context) *WebhooksUpdateParams {
+	return &WebhooksUpdateParams{
+		Context: ctx,
+	}
+}
+
+// NewWebhooksUpdateParamsWithHTTPClient creates a new WebhooksUpdateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewWebhooksUpdateParamsWithHTTPClient(client *http.Client) *WebhooksUpdateParams {
+	return &WebhooksUpdateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+WebhooksUpdateParams contains all the parameters to send to the API endpoint
+
+	for the webhooks update operation.
+
+	Typically these are written to a http.Request.
+*/
+type WebhooksUpdateParams struct {
+
+	/* Request.
+
+	   request body
+	*/
+	Request *models.APIWebhookRequest
+
+	/* WebhookID.
+
+	   Webhook ID
+
+	   Format: uuid
+	*/
+	WebhookID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the webhooks update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhooksUpdateParams) WithDefaults() *WebhooksUpdateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the webhooks update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WebhooksUpdateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the webhooks update params
+func (o *WebhooksUpdateParams) WithTimeout(timeout time.Duration) *WebhooksUpdateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the webhooks update params
+func (o *WebhooksUpdateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the webhooks update params
+func (o *WebhooksUpdateParams) WithContext(ctx context.Context) *WebhooksUpdateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the webhooks update params
+func (o *WebhooksUpdateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the webhooks update params
+func (o *WebhooksUpdateParams) WithHTTPClient(client *http.Client) *WebhooksUpdateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the webhooks update params
+func (o *WebhooksUpdateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRequest adds the request to the webhooks update params
+func (o *WebhooksUpdateParams) WithRequest(request *models.APIWebhookRequest) *WebhooksUpdateParams {
+	o.SetRequest(request)
+	return o
+}
+
+// SetRequest adds the request to the webhooks update params
+func (o *WebhooksUpdateParams) SetRequest(request *models.APIWebhookRequest) {
+	o.Request = request
+}
+
+// WithWebhookID adds the webhookID to the webhooks update params
+func (o *WebhooksUpdateParams) WithWebhookID(webhookID strfmt.UUID) *WebhooksUpdateParams {
+	o.SetWebhookID(webhookID)
+	return o
+}
+
+// SetWebhookID adds the webhookId to the webhooks update params
+func (o *WebhooksUpdateParams) SetWebhookID(webhookID strfmt.UUID) {
+	o.WebhookID = webhookID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *WebhooksUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Request != nil {
+		if err := r.SetBodyParam(o.Request); err != nil {
+			return err
+		}
+	}
+
+	// path param webhookID
+	if err := r.SetPathParam("webhookID", o.WebhookID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+)
+
+// WebhooksUpdateReader is a Reader for the WebhooksUpdate structure.
+type WebhooksUpdateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *WebhooksUpdateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewWebhooksUpdateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewWebhooksUpdateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewWebhooksUpdateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewWebhooksUpdateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PUT /webhooks/{webhookID}] WebhooksUpdate", response, response.Code())
+	}
+}
+
+// NewWebhooksUpdateOK creates a WebhooksUpdateOK with default headers values
+func NewWebhooksUpdateOK() *WebhooksUpdateOK {
+	return &WebhooksUpdateOK{}
+}
+
+/*
+WebhooksUpdateOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type WebhooksUpdateOK struct {
+	Payload *models.APIWebhookResponse
+}
+
+// IsSuccess returns true when this webhooks update o k response has a 2xx status code
+func (o *WebhooksUpdateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this webhooks update o k response has a 3xx status code
+func (o *WebhooksUpdateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks update o k response has a 4xx status code
+func (o *WebhooksUpdateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhooks update o k response has a 5xx status code
+func (o *WebhooksUpdateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks update o k response a status code equal to that given
+func (o *WebhooksUpdateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the webhooks update o k response
+func (o *WebhooksUpdateOK) Code() int {
+	return 200
+}
+
+func (o *WebhooksUpdateOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /webhooks/{webhookID}][%d] webhooksUpdateOK %s", 200, payload)
+}
+
+func (o *WebhooksUpdateOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /webhooks/{webhookID}][%d] webhooksUpdateOK %s", 200, payload)
+}
+
+func (o *WebhooksUpdateOK) GetPayload() *models.APIWebhookResponse {
+	return o.Payload
+}
+
+func (o *WebhooksUpdateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIWebhookResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksUpdateBadRequest creates a WebhooksUpdateBadRequest with default headers values
+func NewWebhooksUpdateBadRequest() *WebhooksUpdateBadRequest {
+	return &WebhooksUpdateBadRequest{}
+}
+
+/*
+WebhooksUpdateBadRequest describes a response with status code 400, with default header values.
+
+Bad Request
+*/
+type WebhooksUpdateBadRequest struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks update bad request response has a 2xx status code
+func (o *WebhooksUpdateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks update bad request response has a 3xx status code
+func (o *WebhooksUpdateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks update bad request response has a 4xx status code
+func (o *WebhooksUpdateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhooks update bad request response has a 5xx status code
+func (o *WebhooksUpdateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks update bad request response a status code equal to that given
+func (o *WebhooksUpdateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the webhooks update bad request response
+func (o *WebhooksUpdateBadRequest) Code() int {
+	return 400
+}
+
+func (o *WebhooksUpdateBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /webhooks/{webhookID}][%d] webhooksUpdateBadRequest %s", 400, payload)
+}
+
+func (o *WebhooksUpdateBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /webhooks/{webhookID}][%d] webhooksUpdateBadRequest %s", 400, payload)
+}
+
+func (o *WebhooksUpdateBadRequest) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksUpdateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksUpdateNotFound creates a WebhooksUpdateNotFound with default headers values
+func NewWebhooksUpdateNotFound() *WebhooksUpdateNotFound {
+	return &WebhooksUpdateNotFound{}
+}
+
+/*
+WebhooksUpdateNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type WebhooksUpdateNotFound struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks update not found response has a 2xx status code
+func (o *WebhooksUpdateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks update not found response has a 3xx status code
+func (o *WebhooksUpdateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks update not found response has a 4xx status code
+func (o *WebhooksUpdateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this webhooks update not found response has a 5xx status code
+func (o *WebhooksUpdateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this webhooks update not found response a status code equal to that given
+func (o *WebhooksUpdateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the webhooks update not found response
+func (o *WebhooksUpdateNotFound) Code() int {
+	return 404
+}
+
+func (o *WebhooksUpdateNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /webhooks/{webhookID}][%d] webhooksUpdateNotFound %s", 404, payload)
+}
+
+func (o *WebhooksUpdateNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /webhooks/{webhookID}][%d] webhooksUpdateNotFound %s", 404, payload)
+}
+
+func (o *WebhooksUpdateNotFound) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksUpdateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWebhooksUpdateInternalServerError creates a WebhooksUpdateInternalServerError with default headers values
+func NewWebhooksUpdateInternalServerError() *WebhooksUpdateInternalServerError {
+	return &WebhooksUpdateInternalServerError{}
+}
+
+/*
+WebhooksUpdateInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type WebhooksUpdateInternalServerError struct {
+	Payload *models.MiddlewareHTTPError
+}
+
+// IsSuccess returns true when this webhooks update internal server error response has a 2xx status code
+func (o *WebhooksUpdateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this webhooks update internal server error response has a 3xx status code
+func (o *WebhooksUpdateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this webhooks update internal server error response has a 4xx status code
+func (o *WebhooksUpdateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this webhooks update internal server error response has a 5xx status code
+func (o *WebhooksUpdateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this webhooks update internal server error response a status code equal to that given
+func (o *WebhooksUpdateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the webhooks update internal server error response
+func (o *WebhooksUpdateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *WebhooksUpdateInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /webhooks/{webhookID}][%d] webhooksUpdateInternalServerError %s", 500, payload)
+}
+
+func (o *WebhooksUpdateInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /webhooks/{webhookID}][%d] webhooksUpdateInternalServerError %s", 500, payload)
+}
+
+func (o *WebhooksUpdateInternalServerError) GetPayload() *models.MiddlewareHTTPError {
+	return o.Payload
+}
+
+func (o *WebhooksUpdateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.MiddlewareHTTPError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
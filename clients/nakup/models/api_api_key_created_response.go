@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIAPIKeyCreatedResponse api API key created response
+//
+// swagger:model api.APIKeyCreatedResponse
+type APIAPIKeyCreatedResponse struct {
+
+	// created at
+	CreatedAt string `json:"created_at,omitempty"`
+
+	// expires at
+	ExpiresAt string `json:"expires_at,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// key
+	Key string `json:"key,omitempty"`
+
+	// last used at
+	LastUsedAt string `json:"last_used_at,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// prefix
+	Prefix string `json:"prefix,omitempty"`
+
+	// scopes
+	Scopes []string `json:"scopes"`
+
+	// updated at
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// Validate validates this api API key created response
+func (m *APIAPIKeyCreatedResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api API key created response based on context it is used
+func (m *APIAPIKeyCreatedResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIAPIKeyCreatedResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIAPIKeyCreatedResponse) UnmarshalBinary(b []byte) error {
+	var res APIAPIKeyCreatedResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIAPIKeyRequest api API key request
+//
+// swagger:model api.APIKeyRequest
+type APIAPIKeyRequest struct {
+
+	// expires at
+	ExpiresAt string `json:"expires_at,omitempty"`
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+
+	// scopes
+	// Required: true
+	// Min Items: 1
+	// Unique: true
+	Scopes []string `json:"scopes"`
+}
+
+// Validate validates this api API key request
+func (m *APIAPIKeyRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScopes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIAPIKeyRequest) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIAPIKeyRequest) validateScopes(formats strfmt.Registry) error {
+
+	if err := validate.Required("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	iScopesSize := int64(len(m.Scopes))
+
+	if err := validate.MinItems("scopes", "body", iScopesSize, 1); err != nil {
+		return err
+	}
+
+	if err := validate.UniqueItems("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this api API key request based on context it is used
+func (m *APIAPIKeyRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIAPIKeyRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIAPIKeyRequest) UnmarshalBinary(b []byte) error {
+	var res APIAPIKeyRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
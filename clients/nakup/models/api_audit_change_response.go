@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIAuditChangeResponse api audit change response
+//
+// swagger:model api.AuditChangeResponse
+type APIAuditChangeResponse struct {
+
+	// after
+	After any `json:"after,omitempty"`
+
+	// before
+	Before any `json:"before,omitempty"`
+}
+
+// Validate validates this api audit change response
+func (m *APIAuditChangeResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api audit change response based on context it is used
+func (m *APIAuditChangeResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIAuditChangeResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIAuditChangeResponse) UnmarshalBinary(b []byte) error {
+	var res APIAuditChangeResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,241 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIAuditEntryResponse api audit entry response
+//
+// swagger:model api.AuditEntryResponse
+type APIAuditEntryResponse struct {
+
+	// action
+	// Enum: ["create","update","delete","restore"]
+	Action string `json:"action,omitempty"`
+
+	// actor api key id
+	ActorAPIKeyID string `json:"actor_api_key_id,omitempty"`
+
+	// actor user id
+	ActorUserID string `json:"actor_user_id,omitempty"`
+
+	// changes
+	Changes map[string]APIAuditChangeResponse `json:"changes,omitempty"`
+
+	// created at
+	CreatedAt string `json:"created_at,omitempty"`
+
+	// entity id
+	EntityID string `json:"entity_id,omitempty"`
+
+	// entity type
+	// Enum: ["reservation","purchase"]
+	EntityType string `json:"entity_type,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// request id
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// Validate validates this api audit entry response
+func (m *APIAuditEntryResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateChanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEntityType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var apiAuditEntryResponseTypeActionPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["create","update","delete","restore"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiAuditEntryResponseTypeActionPropEnum = append(apiAuditEntryResponseTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// APIAuditEntryResponseActionCreate captures enum value "create"
+	APIAuditEntryResponseActionCreate string = "create"
+
+	// APIAuditEntryResponseActionUpdate captures enum value "update"
+	APIAuditEntryResponseActionUpdate string = "update"
+
+	// APIAuditEntryResponseActionDelete captures enum value "delete"
+	APIAuditEntryResponseActionDelete string = "delete"
+
+	// APIAuditEntryResponseActionRestore captures enum value "restore"
+	APIAuditEntryResponseActionRestore string = "restore"
+)
+
+// prop value enum
+func (m *APIAuditEntryResponse) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, apiAuditEntryResponseTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *APIAuditEntryResponse) validateAction(formats strfmt.Registry) error {
+	if swag.IsZero(m.Action) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIAuditEntryResponse) validateChanges(formats strfmt.Registry) error {
+	if swag.IsZero(m.Changes) { // not required
+		return nil
+	}
+
+	for k := range m.Changes {
+
+		if err := validate.Required("changes"+"."+k, "body", m.Changes[k]); err != nil {
+			return err
+		}
+		if val, ok := m.Changes[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("changes" + "." + k)
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("changes" + "." + k)
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var apiAuditEntryResponseTypeEntityTypePropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["reservation","purchase"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiAuditEntryResponseTypeEntityTypePropEnum = append(apiAuditEntryResponseTypeEntityTypePropEnum, v)
+	}
+}
+
+const (
+
+	// APIAuditEntryResponseEntityTypeReservation captures enum value "reservation"
+	APIAuditEntryResponseEntityTypeReservation string = "reservation"
+
+	// APIAuditEntryResponseEntityTypePurchase captures enum value "purchase"
+	APIAuditEntryResponseEntityTypePurchase string = "purchase"
+)
+
+// prop value enum
+func (m *APIAuditEntryResponse) validateEntityTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, apiAuditEntryResponseTypeEntityTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *APIAuditEntryResponse) validateEntityType(formats strfmt.Registry) error {
+	if swag.IsZero(m.EntityType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateEntityTypeEnum("entity_type", "body", m.EntityType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api audit entry response based on the context it is used
+func (m *APIAuditEntryResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateChanges(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIAuditEntryResponse) contextValidateChanges(ctx context.Context, formats strfmt.Registry) error {
+
+	for k := range m.Changes {
+
+		if val, ok := m.Changes[k]; ok {
+			if err := val.ContextValidate(ctx, formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIAuditEntryResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIAuditEntryResponse) UnmarshalBinary(b []byte) error {
+	var res APIAuditEntryResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
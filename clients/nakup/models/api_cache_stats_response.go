@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APICacheStatsResponse api cache stats response
+//
+// swagger:model api.CacheStatsResponse
+type APICacheStatsResponse struct {
+
+	// entries
+	Entries int64 `json:"entries,omitempty"`
+
+	// fetches
+	Fetches int64 `json:"fetches,omitempty"`
+
+	// hit ratio
+	HitRatio float64 `json:"hit_ratio,omitempty"`
+
+	// hits
+	Hits int64 `json:"hits,omitempty"`
+
+	// misses
+	Misses int64 `json:"misses,omitempty"`
+
+	// negative hits
+	NegativeHits int64 `json:"negative_hits,omitempty"`
+}
+
+// Validate validates this api cache stats response
+func (m *APICacheStatsResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api cache stats response based on context it is used
+func (m *APICacheStatsResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APICacheStatsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APICacheStatsResponse) UnmarshalBinary(b []byte) error {
+	var res APICacheStatsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,78 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// APIConsistencyAction api consistency action
+//
+// swagger:model api.ConsistencyAction
+type APIConsistencyAction string
+
+func NewAPIConsistencyAction(value APIConsistencyAction) *APIConsistencyAction {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated APIConsistencyAction.
+func (m APIConsistencyAction) Pointer() *APIConsistencyAction {
+	return &m
+}
+
+const (
+
+	// APIConsistencyActionCANCELLED captures enum value "CANCELLED"
+	APIConsistencyActionCANCELLED APIConsistencyAction = "CANCELLED"
+
+	// APIConsistencyActionMOVED captures enum value "MOVED"
+	APIConsistencyActionMOVED APIConsistencyAction = "MOVED"
+)
+
+// for schema
+var apiConsistencyActionEnum []any
+
+func init() {
+	var res []APIConsistencyAction
+	if err := json.Unmarshal([]byte(`["CANCELLED","MOVED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiConsistencyActionEnum = append(apiConsistencyActionEnum, v)
+	}
+}
+
+func (m APIConsistencyAction) validateAPIConsistencyActionEnum(path, location string, value APIConsistencyAction) error {
+	if err := validate.EnumCase(path, location, value, apiConsistencyActionEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this api consistency action
+func (m APIConsistencyAction) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPIConsistencyActionEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this api consistency action based on context it is used
+func (m APIConsistencyAction) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIConsistencyFixRequest api consistency fix request
+//
+// swagger:model api.ConsistencyFixRequest
+type APIConsistencyFixRequest struct {
+
+	// mode
+	// Required: true
+	// Enum: ["cancel","move"]
+	Mode *string `json:"mode"`
+}
+
+// Validate validates this api consistency fix request
+func (m *APIConsistencyFixRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var apiConsistencyFixRequestTypeModePropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["cancel","move"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiConsistencyFixRequestTypeModePropEnum = append(apiConsistencyFixRequestTypeModePropEnum, v)
+	}
+}
+
+const (
+
+	// APIConsistencyFixRequestModeCancel captures enum value "cancel"
+	APIConsistencyFixRequestModeCancel string = "cancel"
+
+	// APIConsistencyFixRequestModeMove captures enum value "move"
+	APIConsistencyFixRequestModeMove string = "move"
+)
+
+// prop value enum
+func (m *APIConsistencyFixRequest) validateModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, apiConsistencyFixRequestTypeModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *APIConsistencyFixRequest) validateMode(formats strfmt.Registry) error {
+
+	if err := validate.Required("mode", "body", m.Mode); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateModeEnum("mode", "body", *m.Mode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this api consistency fix request based on context it is used
+func (m *APIConsistencyFixRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIConsistencyFixRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIConsistencyFixRequest) UnmarshalBinary(b []byte) error {
+	var res APIConsistencyFixRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,312 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIConsistencyIssueResponse api consistency issue response
+//
+// swagger:model api.ConsistencyIssueResponse
+type APIConsistencyIssueResponse struct {
+
+	// action
+	Action APIConsistencyAction `json:"action,omitempty"`
+
+	// col
+	Col int64 `json:"col,omitempty"`
+
+	// moved to
+	MovedTo *APISeatResponse `json:"moved_to,omitempty"`
+
+	// problem
+	Problem APIConsistencyProblem `json:"problem,omitempty"`
+
+	// refund
+	Refund *APIRefundResponse `json:"refund,omitempty"`
+
+	// reservation id
+	ReservationID string `json:"reservation_id,omitempty"`
+
+	// room columns
+	RoomColumns int64 `json:"room_columns,omitempty"`
+
+	// room id
+	RoomID string `json:"room_id,omitempty"`
+
+	// room rows
+	RoomRows int64 `json:"room_rows,omitempty"`
+
+	// row
+	Row int64 `json:"row,omitempty"`
+
+	// theater id
+	TheaterID string `json:"theater_id,omitempty"`
+
+	// time slot id
+	TimeSlotID string `json:"time_slot_id,omitempty"`
+
+	// user id
+	UserID string `json:"user_id,omitempty"`
+}
+
+// Validate validates this api consistency issue response
+func (m *APIConsistencyIssueResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMovedTo(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProblem(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRefund(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIConsistencyIssueResponse) validateAction(formats strfmt.Registry) error {
+	if swag.IsZero(m.Action) { // not required
+		return nil
+	}
+
+	if err := m.Action.Validate(formats); err != nil {
+		ve := new(errors.Validation)
+		if stderrors.As(err, &ve) {
+			return ve.ValidateName("action")
+		}
+		ce := new(errors.CompositeError)
+		if stderrors.As(err, &ce) {
+			return ce.ValidateName("action")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIConsistencyIssueResponse) validateMovedTo(formats strfmt.Registry) error {
+	if swag.IsZero(m.MovedTo) { // not required
+		return nil
+	}
+
+	if m.MovedTo != nil {
+		if err := m.MovedTo.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("moved_to")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("moved_to")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIConsistencyIssueResponse) validateProblem(formats strfmt.Registry) error {
+	if swag.IsZero(m.Problem) { // not required
+		return nil
+	}
+
+	if err := m.Problem.Validate(formats); err != nil {
+		ve := new(errors.Validation)
+		if stderrors.As(err, &ve) {
+			return ve.ValidateName("problem")
+		}
+		ce := new(errors.CompositeError)
+		if stderrors.As(err, &ce) {
+			return ce.ValidateName("problem")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIConsistencyIssueResponse) validateRefund(formats strfmt.Registry) error {
+	if swag.IsZero(m.Refund) { // not required
+		return nil
+	}
+
+	if m.Refund != nil {
+		if err := m.Refund.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("refund")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("refund")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api consistency issue response based on the context it is used
+func (m *APIConsistencyIssueResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAction(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMovedTo(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateProblem(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRefund(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIConsistencyIssueResponse) contextValidateAction(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Action) { // not required
+		return nil
+	}
+
+	if err := m.Action.ContextValidate(ctx, formats); err != nil {
+		ve := new(errors.Validation)
+		if stderrors.As(err, &ve) {
+			return ve.ValidateName("action")
+		}
+		ce := new(errors.CompositeError)
+		if stderrors.As(err, &ce) {
+			return ce.ValidateName("action")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIConsistencyIssueResponse) contextValidateMovedTo(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MovedTo != nil {
+
+		if swag.IsZero(m.MovedTo) { // not required
+			return nil
+		}
+
+		if err := m.MovedTo.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("moved_to")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("moved_to")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIConsistencyIssueResponse) contextValidateProblem(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Problem) { // not required
+		return nil
+	}
+
+	if err := m.Problem.ContextValidate(ctx, formats); err != nil {
+		ve := new(errors.Validation)
+		if stderrors.As(err, &ve) {
+			return ve.ValidateName("problem")
+		}
+		ce := new(errors.CompositeError)
+		if stderrors.As(err, &ce) {
+			return ce.ValidateName("problem")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIConsistencyIssueResponse) contextValidateRefund(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Refund != nil {
+
+		if swag.IsZero(m.Refund) { // not required
+			return nil
+		}
+
+		if err := m.Refund.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("refund")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("refund")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIConsistencyIssueResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIConsistencyIssueResponse) UnmarshalBinary(b []byte) error {
+	var res APIConsistencyIssueResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,81 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// APIConsistencyProblem api consistency problem
+//
+// swagger:model api.ConsistencyProblem
+type APIConsistencyProblem string
+
+func NewAPIConsistencyProblem(value APIConsistencyProblem) *APIConsistencyProblem {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated APIConsistencyProblem.
+func (m APIConsistencyProblem) Pointer() *APIConsistencyProblem {
+	return &m
+}
+
+const (
+
+	// APIConsistencyProblemTIMESLOTMISSING captures enum value "TIME_SLOT_MISSING"
+	APIConsistencyProblemTIMESLOTMISSING APIConsistencyProblem = "TIME_SLOT_MISSING"
+
+	// APIConsistencyProblemROOMMISSING captures enum value "ROOM_MISSING"
+	APIConsistencyProblemROOMMISSING APIConsistencyProblem = "ROOM_MISSING"
+
+	// APIConsistencyProblemSEATOUTOFBOUNDS captures enum value "SEAT_OUT_OF_BOUNDS"
+	APIConsistencyProblemSEATOUTOFBOUNDS APIConsistencyProblem = "SEAT_OUT_OF_BOUNDS"
+)
+
+// for schema
+var apiConsistencyProblemEnum []any
+
+func init() {
+	var res []APIConsistencyProblem
+	if err := json.Unmarshal([]byte(`["TIME_SLOT_MISSING","ROOM_MISSING","SEAT_OUT_OF_BOUNDS"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiConsistencyProblemEnum = append(apiConsistencyProblemEnum, v)
+	}
+}
+
+func (m APIConsistencyProblem) validateAPIConsistencyProblemEnum(path, location string, value APIConsistencyProblem) error {
+	if err := validate.EnumCase(path, location, value, apiConsistencyProblemEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this api consistency problem
+func (m APIConsistencyProblem) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPIConsistencyProblemEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this api consistency problem based on context it is used
+func (m APIConsistencyProblem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
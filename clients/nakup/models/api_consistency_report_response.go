@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIConsistencyReportResponse api consistency report response
+//
+// swagger:model api.ConsistencyReportResponse
+type APIConsistencyReportResponse struct {
+
+	// checked
+	Checked int64 `json:"checked,omitempty"`
+
+	// issues
+	Issues []*APIConsistencyIssueResponse `json:"issues"`
+
+	// time slots
+	TimeSlots int64 `json:"time_slots,omitempty"`
+}
+
+// Validate validates this api consistency report response
+func (m *APIConsistencyReportResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateIssues(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIConsistencyReportResponse) validateIssues(formats strfmt.Registry) error {
+	if swag.IsZero(m.Issues) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Issues); i++ {
+		if swag.IsZero(m.Issues[i]) { // not required
+			continue
+		}
+
+		if m.Issues[i] != nil {
+			if err := m.Issues[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("issues" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("issues" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api consistency report response based on the context it is used
+func (m *APIConsistencyReportResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateIssues(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIConsistencyReportResponse) contextValidateIssues(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Issues); i++ {
+
+		if m.Issues[i] != nil {
+
+			if swag.IsZero(m.Issues[i]) { // not required
+				return nil
+			}
+
+			if err := m.Issues[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("issues" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("issues" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIConsistencyReportResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIConsistencyReportResponse) UnmarshalBinary(b []byte) error {
+	var res APIConsistencyReportResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APICursorPaginatedResponse api cursor paginated response
+//
+// swagger:model api.CursorPaginatedResponse
+type APICursorPaginatedResponse struct {
+
+	// data
+	Data any `json:"data,omitempty"`
+
+	// limit
+	Limit int64 `json:"limit,omitempty"`
+
+	// next cursor
+	NextCursor string `json:"next_cursor,omitempty"`
+
+	// offset
+	Offset int64 `json:"offset,omitempty"`
+
+	// prev cursor
+	PrevCursor string `json:"prev_cursor,omitempty"`
+
+	// total
+	Total int64 `json:"total,omitempty"`
+}
+
+// Validate validates this api cursor paginated response
+func (m *APICursorPaginatedResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api cursor paginated response based on context it is used
+func (m *APICursorPaginatedResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APICursorPaginatedResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APICursorPaginatedResponse) UnmarshalBinary(b []byte) error {
+	var res APICursorPaginatedResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
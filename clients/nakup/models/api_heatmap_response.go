@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIHeatmapResponse api heatmap response
+//
+// swagger:model api.HeatmapResponse
+type APIHeatmapResponse struct {
+
+	// bookings
+	Bookings int64 `json:"bookings,omitempty"`
+
+	// columns
+	Columns int64 `json:"columns,omitempty"`
+
+	// counts
+	Counts [][]int64 `json:"counts"`
+
+	// from
+	From string `json:"from,omitempty"`
+
+	// room id
+	RoomID string `json:"room_id,omitempty"`
+
+	// room name
+	RoomName string `json:"room_name,omitempty"`
+
+	// rows
+	Rows int64 `json:"rows,omitempty"`
+
+	// scores
+	Scores [][]float64 `json:"scores"`
+
+	// screenings
+	Screenings int64 `json:"screenings,omitempty"`
+
+	// theater id
+	TheaterID string `json:"theater_id,omitempty"`
+
+	// to
+	To string `json:"to,omitempty"`
+}
+
+// Validate validates this api heatmap response
+func (m *APIHeatmapResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api heatmap response based on context it is used
+func (m *APIHeatmapResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIHeatmapResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIHeatmapResponse) UnmarshalBinary(b []byte) error {
+	var res APIHeatmapResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
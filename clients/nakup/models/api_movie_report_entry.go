@@ -0,0 +1,68 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIMovieReportEntry api movie report entry
+//
+// swagger:model api.MovieReportEntry
+type APIMovieReportEntry struct {
+
+	// admissions
+	Admissions int64 `json:"admissions,omitempty"`
+
+	// concession revenue cents
+	ConcessionRevenueCents int64 `json:"concession_revenue_cents,omitempty"`
+
+	// movie id
+	MovieID string `json:"movie_id,omitempty"`
+
+	// screenings
+	Screenings int64 `json:"screenings,omitempty"`
+
+	// ticket revenue cents
+	TicketRevenueCents int64 `json:"ticket_revenue_cents,omitempty"`
+
+	// title
+	Title string `json:"title,omitempty"`
+
+	// total revenue cents
+	TotalRevenueCents int64 `json:"total_revenue_cents,omitempty"`
+}
+
+// Validate validates this api movie report entry
+func (m *APIMovieReportEntry) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api movie report entry based on context it is used
+func (m *APIMovieReportEntry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIMovieReportEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIMovieReportEntry) UnmarshalBinary(b []byte) error {
+	var res APIMovieReportEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
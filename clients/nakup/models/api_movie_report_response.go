@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIMovieReportResponse api movie report response
+//
+// swagger:model api.MovieReportResponse
+type APIMovieReportResponse struct {
+
+	// from
+	From string `json:"from,omitempty"`
+
+	// movies
+	Movies []*APIMovieReportEntry `json:"movies"`
+
+	// ticket price cents
+	TicketPriceCents int64 `json:"ticket_price_cents,omitempty"`
+
+	// to
+	To string `json:"to,omitempty"`
+}
+
+// Validate validates this api movie report response
+func (m *APIMovieReportResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMovies(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIMovieReportResponse) validateMovies(formats strfmt.Registry) error {
+	if swag.IsZero(m.Movies) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Movies); i++ {
+		if swag.IsZero(m.Movies[i]) { // not required
+			continue
+		}
+
+		if m.Movies[i] != nil {
+			if err := m.Movies[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("movies" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("movies" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api movie report response based on the context it is used
+func (m *APIMovieReportResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMovies(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIMovieReportResponse) contextValidateMovies(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Movies); i++ {
+
+		if m.Movies[i] != nil {
+
+			if swag.IsZero(m.Movies[i]) { // not required
+				return nil
+			}
+
+			if err := m.Movies[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("movies" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("movies" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIMovieReportResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIMovieReportResponse) UnmarshalBinary(b []byte) error {
+	var res APIMovieReportResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
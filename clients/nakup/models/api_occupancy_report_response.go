@@ -0,0 +1,276 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIOccupancyReportResponse api occupancy report response
+//
+// swagger:model api.OccupancyReportResponse
+type APIOccupancyReportResponse struct {
+
+	// from
+	From string `json:"from,omitempty"`
+
+	// rooms
+	Rooms []*APIRoomOccupancyEntry `json:"rooms"`
+
+	// time slots
+	TimeSlots []*APITimeSlotOccupancyEntry `json:"time_slots"`
+
+	// to
+	To string `json:"to,omitempty"`
+
+	// weekday hours
+	WeekdayHours []*APIWeekdayHourOccupancyEntry `json:"weekday_hours"`
+}
+
+// Validate validates this api occupancy report response
+func (m *APIOccupancyReportResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRooms(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeSlots(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWeekdayHours(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIOccupancyReportResponse) validateRooms(formats strfmt.Registry) error {
+	if swag.IsZero(m.Rooms) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Rooms); i++ {
+		if swag.IsZero(m.Rooms[i]) { // not required
+			continue
+		}
+
+		if m.Rooms[i] != nil {
+			if err := m.Rooms[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("rooms" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("rooms" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APIOccupancyReportResponse) validateTimeSlots(formats strfmt.Registry) error {
+	if swag.IsZero(m.TimeSlots) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.TimeSlots); i++ {
+		if swag.IsZero(m.TimeSlots[i]) { // not required
+			continue
+		}
+
+		if m.TimeSlots[i] != nil {
+			if err := m.TimeSlots[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("time_slots" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("time_slots" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APIOccupancyReportResponse) validateWeekdayHours(formats strfmt.Registry) error {
+	if swag.IsZero(m.WeekdayHours) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.WeekdayHours); i++ {
+		if swag.IsZero(m.WeekdayHours[i]) { // not required
+			continue
+		}
+
+		if m.WeekdayHours[i] != nil {
+			if err := m.WeekdayHours[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("weekday_hours" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("weekday_hours" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api occupancy report response based on the context it is used
+func (m *APIOccupancyReportResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRooms(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTimeSlots(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateWeekdayHours(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIOccupancyReportResponse) contextValidateRooms(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Rooms); i++ {
+
+		if m.Rooms[i] != nil {
+
+			if swag.IsZero(m.Rooms[i]) { // not required
+				return nil
+			}
+
+			if err := m.Rooms[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("rooms" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("rooms" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APIOccupancyReportResponse) contextValidateTimeSlots(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.TimeSlots); i++ {
+
+		if m.TimeSlots[i] != nil {
+
+			if swag.IsZero(m.TimeSlots[i]) { // not required
+				return nil
+			}
+
+			if err := m.TimeSlots[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("time_slots" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("time_slots" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APIOccupancyReportResponse) contextValidateWeekdayHours(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.WeekdayHours); i++ {
+
+		if m.WeekdayHours[i] != nil {
+
+			if swag.IsZero(m.WeekdayHours[i]) { // not required
+				return nil
+			}
+
+			if err := m.WeekdayHours[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("weekday_hours" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("weekday_hours" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIOccupancyReportResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIOccupancyReportResponse) UnmarshalBinary(b []byte) error {
+	var res APIOccupancyReportResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,176 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIPurchaseRequest api purchase request
+//
+// swagger:model api.PurchaseRequest
+type APIPurchaseRequest struct {
+
+	// count
+	// Required: true
+	// Minimum: 1
+	Count *int64 `json:"count"`
+
+	// name
+	// Required: true
+	// Min Length: 3
+	Name *string `json:"name"`
+
+	// price per item cents
+	// Required: true
+	// Minimum: 0
+	PricePerItemCents *int64 `json:"price_per_item_cents"`
+
+	// type
+	// Required: true
+	// Enum: ["FOOD","DRINK","SNACK"]
+	Type *string `json:"type"`
+}
+
+// Validate validates this api purchase request
+func (m *APIPurchaseRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePricePerItemCents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIPurchaseRequest) validateCount(formats strfmt.Registry) error {
+
+	if err := validate.Required("count", "body", m.Count); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("count", "body", *m.Count, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIPurchaseRequest) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("name", "body", *m.Name, 3); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIPurchaseRequest) validatePricePerItemCents(formats strfmt.Registry) error {
+
+	if err := validate.Required("price_per_item_cents", "body", m.PricePerItemCents); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("price_per_item_cents", "body", *m.PricePerItemCents, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var apiPurchaseRequestTypeTypePropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["FOOD","DRINK","SNACK"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiPurchaseRequestTypeTypePropEnum = append(apiPurchaseRequestTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// APIPurchaseRequestTypeFOOD captures enum value "FOOD"
+	APIPurchaseRequestTypeFOOD string = "FOOD"
+
+	// APIPurchaseRequestTypeDRINK captures enum value "DRINK"
+	APIPurchaseRequestTypeDRINK string = "DRINK"
+
+	// APIPurchaseRequestTypeSNACK captures enum value "SNACK"
+	APIPurchaseRequestTypeSNACK string = "SNACK"
+)
+
+// prop value enum
+func (m *APIPurchaseRequest) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, apiPurchaseRequestTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *APIPurchaseRequest) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this api purchase request based on context it is used
+func (m *APIPurchaseRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIPurchaseRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIPurchaseRequest) UnmarshalBinary(b []byte) error {
+	var res APIPurchaseRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
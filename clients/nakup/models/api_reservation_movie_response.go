@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIReservationMovieResponse api reservation movie response
+//
+// swagger:model api.ReservationMovieResponse
+type APIReservationMovieResponse struct {
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// length minutes
+	LengthMinutes int64 `json:"length_minutes,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this api reservation movie response
+func (m *APIReservationMovieResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api reservation movie response based on context it is used
+func (m *APIReservationMovieResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIReservationMovieResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIReservationMovieResponse) UnmarshalBinary(b []byte) error {
+	var res APIReservationMovieResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
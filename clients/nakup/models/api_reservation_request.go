@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIReservationRequest api reservation request
+//
+// swagger:model api.ReservationRequest
+type APIReservationRequest struct {
+
+	// col
+	// Required: true
+	// Minimum: 1
+	Col *int64 `json:"col"`
+
+	// room id
+	// Required: true
+	RoomID *string `json:"room_id"`
+
+	// row
+	// Required: true
+	// Minimum: 1
+	Row *int64 `json:"row"`
+
+	// theater id
+	// Required: true
+	TheaterID *string `json:"theater_id"`
+
+	// time slot id
+	// Required: true
+	TimeSlotID *string `json:"time_slot_id"`
+
+	// type
+	// Required: true
+	// Enum: ["ONLINE","POS"]
+	Type *string `json:"type"`
+}
+
+// Validate validates this api reservation request
+func (m *APIReservationRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCol(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoomID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRow(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTheaterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeSlotID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIReservationRequest) validateCol(formats strfmt.Registry) error {
+
+	if err := validate.Required("col", "body", m.Col); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("col", "body", *m.Col, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIReservationRequest) validateRoomID(formats strfmt.Registry) error {
+
+	if err := validate.Required("room_id", "body", m.RoomID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIReservationRequest) validateRow(formats strfmt.Registry) error {
+
+	if err := validate.Required("row", "body", m.Row); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("row", "body", *m.Row, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIReservationRequest) validateTheaterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("theater_id", "body", m.TheaterID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIReservationRequest) validateTimeSlotID(formats strfmt.Registry) error {
+
+	if err := validate.Required("time_slot_id", "body", m.TimeSlotID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var apiReservationRequestTypeTypePropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ONLINE","POS"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiReservationRequestTypeTypePropEnum = append(apiReservationRequestTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// APIReservationRequestTypeONLINE captures enum value "ONLINE"
+	APIReservationRequestTypeONLINE string = "ONLINE"
+
+	// APIReservationRequestTypePOS captures enum value "POS"
+	APIReservationRequestTypePOS string = "POS"
+)
+
+// prop value enum
+func (m *APIReservationRequest) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, apiReservationRequestTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *APIReservationRequest) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this api reservation request based on context it is used
+func (m *APIReservationRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIReservationRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIReservationRequest) UnmarshalBinary(b []byte) error {
+	var res APIReservationRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
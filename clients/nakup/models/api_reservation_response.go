@@ -0,0 +1,320 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIReservationResponse api reservation response
+//
+// swagger:model api.ReservationResponse
+type APIReservationResponse struct {
+
+	// api key id
+	APIKeyID string `json:"api_key_id,omitempty"`
+
+	// col
+	Col int64 `json:"col,omitempty"`
+
+	// created at
+	CreatedAt string `json:"created_at,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// movie
+	Movie *APIReservationMovieResponse `json:"movie,omitempty"`
+
+	// room
+	Room *APIReservationRoomResponse `json:"room,omitempty"`
+
+	// room id
+	RoomID string `json:"room_id,omitempty"`
+
+	// row
+	Row int64 `json:"row,omitempty"`
+
+	// theater id
+	TheaterID string `json:"theater_id,omitempty"`
+
+	// time slot
+	TimeSlot *APIReservationTimeSlotResponse `json:"time_slot,omitempty"`
+
+	// time slot id
+	TimeSlotID string `json:"time_slot_id,omitempty"`
+
+	// type
+	Type ModelsReservationType `json:"type,omitempty"`
+
+	// updated at
+	UpdatedAt string `json:"updated_at,omitempty"`
+
+	// user id
+	UserID string `json:"user_id,omitempty"`
+}
+
+// Validate validates this api reservation response
+func (m *APIReservationResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMovie(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoom(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeSlot(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIReservationResponse) validateMovie(formats strfmt.Registry) error {
+	if swag.IsZero(m.Movie) { // not required
+		return nil
+	}
+
+	if m.Movie != nil {
+		if err := m.Movie.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("movie")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("movie")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIReservationResponse) validateRoom(formats strfmt.Registry) error {
+	if swag.IsZero(m.Room) { // not required
+		return nil
+	}
+
+	if m.Room != nil {
+		if err := m.Room.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("room")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("room")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIReservationResponse) validateTimeSlot(formats strfmt.Registry) error {
+	if swag.IsZero(m.TimeSlot) { // not required
+		return nil
+	}
+
+	if m.TimeSlot != nil {
+		if err := m.TimeSlot.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("time_slot")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("time_slot")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIReservationResponse) validateType(formats strfmt.Registry) error {
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	if err := m.Type.Validate(formats); err != nil {
+		ve := new(errors.Validation)
+		if stderrors.As(err, &ve) {
+			return ve.ValidateName("type")
+		}
+		ce := new(errors.CompositeError)
+		if stderrors.As(err, &ce) {
+			return ce.ValidateName("type")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api reservation response based on the context it is used
+func (m *APIReservationResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMovie(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRoom(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTimeSlot(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateType(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIReservationResponse) contextValidateMovie(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Movie != nil {
+
+		if swag.IsZero(m.Movie) { // not required
+			return nil
+		}
+
+		if err := m.Movie.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("movie")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("movie")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIReservationResponse) contextValidateRoom(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Room != nil {
+
+		if swag.IsZero(m.Room) { // not required
+			return nil
+		}
+
+		if err := m.Room.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("room")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("room")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIReservationResponse) contextValidateTimeSlot(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TimeSlot != nil {
+
+		if swag.IsZero(m.TimeSlot) { // not required
+			return nil
+		}
+
+		if err := m.TimeSlot.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("time_slot")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("time_slot")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIReservationResponse) contextValidateType(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	if err := m.Type.ContextValidate(ctx, formats); err != nil {
+		ve := new(errors.Validation)
+		if stderrors.As(err, &ve) {
+			return ve.ValidateName("type")
+		}
+		ce := new(errors.CompositeError)
+		if stderrors.As(err, &ce) {
+			return ce.ValidateName("type")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIReservationResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIReservationResponse) UnmarshalBinary(b []byte) error {
+	var res APIReservationResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,59 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIReservationRoomResponse api reservation room response
+//
+// swagger:model api.ReservationRoomResponse
+type APIReservationRoomResponse struct {
+
+	// columns
+	Columns int64 `json:"columns,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// rows
+	Rows int64 `json:"rows,omitempty"`
+}
+
+// Validate validates this api reservation room response
+func (m *APIReservationRoomResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api reservation room response based on context it is used
+func (m *APIReservationRoomResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIReservationRoomResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIReservationRoomResponse) UnmarshalBinary(b []byte) error {
+	var res APIReservationRoomResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIReservationTimeSlotResponse api reservation time slot response
+//
+// swagger:model api.ReservationTimeSlotResponse
+type APIReservationTimeSlotResponse struct {
+
+	// end time
+	EndTime string `json:"end_time,omitempty"`
+
+	// movie id
+	MovieID string `json:"movie_id,omitempty"`
+
+	// start time
+	StartTime string `json:"start_time,omitempty"`
+}
+
+// Validate validates this api reservation time slot response
+func (m *APIReservationTimeSlotResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api reservation time slot response based on context it is used
+func (m *APIReservationTimeSlotResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIReservationTimeSlotResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIReservationTimeSlotResponse) UnmarshalBinary(b []byte) error {
+	var res APIReservationTimeSlotResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
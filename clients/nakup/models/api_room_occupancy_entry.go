@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIRoomOccupancyEntry api room occupancy entry
+//
+// swagger:model api.RoomOccupancyEntry
+type APIRoomOccupancyEntry struct {
+
+	// capacity
+	Capacity int64 `json:"capacity,omitempty"`
+
+	// occupancy percent
+	OccupancyPercent float64 `json:"occupancy_percent,omitempty"`
+
+	// online
+	Online int64 `json:"online,omitempty"`
+
+	// pos
+	Pos int64 `json:"pos,omitempty"`
+
+	// room id
+	RoomID string `json:"room_id,omitempty"`
+
+	// room name
+	RoomName string `json:"room_name,omitempty"`
+
+	// screenings
+	Screenings int64 `json:"screenings,omitempty"`
+
+	// sold
+	Sold int64 `json:"sold,omitempty"`
+
+	// theater id
+	TheaterID string `json:"theater_id,omitempty"`
+}
+
+// Validate validates this api room occupancy entry
+func (m *APIRoomOccupancyEntry) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api room occupancy entry based on context it is used
+func (m *APIRoomOccupancyEntry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIRoomOccupancyEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIRoomOccupancyEntry) UnmarshalBinary(b []byte) error {
+	var res APIRoomOccupancyEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
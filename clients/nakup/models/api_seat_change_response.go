@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APISeatChangeResponse api seat change response
+//
+// swagger:model api.SeatChangeResponse
+type APISeatChangeResponse struct {
+
+	// col
+	Col int64 `json:"col,omitempty"`
+
+	// row
+	Row int64 `json:"row,omitempty"`
+
+	// state
+	// Enum: ["reserved","held","released"]
+	State string `json:"state,omitempty"`
+}
+
+// Validate validates this api seat change response
+func (m *APISeatChangeResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var apiSeatChangeResponseTypeStatePropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["reserved","held","released"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiSeatChangeResponseTypeStatePropEnum = append(apiSeatChangeResponseTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// APISeatChangeResponseStateReserved captures enum value "reserved"
+	APISeatChangeResponseStateReserved string = "reserved"
+
+	// APISeatChangeResponseStateHeld captures enum value "held"
+	APISeatChangeResponseStateHeld string = "held"
+
+	// APISeatChangeResponseStateReleased captures enum value "released"
+	APISeatChangeResponseStateReleased string = "released"
+)
+
+// prop value enum
+func (m *APISeatChangeResponse) validateStateEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, apiSeatChangeResponseTypeStatePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *APISeatChangeResponse) validateState(formats strfmt.Registry) error {
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this api seat change response based on context it is used
+func (m *APISeatChangeResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APISeatChangeResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APISeatChangeResponse) UnmarshalBinary(b []byte) error {
+	var res APISeatChangeResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
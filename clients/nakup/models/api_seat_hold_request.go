@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APISeatHoldRequest api seat hold request
+//
+// swagger:model api.SeatHoldRequest
+type APISeatHoldRequest struct {
+
+	// col
+	// Required: true
+	// Minimum: 1
+	Col *int64 `json:"col"`
+
+	// room id
+	// Required: true
+	RoomID *string `json:"room_id"`
+
+	// row
+	// Required: true
+	// Minimum: 1
+	Row *int64 `json:"row"`
+
+	// theater id
+	// Required: true
+	TheaterID *string `json:"theater_id"`
+}
+
+// Validate validates this api seat hold request
+func (m *APISeatHoldRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCol(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoomID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRow(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTheaterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APISeatHoldRequest) validateCol(formats strfmt.Registry) error {
+
+	if err := validate.Required("col", "body", m.Col); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("col", "body", *m.Col, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APISeatHoldRequest) validateRoomID(formats strfmt.Registry) error {
+
+	if err := validate.Required("room_id", "body", m.RoomID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APISeatHoldRequest) validateRow(formats strfmt.Registry) error {
+
+	if err := validate.Required("row", "body", m.Row); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("row", "body", *m.Row, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APISeatHoldRequest) validateTheaterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("theater_id", "body", m.TheaterID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this api seat hold request based on context it is used
+func (m *APISeatHoldRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APISeatHoldRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APISeatHoldRequest) UnmarshalBinary(b []byte) error {
+	var res APISeatHoldRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
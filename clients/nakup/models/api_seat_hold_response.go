@@ -0,0 +1,68 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APISeatHoldResponse api seat hold response
+//
+// swagger:model api.SeatHoldResponse
+type APISeatHoldResponse struct {
+
+	// col
+	Col int64 `json:"col,omitempty"`
+
+	// expires at
+	ExpiresAt string `json:"expires_at,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// room id
+	RoomID string `json:"room_id,omitempty"`
+
+	// row
+	Row int64 `json:"row,omitempty"`
+
+	// theater id
+	TheaterID string `json:"theater_id,omitempty"`
+
+	// time slot id
+	TimeSlotID string `json:"time_slot_id,omitempty"`
+}
+
+// Validate validates this api seat hold response
+func (m *APISeatHoldResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api seat hold response based on context it is used
+func (m *APISeatHoldResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APISeatHoldResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APISeatHoldResponse) UnmarshalBinary(b []byte) error {
+	var res APISeatHoldResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,203 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APISeatMapResponse api seat map response
+//
+// swagger:model api.SeatMapResponse
+type APISeatMapResponse struct {
+
+	// held
+	Held []*APISeatResponse `json:"held"`
+
+	// reserved
+	Reserved []*APISeatResponse `json:"reserved"`
+
+	// time slot id
+	TimeSlotID string `json:"time_slot_id,omitempty"`
+}
+
+// Validate validates this api seat map response
+func (m *APISeatMapResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHeld(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReserved(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APISeatMapResponse) validateHeld(formats strfmt.Registry) error {
+	if swag.IsZero(m.Held) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Held); i++ {
+		if swag.IsZero(m.Held[i]) { // not required
+			continue
+		}
+
+		if m.Held[i] != nil {
+			if err := m.Held[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("held" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("held" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APISeatMapResponse) validateReserved(formats strfmt.Registry) error {
+	if swag.IsZero(m.Reserved) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Reserved); i++ {
+		if swag.IsZero(m.Reserved[i]) { // not required
+			continue
+		}
+
+		if m.Reserved[i] != nil {
+			if err := m.Reserved[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("reserved" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("reserved" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api seat map response based on the context it is used
+func (m *APISeatMapResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateHeld(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateReserved(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APISeatMapResponse) contextValidateHeld(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Held); i++ {
+
+		if m.Held[i] != nil {
+
+			if swag.IsZero(m.Held[i]) { // not required
+				return nil
+			}
+
+			if err := m.Held[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("held" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("held" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APISeatMapResponse) contextValidateReserved(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Reserved); i++ {
+
+		if m.Reserved[i] != nil {
+
+			if swag.IsZero(m.Reserved[i]) { // not required
+				return nil
+			}
+
+			if err := m.Reserved[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("reserved" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("reserved" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APISeatMapResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APISeatMapResponse) UnmarshalBinary(b []byte) error {
+	var res APISeatMapResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
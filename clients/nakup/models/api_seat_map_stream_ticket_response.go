@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APISeatMapStreamTicketResponse api seat map stream ticket response
+//
+// swagger:model api.SeatMapStreamTicketResponse
+type APISeatMapStreamTicketResponse struct {
+
+	// expires at
+	ExpiresAt string `json:"expires_at,omitempty"`
+
+	// ticket
+	Ticket string `json:"ticket,omitempty"`
+}
+
+// Validate validates this api seat map stream ticket response
+func (m *APISeatMapStreamTicketResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api seat map stream ticket response based on context it is used
+func (m *APISeatMapStreamTicketResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APISeatMapStreamTicketResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APISeatMapStreamTicketResponse) UnmarshalBinary(b []byte) error {
+	var res APISeatMapStreamTicketResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APISeatResponse api seat response
+//
+// swagger:model api.SeatResponse
+type APISeatResponse struct {
+
+	// col
+	Col int64 `json:"col,omitempty"`
+
+	// row
+	Row int64 `json:"row,omitempty"`
+}
+
+// Validate validates this api seat response
+func (m *APISeatResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api seat response based on context it is used
+func (m *APISeatResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APISeatResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APISeatResponse) UnmarshalBinary(b []byte) error {
+	var res APISeatResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
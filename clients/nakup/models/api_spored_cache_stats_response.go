@@ -0,0 +1,295 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APISporedCacheStatsResponse api spored cache stats response
+//
+// swagger:model api.SporedCacheStatsResponse
+type APISporedCacheStatsResponse struct {
+
+	// movies
+	Movies *APICacheStatsResponse `json:"movies,omitempty"`
+
+	// rooms
+	Rooms *APICacheStatsResponse `json:"rooms,omitempty"`
+
+	// time slots
+	TimeSlots *APICacheStatsResponse `json:"time_slots,omitempty"`
+
+	// validations
+	Validations *APICacheStatsResponse `json:"validations,omitempty"`
+}
+
+// Validate validates this api spored cache stats response
+func (m *APISporedCacheStatsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMovies(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRooms(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeSlots(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValidations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APISporedCacheStatsResponse) validateMovies(formats strfmt.Registry) error {
+	if swag.IsZero(m.Movies) { // not required
+		return nil
+	}
+
+	if m.Movies != nil {
+		if err := m.Movies.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("movies")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("movies")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APISporedCacheStatsResponse) validateRooms(formats strfmt.Registry) error {
+	if swag.IsZero(m.Rooms) { // not required
+		return nil
+	}
+
+	if m.Rooms != nil {
+		if err := m.Rooms.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("rooms")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("rooms")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APISporedCacheStatsResponse) validateTimeSlots(formats strfmt.Registry) error {
+	if swag.IsZero(m.TimeSlots) { // not required
+		return nil
+	}
+
+	if m.TimeSlots != nil {
+		if err := m.TimeSlots.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("time_slots")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("time_slots")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APISporedCacheStatsResponse) validateValidations(formats strfmt.Registry) error {
+	if swag.IsZero(m.Validations) { // not required
+		return nil
+	}
+
+	if m.Validations != nil {
+		if err := m.Validations.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("validations")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("validations")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api spored cache stats response based on the context it is used
+func (m *APISporedCacheStatsResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMovies(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRooms(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTimeSlots(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateValidations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APISporedCacheStatsResponse) contextValidateMovies(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Movies != nil {
+
+		if swag.IsZero(m.Movies) { // not required
+			return nil
+		}
+
+		if err := m.Movies.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("movies")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("movies")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APISporedCacheStatsResponse) contextValidateRooms(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Rooms != nil {
+
+		if swag.IsZero(m.Rooms) { // not required
+			return nil
+		}
+
+		if err := m.Rooms.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("rooms")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("rooms")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APISporedCacheStatsResponse) contextValidateTimeSlots(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TimeSlots != nil {
+
+		if swag.IsZero(m.TimeSlots) { // not required
+			return nil
+		}
+
+		if err := m.TimeSlots.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("time_slots")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("time_slots")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APISporedCacheStatsResponse) contextValidateValidations(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Validations != nil {
+
+		if swag.IsZero(m.Validations) { // not required
+			return nil
+		}
+
+		if err := m.Validations.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("validations")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("validations")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APISporedCacheStatsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APISporedCacheStatsResponse) UnmarshalBinary(b []byte) error {
+	var res APISporedCacheStatsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
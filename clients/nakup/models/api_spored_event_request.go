@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APISporedEventRequest api spored event request
+//
+// swagger:model api.SporedEventRequest
+type APISporedEventRequest struct {
+
+	// room id
+	RoomID string `json:"room_id,omitempty"`
+
+	// StartTime is the (possibly new) start of an updated time slot.
+	StartTime string `json:"start_time,omitempty"`
+
+	// theater id
+	TheaterID string `json:"theater_id,omitempty"`
+
+	// time slot id
+	// Required: true
+	TimeSlotID *string `json:"time_slot_id"`
+
+	// type
+	// Required: true
+	// Enum: ["time_slot.updated","time_slot.deleted"]
+	Type *string `json:"type"`
+}
+
+// Validate validates this api spored event request
+func (m *APISporedEventRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTimeSlotID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APISporedEventRequest) validateTimeSlotID(formats strfmt.Registry) error {
+
+	if err := validate.Required("time_slot_id", "body", m.TimeSlotID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var apiSporedEventRequestTypeTypePropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["time_slot.updated","time_slot.deleted"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiSporedEventRequestTypeTypePropEnum = append(apiSporedEventRequestTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// APISporedEventRequestTypeTimeSlotDotUpdated captures enum value "time_slot.updated"
+	APISporedEventRequestTypeTimeSlotDotUpdated string = "time_slot.updated"
+
+	// APISporedEventRequestTypeTimeSlotDotDeleted captures enum value "time_slot.deleted"
+	APISporedEventRequestTypeTimeSlotDotDeleted string = "time_slot.deleted"
+)
+
+// prop value enum
+func (m *APISporedEventRequest) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, apiSporedEventRequestTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *APISporedEventRequest) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this api spored event request based on context it is used
+func (m *APISporedEventRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APISporedEventRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APISporedEventRequest) UnmarshalBinary(b []byte) error {
+	var res APISporedEventRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
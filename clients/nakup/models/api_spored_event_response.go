@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APISporedEventResponse api spored event response
+//
+// swagger:model api.SporedEventResponse
+type APISporedEventResponse struct {
+
+	// refunds
+	Refunds []*APIRefundResponse `json:"refunds"`
+
+	// time slot id
+	TimeSlotID string `json:"time_slot_id,omitempty"`
+
+	// updated
+	Updated []string `json:"updated"`
+}
+
+// Validate validates this api spored event response
+func (m *APISporedEventResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRefunds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APISporedEventResponse) validateRefunds(formats strfmt.Registry) error {
+	if swag.IsZero(m.Refunds) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Refunds); i++ {
+		if swag.IsZero(m.Refunds[i]) { // not required
+			continue
+		}
+
+		if m.Refunds[i] != nil {
+			if err := m.Refunds[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("refunds" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("refunds" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api spored event response based on the context it is used
+func (m *APISporedEventResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRefunds(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APISporedEventResponse) contextValidateRefunds(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Refunds); i++ {
+
+		if m.Refunds[i] != nil {
+
+			if swag.IsZero(m.Refunds[i]) { // not required
+				return nil
+			}
+
+			if err := m.Refunds[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("refunds" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("refunds" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APISporedEventResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APISporedEventResponse) UnmarshalBinary(b []byte) error {
+	var res APISporedEventResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
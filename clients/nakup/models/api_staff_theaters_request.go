@@ -0,0 +1,76 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIStaffTheatersRequest api staff theaters request
+//
+// swagger:model api.StaffTheatersRequest
+type APIStaffTheatersRequest struct {
+
+	// theater ids
+	// Required: true
+	// Unique: true
+	TheaterIds []string `json:"theater_ids"`
+}
+
+// Validate validates this api staff theaters request
+func (m *APIStaffTheatersRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTheaterIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIStaffTheatersRequest) validateTheaterIds(formats strfmt.Registry) error {
+
+	if err := validate.Required("theater_ids", "body", m.TheaterIds); err != nil {
+		return err
+	}
+
+	if err := validate.UniqueItems("theater_ids", "body", m.TheaterIds); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this api staff theaters request based on context it is used
+func (m *APIStaffTheatersRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIStaffTheatersRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIStaffTheatersRequest) UnmarshalBinary(b []byte) error {
+	var res APIStaffTheatersRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
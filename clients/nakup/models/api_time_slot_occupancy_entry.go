@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APITimeSlotOccupancyEntry api time slot occupancy entry
+//
+// swagger:model api.TimeSlotOccupancyEntry
+type APITimeSlotOccupancyEntry struct {
+
+	// capacity
+	Capacity int64 `json:"capacity,omitempty"`
+
+	// occupancy percent
+	OccupancyPercent float64 `json:"occupancy_percent,omitempty"`
+
+	// online
+	Online int64 `json:"online,omitempty"`
+
+	// pos
+	Pos int64 `json:"pos,omitempty"`
+
+	// room id
+	RoomID string `json:"room_id,omitempty"`
+
+	// sold
+	Sold int64 `json:"sold,omitempty"`
+
+	// start time
+	StartTime string `json:"start_time,omitempty"`
+
+	// theater id
+	TheaterID string `json:"theater_id,omitempty"`
+
+	// time slot id
+	TimeSlotID string `json:"time_slot_id,omitempty"`
+}
+
+// Validate validates this api time slot occupancy entry
+func (m *APITimeSlotOccupancyEntry) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api time slot occupancy entry based on context it is used
+func (m *APITimeSlotOccupancyEntry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APITimeSlotOccupancyEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APITimeSlotOccupancyEntry) UnmarshalBinary(b []byte) error {
+	var res APITimeSlotOccupancyEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
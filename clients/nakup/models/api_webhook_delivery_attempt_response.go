@@ -0,0 +1,62 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIWebhookDeliveryAttemptResponse api webhook delivery attempt response
+//
+// swagger:model api.WebhookDeliveryAttemptResponse
+type APIWebhookDeliveryAttemptResponse struct {
+
+	// created at
+	CreatedAt string `json:"created_at,omitempty"`
+
+	// duration ms
+	DurationMs int64 `json:"duration_ms,omitempty"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// status code
+	StatusCode int64 `json:"status_code,omitempty"`
+}
+
+// Validate validates this api webhook delivery attempt response
+func (m *APIWebhookDeliveryAttemptResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api webhook delivery attempt response based on context it is used
+func (m *APIWebhookDeliveryAttemptResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWebhookDeliveryAttemptResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWebhookDeliveryAttemptResponse) UnmarshalBinary(b []byte) error {
+	var res APIWebhookDeliveryAttemptResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,217 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIWebhookDeliveryDetailResponse api webhook delivery detail response
+//
+// swagger:model api.WebhookDeliveryDetailResponse
+type APIWebhookDeliveryDetailResponse struct {
+
+	// attempt logs
+	AttemptLogs []*APIWebhookDeliveryAttemptResponse `json:"attempt_logs"`
+
+	// attempts
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// created at
+	CreatedAt string `json:"created_at,omitempty"`
+
+	// delivered at
+	DeliveredAt string `json:"delivered_at,omitempty"`
+
+	// event id
+	EventID string `json:"event_id,omitempty"`
+
+	// event type
+	EventType string `json:"event_type,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// last error
+	LastError string `json:"last_error,omitempty"`
+
+	// last status code
+	LastStatusCode int64 `json:"last_status_code,omitempty"`
+
+	// next attempt at
+	NextAttemptAt string `json:"next_attempt_at,omitempty"`
+
+	// payload
+	Payload any `json:"payload,omitempty"`
+
+	// status
+	Status ModelsWebhookDeliveryStatus `json:"status,omitempty"`
+
+	// updated at
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// Validate validates this api webhook delivery detail response
+func (m *APIWebhookDeliveryDetailResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAttemptLogs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIWebhookDeliveryDetailResponse) validateAttemptLogs(formats strfmt.Registry) error {
+	if swag.IsZero(m.AttemptLogs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AttemptLogs); i++ {
+		if swag.IsZero(m.AttemptLogs[i]) { // not required
+			continue
+		}
+
+		if m.AttemptLogs[i] != nil {
+			if err := m.AttemptLogs[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("attempt_logs" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("attempt_logs" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APIWebhookDeliveryDetailResponse) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	if err := m.Status.Validate(formats); err != nil {
+		ve := new(errors.Validation)
+		if stderrors.As(err, &ve) {
+			return ve.ValidateName("status")
+		}
+		ce := new(errors.CompositeError)
+		if stderrors.As(err, &ce) {
+			return ce.ValidateName("status")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api webhook delivery detail response based on the context it is used
+func (m *APIWebhookDeliveryDetailResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAttemptLogs(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIWebhookDeliveryDetailResponse) contextValidateAttemptLogs(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.AttemptLogs); i++ {
+
+		if m.AttemptLogs[i] != nil {
+
+			if swag.IsZero(m.AttemptLogs[i]) { // not required
+				return nil
+			}
+
+			if err := m.AttemptLogs[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("attempt_logs" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("attempt_logs" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APIWebhookDeliveryDetailResponse) contextValidateStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	if err := m.Status.ContextValidate(ctx, formats); err != nil {
+		ve := new(errors.Validation)
+		if stderrors.As(err, &ve) {
+			return ve.ValidateName("status")
+		}
+		ce := new(errors.CompositeError)
+		if stderrors.As(err, &ce) {
+			return ce.ValidateName("status")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWebhookDeliveryDetailResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWebhookDeliveryDetailResponse) UnmarshalBinary(b []byte) error {
+	var res APIWebhookDeliveryDetailResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,143 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIWebhookDeliveryResponse api webhook delivery response
+//
+// swagger:model api.WebhookDeliveryResponse
+type APIWebhookDeliveryResponse struct {
+
+	// attempts
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// created at
+	CreatedAt string `json:"created_at,omitempty"`
+
+	// delivered at
+	DeliveredAt string `json:"delivered_at,omitempty"`
+
+	// event id
+	EventID string `json:"event_id,omitempty"`
+
+	// event type
+	EventType string `json:"event_type,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// last error
+	LastError string `json:"last_error,omitempty"`
+
+	// last status code
+	LastStatusCode int64 `json:"last_status_code,omitempty"`
+
+	// next attempt at
+	NextAttemptAt string `json:"next_attempt_at,omitempty"`
+
+	// status
+	Status ModelsWebhookDeliveryStatus `json:"status,omitempty"`
+
+	// updated at
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// Validate validates this api webhook delivery response
+func (m *APIWebhookDeliveryResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIWebhookDeliveryResponse) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	if err := m.Status.Validate(formats); err != nil {
+		ve := new(errors.Validation)
+		if stderrors.As(err, &ve) {
+			return ve.ValidateName("status")
+		}
+		ce := new(errors.CompositeError)
+		if stderrors.As(err, &ce) {
+			return ce.ValidateName("status")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api webhook delivery response based on the context it is used
+func (m *APIWebhookDeliveryResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIWebhookDeliveryResponse) contextValidateStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	if err := m.Status.ContextValidate(ctx, formats); err != nil {
+		ve := new(errors.Validation)
+		if stderrors.As(err, &ve) {
+			return ve.ValidateName("status")
+		}
+		ce := new(errors.CompositeError)
+		if stderrors.As(err, &ce) {
+			return ce.ValidateName("status")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWebhookDeliveryResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWebhookDeliveryResponse) UnmarshalBinary(b []byte) error {
+	var res APIWebhookDeliveryResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
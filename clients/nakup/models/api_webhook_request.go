@@ -0,0 +1,125 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIWebhookRequest api webhook request
+//
+// swagger:model api.WebhookRequest
+type APIWebhookRequest struct {
+
+	// active
+	Active bool `json:"active,omitempty"`
+
+	// event types
+	// Required: true
+	// Min Items: 1
+	// Unique: true
+	EventTypes []string `json:"event_types"`
+
+	// secret
+	// Required: true
+	// Min Length: 16
+	Secret *string `json:"secret"`
+
+	// url
+	// Required: true
+	URL *string `json:"url"`
+}
+
+// Validate validates this api webhook request
+func (m *APIWebhookRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEventTypes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecret(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIWebhookRequest) validateEventTypes(formats strfmt.Registry) error {
+
+	if err := validate.Required("event_types", "body", m.EventTypes); err != nil {
+		return err
+	}
+
+	iEventTypesSize := int64(len(m.EventTypes))
+
+	if err := validate.MinItems("event_types", "body", iEventTypesSize, 1); err != nil {
+		return err
+	}
+
+	if err := validate.UniqueItems("event_types", "body", m.EventTypes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIWebhookRequest) validateSecret(formats strfmt.Registry) error {
+
+	if err := validate.Required("secret", "body", m.Secret); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("secret", "body", *m.Secret, 16); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIWebhookRequest) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this api webhook request based on context it is used
+func (m *APIWebhookRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWebhookRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWebhookRequest) UnmarshalBinary(b []byte) error {
+	var res APIWebhookRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
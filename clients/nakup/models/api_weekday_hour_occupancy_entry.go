@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIWeekdayHourOccupancyEntry api weekday hour occupancy entry
+//
+// swagger:model api.WeekdayHourOccupancyEntry
+type APIWeekdayHourOccupancyEntry struct {
+
+	// capacity
+	Capacity int64 `json:"capacity,omitempty"`
+
+	// hour
+	Hour int64 `json:"hour,omitempty"`
+
+	// occupancy percent
+	OccupancyPercent float64 `json:"occupancy_percent,omitempty"`
+
+	// online
+	Online int64 `json:"online,omitempty"`
+
+	// pos
+	Pos int64 `json:"pos,omitempty"`
+
+	// screenings
+	Screenings int64 `json:"screenings,omitempty"`
+
+	// sold
+	Sold int64 `json:"sold,omitempty"`
+
+	// weekday
+	Weekday string `json:"weekday,omitempty"`
+}
+
+// Validate validates this api weekday hour occupancy entry
+func (m *APIWeekdayHourOccupancyEntry) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this api weekday hour occupancy entry based on context it is used
+func (m *APIWeekdayHourOccupancyEntry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWeekdayHourOccupancyEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWeekdayHourOccupancyEntry) UnmarshalBinary(b []byte) error {
+	var res APIWeekdayHourOccupancyEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,81 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// ModelsPurchaseType models purchase type
+//
+// swagger:model models.PurchaseType
+type ModelsPurchaseType string
+
+func NewModelsPurchaseType(value ModelsPurchaseType) *ModelsPurchaseType {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated ModelsPurchaseType.
+func (m ModelsPurchaseType) Pointer() *ModelsPurchaseType {
+	return &m
+}
+
+const (
+
+	// ModelsPurchaseTypeFOOD captures enum value "FOOD"
+	ModelsPurchaseTypeFOOD ModelsPurchaseType = "FOOD"
+
+	// ModelsPurchaseTypeDRINK captures enum value "DRINK"
+	ModelsPurchaseTypeDRINK ModelsPurchaseType = "DRINK"
+
+	// ModelsPurchaseTypeSNACK captures enum value "SNACK"
+	ModelsPurchaseTypeSNACK ModelsPurchaseType = "SNACK"
+)
+
+// for schema
+var modelsPurchaseTypeEnum []any
+
+func init() {
+	var res []ModelsPurchaseType
+	if err := json.Unmarshal([]byte(`["FOOD","DRINK","SNACK"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		modelsPurchaseTypeEnum = append(modelsPurchaseTypeEnum, v)
+	}
+}
+
+func (m ModelsPurchaseType) validateModelsPurchaseTypeEnum(path, location string, value ModelsPurchaseType) error {
+	if err := validate.EnumCase(path, location, value, modelsPurchaseTypeEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this models purchase type
+func (m ModelsPurchaseType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateModelsPurchaseTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this models purchase type based on context it is used
+func (m ModelsPurchaseType) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
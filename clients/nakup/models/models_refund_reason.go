@@ -0,0 +1,78 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// ModelsRefundReason models refund reason
+//
+// swagger:model models.RefundReason
+type ModelsRefundReason string
+
+func NewModelsRefundReason(value ModelsRefundReason) *ModelsRefundReason {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated ModelsRefundReason.
+func (m ModelsRefundReason) Pointer() *ModelsRefundReason {
+	return &m
+}
+
+const (
+
+	// ModelsRefundReasonTIMESLOTCANCELLED captures enum value "TIME_SLOT_CANCELLED"
+	ModelsRefundReasonTIMESLOTCANCELLED ModelsRefundReason = "TIME_SLOT_CANCELLED"
+
+	// ModelsRefundReasonSEATUNAVAILABLE captures enum value "SEAT_UNAVAILABLE"
+	ModelsRefundReasonSEATUNAVAILABLE ModelsRefundReason = "SEAT_UNAVAILABLE"
+)
+
+// for schema
+var modelsRefundReasonEnum []any
+
+func init() {
+	var res []ModelsRefundReason
+	if err := json.Unmarshal([]byte(`["TIME_SLOT_CANCELLED","SEAT_UNAVAILABLE"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		modelsRefundReasonEnum = append(modelsRefundReasonEnum, v)
+	}
+}
+
+func (m ModelsRefundReason) validateModelsRefundReasonEnum(path, location string, value ModelsRefundReason) error {
+	if err := validate.EnumCase(path, location, value, modelsRefundReasonEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this models refund reason
+func (m ModelsRefundReason) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateModelsRefundReasonEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this models refund reason based on context it is used
+func (m ModelsRefundReason) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
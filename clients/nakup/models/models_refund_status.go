@@ -0,0 +1,78 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// ModelsRefundStatus models refund status
+//
+// swagger:model models.RefundStatus
+type ModelsRefundStatus string
+
+func NewModelsRefundStatus(value ModelsRefundStatus) *ModelsRefundStatus {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated ModelsRefundStatus.
+func (m ModelsRefundStatus) Pointer() *ModelsRefundStatus {
+	return &m
+}
+
+const (
+
+	// ModelsRefundStatusPENDING captures enum value "PENDING"
+	ModelsRefundStatusPENDING ModelsRefundStatus = "PENDING"
+
+	// ModelsRefundStatusREFUNDED captures enum value "REFUNDED"
+	ModelsRefundStatusREFUNDED ModelsRefundStatus = "REFUNDED"
+)
+
+// for schema
+var modelsRefundStatusEnum []any
+
+func init() {
+	var res []ModelsRefundStatus
+	if err := json.Unmarshal([]byte(`["PENDING","REFUNDED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		modelsRefundStatusEnum = append(modelsRefundStatusEnum, v)
+	}
+}
+
+func (m ModelsRefundStatus) validateModelsRefundStatusEnum(path, location string, value ModelsRefundStatus) error {
+	if err := validate.EnumCase(path, location, value, modelsRefundStatusEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this models refund status
+func (m ModelsRefundStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateModelsRefundStatusEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this models refund status based on context it is used
+func (m ModelsRefundStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
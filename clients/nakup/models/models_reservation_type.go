@@ -0,0 +1,78 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// ModelsReservationType models reservation type
+//
+// swagger:model models.ReservationType
+type ModelsReservationType string
+
+func NewModelsReservationType(value ModelsReservationType) *ModelsReservationType {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated ModelsReservationType.
+func (m ModelsReservationType) Pointer() *ModelsReservationType {
+	return &m
+}
+
+const (
+
+	// ModelsReservationTypeONLINE captures enum value "ONLINE"
+	ModelsReservationTypeONLINE ModelsReservationType = "ONLINE"
+
+	// ModelsReservationTypePOS captures enum value "POS"
+	ModelsReservationTypePOS ModelsReservationType = "POS"
+)
+
+// for schema
+var modelsReservationTypeEnum []any
+
+func init() {
+	var res []ModelsReservationType
+	if err := json.Unmarshal([]byte(`["ONLINE","POS"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		modelsReservationTypeEnum = append(modelsReservationTypeEnum, v)
+	}
+}
+
+func (m ModelsReservationType) validateModelsReservationTypeEnum(path, location string, value ModelsReservationType) error {
+	if err := validate.EnumCase(path, location, value, modelsReservationTypeEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this models reservation type
+func (m ModelsReservationType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateModelsReservationTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this models reservation type based on context it is used
+func (m ModelsReservationType) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,81 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// ModelsWebhookDeliveryStatus models webhook delivery status
+//
+// swagger:model models.WebhookDeliveryStatus
+type ModelsWebhookDeliveryStatus string
+
+func NewModelsWebhookDeliveryStatus(value ModelsWebhookDeliveryStatus) *ModelsWebhookDeliveryStatus {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated ModelsWebhookDeliveryStatus.
+func (m ModelsWebhookDeliveryStatus) Pointer() *ModelsWebhookDeliveryStatus {
+	return &m
+}
+
+const (
+
+	// ModelsWebhookDeliveryStatusPENDING captures enum value "PENDING"
+	ModelsWebhookDeliveryStatusPENDING ModelsWebhookDeliveryStatus = "PENDING"
+
+	// ModelsWebhookDeliveryStatusSUCCEEDED captures enum value "SUCCEEDED"
+	ModelsWebhookDeliveryStatusSUCCEEDED ModelsWebhookDeliveryStatus = "SUCCEEDED"
+
+	// ModelsWebhookDeliveryStatusDEAD captures enum value "DEAD"
+	ModelsWebhookDeliveryStatusDEAD ModelsWebhookDeliveryStatus = "DEAD"
+)
+
+// for schema
+var modelsWebhookDeliveryStatusEnum []any
+
+func init() {
+	var res []ModelsWebhookDeliveryStatus
+	if err := json.Unmarshal([]byte(`["PENDING","SUCCEEDED","DEAD"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		modelsWebhookDeliveryStatusEnum = append(modelsWebhookDeliveryStatusEnum, v)
+	}
+}
+
+func (m ModelsWebhookDeliveryStatus) validateModelsWebhookDeliveryStatusEnum(path, location string, value ModelsWebhookDeliveryStatus) error {
+	if err := validate.EnumCase(path, location, value, modelsWebhookDeliveryStatusEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this models webhook delivery status
+func (m ModelsWebhookDeliveryStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateModelsWebhookDeliveryStatusEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this models webhook delivery status based on context it is used
+func (m ModelsWebhookDeliveryStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
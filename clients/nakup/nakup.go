@@ -0,0 +1,365 @@
+// Package nakup is a typed client for the nakup API. It wraps the client
+// generated from api/docs/swagger.json with bearer token or API key
+// authentication,
+// iteration over paginated list endpoints and decoding of error responses
+// into middleware.HttpError.
+package nakup
+
+import (
+	"errors"
+	"iter"
+	"net/http"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client"
+	apikeys "github.com/PRPO-skupina-02/nakup/clients/nakup/client/api_keys"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/purchases"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/refunds"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/reservations"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/webhooks"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/models"
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultPageSize is the number of items requested per page when iterating
+// over a list endpoint without an explicit limit. It is the largest page the
+// API serves.
+const DefaultPageSize int64 = 100
+
+// APIKeyHeader is the header API keys are sent in.
+const APIKeyHeader = "X-API-Key"
+
+// Client embeds the generated client, so every operation is available as
+// e.g. client.Reservations.ReservationsCreate. Operations may be called with a
+// nil auth info to use the token the client was created with. Errors returned
+// for API responses are *middleware.HttpError.
+type Client struct {
+	*client.Nakup
+}
+
+type config struct {
+	schemes     []string
+	basePath    string
+	httpClient  *http.Client
+	tokenSource func() (string, error)
+	apiKey      string
+}
+
+type Option func(*config)
+
+// WithToken authenticates every request with a static bearer token.
+func WithToken(token string) Option {
+	return WithTokenSource(func() (string, error) {
+		return token, nil
+	})
+}
+
+// WithTokenSource authenticates every request with the bearer token returned
+// by source, which is called once per request so tokens can be refreshed.
+func WithTokenSource(source func() (string, error)) Option {
+	return func(cfg *config) {
+		cfg.tokenSource = source
+	}
+}
+
+// WithAPIKey authenticates every request with an API key instead of a bearer
+// token, for machine clients such as kiosks.
+func WithAPIKey(key string) Option {
+	return func(cfg *config) {
+		cfg.apiKey = key
+	}
+}
+
+// WithSchemes overrides the schemes used to reach the API, http by default.
+func WithSchemes(schemes ...string) Option {
+	return func(cfg *config) {
+		cfg.schemes = schemes
+	}
+}
+
+// WithBasePath overrides the path the API is served under.
+func WithBasePath(basePath string) Option {
+	return func(cfg *config) {
+		cfg.basePath = basePath
+	}
+}
+
+// WithHTTPClient sends requests through httpClient instead of
+// http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(cfg *config) {
+		cfg.httpClient = httpClient
+	}
+}
+
+// New creates a client for the nakup API reachable at host, e.g.
+// "nakup:8081".
+func New(host string, opts ...Option) *Client {
+	cfg := config{
+		schemes:  client.DefaultSchemes,
+		basePath: client.DefaultBasePath,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	transport := httptransport.NewWithClient(host, cfg.basePath, cfg.schemes, cfg.httpClient)
+	switch {
+	case cfg.apiKey != "":
+		transport.DefaultAuthentication = httptransport.APIKeyAuth(APIKeyHeader, "header", cfg.apiKey)
+	case cfg.tokenSource != nil:
+		transport.DefaultAuthentication = runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, formats strfmt.Registry) error {
+			token, err := cfg.tokenSource()
+			if err != nil {
+				return err
+			}
+			return httptransport.BearerToken(token).AuthenticateRequest(r, formats)
+		})
+	}
+
+	return &Client{
+		Nakup: client.New(errorTransport{transport}, strfmt.Default),
+	}
+}
+
+// errorTransport replaces the errors the generated client returns for API
+// responses with the middleware.HttpError the API responded with.
+type errorTransport struct {
+	runtime.ClientTransport
+}
+
+func (t errorTransport) Submit(operation *runtime.ClientOperation) (any, error) {
+	operation.Reader = errorReader{operation.Reader}
+	return t.ClientTransport.Submit(operation)
+}
+
+// errorResponse is implemented by the generated error responses of every
+// operation, which all carry a middleware.HttpError payload.
+type errorResponse interface {
+	Code() int
+	GetPayload() *models.MiddlewareHTTPError
+}
+
+type errorReader struct {
+	runtime.ClientResponseReader
+}
+
+func (r errorReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	result, err := r.ClientResponseReader.ReadResponse(response, consumer)
+	if err == nil {
+		return result, nil
+	}
+
+	var known errorResponse
+	if errors.As(err, &known) {
+		return nil, newHttpError(known.Code(), known.GetPayload())
+	}
+
+	// Responses the spec does not document, such as 401 and 403 from the auth
+	// middleware, are left unread by the generated reader.
+	var unknown *runtime.APIError
+	if errors.As(err, &unknown) {
+		payload := &models.MiddlewareHTTPError{}
+		if consumer.Consume(response.Body(), payload) != nil {
+			payload = nil
+		}
+		return nil, newHttpError(unknown.Code, payload)
+	}
+
+	return nil, err
+}
+
+func newHttpError(code int, payload *models.MiddlewareHTTPError) *middleware.HttpError {
+	if payload == nil || payload.Message == "" {
+		return &middleware.HttpError{
+			Code:    code,
+			Message: http.StatusText(code),
+		}
+	}
+
+	return &middleware.HttpError{
+		Code:    code,
+		Message: payload.Message,
+		Fields:  payload.Fields,
+	}
+}
+
+// Paginate iterates over every item of a paginated list endpoint, starting at
+// offset and fetching limit items at a time. fetch returns one page together
+// with the total number of items. Iteration stops at the first error, which
+// is yielded with the zero value.
+func Paginate[T any](limit, offset int64, fetch func(limit, offset int64) ([]T, int64, error)) iter.Seq2[T, error] {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+
+	return func(yield func(T, error) bool) {
+		for {
+			items, total, err := fetch(limit, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			offset += int64(len(items))
+			if len(items) == 0 || offset >= total {
+				return
+			}
+		}
+	}
+}
+
+// pageArgs returns the limit and offset a list iteration starts from.
+func pageArgs(limit, offset *int64) (int64, int64) {
+	var l, o int64
+	if limit != nil {
+		l = *limit
+	}
+	if offset != nil {
+		o = *offset
+	}
+	return l, o
+}
+
+// AllReservations iterates over every reservation matching params. Limit and
+// offset in params set the page size and where iteration starts.
+func (c *Client) AllReservations(params *reservations.ReservationsListParams) iter.Seq2[*models.APIReservationResponse, error] {
+	if params == nil {
+		params = reservations.NewReservationsListParams()
+	}
+
+	limit, offset := pageArgs(params.Limit, params.Offset)
+	return Paginate(limit, offset, func(limit, offset int64) ([]*models.APIReservationResponse, int64, error) {
+		page := *params
+		page.Limit = &limit
+		page.Offset = &offset
+
+		response, err := c.Reservations.ReservationsList(&page, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Payload.Data, response.Payload.Total, nil
+	})
+}
+
+// AllMyReservations iterates over every reservation of the authenticated user.
+func (c *Client) AllMyReservations(params *reservations.MyReservationsListParams) iter.Seq2[*models.APIReservationResponse, error] {
+	if params == nil {
+		params = reservations.NewMyReservationsListParams()
+	}
+
+	limit, offset := pageArgs(params.Limit, params.Offset)
+	return Paginate(limit, offset, func(limit, offset int64) ([]*models.APIReservationResponse, int64, error) {
+		page := *params
+		page.Limit = &limit
+		page.Offset = &offset
+
+		response, err := c.Reservations.MyReservationsList(&page, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Payload.Data, response.Payload.Total, nil
+	})
+}
+
+// AllReservationAuditEntries iterates over the history of the reservation in
+// params.
+func (c *Client) AllReservationAuditEntries(params *reservations.ReservationAuditListParams) iter.Seq2[*models.APIAuditEntryResponse, error] {
+	limit, offset := pageArgs(params.Limit, params.Offset)
+	return Paginate(limit, offset, func(limit, offset int64) ([]*models.APIAuditEntryResponse, int64, error) {
+		page := *params
+		page.Limit = &limit
+		page.Offset = &offset
+
+		response, err := c.Reservations.ReservationAuditList(&page, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Payload.Data, response.Payload.Total, nil
+	})
+}
+
+// AllPurchases iterates over every purchase of the reservation in params.
+func (c *Client) AllPurchases(params *purchases.PurchasesListParams) iter.Seq2[*models.APIPurchaseResponse, error] {
+	limit, offset := pageArgs(params.Limit, params.Offset)
+	return Paginate(limit, offset, func(limit, offset int64) ([]*models.APIPurchaseResponse, int64, error) {
+		page := *params
+		page.Limit = &limit
+		page.Offset = &offset
+
+		response, err := c.Purchases.PurchasesList(&page, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Payload.Data, response.Payload.Total, nil
+	})
+}
+
+// AllRefunds iterates over every refund matching params.
+func (c *Client) AllRefunds(params *refunds.RefundsListParams) iter.Seq2[*models.APIRefundResponse, error] {
+	if params == nil {
+		params = refunds.NewRefundsListParams()
+	}
+
+	limit, offset := pageArgs(params.Limit, params.Offset)
+	return Paginate(limit, offset, func(limit, offset int64) ([]*models.APIRefundResponse, int64, error) {
+		page := *params
+		page.Limit = &limit
+		page.Offset = &offset
+
+		response, err := c.Refunds.RefundsList(&page, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Payload.Data, response.Payload.Total, nil
+	})
+}
+
+// AllWebhooks iterates over every webhook subscription.
+func (c *Client) AllWebhooks(params *webhooks.WebhooksListParams) iter.Seq2[*models.APIWebhookResponse, error] {
+	if params == nil {
+		params = webhooks.NewWebhooksListParams()
+	}
+
+	limit, offset := pageArgs(params.Limit, params.Offset)
+	return Paginate(limit, offset, func(limit, offset int64) ([]*models.APIWebhookResponse, int64, error) {
+		page := *params
+		page.Limit = &limit
+		page.Offset = &offset
+
+		response, err := c.Webhooks.WebhooksList(&page, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Payload.Data, response.Payload.Total, nil
+	})
+}
+
+// AllAPIKeys iterates over every API key.
+func (c *Client) AllAPIKeys(params *apikeys.APIKeysListParams) iter.Seq2[*models.APIAPIKeyResponse, error] {
+	if params == nil {
+		params = apikeys.NewAPIKeysListParams()
+	}
+
+	limit, offset := pageArgs(params.Limit, params.Offset)
+	return Paginate(limit, offset, func(limit, offset int64) ([]*models.APIAPIKeyResponse, int64, error) {
+		page := *params
+		page.Limit = &limit
+		page.Offset = &offset
+
+		response, err := c.APIKeys.APIKeysList(&page, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Payload.Data, response.Payload.Total, nil
+	})
+}
@@ -0,0 +1,157 @@
+package nakup
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/reservations"
+	"github.com/PRPO-skupina-02/nakup/clients/nakup/client/seats"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return New(strings.TrimPrefix(server.URL, "http://"), opts...)
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestClientErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     int
+		body     any
+		expected *middleware.HttpError
+	}{
+		{
+			name: "documented",
+			code: http.StatusBadRequest,
+			body: middleware.HttpError{Code: http.StatusBadRequest, Message: "validation error", Fields: map[string]string{"row": "row must be 10 or less"}},
+			expected: &middleware.HttpError{
+				Code:    http.StatusBadRequest,
+				Message: "validation error",
+				Fields:  map[string]string{"row": "row must be 10 or less"},
+			},
+		},
+		{
+			name:     "undocumented",
+			code:     http.StatusForbidden,
+			body:     middleware.NewForbiddenError("insufficient role"),
+			expected: middleware.NewForbiddenError("insufficient role"),
+		},
+		{
+			name:     "no-body",
+			code:     http.StatusUnauthorized,
+			expected: &middleware.HttpError{Code: http.StatusUnauthorized, Message: "Unauthorized"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if testCase.body == nil {
+					w.WriteHeader(testCase.code)
+					return
+				}
+				writeJSON(w, testCase.code, testCase.body)
+			})
+
+			params := reservations.NewReservationsShowParams().WithReservationID(strfmt.UUID(uuid.NewString()))
+			_, err := client.Reservations.ReservationsShow(params, nil)
+
+			var httpError *middleware.HttpError
+			require.True(t, errors.As(err, &httpError))
+			assert.Equal(t, testCase.expected, httpError)
+		})
+	}
+}
+
+func TestClientToken(t *testing.T) {
+	tokens := []string{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("Authorization"))
+		writeJSON(w, http.StatusOK, map[string]any{"data": []any{}, "total": 0})
+	}, WithTokenSource(func() (string, error) {
+		return "token-" + strconv.Itoa(len(tokens)), nil
+	}))
+
+	for range 2 {
+		_, err := client.Reservations.MyReservationsList(reservations.NewMyReservationsListParams(), nil)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"Bearer token-0", "Bearer token-1"}, tokens)
+}
+
+func TestClientAPIKey(t *testing.T) {
+	var header, authorization string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get(APIKeyHeader)
+		authorization = r.Header.Get("Authorization")
+		writeJSON(w, http.StatusOK, map[string]any{"time_slot_id": "9d71d7fd-d88e-41a1-86dc-21b7f2550295", "reserved": []any{}})
+	}, WithAPIKey("kiosk-test-key"))
+
+	params := seats.NewSeatMapShowParams()
+	params.TimeSlotID = "9d71d7fd-d88e-41a1-86dc-21b7f2550295"
+
+	_, err := client.Seats.SeatMapShow(params, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "kiosk-test-key", header)
+	assert.Empty(t, authorization)
+}
+
+func TestPaginate(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	fetch := func(limit, offset int64) ([]int, int64, error) {
+		end := min(offset+limit, int64(len(items)))
+		return items[offset:end], int64(len(items)), nil
+	}
+
+	collect := func(limit, offset int64) []int {
+		result := []int{}
+		for item, err := range Paginate(limit, offset, fetch) {
+			require.NoError(t, err)
+			result = append(result, item)
+		}
+		return result
+	}
+
+	assert.Equal(t, items, collect(2, 0))
+	assert.Equal(t, items, collect(0, 0))
+	assert.Equal(t, []int{4, 5}, collect(1, 3))
+
+	result := []int{}
+	for item := range Paginate(2, 0, fetch) {
+		result = append(result, item)
+		if item == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2, 3}, result)
+
+	failure := errors.New("failure")
+	calls := 0
+	for _, err := range Paginate(2, 0, func(limit, offset int64) ([]int, int64, error) {
+		calls++
+		return nil, 0, failure
+	}) {
+		assert.Equal(t, failure, err)
+	}
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,506 @@
+// Package sporedtest provides an in-process fake of the spored service for
+// tests that need to exercise the generated spored client.
+package sporedtest
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/nakup/clients/spored/client"
+	"github.com/PRPO-skupina-02/nakup/clients/spored/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+const defaultLimit = 10
+
+type Theater struct {
+	ID   uuid.UUID
+	Name string
+}
+
+type Room struct {
+	ID        uuid.UUID
+	TheaterID uuid.UUID
+	Name      string
+	Rows      int
+	Columns   int
+}
+
+type Movie struct {
+	ID            uuid.UUID
+	Name          string
+	LengthMinutes int
+}
+
+type TimeSlot struct {
+	ID        uuid.UUID
+	RoomID    uuid.UUID
+	MovieID   uuid.UUID
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// Server serves the read endpoints of spored from data seeded by the test.
+// Failures and slow responses can be injected to test how clients cope with
+// them.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	createdAt time.Time
+	theaters  map[uuid.UUID]Theater
+	rooms     map[uuid.UUID]Room
+	movies    map[uuid.UUID]Movie
+	timeSlots map[uuid.UUID]TimeSlot
+
+	delay        time.Duration
+	failStatus   int
+	failRequests int
+	requests     int
+}
+
+// NewServer starts a fake spored server that is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		createdAt: time.Date(2025, 11, 1, 8, 0, 0, 0, time.UTC),
+		theaters:  map[uuid.UUID]Theater{},
+		rooms:     map[uuid.UUID]Room{},
+		movies:    map[uuid.UUID]Movie{},
+		timeSlots: map[uuid.UUID]TimeSlot{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/spored/theaters", s.theatersList)
+	mux.HandleFunc("GET /api/v1/spored/theaters/{theaterID}", s.theatersShow)
+	mux.HandleFunc("GET /api/v1/spored/theaters/{theaterID}/rooms", s.roomsList)
+	mux.HandleFunc("GET /api/v1/spored/theaters/{theaterID}/rooms/{roomID}", s.roomsShow)
+	mux.HandleFunc("GET /api/v1/spored/theaters/{theaterID}/rooms/{roomID}/timeslots", s.timeSlotsList)
+	mux.HandleFunc("GET /api/v1/spored/theaters/{theaterID}/rooms/{roomID}/timeslots/{timeSlotID}", s.timeSlotsShow)
+	mux.HandleFunc("GET /api/v1/spored/movies", s.moviesList)
+	mux.HandleFunc("GET /api/v1/spored/movies/{movieID}", s.moviesShow)
+
+	s.Server = httptest.NewServer(s.middleware(mux))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Client returns a spored client that talks to the fake server.
+func (s *Server) Client() *client.Spored {
+	host := strings.TrimPrefix(s.URL, "http://")
+	transportConfig := client.DefaultTransportConfig().WithHost(host).WithSchemes([]string{"http"})
+	return client.NewHTTPClientWithConfig(strfmt.Default, transportConfig)
+}
+
+func (s *Server) AddTheater(theater Theater) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.theaters[theater.ID] = theater
+}
+
+// AddRoom adds a room, creating its theater if it does not exist yet.
+func (s *Server) AddRoom(room Room) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.theaters[room.TheaterID]; !ok {
+		s.theaters[room.TheaterID] = Theater{ID: room.TheaterID}
+	}
+	s.rooms[room.ID] = room
+}
+
+func (s *Server) AddMovie(movie Movie) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.movies[movie.ID] = movie
+}
+
+func (s *Server) AddTimeSlot(timeSlot TimeSlot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.timeSlots[timeSlot.ID] = timeSlot
+}
+
+func (s *Server) RemoveRoom(id uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.rooms, id)
+}
+
+func (s *Server) RemoveMovie(id uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.movies, id)
+}
+
+func (s *Server) RemoveTimeSlot(id uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.timeSlots, id)
+}
+
+// SetDelay makes every following response wait for delay before it is sent.
+func (s *Server) SetDelay(delay time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delay = delay
+}
+
+// Fail makes every following request fail with status until Recover is called.
+func (s *Server) Fail(status int) {
+	s.FailNext(status, -1)
+}
+
+// FailNext makes the next n requests fail with status.
+func (s *Server) FailNext(status, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failStatus = status
+	s.failRequests = n
+}
+
+func (s *Server) Recover() {
+	s.FailNext(0, 0)
+}
+
+// Requests returns how many requests the server has received.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests++
+		delay := s.delay
+		failStatus := 0
+		if s.failRequests != 0 {
+			failStatus = s.failStatus
+			if s.failRequests > 0 {
+				s.failRequests--
+			}
+		}
+		s.mu.Unlock()
+
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if failStatus != 0 {
+			writeError(w, failStatus, http.StatusText(failStatus))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, models.MiddlewareHTTPError{Code: int64(status), Message: message})
+}
+
+// pathUUIDs parses the named path values and writes a validation error like
+// spored does when one of them is not a valid UUID.
+func pathUUIDs(w http.ResponseWriter, r *http.Request, names ...string) ([]uuid.UUID, bool) {
+	ids := make([]uuid.UUID, 0, len(names))
+	for _, name := range names {
+		id, err := uuid.Parse(r.PathValue(name))
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, models.MiddlewareHTTPError{
+				Code:    http.StatusBadRequest,
+				Message: "validation error",
+				Fields:  map[string]string{name: fmt.Sprintf("%s must be a valid UUID", name)},
+			})
+			return nil, false
+		}
+		ids = append(ids, id)
+	}
+	return ids, true
+}
+
+func writePaginated[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultLimit
+	}
+	offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	page := items[min(offset, len(items)):min(offset+limit, len(items))]
+
+	writeJSON(w, http.StatusOK, models.RequestPaginatedResponse{
+		Data:   page,
+		Limit:  int64(limit),
+		Offset: int64(offset),
+		Total:  int64(len(items)),
+	})
+}
+
+// sortedValues returns the values of m in a stable order so that pagination is
+// deterministic.
+func sortedValues[T any](m map[uuid.UUID]T, keep func(T) bool) []T {
+	ids := make([]uuid.UUID, 0, len(m))
+	for id, value := range m {
+		if keep(value) {
+			ids = append(ids, id)
+		}
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+
+	values := make([]T, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, m[id])
+	}
+	return values
+}
+
+func (s *Server) timestamp() string {
+	return s.createdAt.Format(time.RFC3339)
+}
+
+func (s *Server) theaterResponse(theater Theater) models.APITheaterResponse {
+	return models.APITheaterResponse{
+		ID:        theater.ID.String(),
+		Name:      theater.Name,
+		CreatedAt: s.timestamp(),
+		UpdatedAt: s.timestamp(),
+	}
+}
+
+func (s *Server) roomResponse(room Room) models.APIRoomResponse {
+	return models.APIRoomResponse{
+		ID:        room.ID.String(),
+		Name:      room.Name,
+		Rows:      int64(room.Rows),
+		Columns:   int64(room.Columns),
+		CreatedAt: s.timestamp(),
+		UpdatedAt: s.timestamp(),
+	}
+}
+
+func (s *Server) movieResponse(movie Movie) models.APIMovieResponse {
+	return models.APIMovieResponse{
+		ID:            movie.ID.String(),
+		Name:          movie.Name,
+		LengthMinutes: int64(movie.LengthMinutes),
+		Active:        true,
+		CreatedAt:     s.timestamp(),
+		UpdatedAt:     s.timestamp(),
+	}
+}
+
+func (s *Server) timeSlotResponse(timeSlot TimeSlot) models.APITimeSlotResponse {
+	return models.APITimeSlotResponse{
+		ID:        timeSlot.ID.String(),
+		RoomID:    timeSlot.RoomID.String(),
+		MovieID:   timeSlot.MovieID.String(),
+		StartTime: timeSlot.StartTime.Format(time.RFC3339),
+		EndTime:   timeSlot.EndTime.Format(time.RFC3339),
+		CreatedAt: s.timestamp(),
+		UpdatedAt: s.timestamp(),
+	}
+}
+
+// room returns the room if it exists in the theater, writing a 404 otherwise.
+// The caller must hold s.mu.
+func (s *Server) room(w http.ResponseWriter, theaterID, roomID uuid.UUID) (Room, bool) {
+	if _, ok := s.theaters[theaterID]; !ok {
+		writeError(w, http.StatusNotFound, "theater not found")
+		return Room{}, false
+	}
+
+	room, ok := s.rooms[roomID]
+	if !ok || room.TheaterID != theaterID {
+		writeError(w, http.StatusNotFound, "room not found")
+		return Room{}, false
+	}
+
+	return room, true
+}
+
+func (s *Server) theatersList(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response := []models.APITheaterResponse{}
+	for _, theater := range sortedValues(s.theaters, func(Theater) bool { return true }) {
+		response = append(response, s.theaterResponse(theater))
+	}
+
+	writePaginated(w, r, response)
+}
+
+func (s *Server) theatersShow(w http.ResponseWriter, r *http.Request) {
+	ids, ok := pathUUIDs(w, r, "theaterID")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	theater, ok := s.theaters[ids[0]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "theater not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.theaterResponse(theater))
+}
+
+func (s *Server) roomsList(w http.ResponseWriter, r *http.Request) {
+	ids, ok := pathUUIDs(w, r, "theaterID")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.theaters[ids[0]]; !ok {
+		writeError(w, http.StatusNotFound, "theater not found")
+		return
+	}
+
+	response := []models.APIRoomResponse{}
+	for _, room := range sortedValues(s.rooms, func(room Room) bool { return room.TheaterID == ids[0] }) {
+		response = append(response, s.roomResponse(room))
+	}
+
+	writePaginated(w, r, response)
+}
+
+func (s *Server) roomsShow(w http.ResponseWriter, r *http.Request) {
+	ids, ok := pathUUIDs(w, r, "theaterID", "roomID")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	room, ok := s.room(w, ids[0], ids[1])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.roomResponse(room))
+}
+
+func (s *Server) timeSlotsList(w http.ResponseWriter, r *http.Request) {
+	ids, ok := pathUUIDs(w, r, "theaterID", "roomID")
+	if !ok {
+		return
+	}
+
+	var date time.Time
+	if value := r.URL.Query().Get("date"); value != "" {
+		var err error
+		date, err = time.Parse(time.DateOnly, value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "date must be a valid date")
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.room(w, ids[0], ids[1]); !ok {
+		return
+	}
+
+	timeSlots := sortedValues(s.timeSlots, func(timeSlot TimeSlot) bool {
+		if timeSlot.RoomID != ids[1] {
+			return false
+		}
+		return date.IsZero() || timeSlot.StartTime.Format(time.DateOnly) == date.Format(time.DateOnly)
+	})
+	slices.SortStableFunc(timeSlots, func(a, b TimeSlot) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+
+	response := []models.APITimeSlotResponse{}
+	for _, timeSlot := range timeSlots {
+		response = append(response, s.timeSlotResponse(timeSlot))
+	}
+
+	writePaginated(w, r, response)
+}
+
+func (s *Server) timeSlotsShow(w http.ResponseWriter, r *http.Request) {
+	ids, ok := pathUUIDs(w, r, "theaterID", "roomID", "timeSlotID")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.room(w, ids[0], ids[1]); !ok {
+		return
+	}
+
+	timeSlot, ok := s.timeSlots[ids[2]]
+	if !ok || timeSlot.RoomID != ids[1] {
+		writeError(w, http.StatusNotFound, "time slot not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.timeSlotResponse(timeSlot))
+}
+
+func (s *Server) moviesList(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response := []models.APIMovieResponse{}
+	for _, movie := range sortedValues(s.movies, func(Movie) bool { return true }) {
+		response = append(response, s.movieResponse(movie))
+	}
+
+	writePaginated(w, r, response)
+}
+
+func (s *Server) moviesShow(w http.ResponseWriter, r *http.Request) {
+	ids, ok := pathUUIDs(w, r, "movieID")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	movie, ok := s.movies[ids[0]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "movie not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.movieResponse(movie))
+}
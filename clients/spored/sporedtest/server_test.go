@@ -0,0 +1,100 @@
+package sporedtest
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/nakup/clients/spored/client/movies"
+	"github.com/PRPO-skupina-02/nakup/clients/spored/client/rooms"
+	"github.com/PRPO-skupina-02/nakup/clients/spored/client/timeslots"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	server := NewServer(t)
+	spored := server.Client()
+
+	theaterID := uuid.New()
+	roomID := uuid.New()
+	movieID := uuid.New()
+	startTime := time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC)
+
+	server.AddRoom(Room{ID: roomID, TheaterID: theaterID, Name: "Dvorana 1", Rows: 10, Columns: 15})
+	server.AddMovie(Movie{ID: movieID, Name: "Dune: Part Two", LengthMinutes: 166})
+	for i := range 3 {
+		server.AddTimeSlot(TimeSlot{
+			ID:        uuid.New(),
+			RoomID:    roomID,
+			MovieID:   movieID,
+			StartTime: startTime.Add(time.Duration(i) * 24 * time.Hour),
+			EndTime:   startTime.Add(time.Duration(i)*24*time.Hour + 3*time.Hour),
+		})
+	}
+
+	room, err := spored.Rooms.RoomsShow(rooms.NewRoomsShowParams().
+		WithTheaterID(strfmt.UUID(theaterID.String())).
+		WithRoomID(strfmt.UUID(roomID.String())))
+	require.NoError(t, err)
+	assert.Equal(t, "Dvorana 1", room.Payload.Name)
+	assert.EqualValues(t, 15, room.Payload.Columns)
+
+	movie, err := spored.Movies.MoviesShow(movies.NewMoviesShowParams().WithMovieID(strfmt.UUID(movieID.String())))
+	require.NoError(t, err)
+	assert.EqualValues(t, 166, movie.Payload.LengthMinutes)
+
+	limit := int64(2)
+	offset := int64(1)
+	list, err := spored.Timeslots.TimeSlotsList(timeslots.NewTimeSlotsListParams().
+		WithTheaterID(strfmt.UUID(theaterID.String())).
+		WithRoomID(strfmt.UUID(roomID.String())).
+		WithLimit(&limit).
+		WithOffset(&offset))
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, list.Payload.Total)
+	assert.Len(t, list.Payload.Data, 2)
+
+	_, err = spored.Rooms.RoomsShow(rooms.NewRoomsShowParams().
+		WithTheaterID(strfmt.UUID(uuid.NewString())).
+		WithRoomID(strfmt.UUID(roomID.String())))
+	var notFound *rooms.RoomsShowNotFound
+	assert.ErrorAs(t, err, &notFound, "rooms are only found in their theater")
+
+	assert.Equal(t, 4, server.Requests())
+}
+
+func TestServerFailures(t *testing.T) {
+	server := NewServer(t)
+	spored := server.Client()
+
+	movieID := uuid.New()
+	server.AddMovie(Movie{ID: movieID, Name: "Dune: Part Two", LengthMinutes: 166})
+	params := movies.NewMoviesShowParams().WithMovieID(strfmt.UUID(movieID.String()))
+
+	server.FailNext(http.StatusInternalServerError, 1)
+	_, err := spored.Movies.MoviesShow(params)
+	var internalServerError *movies.MoviesShowInternalServerError
+	assert.ErrorAs(t, err, &internalServerError)
+
+	_, err = spored.Movies.MoviesShow(params)
+	assert.NoError(t, err, "only the next request fails")
+
+	server.Fail(http.StatusServiceUnavailable)
+	for range 2 {
+		_, err = spored.Movies.MoviesShow(params)
+		var apiError *runtime.APIError
+		if assert.True(t, errors.As(err, &apiError)) {
+			assert.Equal(t, http.StatusServiceUnavailable, apiError.Code)
+		}
+	}
+
+	server.Recover()
+	server.SetDelay(200 * time.Millisecond)
+	_, err = spored.Movies.MoviesShow(params.WithTimeout(20 * time.Millisecond))
+	assert.Error(t, err)
+}
@@ -0,0 +1,3 @@
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS room_id,
+    DROP COLUMN IF EXISTS theater_id;
\ No newline at end of file
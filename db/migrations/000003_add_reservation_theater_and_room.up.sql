@@ -0,0 +1,3 @@
+ALTER TABLE reservations
+    ADD COLUMN IF NOT EXISTS theater_id uuid,
+    ADD COLUMN IF NOT EXISTS room_id uuid;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS outbox_events(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    type varchar NOT NULL,
+    aggregate_id uuid NOT NULL,
+    payload jsonb NOT NULL,
+    attempts int NOT NULL DEFAULT 0,
+    last_error varchar,
+    published_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS outbox_events_pending_idx ON outbox_events(created_at, id) WHERE published_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,41 @@
+CREATE TYPE webhook_delivery_status AS ENUM ('PENDING', 'SUCCEEDED', 'DEAD');
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    url varchar NOT NULL,
+    event_types jsonb NOT NULL,
+    secret varchar NOT NULL,
+    active boolean NOT NULL DEFAULT true
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    subscription_id uuid NOT NULL,
+    event_id uuid NOT NULL,
+    event_type varchar NOT NULL,
+    payload jsonb NOT NULL,
+    status webhook_delivery_status NOT NULL DEFAULT 'PENDING',
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz,
+    last_status_code int,
+    last_error varchar,
+    delivered_at timestamptz,
+    CONSTRAINT "WEBHOOK_SUBSCRIPTION_ID_FKEY" FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    CONSTRAINT "WEBHOOK_DELIVERY_EVENT_UNIQUE" UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries(next_attempt_at) WHERE status = 'PENDING';
+
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    delivery_id uuid NOT NULL,
+    status_code int,
+    error varchar,
+    duration_ms int NOT NULL,
+    CONSTRAINT "WEBHOOK_DELIVERY_ID_FKEY" FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries(id) ON DELETE CASCADE
+);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS refunds;
+DROP TYPE IF EXISTS refund_status;
+DROP TYPE IF EXISTS refund_reason;
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TYPE refund_reason AS ENUM ('TIME_SLOT_CANCELLED', 'SEAT_UNAVAILABLE');
+CREATE TYPE refund_status AS ENUM ('PENDING', 'REFUNDED');
+
+CREATE TABLE IF NOT EXISTS refunds(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    reservation_id uuid NOT NULL,
+    time_slot_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    reason refund_reason NOT NULL,
+    status refund_status NOT NULL DEFAULT 'PENDING',
+    amount_cents int NOT NULL,
+    refunded_at timestamptz,
+    CONSTRAINT "REFUND_RESERVATION_UNIQUE" UNIQUE (reservation_id)
+);
\ No newline at end of file
@@ -0,0 +1,18 @@
+DELETE FROM purchases
+    WHERE reservation_id IN (SELECT id FROM reservations WHERE user_id IS NULL);
+
+DELETE FROM refunds
+    WHERE user_id IS NULL
+    OR reservation_id IN (SELECT id FROM reservations WHERE user_id IS NULL);
+
+DELETE FROM reservations
+    WHERE user_id IS NULL;
+
+ALTER TABLE refunds
+    ALTER COLUMN user_id SET NOT NULL;
+
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS api_key_id,
+    ALTER COLUMN user_id SET NOT NULL;
+
+DROP TABLE IF EXISTS api_keys;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS api_keys(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    name varchar NOT NULL,
+    prefix varchar NOT NULL,
+    key_hash varchar NOT NULL,
+    scopes jsonb NOT NULL,
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    CONSTRAINT "API_KEY_HASH_UNIQUE" UNIQUE (key_hash)
+);
+
+ALTER TABLE reservations
+    ALTER COLUMN user_id DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS api_key_id uuid;
+
+ALTER TABLE refunds
+    ALTER COLUMN user_id DROP NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS reservations_theater_id_idx;
+
+DROP TABLE IF EXISTS staff_theaters;
\ No newline at end of file
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS staff_theaters(
+    user_id uuid NOT NULL,
+    theater_id uuid NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, theater_id)
+);
+
+CREATE INDEX IF NOT EXISTS reservations_theater_id_idx ON reservations (theater_id);
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS audit_entries(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    actor_user_id uuid,
+    actor_api_key_id uuid,
+    request_id varchar,
+    action varchar NOT NULL,
+    entity_type varchar NOT NULL,
+    entity_id uuid NOT NULL,
+    reservation_id uuid NOT NULL,
+    changes jsonb NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_entries_reservation_id_idx ON audit_entries (reservation_id, created_at);
\ No newline at end of file
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS reservations_seat_idx;
+
+DELETE FROM purchases WHERE deleted_at IS NOT NULL;
+DELETE FROM reservations WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE purchases
+    DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS deleted_at;
\ No newline at end of file
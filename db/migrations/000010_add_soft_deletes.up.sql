@@ -0,0 +1,7 @@
+ALTER TABLE reservations
+    ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+
+ALTER TABLE purchases
+    ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS reservations_seat_idx ON reservations (time_slot_id, row, col) WHERE deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL,
+    owner varchar NOT NULL,
+    key varchar NOT NULL,
+    request_hash varchar NOT NULL,
+    status_code int NOT NULL DEFAULT 0,
+    content_type varchar NOT NULL DEFAULT '',
+    body bytea,
+    CONSTRAINT "IDEMPOTENCY_KEY_OWNER_KEY_UNIQUE" UNIQUE (owner, key)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
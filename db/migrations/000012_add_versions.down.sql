@@ -0,0 +1,5 @@
+ALTER TABLE purchases
+    DROP COLUMN IF EXISTS version;
+
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS version;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE reservations
+    ADD COLUMN IF NOT EXISTS version int NOT NULL DEFAULT 1;
+
+ALTER TABLE purchases
+    ADD COLUMN IF NOT EXISTS version int NOT NULL DEFAULT 1;
\ No newline at end of file
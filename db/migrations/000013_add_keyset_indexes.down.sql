@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS reservations_theater_created_at_id_idx;
+
+DROP INDEX IF EXISTS reservations_updated_at_id_idx;
+
+DROP INDEX IF EXISTS reservations_created_at_id_idx;
\ No newline at end of file
@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS reservations_created_at_id_idx ON reservations (created_at, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS reservations_updated_at_id_idx ON reservations (updated_at, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS reservations_theater_created_at_id_idx ON reservations (theater_id, created_at, id) WHERE deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS reservations_screening_starts_at_idx;
+
+ALTER TABLE reservations DROP COLUMN IF EXISTS screening_starts_at;
\ No newline at end of file
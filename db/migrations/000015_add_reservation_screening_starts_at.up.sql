@@ -0,0 +1,3 @@
+ALTER TABLE reservations ADD COLUMN IF NOT EXISTS screening_starts_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS reservations_screening_starts_at_idx ON reservations (screening_starts_at) WHERE deleted_at IS NULL;
\ No newline at end of file
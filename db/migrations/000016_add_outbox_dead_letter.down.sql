@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS outbox_events_pending_idx;
+CREATE INDEX IF NOT EXISTS outbox_events_pending_idx ON outbox_events(created_at, id) WHERE published_at IS NULL;
+
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS dead_at;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS next_attempt_at;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS next_attempt_at timestamptz;
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS dead_at timestamptz;
+
+DROP INDEX IF EXISTS outbox_events_pending_idx;
+CREATE INDEX IF NOT EXISTS outbox_events_pending_idx ON outbox_events(created_at, id) WHERE published_at IS NULL AND dead_at IS NULL;
\ No newline at end of file
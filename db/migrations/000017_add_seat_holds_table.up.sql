@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS seat_holds(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL,
+    time_slot_id uuid NOT NULL,
+    theater_id uuid NOT NULL,
+    room_id uuid NOT NULL,
+    row int NOT NULL,
+    col int NOT NULL,
+    owner varchar NOT NULL,
+    CONSTRAINT "SEAT_HOLD_SEAT_UNIQUE" UNIQUE (time_slot_id, row, col)
+);
+
+CREATE INDEX IF NOT EXISTS seat_holds_expires_at_idx ON seat_holds (expires_at);
\ No newline at end of file
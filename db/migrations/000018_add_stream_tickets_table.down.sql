@@ -0,0 +1 @@
+DROP TABLE IF EXISTS stream_tickets;
\ No newline at end of file
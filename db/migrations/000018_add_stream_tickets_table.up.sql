@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS stream_tickets(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL,
+    ticket_hash varchar(64) NOT NULL,
+    time_slot_id uuid NOT NULL,
+    user_id uuid,
+    user_role varchar,
+    api_key_id uuid,
+    CONSTRAINT "STREAM_TICKET_HASH_UNIQUE" UNIQUE (ticket_hash),
+    CONSTRAINT "STREAM_TICKET_API_KEY_ID_FKEY" FOREIGN KEY (api_key_id) REFERENCES api_keys(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS stream_tickets_expires_at_idx ON stream_tickets (expires_at);
\ No newline at end of file
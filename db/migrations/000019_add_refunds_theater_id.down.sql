@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS refunds_theater_id_idx;
+
+ALTER TABLE refunds DROP COLUMN IF EXISTS theater_id;
\ No newline at end of file
@@ -0,0 +1,7 @@
+ALTER TABLE refunds ADD COLUMN IF NOT EXISTS theater_id uuid;
+
+UPDATE refunds SET theater_id = reservations.theater_id
+FROM reservations
+WHERE reservations.id = refunds.reservation_id;
+
+CREATE INDEX IF NOT EXISTS refunds_theater_id_idx ON refunds (theater_id);
\ No newline at end of file
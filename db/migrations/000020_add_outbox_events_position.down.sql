@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS outbox_events_position_idx;
+
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS sequence;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS transaction_id;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS transaction_id xid8 NOT NULL DEFAULT pg_current_xact_id();
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS sequence bigserial;
+
+CREATE INDEX IF NOT EXISTS outbox_events_position_idx ON outbox_events(transaction_id, sequence);
\ No newline at end of file
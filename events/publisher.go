@@ -0,0 +1,171 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/google/uuid"
+)
+
+type Event struct {
+	ID          uuid.UUID       `json:"id"`
+	Type        string          `json:"type"`
+	AggregateID uuid.UUID       `json:"aggregate_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Data        json.RawMessage `json:"data"`
+}
+
+func newEvent(outboxEvent models.OutboxEvent) Event {
+	return Event{
+		ID:          outboxEvent.ID,
+		Type:        outboxEvent.Type,
+		AggregateID: outboxEvent.AggregateID,
+		OccurredAt:  outboxEvent.CreatedAt,
+		Data:        outboxEvent.Payload,
+	}
+}
+
+// Publisher delivers events to consumers outside of nakup. Delivery is
+// at-least-once, so the same event (identified by its ID) can be published
+// more than once and consumers have to deduplicate.
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// MemoryPublisher keeps published events in memory. It is meant for tests and
+// local development.
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, event Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *MemoryPublisher) Events() []Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	events := make([]Event, len(p.events))
+	copy(events, p.events)
+	return events
+}
+
+func (p *MemoryPublisher) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = nil
+}
+
+// LogPublisher writes every event to the structured log.
+type LogPublisher struct {
+	logger *slog.Logger
+}
+
+func NewLogPublisher(logger *slog.Logger) *LogPublisher {
+	return &LogPublisher{
+		logger: logger,
+	}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, event Event) error {
+	p.logger.InfoContext(ctx, "event published",
+		"event_id", event.ID,
+		"type", event.Type,
+		"aggregate_id", event.AggregateID,
+		"occurred_at", event.OccurredAt,
+		"data", string(event.Data),
+	)
+	return nil
+}
+
+// acceptedRetention is how long MultiPublisher remembers which of its
+// publishers accepted an event that failed on others. It is well above the
+// relay's longest backoff.
+const acceptedRetention = time.Hour
+
+type acceptedEvent struct {
+	publishers []bool
+	failedAt   time.Time
+}
+
+// MultiPublisher publishes every event to all of its publishers. When some of
+// them fail, the ones that accepted the event are remembered and skipped when
+// the event is published again. They are only remembered in memory, so after a
+// restart such a publisher receives the event again like any other
+// at-least-once redelivery.
+type MultiPublisher struct {
+	publishers []Publisher
+	now        func() time.Time
+
+	mu       sync.Mutex
+	accepted map[uuid.UUID]acceptedEvent
+}
+
+func NewMultiPublisher(publishers ...Publisher) *MultiPublisher {
+	return &MultiPublisher{
+		publishers: publishers,
+		now:        time.Now,
+		accepted:   map[uuid.UUID]acceptedEvent{},
+	}
+}
+
+func (p *MultiPublisher) Publish(ctx context.Context, event Event) error {
+	accepted := p.takeAccepted(event.ID)
+
+	var errs []error
+	for i, publisher := range p.publishers {
+		if accepted[i] {
+			continue
+		}
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		accepted[i] = true
+	}
+
+	if len(errs) > 0 {
+		p.mu.Lock()
+		p.accepted[event.ID] = acceptedEvent{publishers: accepted, failedAt: p.now()}
+		p.mu.Unlock()
+	}
+
+	return errors.Join(errs...)
+}
+
+// takeAccepted returns which publishers already accepted the event and forgets
+// events that failed too long ago to still be retried.
+func (p *MultiPublisher) takeAccepted(eventID uuid.UUID) []bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for id, event := range p.accepted {
+		if p.now().Sub(event.failedAt) > acceptedRetention {
+			delete(p.accepted, id)
+		}
+	}
+
+	event, ok := p.accepted[eventID]
+	if !ok {
+		return make([]bool, len(p.publishers))
+	}
+
+	delete(p.accepted, eventID)
+	return event.publishers
+}
@@ -0,0 +1,99 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustField(t *testing.T, data json.RawMessage, field string) json.RawMessage {
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(data, &fields))
+	return fields[field]
+}
+
+func TestMemoryPublisher(t *testing.T) {
+	publisher := NewMemoryPublisher()
+
+	event := Event{ID: uuid.New(), Type: "reservation.created", AggregateID: uuid.New(), OccurredAt: time.Now()}
+	require.NoError(t, publisher.Publish(context.Background(), event))
+	require.NoError(t, publisher.Publish(context.Background(), event))
+
+	assert.Equal(t, []Event{event, event}, publisher.Events())
+
+	publisher.Reset()
+	assert.Empty(t, publisher.Events())
+}
+
+func TestLogPublisher(t *testing.T) {
+	var buf bytes.Buffer
+	publisher := NewLogPublisher(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	event := Event{
+		ID:          uuid.New(),
+		Type:        "purchase.created",
+		AggregateID: uuid.New(),
+		OccurredAt:  time.Now(),
+		Data:        json.RawMessage(`{"name":"Popcorn"}`),
+	}
+	require.NoError(t, publisher.Publish(context.Background(), event))
+
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	assert.Equal(t, "event published", line["msg"])
+	assert.Equal(t, "purchase.created", line["type"])
+	assert.Equal(t, event.ID.String(), line["event_id"])
+	assert.Equal(t, `{"name":"Popcorn"}`, line["data"])
+}
+
+type failingPublisher struct {
+	*MemoryPublisher
+	fail bool
+}
+
+func (p *failingPublisher) Publish(ctx context.Context, event Event) error {
+	if p.fail {
+		return errors.New("broker unavailable")
+	}
+	return p.MemoryPublisher.Publish(ctx, event)
+}
+
+func TestMultiPublisher(t *testing.T) {
+	healthy := NewMemoryPublisher()
+	flaky := &failingPublisher{MemoryPublisher: NewMemoryPublisher(), fail: true}
+	publisher := NewMultiPublisher(healthy, flaky)
+
+	now := time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC)
+	publisher.now = func() time.Time { return now }
+
+	event := Event{ID: uuid.New(), Type: "reservation.created", AggregateID: uuid.New(), OccurredAt: now}
+	assert.Error(t, publisher.Publish(context.Background(), event))
+	assert.Error(t, publisher.Publish(context.Background(), event))
+	assert.Len(t, healthy.Events(), 1, "publishers that accepted the event do not receive it again")
+	assert.Empty(t, flaky.Events())
+
+	flaky.fail = false
+	require.NoError(t, publisher.Publish(context.Background(), event))
+	assert.Len(t, healthy.Events(), 1)
+	assert.Len(t, flaky.Events(), 1)
+	assert.Empty(t, publisher.accepted)
+
+	// Events that failed long ago are forgotten and published to everyone.
+	flaky.fail = true
+	other := Event{ID: uuid.New(), Type: "reservation.updated", AggregateID: uuid.New(), OccurredAt: now}
+	assert.Error(t, publisher.Publish(context.Background(), other))
+
+	now = now.Add(acceptedRetention + time.Minute)
+	flaky.fail = false
+	require.NoError(t, publisher.Publish(context.Background(), other))
+	assert.Len(t, healthy.Events(), 3)
+	assert.Len(t, flaky.Events(), 2)
+}
@@ -0,0 +1,132 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/PRPO-skupina-02/nakup/models"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultRelayInterval    = time.Second
+	DefaultRelayBatchSize   = 100
+	DefaultRelayMaxAttempts = 10
+	DefaultRelayBackoffBase = time.Second
+	DefaultRelayBackoffMax  = 5 * time.Minute
+)
+
+// Relay moves events from the outbox table to a publisher. An event is only
+// marked as published after the publisher accepted it, so a crash between the
+// two steps results in the event being published again. Failed events are
+// retried with exponential backoff until MaxAttempts is reached, after which
+// they are moved to the dead-letter state and kept in the outbox for
+// inspection.
+type Relay struct {
+	db          *gorm.DB
+	publisher   Publisher
+	interval    time.Duration
+	batchSize   int
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	now         func() time.Time
+}
+
+func NewRelay(db *gorm.DB, publisher Publisher, interval time.Duration) *Relay {
+	return &Relay{
+		db:          db,
+		publisher:   publisher,
+		interval:    interval,
+		batchSize:   DefaultRelayBatchSize,
+		MaxAttempts: DefaultRelayMaxAttempts,
+		BackoffBase: DefaultRelayBackoffBase,
+		BackoffMax:  DefaultRelayBackoffMax,
+		now:         time.Now,
+	}
+}
+
+// Backoff returns how long to wait after the given number of failed attempts.
+func (r *Relay) Backoff(attempts int) time.Duration {
+	backoff := r.BackoffBase
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= r.BackoffMax {
+			return r.BackoffMax
+		}
+	}
+	return backoff
+}
+
+// Run drains the outbox every interval until the context is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			attempted, err := r.ProcessBatch(ctx)
+			if err != nil {
+				slog.Error("failed to relay outbox events", "err", err)
+				break
+			}
+			if attempted < r.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch publishes one batch of due events and returns how many were
+// attempted. Events are attempted in creation order, but a failed event does
+// not hold back the ones after it and relays of other replicas skip events
+// locked by this one, so consumers cannot rely on the order in which events
+// arrive and should use their occurred_at time where it matters.
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	attempted := 0
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		pending, err := models.GetPendingOutboxEvents(tx, r.now(), r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, outboxEvent := range pending {
+			attempted++
+
+			publishErr := r.publisher.Publish(ctx, newEvent(outboxEvent))
+			if publishErr == nil {
+				err = models.MarkOutboxEventPublished(tx, outboxEvent.ID, r.now())
+			} else {
+				err = r.fail(tx, outboxEvent, publishErr)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return attempted, err
+}
+
+func (r *Relay) fail(tx *gorm.DB, outboxEvent models.OutboxEvent, publishErr error) error {
+	attempts := outboxEvent.Attempts + 1
+	now := r.now()
+
+	if attempts >= r.MaxAttempts {
+		slog.Error("outbox event moved to dead letter", "event_id", outboxEvent.ID, "type", outboxEvent.Type, "attempts", attempts, "err", publishErr)
+		return models.MarkOutboxEventDead(tx, outboxEvent.ID, publishErr, now)
+	}
+
+	slog.Warn("failed to publish event", "event_id", outboxEvent.ID, "type", outboxEvent.Type, "attempts", attempts, "err", publishErr)
+	return models.MarkOutboxEventFailed(tx, outboxEvent.ID, publishErr, now.Add(r.Backoff(attempts)))
+}
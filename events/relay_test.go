@@ -0,0 +1,179 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type flakyPublisher struct {
+	*MemoryPublisher
+	failures int
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, event Event) error {
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("broker unavailable")
+	}
+	return p.MemoryPublisher.Publish(ctx, event)
+}
+
+func newTestReservation() models.Reservation {
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	return models.Reservation{
+		ID:         uuid.New(),
+		TimeSlotID: uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"),
+		TheaterID:  uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d"),
+		RoomID:     uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1"),
+		UserID:     &userID,
+		Type:       models.Online,
+		Row:        7,
+		Col:        7,
+	}
+}
+
+func TestRelay(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	require.NoError(t, fixtures.Load())
+
+	reservation := newTestReservation()
+	require.NoError(t, reservation.Create(db))
+
+	purchase := models.Purchase{
+		ID:                uuid.New(),
+		ReservationID:     reservation.ID,
+		Type:              models.Drink,
+		Name:              "Water",
+		Count:             1,
+		PricePerItemCents: 250,
+	}
+	require.NoError(t, purchase.Create(db))
+
+	reservation.Row = 8
+	require.NoError(t, reservation.Save(db))
+	require.NoError(t, models.DeleteReservation(db, reservation.ID))
+
+	publisher := NewMemoryPublisher()
+	relay := NewRelay(db, publisher, time.Second)
+
+	attempted, err := relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 5, attempted)
+
+	types := []string{}
+	for _, event := range publisher.Events() {
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []string{
+		models.EventReservationCreated,
+		models.EventPurchaseCreated,
+		models.EventReservationUpdated,
+		models.EventPurchaseDeleted,
+		models.EventReservationCancelled,
+	}, types)
+	assert.Equal(t, reservation.ID, publisher.Events()[0].AggregateID)
+	assert.JSONEq(t, `"Water"`, string(mustField(t, publisher.Events()[1].Data, "name")))
+
+	attempted, err = relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, attempted)
+	assert.Len(t, publisher.Events(), 5)
+}
+
+func TestRelayRetriesFailedEvents(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	require.NoError(t, fixtures.Load())
+
+	first := newTestReservation()
+	require.NoError(t, first.Create(db))
+	second := newTestReservation()
+	second.Row = 8
+	require.NoError(t, second.Create(db))
+
+	now := time.Now()
+	publisher := &flakyPublisher{MemoryPublisher: NewMemoryPublisher(), failures: 1}
+	relay := NewRelay(db, publisher, time.Second)
+	relay.now = func() time.Time { return now }
+
+	attempted, err := relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempted)
+
+	events := publisher.Events()
+	require.Len(t, events, 1, "events after a failure are not held back")
+	assert.Equal(t, second.ID, events[0].AggregateID)
+
+	var failed models.OutboxEvent
+	require.NoError(t, db.Where("aggregate_id = ?", first.ID).First(&failed).Error)
+	assert.Equal(t, 1, failed.Attempts)
+	require.NotNil(t, failed.LastError)
+	assert.Equal(t, "broker unavailable", *failed.LastError)
+	assert.Nil(t, failed.PublishedAt)
+	require.NotNil(t, failed.NextAttemptAt)
+	assert.WithinDuration(t, now.Add(relay.BackoffBase), *failed.NextAttemptAt, time.Millisecond)
+
+	attempted, err = relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, attempted, "failed events wait for their backoff")
+
+	now = now.Add(relay.BackoffBase)
+	attempted, err = relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempted)
+
+	events = publisher.Events()
+	require.Len(t, events, 2)
+	assert.Equal(t, first.ID, events[1].AggregateID)
+}
+
+func TestRelayDeadLettersEvents(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	require.NoError(t, fixtures.Load())
+
+	reservation := newTestReservation()
+	require.NoError(t, reservation.Create(db))
+
+	now := time.Now()
+	publisher := &flakyPublisher{MemoryPublisher: NewMemoryPublisher(), failures: 3}
+	relay := NewRelay(db, publisher, time.Second)
+	relay.MaxAttempts = 2
+	relay.now = func() time.Time { return now }
+
+	for range relay.MaxAttempts {
+		attempted, err := relay.ProcessBatch(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempted)
+		now = now.Add(relay.BackoffMax)
+	}
+
+	var dead models.OutboxEvent
+	require.NoError(t, db.Where("aggregate_id = ?", reservation.ID).First(&dead).Error)
+	assert.Equal(t, 2, dead.Attempts)
+	assert.NotNil(t, dead.DeadAt)
+	assert.Nil(t, dead.NextAttemptAt)
+	assert.Nil(t, dead.PublishedAt)
+
+	attempted, err := relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, attempted, "dead events are not published anymore")
+	assert.Empty(t, publisher.Events())
+}
+
+func TestRelayBackoff(t *testing.T) {
+	relay := NewRelay(nil, NewMemoryPublisher(), time.Second)
+
+	assert.Equal(t, time.Second, relay.Backoff(1))
+	assert.Equal(t, 2*time.Second, relay.Backoff(2))
+	assert.Equal(t, 4*time.Second, relay.Backoff(3))
+	assert.Equal(t, relay.BackoffMax, relay.Backoff(20))
+}
@@ -0,0 +1,56 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+type CSVWriter struct {
+	w       *csv.Writer
+	header  []string
+	started bool
+}
+
+func NewCSVWriter(w io.Writer, header []string) *CSVWriter {
+	return &CSVWriter{
+		w:      csv.NewWriter(w),
+		header: header,
+	}
+}
+
+func (cw *CSVWriter) start() error {
+	if cw.started {
+		return nil
+	}
+	cw.started = true
+
+	if len(cw.header) == 0 {
+		return nil
+	}
+	return cw.w.Write(cw.header)
+}
+
+func (cw *CSVWriter) Write(record []any) error {
+	if err := cw.start(); err != nil {
+		return err
+	}
+
+	fields := make([]string, len(record))
+	for i, value := range record {
+		fields[i] = formatValue(value)
+	}
+
+	return cw.w.Write(fields)
+}
+
+func (cw *CSVWriter) Flush() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func (cw *CSVWriter) Close() error {
+	if err := cw.start(); err != nil {
+		return err
+	}
+	return cw.Flush()
+}
@@ -0,0 +1,105 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		format Format
+		err    bool
+	}{
+		{name: "default", value: "", format: CSV},
+		{name: "csv", value: "csv", format: CSV},
+		{name: "xlsx", value: "xlsx", format: XLSX},
+		{name: "unknown", value: "pdf", err: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			format, err := ParseFormat(testCase.value)
+			if testCase.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.format, format)
+		})
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewCSVWriter(&buf, []string{"id", "created_at", "name", "count"})
+
+	assert.Empty(t, buf.String())
+
+	id := uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	createdAt := time.Date(2025, 12, 1, 8, 0, 0, 0, time.UTC)
+
+	require.NoError(t, w.Write([]any{id, createdAt, "Popcorn, large", 2}))
+	require.NoError(t, w.Close())
+
+	expected := "id,created_at,name,count\n" +
+		"aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa,2025-12-01T08:00:00Z,\"Popcorn, large\",2\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestCSVWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewCSVWriter(&buf, []string{"id", "name"})
+
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "id,name\n", buf.String())
+}
+
+func TestXLSXWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewXLSXWriter(&buf, "Purchases & more", []string{"name", "count"})
+
+	assert.Zero(t, buf.Len())
+
+	require.NoError(t, w.Write([]any{"Fish <&> Chips", 3}))
+	require.NoError(t, w.Close())
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := map[string]string{}
+	for _, f := range archive.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		files[f.Name] = string(content)
+	}
+
+	assert.Contains(t, files, "[Content_Types].xml")
+	assert.Contains(t, files, "_rels/.rels")
+	assert.Contains(t, files, "xl/_rels/workbook.xml.rels")
+	assert.Contains(t, files["xl/workbook.xml"], `<sheet name="Purchases &amp; more"`)
+
+	sheet := files["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<row r="1"><c r="A1" t="inlineStr"><is><t>name</t></is></c><c r="B1" t="inlineStr"><is><t>count</t></is></c></row>`)
+	assert.Contains(t, sheet, `<row r="2"><c r="A2" t="inlineStr"><is><t>Fish &lt;&amp;&gt; Chips</t></is></c><c r="B2"><v>3</v></c></row>`)
+	assert.Contains(t, sheet, `</sheetData></worksheet>`)
+}
+
+func TestColumnName(t *testing.T) {
+	assert.Equal(t, "A", columnName(0))
+	assert.Equal(t, "Z", columnName(25))
+	assert.Equal(t, "AA", columnName(26))
+	assert.Equal(t, "AZ", columnName(51))
+	assert.Equal(t, "BA", columnName(52))
+}
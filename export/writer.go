@@ -0,0 +1,81 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Format string
+
+const (
+	CSV  Format = "csv"
+	XLSX Format = "xlsx"
+)
+
+// Writer streams tabular records to an underlying io.Writer. Nothing is
+// written until the first record (or Close), so callers can still report
+// errors that happen before any data is produced.
+type Writer interface {
+	Write(record []any) error
+	Flush() error
+	Close() error
+}
+
+func ParseFormat(value string) (Format, error) {
+	switch Format(value) {
+	case "", CSV:
+		return CSV, nil
+	case XLSX:
+		return XLSX, nil
+	default:
+		return "", fmt.Errorf("unsupported export format %q", value)
+	}
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case XLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+func NewWriter(format Format, w io.Writer, sheet string, header []string) Writer {
+	switch format {
+	case XLSX:
+		return NewXLSXWriter(w, sheet, header)
+	default:
+		return NewCSVWriter(w, header)
+	}
+}
+
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case uuid.UUID:
+		return v.String()
+	case *uuid.UUID:
+		if v == nil {
+			return ""
+		}
+		return v.String()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
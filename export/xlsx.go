@@ -0,0 +1,174 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`
+
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+
+	xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+	xlsxSheetEnd = `</sheetData></worksheet>`
+)
+
+// XLSXWriter writes a single-sheet workbook. Rows are streamed straight into
+// the zipped worksheet part, so memory use does not grow with the row count.
+type XLSXWriter struct {
+	zip     *zip.Writer
+	sheet   *bufio.Writer
+	name    string
+	header  []string
+	row     int
+	started bool
+}
+
+func NewXLSXWriter(w io.Writer, sheet string, header []string) *XLSXWriter {
+	return &XLSXWriter{
+		zip:    zip.NewWriter(w),
+		name:   sheet,
+		header: header,
+	}
+}
+
+func (xw *XLSXWriter) start() error {
+	if xw.started {
+		return nil
+	}
+	xw.started = true
+
+	var name strings.Builder
+	if err := xml.EscapeText(&name, []byte(xw.name)); err != nil {
+		return err
+	}
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbook, name.String())},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	}
+
+	for _, part := range parts {
+		f, err := xw.zip.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return err
+		}
+	}
+
+	f, err := xw.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	xw.sheet = bufio.NewWriter(f)
+
+	if _, err := xw.sheet.WriteString(xlsxSheetStart); err != nil {
+		return err
+	}
+
+	if len(xw.header) == 0 {
+		return nil
+	}
+
+	record := make([]any, len(xw.header))
+	for i, column := range xw.header {
+		record[i] = column
+	}
+	return xw.writeRow(record)
+}
+
+func (xw *XLSXWriter) Write(record []any) error {
+	if err := xw.start(); err != nil {
+		return err
+	}
+	return xw.writeRow(record)
+}
+
+func (xw *XLSXWriter) writeRow(record []any) error {
+	xw.row++
+
+	if _, err := fmt.Fprintf(xw.sheet, `<row r="%d">`, xw.row); err != nil {
+		return err
+	}
+
+	for i, value := range record {
+		ref := columnName(i) + strconv.Itoa(xw.row)
+
+		var err error
+		switch v := value.(type) {
+		case int:
+			_, err = fmt.Fprintf(xw.sheet, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case int64:
+			_, err = fmt.Fprintf(xw.sheet, `<c r="%s"><v>%d</v></c>`, ref, v)
+		default:
+			if _, err = fmt.Fprintf(xw.sheet, `<c r="%s" t="inlineStr"><is><t>`, ref); err != nil {
+				return err
+			}
+			if err = xml.EscapeText(xw.sheet, []byte(formatValue(v))); err != nil {
+				return err
+			}
+			_, err = xw.sheet.WriteString(`</t></is></c>`)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := xw.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (xw *XLSXWriter) Flush() error {
+	if !xw.started {
+		return nil
+	}
+	if err := xw.sheet.Flush(); err != nil {
+		return err
+	}
+	return xw.zip.Flush()
+}
+
+func (xw *XLSXWriter) Close() error {
+	if err := xw.start(); err != nil {
+		return err
+	}
+	if _, err := xw.sheet.WriteString(xlsxSheetEnd); err != nil {
+		return err
+	}
+	if err := xw.sheet.Flush(); err != nil {
+		return err
+	}
+	return xw.zip.Close()
+}
+
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
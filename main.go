@@ -1,20 +1,33 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
 	"log/slog"
+	"net/http"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/PRPO-skupina-02/common/config"
 	"github.com/PRPO-skupina-02/common/database"
 	"github.com/PRPO-skupina-02/common/logging"
+	"github.com/PRPO-skupina-02/common/middleware"
 	"github.com/PRPO-skupina-02/common/validation"
 	"github.com/PRPO-skupina-02/nakup/api"
 	"github.com/PRPO-skupina-02/nakup/clients/spored/client"
 	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/events"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/notify"
+	"github.com/PRPO-skupina-02/nakup/seats"
 	"github.com/PRPO-skupina-02/nakup/services"
+	"github.com/PRPO-skupina-02/nakup/webhooks"
 	"github.com/gin-gonic/gin"
 	"github.com/go-openapi/strfmt"
+	"gorm.io/gorm"
 )
 
 func main() {
@@ -46,17 +59,92 @@ func run() error {
 	transportConfig := client.DefaultTransportConfig().WithHost(sporedHost)
 	sporedClient := client.NewHTTPClientWithConfig(strfmt.Default, transportConfig)
 
-	timeSlotService := services.NewSporedTimeSlotService(sporedClient)
+	sporedTimeSlotService := services.NewSporedTimeSlotService(sporedClient)
 
-	authHost := config.GetEnv("AUTH_HOST")
+	sporedTimeSlotService.Timeout, err = time.ParseDuration(config.GetEnvDefault("SPORED_TIMEOUT", services.DefaultSporedTimeout.String()))
+	if err != nil {
+		return err
+	}
+
+	sporedTimeSlotService.MaxRetries, err = strconv.Atoi(config.GetEnvDefault("SPORED_MAX_RETRIES", strconv.Itoa(services.DefaultSporedMaxRetries)))
+	if err != nil {
+		return err
+	}
+
+	sporedCacheTTL, err := time.ParseDuration(config.GetEnvDefault("SPORED_CACHE_TTL", services.DefaultTimeSlotCacheTTL.String()))
+	if err != nil {
+		return err
+	}
+
+	sporedCacheNegativeTTL, err := time.ParseDuration(config.GetEnvDefault("SPORED_CACHE_NEGATIVE_TTL", services.DefaultTimeSlotCacheNegativeTTL.String()))
+	if err != nil {
+		return err
+	}
+
+	timeSlotService := services.NewCachedTimeSlotService(sporedTimeSlotService, sporedCacheTTL, sporedCacheNegativeTTL)
+
+	userMiddleware, err := newUserMiddleware()
+	if err != nil {
+		return err
+	}
+
+	ticketPriceCents, err := strconv.Atoi(config.GetEnv("TICKET_PRICE_CENTS"))
+	if err != nil {
+		return err
+	}
+
+	permissions := api.DefaultPermissions()
+	if path := config.GetEnvDefault("PERMISSIONS_FILE", ""); path != "" {
+		permissions, err = api.LoadPermissions(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	relayInterval, err := time.ParseDuration(config.GetEnvDefault("OUTBOX_RELAY_INTERVAL", events.DefaultRelayInterval.String()))
+	if err != nil {
+		return err
+	}
+
+	publisher := events.NewMultiPublisher(
+		events.NewLogPublisher(logger),
+		webhooks.NewPublisher(db),
+	)
+
+	relay := events.NewRelay(db, publisher, relayInterval)
+	go relay.Run(context.Background())
+
+	dispatcher := webhooks.NewDispatcher(db, &http.Client{Timeout: webhooks.DefaultRequestTimeout}, webhooks.DefaultDispatchInterval)
+	go dispatcher.Run(context.Background())
+
+	go purgeExpired(context.Background(), db, time.Hour)
+	go backfillReservations(context.Background(), db, sporedTimeSlotService, time.Minute)
+
+	changeBus, err := newChangeBus(db)
+	if err != nil {
+		return err
+	}
+	go changeBus.Run(context.Background())
+	go timeSlotService.Follow(context.Background(), changeBus)
+
+	seatPollInterval, err := time.ParseDuration(config.GetEnvDefault("SEAT_STREAM_POLL_INTERVAL", seats.DefaultPollInterval.String()))
+	if err != nil {
+		return err
+	}
+
+	seatWatcher := seats.NewWatcher(db, seatPollInterval)
+	go seatWatcher.Run(context.Background())
+	go seatWatcher.Follow(context.Background(), changeBus)
 
-	router := gin.Default()
+	router := gin.New()
+	router.Use(api.LoggerMiddleware(), gin.Recovery())
 
 	// Add CORS middleware
 	router.Use(func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, X-Request-ID, X-API-Key, Idempotency-Key, If-Match, If-None-Match")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "ETag")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE, PATCH")
 
 		if c.Request.Method == "OPTIONS" {
@@ -67,7 +155,7 @@ func run() error {
 		c.Next()
 	})
 
-	api.Register(router, db, trans, timeSlotService, authHost)
+	api.Register(router, db, trans, timeSlotService, userMiddleware, ticketPriceCents, permissions, seatWatcher)
 
 	slog.Info("Server startup complete")
 	err = router.Run(":8080")
@@ -77,3 +165,158 @@ func run() error {
 
 	return nil
 }
+
+func newChangeBus(db *gorm.DB) (*notify.Bus, error) {
+	mode := notify.Mode(config.GetEnvDefault("CHANGE_NOTIFY_MODE", string(notify.ModeListen)))
+	switch mode {
+	case notify.ModeListen, notify.ModePoll:
+	default:
+		return nil, fmt.Errorf("unknown CHANGE_NOTIFY_MODE %q", mode)
+	}
+
+	pollInterval, err := time.ParseDuration(config.GetEnvDefault("CHANGE_NOTIFY_POLL_INTERVAL", notify.DefaultPollInterval.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	return notify.NewBus(db, mode, pollInterval), nil
+}
+
+// purgeExpired deletes expired idempotency keys, seat holds and stream tickets
+// every interval until the context is cancelled.
+func purgeExpired(ctx context.Context, db *gorm.DB, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := models.DeleteExpiredIdempotencyKeys(db.WithContext(ctx), time.Now()); err != nil {
+			slog.Error("failed to delete expired idempotency keys", "err", err)
+		}
+
+		if err := models.DeleteExpiredSeatHolds(db.WithContext(ctx), time.Now()); err != nil {
+			slog.Error("failed to delete expired seat holds", "err", err)
+		}
+
+		if err := models.DeleteExpiredStreamTickets(db.WithContext(ctx), time.Now()); err != nil {
+			slog.Error("failed to delete expired stream tickets", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// backfillReservations fills in the theater, room and screening start of
+// reservations made before they were recorded, by looking their time slots up
+// in spored. It retries every interval until spored could be asked about all
+// of them.
+func backfillReservations(ctx context.Context, db *gorm.DB, service *services.SporedTimeSlotService, interval time.Duration) {
+	for {
+		err := locateReservations(ctx, db, service)
+		if err == nil {
+			err = scheduleReservations(ctx, db, service)
+		}
+		if err == nil {
+			return
+		}
+		slog.Error("failed to backfill reservations", "err", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func locateReservations(ctx context.Context, db *gorm.DB, locator services.TimeSlotLocator) error {
+	timeSlotIDs, err := models.GetUnlocatedTimeSlotIDs(db.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	if len(timeSlotIDs) == 0 {
+		return nil
+	}
+
+	located, err := locator.LocateTimeSlots(ctx, timeSlotIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range located {
+		if err := models.LocateTimeSlotReservations(db.WithContext(ctx), ref.TimeSlotID, ref.TheaterID, ref.RoomID); err != nil {
+			return err
+		}
+	}
+
+	slog.Info("backfilled reservation theaters and rooms", "time_slots", len(located), "unknown_time_slots", len(timeSlotIDs)-len(located))
+	return nil
+}
+
+func scheduleReservations(ctx context.Context, db *gorm.DB, timeSlotService services.TimeSlotService) error {
+	timeSlots, err := models.GetUnscheduledTimeSlots(db.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	if len(timeSlots) == 0 {
+		return nil
+	}
+
+	scheduled := 0
+	for _, timeSlot := range timeSlots {
+		details, err := timeSlotService.GetTimeSlot(ctx, timeSlot.TheaterID, timeSlot.RoomID, timeSlot.TimeSlotID)
+		var httpError *middleware.HttpError
+		if errors.As(err, &httpError) && httpError.Code == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := models.ScheduleTimeSlotReservations(db.WithContext(ctx), timeSlot.TimeSlotID, details.StartTime); err != nil {
+			return err
+		}
+		scheduled++
+	}
+
+	slog.Info("backfilled reservation screening starts", "time_slots", scheduled, "unknown_time_slots", len(timeSlots)-scheduled)
+	return nil
+}
+
+func newUserMiddleware() (gin.HandlerFunc, error) {
+	mode := config.GetEnvDefault("AUTH_MODE", api.AuthModeRemote)
+	switch mode {
+	case api.AuthModeRemote:
+		return middleware.UserMiddleware(config.GetEnv("AUTH_HOST")), nil
+	case api.AuthModeLocal, api.AuthModeHybrid:
+	default:
+		return nil, fmt.Errorf("unknown AUTH_MODE %q", mode)
+	}
+
+	var keys services.KeySet
+	if path := config.GetEnvDefault("AUTH_PUBLIC_KEY_FILE", ""); path != "" {
+		staticKeys, err := services.LoadPublicKeyFile(path)
+		if err != nil {
+			return nil, err
+		}
+		keys = staticKeys
+	} else {
+		refreshInterval, err := time.ParseDuration(config.GetEnvDefault("AUTH_JWKS_REFRESH_INTERVAL", services.DefaultJWKSRefreshInterval.String()))
+		if err != nil {
+			return nil, err
+		}
+		keys = services.NewJWKSKeySet(config.GetEnv("AUTH_JWKS_URL"), refreshInterval)
+	}
+
+	verifier := services.NewJWTVerifier(keys)
+	verifier.Issuer = config.GetEnvDefault("AUTH_JWT_ISSUER", "")
+	verifier.Audience = config.GetEnvDefault("AUTH_JWT_AUDIENCE", "")
+
+	if mode == api.AuthModeHybrid {
+		return api.JWTUserMiddleware(verifier, middleware.UserMiddleware(config.GetEnv("AUTH_HOST"))), nil
+	}
+	return api.JWTUserMiddleware(verifier, nil), nil
+}
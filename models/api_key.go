@@ -0,0 +1,158 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	ScopeReservationsCreate = "reservations:create"
+	ScopeSeatMapRead        = "seatmap:read"
+	ScopeSporedEvents       = "spored:events"
+)
+
+// APIKeyPrefixLength is how many leading characters of a key are stored in
+// plain text so admins can tell keys apart.
+const APIKeyPrefixLength = 8
+
+// APIKeyScopes is a list of scopes stored as a JSON array.
+type APIKeyScopes []string
+
+func (s APIKeyScopes) Value() (driver.Value, error) {
+	return EventTypes(s).Value()
+}
+
+func (s *APIKeyScopes) Scan(value any) error {
+	return (*EventTypes)(s).Scan(value)
+}
+
+// APIKey authenticates a machine client such as a kiosk or a reseller. Only a
+// hash of the key is stored; the key itself is shown once when it is created.
+type APIKey struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     APIKeyScopes `gorm:"type:jsonb"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+}
+
+// GenerateAPIKey returns a new random key.
+func GenerateAPIKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(key), nil
+}
+
+// HashAPIKey returns the hash a key is stored and looked up by. Keys are
+// random, so a fast hash is enough.
+func HashAPIKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// SetKey stores the hash and prefix of key.
+func (k *APIKey) SetKey(key string) {
+	k.KeyHash = HashAPIKey(key)
+	k.Prefix = key[:min(len(key), APIKeyPrefixLength)]
+}
+
+func (k APIKey) HasScope(scope string) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+func (k APIKey) Expired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+func (k *APIKey) Create(tx *gorm.DB) error {
+	if err := tx.Create(k).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// Touch records that the key was used at now. To avoid a write on every
+// request, it is only updated once per minute.
+func (k *APIKey) Touch(tx *gorm.DB, now time.Time) error {
+	if k.LastUsedAt != nil && now.Sub(*k.LastUsedAt) < time.Minute {
+		return nil
+	}
+
+	k.LastUsedAt = &now
+	if err := tx.Model(k).UpdateColumn("last_used_at", now).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetAPIKeys(tx *gorm.DB, pagination *request.PaginationOptions, sort *request.SortOptions) ([]APIKey, int, error) {
+	var keys []APIKey
+
+	query := tx.Model(&APIKey{}).Session(&gorm.Session{})
+
+	if err := query.Scopes(request.PaginateScope(pagination), request.SortScope(sort)).Find(&keys).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return keys, int(total), nil
+}
+
+func GetAPIKey(tx *gorm.DB, id uuid.UUID) (APIKey, error) {
+	key := APIKey{
+		ID: id,
+	}
+
+	if err := tx.Where(&key).First(&key).Error; err != nil {
+		return key, err
+	}
+
+	return key, nil
+}
+
+func GetAPIKeyByKey(tx *gorm.DB, key string) (APIKey, error) {
+	apiKey := APIKey{
+		KeyHash: HashAPIKey(key),
+	}
+
+	if err := tx.Where(&apiKey).First(&apiKey).Error; err != nil {
+		return apiKey, err
+	}
+
+	return apiKey, nil
+}
+
+func DeleteAPIKey(tx *gorm.DB, id uuid.UUID) error {
+	key := APIKey{
+		ID: id,
+	}
+
+	if err := tx.Where(&key).First(&key).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Delete(&key).Error; err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package models
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionRestore = "restore"
+
+	AuditEntityReservation = "reservation"
+	AuditEntityPurchase    = "purchase"
+)
+
+// auditIgnoredFields are left out of the recorded changes, as they change on
+// every write or never change at all.
+var auditIgnoredFields = []string{"id", "created_at", "updated_at"}
+
+// AuditActor identifies who made a change. Changes made without a user or an
+// API key, for example by background jobs, have neither set.
+type AuditActor struct {
+	UserID    *uuid.UUID
+	APIKeyID  *uuid.UUID
+	RequestID string
+}
+
+type auditActorKey struct{}
+
+// WithAuditActor returns a context whose changes are attributed to actor when
+// it is used as the context of a transaction.
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+func auditActorFromTx(tx *gorm.DB) AuditActor {
+	if tx.Statement == nil || tx.Statement.Context == nil {
+		return AuditActor{}
+	}
+
+	actor, _ := tx.Statement.Context.Value(auditActorKey{}).(AuditActor)
+	return actor
+}
+
+// AuditChange holds the value of a field before and after a change. Before is
+// null for created and restored entities and after is null for deleted ones.
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditChanges maps field names to their changes and is stored as a JSON
+// object.
+type AuditChanges map[string]AuditChange
+
+func (a AuditChanges) Value() (driver.Value, error) {
+	if a == nil {
+		return "{}", nil
+	}
+	value, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return string(value), nil
+}
+
+func (a *AuditChanges) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return errors.New("unsupported audit changes value")
+	}
+}
+
+// AuditEntry records a single change of a reservation or one of its
+// purchases. Entries are only ever appended.
+type AuditEntry struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+
+	ActorUserID   *uuid.UUID
+	ActorAPIKeyID *uuid.UUID `gorm:"column:actor_api_key_id"`
+	RequestID     *string
+
+	Action        string
+	EntityType    string
+	EntityID      uuid.UUID
+	ReservationID uuid.UUID
+	Changes       AuditChanges `gorm:"type:jsonb"`
+}
+
+// recordAudit appends an audit entry for a change of an entity from before to
+// after, attributed to the actor of tx. Either may be nil for created and
+// deleted entities.
+func recordAudit(tx *gorm.DB, action, entityType string, entityID, reservationID uuid.UUID, before, after any) error {
+	changes, err := diffAudit(before, after)
+	if err != nil {
+		return err
+	}
+
+	actor := auditActorFromTx(tx)
+
+	entry := AuditEntry{
+		ID:            uuid.New(),
+		ActorUserID:   actor.UserID,
+		ActorAPIKeyID: actor.APIKeyID,
+		Action:        action,
+		EntityType:    entityType,
+		EntityID:      entityID,
+		ReservationID: reservationID,
+		Changes:       changes,
+	}
+	if actor.RequestID != "" {
+		entry.RequestID = &actor.RequestID
+	}
+
+	if err := tx.Create(&entry).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// diffAudit compares the JSON representation of before and after field by
+// field and returns the fields that differ.
+func diffAudit(before, after any) (AuditChanges, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := AuditChanges{}
+	for _, fields := range []map[string]any{beforeFields, afterFields} {
+		for field := range fields {
+			if _, ok := changes[field]; ok {
+				continue
+			}
+
+			change := AuditChange{
+				Before: beforeFields[field],
+				After:  afterFields[field],
+			}
+			if !reflect.DeepEqual(change.Before, change.After) {
+				changes[field] = change
+			}
+		}
+	}
+
+	for _, field := range auditIgnoredFields {
+		delete(changes, field)
+	}
+
+	return changes, nil
+}
+
+func auditFields(value any) (map[string]any, error) {
+	fields := map[string]any{}
+	if value == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// GetReservationAuditEntries returns the history of a reservation and its
+// purchases, oldest first unless sort says otherwise.
+func GetReservationAuditEntries(tx *gorm.DB, reservationID uuid.UUID, pagination *request.PaginationOptions, sort *request.SortOptions) ([]AuditEntry, int, error) {
+	var entries []AuditEntry
+
+	query := tx.Model(&AuditEntry{}).Where("reservation_id = ?", reservationID).Session(&gorm.Session{})
+
+	if err := query.Scopes(request.PaginateScope(pagination), request.SortScope(sort)).Order("created_at, id").Find(&entries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return entries, int(total), nil
+}
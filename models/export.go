@@ -0,0 +1,94 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/PRPO-skupina-02/common/request"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReservationExportSorts maps the columns reservation exports can be sorted by
+// to the columns of the export query.
+var ReservationExportSorts = map[string]clause.Column{
+	"id":                    {Table: "reservations", Name: "id"},
+	"created_at":            {Table: "reservations", Name: "created_at"},
+	"updated_at":            {Table: "reservations", Name: "updated_at"},
+	"time_slot_id":          {Table: "reservations", Name: "time_slot_id"},
+	"theater_id":            {Table: "reservations", Name: "theater_id"},
+	"room_id":               {Table: "reservations", Name: "room_id"},
+	"user_id":               {Table: "reservations", Name: "user_id"},
+	"type":                  {Table: "reservations", Name: "type"},
+	"row":                   {Table: "reservations", Name: "row"},
+	"col":                   {Table: "reservations", Name: "col"},
+	"purchase_count":        {Name: "purchase_count"},
+	"purchases_total_cents": {Name: "purchases_total_cents"},
+}
+
+// PurchaseExportSorts maps the columns purchase exports can be sorted by to
+// the columns of the export query.
+var PurchaseExportSorts = map[string]clause.Column{
+	"id":                   {Table: "purchases", Name: "id"},
+	"created_at":           {Table: "purchases", Name: "created_at"},
+	"updated_at":           {Table: "purchases", Name: "updated_at"},
+	"reservation_id":       {Table: "purchases", Name: "reservation_id"},
+	"time_slot_id":         {Table: "reservations", Name: "time_slot_id"},
+	"user_id":              {Table: "reservations", Name: "user_id"},
+	"reservation_type":     {Table: "reservations", Name: "type"},
+	"row":                  {Table: "reservations", Name: "row"},
+	"col":                  {Table: "reservations", Name: "col"},
+	"type":                 {Table: "purchases", Name: "type"},
+	"name":                 {Table: "purchases", Name: "name"},
+	"count":                {Table: "purchases", Name: "count"},
+	"price_per_item_cents": {Table: "purchases", Name: "price_per_item_cents"},
+	"line_total_cents":     {Name: "line_total_cents"},
+}
+
+// exportSortScope behaves like request.SortScope, but maps the sort to a
+// column of the export query, since export queries join several tables and
+// compute some of their columns. Rows are ordered by creation when no sort is
+// requested so exports are stable.
+func exportSortScope(table string, columns map[string]clause.Column, sort *request.SortOptions) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if sort == nil {
+			return db.Order(clause.OrderBy{Columns: []clause.OrderByColumn{
+				{Column: clause.Column{Table: table, Name: "created_at"}},
+				{Column: clause.Column{Table: table, Name: "id"}},
+			}})
+		}
+
+		column, ok := columns[sort.Column]
+		if !ok {
+			_ = db.AddError(fmt.Errorf("exports cannot be sorted by %q", sort.Column))
+			return db
+		}
+
+		return db.Order(clause.OrderByColumn{
+			Column: column,
+			Desc:   sort.Desc,
+		})
+	}
+}
+
+// eachRow streams the query results one row at a time instead of loading the
+// whole result set into memory.
+func eachRow[T any](tx *gorm.DB, query *gorm.DB, fn func(T) error) error {
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row T
+		if err := tx.ScanRows(rows, &row); err != nil {
+			return err
+		}
+
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IdempotencyKeyTTL is how long a key and the response stored for it are kept.
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// IdempotencyKey stores the response to a request made with an Idempotency-Key
+// header, so that retries of the request are answered without repeating it.
+// Keys are scoped to the user or API key that sent them.
+type IdempotencyKey struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	ExpiresAt time.Time
+
+	Owner       string
+	Key         string
+	RequestHash string
+
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+func (k *IdempotencyKey) Save(tx *gorm.DB) error {
+	if err := tx.Save(k).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ClaimIdempotencyKey stores key for a request that is about to be handled,
+// replacing an expired key with the same owner and name. If the key is still
+// in use, the stored key is returned instead and claimed is false. Claims of a
+// key that another transaction has just claimed wait for it to finish.
+func ClaimIdempotencyKey(tx *gorm.DB, key *IdempotencyKey, now time.Time) (existing IdempotencyKey, claimed bool, err error) {
+	query := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "owner"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"id", "created_at", "expires_at", "request_hash", "status_code", "content_type", "body"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "idempotency_keys.expires_at <= ?", Vars: []any{now}},
+		}},
+	}).Create(key)
+
+	if err := query.Error; err != nil {
+		return IdempotencyKey{}, false, err
+	}
+	if query.RowsAffected > 0 {
+		return IdempotencyKey{}, true, nil
+	}
+
+	if err := tx.Where("owner = ? AND key = ?", key.Owner, key.Key).First(&existing).Error; err != nil {
+		return IdempotencyKey{}, false, err
+	}
+
+	return existing, false, nil
+}
+
+func DeleteIdempotencyKey(tx *gorm.DB, id uuid.UUID) error {
+	if err := tx.Delete(&IdempotencyKey{ID: id}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func DeleteExpiredIdempotencyKeys(tx *gorm.DB, now time.Time) error {
+	if err := tx.Where("expires_at <= ?", now).Delete(&IdempotencyKey{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
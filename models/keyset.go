@@ -0,0 +1,76 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// KeysetColumns lists the columns keyset pagination can order by. Each is
+// paired with id to make the order total and is backed by an index.
+var KeysetColumns = []string{"created_at", "updated_at"}
+
+// KeysetPosition identifies a row by its sort column value and id.
+type KeysetPosition struct {
+	Value time.Time
+	ID    uuid.UUID
+}
+
+// KeysetOptions selects up to Limit rows ordered by Column and id. With After
+// set only rows following that position are selected, or the rows preceding it
+// when Backward is set.
+type KeysetOptions struct {
+	Column   string
+	Desc     bool
+	Limit    int
+	After    *KeysetPosition
+	Backward bool
+}
+
+// KeysetScope orders and limits a query for keyset pagination. It selects one
+// row more than the limit, so callers can tell whether there are more rows.
+// Backward pages are selected in reverse order and must be reversed with
+// keysetPage.
+func KeysetScope(keyset *KeysetOptions) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if !slices.Contains(KeysetColumns, keyset.Column) {
+			_ = db.AddError(fmt.Errorf("keyset pagination by %q is not supported", keyset.Column))
+			return db
+		}
+
+		desc := keyset.Desc != keyset.Backward
+
+		if keyset.After != nil {
+			operator := ">"
+			if desc {
+				operator = "<"
+			}
+			db = db.Where(fmt.Sprintf("(%s, id) %s (?, ?)", keyset.Column, operator), keyset.After.Value, keyset.After.ID)
+		}
+
+		return db.
+			Order(clause.OrderByColumn{Column: clause.Column{Name: keyset.Column}, Desc: desc}).
+			Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: desc}).
+			Limit(keyset.Limit + 1)
+	}
+}
+
+// keysetPage trims the extra row selected by KeysetScope and restores the
+// order of backward pages. It reports whether there are more rows in the
+// direction of the page.
+func keysetPage[T any](rows []T, keyset *KeysetOptions) ([]T, bool) {
+	more := len(rows) > keyset.Limit
+	if more {
+		rows = rows[:keyset.Limit]
+	}
+
+	if keyset.Backward {
+		slices.Reverse(rows)
+	}
+
+	return rows, more
+}
@@ -0,0 +1,311 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	EventReservationCreated   = "reservation.created"
+	EventReservationUpdated   = "reservation.updated"
+	EventReservationCancelled = "reservation.cancelled"
+	EventReservationRestored  = "reservation.restored"
+	EventPurchaseCreated      = "purchase.created"
+	EventPurchaseUpdated      = "purchase.updated"
+	EventPurchaseDeleted      = "purchase.deleted"
+	EventPurchaseRestored     = "purchase.restored"
+)
+
+// ChangesChannel is the Postgres notification channel committed reservation,
+// purchase, seat hold and spored cache changes are announced on.
+const ChangesChannel = "nakup_changes"
+
+// OutboxEvent is a domain event stored in the same transaction as the change
+// that caused it. The events relay publishes it once the transaction commits.
+type OutboxEvent struct {
+	ID            uuid.UUID
+	CreatedAt     time.Time
+	Type          string
+	AggregateID   uuid.UUID
+	Payload       json.RawMessage `gorm:"type:jsonb"`
+	Attempts      int
+	LastError     *string
+	NextAttemptAt *time.Time
+	PublishedAt   *time.Time
+	DeadAt        *time.Time
+}
+
+type ReservationEventData struct {
+	ID         uuid.UUID       `json:"id"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+	TimeSlotID uuid.UUID       `json:"time_slot_id"`
+	TheaterID  uuid.UUID       `json:"theater_id"`
+	RoomID     uuid.UUID       `json:"room_id"`
+	UserID     *uuid.UUID      `json:"user_id"`
+	APIKeyID   *uuid.UUID      `json:"api_key_id,omitempty"`
+	Type       ReservationType `json:"type"`
+	Row        int             `json:"row"`
+	Col        int             `json:"col"`
+}
+
+func newReservationEventData(reservation Reservation) ReservationEventData {
+	return ReservationEventData{
+		ID:         reservation.ID,
+		CreatedAt:  reservation.CreatedAt,
+		UpdatedAt:  reservation.UpdatedAt,
+		TimeSlotID: reservation.TimeSlotID,
+		TheaterID:  reservation.TheaterID,
+		RoomID:     reservation.RoomID,
+		UserID:     reservation.UserID,
+		APIKeyID:   reservation.APIKeyID,
+		Type:       reservation.Type,
+		Row:        reservation.Row,
+		Col:        reservation.Col,
+	}
+}
+
+type PurchaseEventData struct {
+	ID                uuid.UUID    `json:"id"`
+	CreatedAt         time.Time    `json:"created_at"`
+	UpdatedAt         time.Time    `json:"updated_at"`
+	ReservationID     uuid.UUID    `json:"reservation_id"`
+	Type              PurchaseType `json:"type"`
+	Name              string       `json:"name"`
+	Count             int          `json:"count"`
+	PricePerItemCents int          `json:"price_per_item_cents"`
+}
+
+func newPurchaseEventData(purchase Purchase) PurchaseEventData {
+	return PurchaseEventData{
+		ID:                purchase.ID,
+		CreatedAt:         purchase.CreatedAt,
+		UpdatedAt:         purchase.UpdatedAt,
+		ReservationID:     purchase.ReservationID,
+		Type:              purchase.Type,
+		Name:              purchase.Name,
+		Count:             purchase.Count,
+		PricePerItemCents: purchase.PricePerItemCents,
+	}
+}
+
+// ChangeNotification announces a committed reservation, purchase or seat hold
+// change, or stale spored lookups. It only identifies what changed, as
+// Postgres limits notification payloads to 8000 bytes, so listeners load the
+// current state themselves. Seat hold and spored cache changes carry no
+// reservation ID.
+type ChangeNotification struct {
+	EventID       uuid.UUID  `json:"event_id"`
+	Type          string     `json:"type"`
+	ReservationID uuid.UUID  `json:"reservation_id"`
+	PurchaseID    *uuid.UUID `json:"purchase_id,omitempty"`
+	TimeSlotID    *uuid.UUID `json:"time_slot_id,omitempty"`
+	TheaterID     *uuid.UUID `json:"theater_id,omitempty"`
+	RoomID        *uuid.UUID `json:"room_id,omitempty"`
+}
+
+// NewChangeNotification returns the notification announcing an outbox event.
+func NewChangeNotification(event OutboxEvent) (ChangeNotification, error) {
+	notification := ChangeNotification{
+		EventID: event.ID,
+		Type:    event.Type,
+	}
+
+	if strings.HasPrefix(event.Type, "purchase.") {
+		var data PurchaseEventData
+		if err := json.Unmarshal(event.Payload, &data); err != nil {
+			return notification, err
+		}
+		notification.ReservationID = data.ReservationID
+		notification.PurchaseID = &data.ID
+		return notification, nil
+	}
+
+	var data ReservationEventData
+	if err := json.Unmarshal(event.Payload, &data); err != nil {
+		return notification, err
+	}
+	notification.ReservationID = data.ID
+	notification.TimeSlotID = &data.TimeSlotID
+	return notification, nil
+}
+
+// enqueueEvent stores an event in the outbox and announces it on
+// ChangesChannel. Postgres only delivers the notification once the transaction
+// commits, and drops it when the transaction is rolled back.
+func enqueueEvent(tx *gorm.DB, eventType string, aggregateID uuid.UUID, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	event := OutboxEvent{
+		ID:          uuid.New(),
+		Type:        eventType,
+		AggregateID: aggregateID,
+		Payload:     payload,
+	}
+
+	if err := tx.Create(&event).Error; err != nil {
+		return err
+	}
+
+	notification, err := NewChangeNotification(event)
+	if err != nil {
+		return err
+	}
+
+	return notifyChange(tx, notification)
+}
+
+// notifyChange announces a change on ChangesChannel once the transaction
+// commits.
+func notifyChange(tx *gorm.DB, notification ChangeNotification) error {
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Exec("SELECT pg_notify(?, ?)", ChangesChannel, string(payload)).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetPendingOutboxEvents locks up to limit unpublished events that are due at
+// now, in creation order. Rows locked by another relay are skipped, so several
+// replicas can drain the outbox concurrently.
+func GetPendingOutboxEvents(tx *gorm.DB, now time.Time, limit int) ([]OutboxEvent, error) {
+	var events []OutboxEvent
+
+	query := tx.Model(&OutboxEvent{}).
+		Where("published_at IS NULL AND dead_at IS NULL").
+		Where("next_attempt_at IS NULL OR next_attempt_at <= ?", now).
+		Order("created_at, id").
+		Limit(limit).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked})
+
+	if err := query.Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// OutboxPosition orders outbox events for readers polling the outbox: by the
+// transaction that stored them, then by the order they were stored in.
+type OutboxPosition struct {
+	TransactionID int64
+	Sequence      int64
+}
+
+type positionedOutboxEvent struct {
+	OutboxEvent
+	PositionTransactionID int64
+	PositionSequence      int64
+}
+
+// visibleOutboxEvents limits a query to events of transactions older than
+// every transaction still running. Transactions that commit later can only
+// store events after these, whatever order they commit in.
+func visibleOutboxEvents(tx *gorm.DB) *gorm.DB {
+	return tx.Model(&OutboxEvent{}).
+		Where("transaction_id < pg_snapshot_xmin(pg_current_snapshot())")
+}
+
+// GetOutboxEventsAfter returns up to limit events after position in position
+// order, whether or not they were published already, and the position of the
+// last one. Events of transactions that may still be running are left for a
+// later call.
+func GetOutboxEventsAfter(tx *gorm.DB, position OutboxPosition, limit int) ([]OutboxEvent, OutboxPosition, error) {
+	var rows []positionedOutboxEvent
+
+	query := visibleOutboxEvents(tx).
+		Select("outbox_events.*, transaction_id::text::bigint AS position_transaction_id, sequence AS position_sequence").
+		Where("(transaction_id, sequence) > (?::text::xid8, ?)", position.TransactionID, position.Sequence).
+		Order("transaction_id, sequence").
+		Limit(limit)
+
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, position, err
+	}
+
+	events := make([]OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, row.OutboxEvent)
+		position = OutboxPosition{TransactionID: row.PositionTransactionID, Sequence: row.PositionSequence}
+	}
+
+	return events, position, nil
+}
+
+// GetOutboxPosition returns the position of the last event that
+// GetOutboxEventsAfter can return yet.
+func GetOutboxPosition(tx *gorm.DB) (OutboxPosition, error) {
+	var position OutboxPosition
+
+	query := visibleOutboxEvents(tx).
+		Select("transaction_id::text::bigint AS transaction_id, sequence").
+		Order("transaction_id DESC, sequence DESC").
+		Limit(1)
+
+	if err := query.Scan(&position).Error; err != nil {
+		return position, err
+	}
+
+	return position, nil
+}
+
+func MarkOutboxEventPublished(tx *gorm.DB, id uuid.UUID, publishedAt time.Time) error {
+	query := tx.Model(&OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"published_at": publishedAt,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"last_error":   nil,
+		})
+
+	if err := query.Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarkOutboxEventFailed records a failed attempt and when to try again.
+func MarkOutboxEventFailed(tx *gorm.DB, id uuid.UUID, publishErr error, nextAttemptAt time.Time) error {
+	query := tx.Model(&OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"last_error":      publishErr.Error(),
+			"next_attempt_at": nextAttemptAt,
+		})
+
+	if err := query.Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarkOutboxEventDead records the last failed attempt of an event and moves it
+// to the dead-letter state, in which it is not published anymore.
+func MarkOutboxEventDead(tx *gorm.DB, id uuid.UUID, publishErr error, deadAt time.Time) error {
+	query := tx.Model(&OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"last_error":      publishErr.Error(),
+			"next_attempt_at": nil,
+			"dead_at":         deadAt,
+		})
+
+	if err := query.Error; err != nil {
+		return err
+	}
+	return nil
+}
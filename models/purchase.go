@@ -20,6 +20,8 @@ type Purchase struct {
 	ID            uuid.UUID
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt
+	Version       int `gorm:"default:1"`
 	ReservationID uuid.UUID
 
 	Type              PurchaseType
@@ -34,14 +36,27 @@ func (p *Purchase) Create(tx *gorm.DB) error {
 	if err := tx.Create(p).Error; err != nil {
 		return err
 	}
-	return nil
+	if err := recordAudit(tx, AuditActionCreate, AuditEntityPurchase, p.ID, p.ReservationID, nil, newPurchaseEventData(*p)); err != nil {
+		return err
+	}
+	return enqueueEvent(tx, EventPurchaseCreated, p.ID, newPurchaseEventData(*p))
 }
 
 func (p *Purchase) Save(tx *gorm.DB) error {
+	before, err := GetPurchase(tx, p.ReservationID, p.ID)
+	if err != nil {
+		return err
+	}
+
+	p.Version = before.Version + 1
+
 	if err := tx.Save(p).Error; err != nil {
 		return err
 	}
-	return nil
+	if err := recordAudit(tx, AuditActionUpdate, AuditEntityPurchase, p.ID, p.ReservationID, newPurchaseEventData(before), newPurchaseEventData(*p)); err != nil {
+		return err
+	}
+	return enqueueEvent(tx, EventPurchaseUpdated, p.ID, newPurchaseEventData(*p))
 }
 
 func GetReservationPurchases(tx *gorm.DB, reservationID uuid.UUID, pagination *request.PaginationOptions, sort *request.SortOptions) ([]Purchase, int, error) {
@@ -84,8 +99,67 @@ func DeletePurchase(tx *gorm.DB, reservationID, purchaseID uuid.UUID) error {
 		return err
 	}
 
-	if err := tx.Delete(&purchase).Error; err != nil {
+	return deletePurchase(tx, purchase, time.Now())
+}
+
+func deletePurchase(tx *gorm.DB, purchase Purchase, deletedAt time.Time) error {
+	if err := tx.Model(&purchase).UpdateColumn("deleted_at", deletedAt).Error; err != nil {
 		return err
 	}
-	return nil
+	if err := recordAudit(tx, AuditActionDelete, AuditEntityPurchase, purchase.ID, purchase.ReservationID, newPurchaseEventData(purchase), nil); err != nil {
+		return err
+	}
+	return enqueueEvent(tx, EventPurchaseDeleted, purchase.ID, newPurchaseEventData(purchase))
+}
+
+func GetDeletedPurchase(tx *gorm.DB, reservationID, purchaseID uuid.UUID) (Purchase, error) {
+	purchase := Purchase{
+		ID:            purchaseID,
+		ReservationID: reservationID,
+	}
+
+	if err := tx.Unscoped().Where(&purchase).Where("deleted_at IS NOT NULL").First(&purchase).Error; err != nil {
+		return purchase, err
+	}
+
+	return purchase, nil
+}
+
+func RestorePurchase(tx *gorm.DB, purchase *Purchase) error {
+	if err := tx.Unscoped().Model(purchase).UpdateColumn("deleted_at", nil).Error; err != nil {
+		return err
+	}
+	purchase.DeletedAt = gorm.DeletedAt{}
+
+	if err := recordAudit(tx, AuditActionRestore, AuditEntityPurchase, purchase.ID, purchase.ReservationID, nil, newPurchaseEventData(*purchase)); err != nil {
+		return err
+	}
+	return enqueueEvent(tx, EventPurchaseRestored, purchase.ID, newPurchaseEventData(*purchase))
+}
+
+type PurchaseExportRow struct {
+	ID                uuid.UUID
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	Type              PurchaseType
+	Name              string
+	Count             int
+	PricePerItemCents int
+	LineTotalCents    int
+
+	ReservationID   uuid.UUID
+	TimeSlotID      uuid.UUID
+	UserID          *uuid.UUID
+	ReservationType ReservationType
+	Row             int
+	Col             int
+}
+
+func EachPurchaseExportRow(tx *gorm.DB, theaterIDs []uuid.UUID, sort *request.SortOptions, fn func(PurchaseExportRow) error) error {
+	query := tx.Model(&Purchase{}).
+		Select("purchases.*, purchases.count * purchases.price_per_item_cents AS line_total_cents, reservations.time_slot_id, reservations.user_id, reservations.type AS reservation_type, reservations.row, reservations.col").
+		Joins("JOIN reservations ON reservations.id = purchases.reservation_id").
+		Scopes(TheaterScope("reservations.theater_id", theaterIDs), exportSortScope("purchases", PurchaseExportSorts, sort))
+
+	return eachRow(tx, query, fn)
 }
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"time"
+
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RefundReason string
+
+const (
+	RefundTimeSlotCancelled RefundReason = "TIME_SLOT_CANCELLED"
+	RefundSeatUnavailable   RefundReason = "SEAT_UNAVAILABLE"
+)
+
+type RefundStatus string
+
+const (
+	RefundPending  RefundStatus = "PENDING"
+	RefundRefunded RefundStatus = "REFUNDED"
+)
+
+// Refund is owed to a customer whose reservation was cancelled by nakup rather
+// than by the customer. It outlives the reservation, so the details needed to
+// pay the customer back and the theater it is handled by are copied onto it.
+// TheaterID is nil while the reservation's theater is not known.
+type Refund struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	ReservationID uuid.UUID
+	TimeSlotID    uuid.UUID
+	TheaterID     *uuid.UUID
+	UserID        *uuid.UUID
+	Reason        RefundReason
+	Status        RefundStatus
+	AmountCents   int
+	RefundedAt    *time.Time
+}
+
+func (r *Refund) Create(tx *gorm.DB) error {
+	if err := tx.Create(r).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Refund) Save(tx *gorm.DB) error {
+	if err := tx.Save(r).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetRefunds(tx *gorm.DB, theaterIDs []uuid.UUID, status *RefundStatus, pagination *request.PaginationOptions, sort *request.SortOptions) ([]Refund, int, error) {
+	var refunds []Refund
+
+	query := tx.Model(&Refund{}).Scopes(TheaterScope("theater_id", theaterIDs))
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	query = query.Session(&gorm.Session{})
+
+	if err := query.Scopes(request.PaginateScope(pagination), request.SortScope(sort)).Find(&refunds).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return refunds, int(total), nil
+}
+
+func GetRefund(tx *gorm.DB, id uuid.UUID) (Refund, error) {
+	refund := Refund{
+		ID: id,
+	}
+
+	if err := tx.Where(&refund).First(&refund).Error; err != nil {
+		return refund, err
+	}
+
+	return refund, nil
+}
+
+func ReservationHasRefund(tx *gorm.DB, reservationID uuid.UUID) (bool, error) {
+	var count int64
+	if err := tx.Model(&Refund{}).Where("reservation_id = ?", reservationID).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// CancelReservation deletes a reservation and flags the ticket and everything
+// bought with it for refund.
+func CancelReservation(tx *gorm.DB, id uuid.UUID, reason RefundReason, ticketPriceCents int) (Refund, error) {
+	reservation := Reservation{
+		ID: id,
+	}
+
+	if err := tx.Where(&reservation).Preload("Purchases").First(&reservation).Error; err != nil {
+		return Refund{}, err
+	}
+
+	refund := Refund{
+		ID:            uuid.New(),
+		ReservationID: reservation.ID,
+		TimeSlotID:    reservation.TimeSlotID,
+		UserID:        reservation.UserID,
+		Reason:        reason,
+		Status:        RefundPending,
+		AmountCents:   ticketPriceCents,
+	}
+	if reservation.TheaterID != uuid.Nil {
+		refund.TheaterID = &reservation.TheaterID
+	}
+	for _, purchase := range reservation.Purchases {
+		refund.AmountCents += purchase.Count * purchase.PricePerItemCents
+	}
+
+	if err := DeleteReservation(tx, reservation.ID); err != nil {
+		return Refund{}, err
+	}
+
+	if err := refund.Create(tx); err != nil {
+		return Refund{}, err
+	}
+
+	return refund, nil
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TimeSlotSales struct {
+	TimeSlotID             uuid.UUID
+	TheaterID              uuid.UUID
+	RoomID                 uuid.UUID
+	Admissions             int
+	ConcessionRevenueCents int
+}
+
+// screeningsBetween selects the reservations of screenings starting within
+// [from, to). Reservations whose screening start is not known yet are selected
+// when they were made before to, so callers must still check the start time of
+// their time slots.
+func screeningsBetween(from, to time.Time) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(
+			"(reservations.screening_starts_at >= ? AND reservations.screening_starts_at < ?) OR (reservations.screening_starts_at IS NULL AND reservations.created_at < ?)",
+			from, to, to,
+		)
+	}
+}
+
+func GetTimeSlotSales(tx *gorm.DB, theaterIDs []uuid.UUID, from, to time.Time) ([]TimeSlotSales, error) {
+	var sales []TimeSlotSales
+
+	query := tx.Model(&Reservation{}).
+		Select("reservations.time_slot_id, reservations.theater_id, reservations.room_id, COUNT(DISTINCT reservations.id) AS admissions, COALESCE(SUM(purchases.count * purchases.price_per_item_cents), 0) AS concession_revenue_cents").
+		Joins("LEFT JOIN purchases ON purchases.reservation_id = reservations.id AND purchases.deleted_at IS NULL").
+		Scopes(screeningsBetween(from, to), TheaterScope("reservations.theater_id", theaterIDs)).
+		Group("reservations.time_slot_id, reservations.theater_id, reservations.room_id").
+		Order("reservations.time_slot_id")
+
+	if err := query.Scan(&sales).Error; err != nil {
+		return nil, err
+	}
+
+	return sales, nil
+}
+
+type TimeSlotOccupancy struct {
+	TimeSlotID uuid.UUID
+	TheaterID  uuid.UUID
+	RoomID     uuid.UUID
+	Sold       int
+	Online     int
+	POS        int
+}
+
+// GetTimeSlotOccupancy returns the occupancy of the given time slot, or of the
+// screenings starting within [from, to) when timeSlotID is nil, in the given
+// theaters.
+func GetTimeSlotOccupancy(tx *gorm.DB, theaterIDs []uuid.UUID, from, to time.Time, timeSlotID *uuid.UUID) ([]TimeSlotOccupancy, error) {
+	var occupancy []TimeSlotOccupancy
+
+	query := tx.Model(&Reservation{}).
+		Select("time_slot_id, theater_id, room_id, COUNT(*) AS sold, COUNT(*) FILTER (WHERE type = ?) AS online, COUNT(*) FILTER (WHERE type = ?) AS pos", Online, Pos).
+		Scopes(TheaterScope("theater_id", theaterIDs)).
+		Group("time_slot_id, theater_id, room_id").
+		Order("time_slot_id")
+
+	if timeSlotID != nil {
+		query = query.Where("time_slot_id = ?", *timeSlotID)
+	} else {
+		query = query.Scopes(screeningsBetween(from, to))
+	}
+
+	if err := query.Scan(&occupancy).Error; err != nil {
+		return nil, err
+	}
+
+	return occupancy, nil
+}
+
+type SeatBooking struct {
+	TimeSlotID uuid.UUID
+	Row        int
+	Col        int
+	CreatedAt  time.Time
+}
+
+func GetRoomSeatBookings(tx *gorm.DB, theaterID, roomID uuid.UUID, from, to time.Time) ([]SeatBooking, error) {
+	var bookings []SeatBooking
+
+	query := tx.Model(&Reservation{}).
+		Select("reservations.time_slot_id, reservations.row, reservations.col, reservations.created_at").
+		Where("theater_id = ? AND room_id = ?", theaterID, roomID).
+		Scopes(screeningsBetween(from, to)).
+		Order("time_slot_id, created_at")
+
+	if err := query.Scan(&bookings).Error; err != nil {
+		return nil, err
+	}
+
+	return bookings, nil
+}
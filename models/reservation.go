@@ -6,6 +6,7 @@ import (
 	"github.com/PRPO-skupina-02/common/request"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ReservationType string
@@ -19,14 +20,23 @@ type Reservation struct {
 	ID        uuid.UUID
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt
+	Version   int `gorm:"default:1"`
 
 	TimeSlotID uuid.UUID
-	UserID     uuid.UUID
+	TheaterID  uuid.UUID
+	RoomID     uuid.UUID
+	UserID     *uuid.UUID
+	APIKeyID   *uuid.UUID
 	Type       ReservationType
 
 	Row int
 	Col int
 
+	// ScreeningStartsAt copies the start time of the time slot from spored, so
+	// reports can select screenings by date. It is nil while not known.
+	ScreeningStartsAt *time.Time `json:"-"`
+
 	Purchases []Purchase `gorm:"foreignKey:ReservationID" json:"-"`
 }
 
@@ -34,20 +44,33 @@ func (r *Reservation) Create(tx *gorm.DB) error {
 	if err := tx.Create(r).Error; err != nil {
 		return err
 	}
-	return nil
+	if err := recordAudit(tx, AuditActionCreate, AuditEntityReservation, r.ID, r.ID, nil, newReservationEventData(*r)); err != nil {
+		return err
+	}
+	return enqueueEvent(tx, EventReservationCreated, r.ID, newReservationEventData(*r))
 }
 
 func (r *Reservation) Save(tx *gorm.DB) error {
+	before, err := GetReservation(tx, r.ID)
+	if err != nil {
+		return err
+	}
+
+	r.Version = before.Version + 1
+
 	if err := tx.Save(r).Error; err != nil {
 		return err
 	}
-	return nil
+	if err := recordAudit(tx, AuditActionUpdate, AuditEntityReservation, r.ID, r.ID, newReservationEventData(before), newReservationEventData(*r)); err != nil {
+		return err
+	}
+	return enqueueEvent(tx, EventReservationUpdated, r.ID, newReservationEventData(*r))
 }
 
-func GetReservations(tx *gorm.DB, pagination *request.PaginationOptions, sort *request.SortOptions) ([]Reservation, int, error) {
+func GetReservations(tx *gorm.DB, theaterIDs []uuid.UUID, pagination *request.PaginationOptions, sort *request.SortOptions) ([]Reservation, int, error) {
 	var reservations []Reservation
 
-	query := tx.Model(&Reservation{}).Session(&gorm.Session{})
+	query := tx.Model(&Reservation{}).Scopes(TheaterScope("theater_id", theaterIDs)).Session(&gorm.Session{})
 
 	if err := query.Scopes(request.PaginateScope(pagination), request.SortScope(sort)).Find(&reservations).Error; err != nil {
 		return nil, 0, err
@@ -61,6 +84,29 @@ func GetReservations(tx *gorm.DB, pagination *request.PaginationOptions, sort *r
 	return reservations, int(total), nil
 }
 
+// GetReservationsKeyset returns a page of reservations selected by keyset and
+// reports whether there are more reservations in the direction of the page.
+func GetReservationsKeyset(tx *gorm.DB, theaterIDs []uuid.UUID, keyset *KeysetOptions) ([]Reservation, bool, error) {
+	var reservations []Reservation
+
+	query := tx.Model(&Reservation{}).Scopes(TheaterScope("theater_id", theaterIDs), KeysetScope(keyset))
+
+	if err := query.Find(&reservations).Error; err != nil {
+		return nil, false, err
+	}
+
+	reservations, more := keysetPage(reservations, keyset)
+	return reservations, more, nil
+}
+
+func CountReservations(tx *gorm.DB, theaterIDs []uuid.UUID) (int, error) {
+	var total int64
+	if err := tx.Model(&Reservation{}).Scopes(TheaterScope("theater_id", theaterIDs)).Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return int(total), nil
+}
+
 func GetUserReservations(tx *gorm.DB, userID uuid.UUID, pagination *request.PaginationOptions, sort *request.SortOptions) ([]Reservation, int, error) {
 	var reservations []Reservation
 
@@ -78,6 +124,133 @@ func GetUserReservations(tx *gorm.DB, userID uuid.UUID, pagination *request.Pagi
 	return reservations, int(total), nil
 }
 
+func GetAllReservations(tx *gorm.DB) ([]Reservation, error) {
+	var reservations []Reservation
+
+	query := tx.Model(&Reservation{}).
+		Order("time_slot_id, row, col")
+
+	if err := query.Find(&reservations).Error; err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+func GetTimeSlotReservations(tx *gorm.DB, timeSlotID uuid.UUID) ([]Reservation, error) {
+	var reservations []Reservation
+
+	query := tx.Model(&Reservation{}).
+		Where("time_slot_id = ?", timeSlotID).
+		Order("row, col")
+
+	if err := query.Find(&reservations).Error; err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// GetTimeSlotsReservedSeats returns the time slot, row and column of every
+// reservation of the given time slots.
+func GetTimeSlotsReservedSeats(tx *gorm.DB, timeSlotIDs []uuid.UUID) ([]Reservation, error) {
+	var reservations []Reservation
+
+	query := tx.Model(&Reservation{}).
+		Select("time_slot_id", "row", "col").
+		Where("time_slot_id IN ?", timeSlotIDs)
+
+	if err := query.Find(&reservations).Error; err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// GetUnlocatedTimeSlotIDs returns the time slots of reservations that were
+// made before reservations recorded their theater and room, including deleted
+// ones.
+func GetUnlocatedTimeSlotIDs(tx *gorm.DB) ([]uuid.UUID, error) {
+	var timeSlotIDs []uuid.UUID
+
+	query := tx.Unscoped().Model(&Reservation{}).
+		Distinct("time_slot_id").
+		Where("theater_id IS NULL OR room_id IS NULL").
+		Order("time_slot_id")
+
+	if err := query.Pluck("time_slot_id", &timeSlotIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return timeSlotIDs, nil
+}
+
+// LocateTimeSlotReservations fills in the theater and room of the reservations
+// of a time slot that do not have them yet, and the theater of their refunds.
+// It only completes data that was not recorded when the reservations were
+// made, so it bypasses the version, audit log and outbox.
+func LocateTimeSlotReservations(tx *gorm.DB, timeSlotID, theaterID, roomID uuid.UUID) error {
+	query := tx.Unscoped().Model(&Reservation{}).
+		Where("time_slot_id = ? AND (theater_id IS NULL OR room_id IS NULL)", timeSlotID).
+		UpdateColumns(map[string]any{
+			"theater_id": theaterID,
+			"room_id":    roomID,
+		})
+
+	if err := query.Error; err != nil {
+		return err
+	}
+
+	query = tx.Model(&Refund{}).
+		Where("time_slot_id = ? AND theater_id IS NULL", timeSlotID).
+		UpdateColumn("theater_id", theaterID)
+
+	if err := query.Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// TimeSlotLocation is a time slot together with the theater and room its
+// reservations were made in.
+type TimeSlotLocation struct {
+	TimeSlotID uuid.UUID
+	TheaterID  uuid.UUID
+	RoomID     uuid.UUID
+}
+
+// GetUnscheduledTimeSlots returns the time slots of reservations whose
+// screening start was not recorded yet. Reservations without a theater and
+// room are left out until they are located.
+func GetUnscheduledTimeSlots(tx *gorm.DB) ([]TimeSlotLocation, error) {
+	var timeSlots []TimeSlotLocation
+
+	query := tx.Unscoped().Model(&Reservation{}).
+		Distinct("time_slot_id", "theater_id", "room_id").
+		Where("screening_starts_at IS NULL AND theater_id IS NOT NULL AND room_id IS NOT NULL").
+		Order("time_slot_id")
+
+	if err := query.Scan(&timeSlots).Error; err != nil {
+		return nil, err
+	}
+
+	return timeSlots, nil
+}
+
+// ScheduleTimeSlotReservations records the screening start of the reservations
+// of a time slot. Like LocateTimeSlotReservations it only copies data kept by
+// spored, so it bypasses the version, audit log and outbox.
+func ScheduleTimeSlotReservations(tx *gorm.DB, timeSlotID uuid.UUID, startsAt time.Time) error {
+	query := tx.Unscoped().Model(&Reservation{}).
+		Where("time_slot_id = ?", timeSlotID).
+		UpdateColumn("screening_starts_at", startsAt)
+
+	if err := query.Error; err != nil {
+		return err
+	}
+	return nil
+}
+
 func GetReservation(tx *gorm.DB, id uuid.UUID) (Reservation, error) {
 	reservation := Reservation{
 		ID: id,
@@ -90,6 +263,13 @@ func GetReservation(tx *gorm.DB, id uuid.UUID) (Reservation, error) {
 	return reservation, nil
 }
 
+// GetReservationForUpdate is GetReservation that also locks the reservation
+// until the end of the transaction, so that concurrent changes of it and its
+// purchases are applied one after another.
+func GetReservationForUpdate(tx *gorm.DB, id uuid.UUID) (Reservation, error) {
+	return GetReservation(tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}), id)
+}
+
 func CheckDuplicateReservation(tx *gorm.DB, timeSlotID uuid.UUID, row, col int, excludeID *uuid.UUID) (bool, error) {
 	query := tx.Model(&Reservation{}).Where("time_slot_id = ? AND row = ? AND col = ?", timeSlotID, row, col)
 
@@ -105,6 +285,9 @@ func CheckDuplicateReservation(tx *gorm.DB, timeSlotID uuid.UUID, row, col int,
 	return count > 0, nil
 }
 
+// DeleteReservation soft deletes a reservation together with its purchases.
+// They all share the same deletion time, which is how RestoreReservation
+// tells them apart from purchases that were removed earlier.
 func DeleteReservation(tx *gorm.DB, id uuid.UUID) error {
 	reservation := Reservation{
 		ID: id,
@@ -114,15 +297,94 @@ func DeleteReservation(tx *gorm.DB, id uuid.UUID) error {
 		return err
 	}
 
+	deletedAt := time.Now()
+
 	for _, purchase := range reservation.Purchases {
-		err := DeletePurchase(tx, id, purchase.ID)
+		err := deletePurchase(tx, purchase, deletedAt)
 		if err != nil {
 			return err
 		}
 	}
 
-	if err := tx.Delete(&reservation).Error; err != nil {
+	if err := tx.Model(&reservation).UpdateColumn("deleted_at", deletedAt).Error; err != nil {
+		return err
+	}
+	if err := recordAudit(tx, AuditActionDelete, AuditEntityReservation, reservation.ID, reservation.ID, newReservationEventData(reservation), nil); err != nil {
+		return err
+	}
+	return enqueueEvent(tx, EventReservationCancelled, reservation.ID, newReservationEventData(reservation))
+}
+
+func GetDeletedReservation(tx *gorm.DB, id uuid.UUID) (Reservation, error) {
+	reservation := Reservation{
+		ID: id,
+	}
+
+	if err := tx.Unscoped().Where(&reservation).Where("deleted_at IS NOT NULL").First(&reservation).Error; err != nil {
+		return reservation, err
+	}
+
+	return reservation, nil
+}
+
+// RestoreReservation undoes the deletion of a reservation and brings back the
+// purchases that were deleted with it. Callers have to make sure its seat has
+// not been reserved again in the meantime.
+func RestoreReservation(tx *gorm.DB, reservation *Reservation) error {
+	var purchases []Purchase
+
+	query := tx.Unscoped().
+		Where("reservation_id = ? AND deleted_at = ?", reservation.ID, reservation.DeletedAt.Time).
+		Order("created_at, id")
+
+	if err := query.Find(&purchases).Error; err != nil {
 		return err
 	}
+
+	if err := tx.Unscoped().Model(reservation).UpdateColumn("deleted_at", nil).Error; err != nil {
+		return err
+	}
+	reservation.DeletedAt = gorm.DeletedAt{}
+
+	if err := recordAudit(tx, AuditActionRestore, AuditEntityReservation, reservation.ID, reservation.ID, nil, newReservationEventData(*reservation)); err != nil {
+		return err
+	}
+	if err := enqueueEvent(tx, EventReservationRestored, reservation.ID, newReservationEventData(*reservation)); err != nil {
+		return err
+	}
+
+	for _, purchase := range purchases {
+		if err := RestorePurchase(tx, &purchase); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+type ReservationExportRow struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	TimeSlotID uuid.UUID
+	TheaterID  uuid.UUID
+	RoomID     uuid.UUID
+	UserID     *uuid.UUID
+	APIKeyID   *uuid.UUID
+	Type       ReservationType
+	Row        int
+	Col        int
+
+	PurchaseCount       int
+	PurchasesTotalCents int
+}
+
+func EachReservationExportRow(tx *gorm.DB, theaterIDs []uuid.UUID, sort *request.SortOptions, fn func(ReservationExportRow) error) error {
+	query := tx.Model(&Reservation{}).
+		Select("reservations.*, COUNT(purchases.id) AS purchase_count, COALESCE(SUM(purchases.count * purchases.price_per_item_cents), 0) AS purchases_total_cents").
+		Joins("LEFT JOIN purchases ON purchases.reservation_id = reservations.id AND purchases.deleted_at IS NULL").
+		Group("reservations.id").
+		Scopes(TheaterScope("reservations.theater_id", theaterIDs), exportSortScope("reservations", ReservationExportSorts, sort))
+
+	return eachRow(tx, query, fn)
+}
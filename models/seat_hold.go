@@ -0,0 +1,137 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SeatHoldTTL is how long a seat stays held for the customer choosing it.
+const SeatHoldTTL = 5 * time.Minute
+
+// ChangeSeatHold is the type of the change notification announcing that a
+// seat was held or its hold was released. Holds are not domain events, so they
+// are announced without being stored in the outbox.
+const ChangeSeatHold = "seat_hold.changed"
+
+// SeatHold keeps a seat of a time slot for the user or API key choosing it,
+// so nobody else can hold or reserve it until the hold expires, is released or
+// is used up by reserving the seat.
+type SeatHold struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	TimeSlotID uuid.UUID
+	TheaterID  uuid.UUID
+	RoomID     uuid.UUID
+	Row        int
+	Col        int
+	Owner      string
+}
+
+// ClaimSeatHold stores hold and announces it, replacing an expired hold of the
+// same seat or a hold of the same owner. Claimed is false while someone else
+// holds the seat. Claims of a seat that another transaction has just claimed
+// wait for it to finish.
+func ClaimSeatHold(tx *gorm.DB, hold *SeatHold, now time.Time) (bool, error) {
+	claimed, err := claimSeatHold(tx, hold, now)
+	if err != nil || !claimed {
+		return claimed, err
+	}
+
+	return true, notifySeatHold(tx, *hold)
+}
+
+// TakeSeatHold is used when owner reserves a seat. It removes the owner's hold
+// of the seat and reports false if someone else holds it. The seat is claimed
+// until the transaction finishes, so it cannot be held while it is reserved.
+func TakeSeatHold(tx *gorm.DB, timeSlotID uuid.UUID, row, col int, owner string, now time.Time) (bool, error) {
+	hold := SeatHold{
+		ID:         uuid.New(),
+		ExpiresAt:  now,
+		TimeSlotID: timeSlotID,
+		Row:        row,
+		Col:        col,
+		Owner:      owner,
+	}
+
+	claimed, err := claimSeatHold(tx, &hold, now)
+	if err != nil || !claimed {
+		return claimed, err
+	}
+
+	if err := tx.Delete(&SeatHold{ID: hold.ID}).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func claimSeatHold(tx *gorm.DB, hold *SeatHold, now time.Time) (bool, error) {
+	query := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "time_slot_id"}, {Name: "row"}, {Name: "col"}},
+		DoUpdates: clause.AssignmentColumns([]string{"id", "created_at", "expires_at", "theater_id", "room_id", "owner"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "seat_holds.expires_at <= ? OR seat_holds.owner = excluded.owner", Vars: []any{now}},
+		}},
+	}).Create(hold)
+
+	if err := query.Error; err != nil {
+		return false, err
+	}
+	return query.RowsAffected > 0, nil
+}
+
+// GetSeatHold returns the hold of a time slot with the given ID if it belongs
+// to owner.
+func GetSeatHold(tx *gorm.DB, timeSlotID, id uuid.UUID, owner string) (SeatHold, error) {
+	var hold SeatHold
+
+	if err := tx.Where("id = ? AND time_slot_id = ? AND owner = ?", id, timeSlotID, owner).First(&hold).Error; err != nil {
+		return SeatHold{}, err
+	}
+
+	return hold, nil
+}
+
+// ReleaseSeatHold frees the seat of a hold and announces it.
+func ReleaseSeatHold(tx *gorm.DB, hold SeatHold) error {
+	if err := tx.Delete(&SeatHold{ID: hold.ID}).Error; err != nil {
+		return err
+	}
+
+	return notifySeatHold(tx, hold)
+}
+
+// GetTimeSlotsHeldSeats returns the holds of the time slots that have not
+// expired at now.
+func GetTimeSlotsHeldSeats(tx *gorm.DB, timeSlotIDs []uuid.UUID, now time.Time) ([]SeatHold, error) {
+	var holds []SeatHold
+
+	query := tx.Model(&SeatHold{}).
+		Select("time_slot_id", "row", "col").
+		Where("time_slot_id IN ? AND expires_at > ?", timeSlotIDs, now).
+		Order("time_slot_id, row, col")
+
+	if err := query.Find(&holds).Error; err != nil {
+		return nil, err
+	}
+
+	return holds, nil
+}
+
+func DeleteExpiredSeatHolds(tx *gorm.DB, now time.Time) error {
+	if err := tx.Where("expires_at <= ?", now).Delete(&SeatHold{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func notifySeatHold(tx *gorm.DB, hold SeatHold) error {
+	return notifyChange(tx, ChangeNotification{
+		EventID:    uuid.New(),
+		Type:       ChangeSeatHold,
+		TimeSlotID: &hold.TimeSlotID,
+	})
+}
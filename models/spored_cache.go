@@ -0,0 +1,44 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Types of the change notifications that tell every replica to drop cached
+// spored lookups. Like seat hold changes, they are announced without being
+// stored in the outbox.
+const (
+	ChangeSporedTimeSlotInvalidated = "spored_cache.time_slot_invalidated"
+	ChangeSporedRoomInvalidated     = "spored_cache.room_invalidated"
+	ChangeSporedCachePurged         = "spored_cache.purged"
+)
+
+// NotifySporedTimeSlotInvalidated announces that the cached lookups of a time
+// slot are stale.
+func NotifySporedTimeSlotInvalidated(tx *gorm.DB, timeSlotID uuid.UUID) error {
+	return notifyChange(tx, ChangeNotification{
+		EventID:    uuid.New(),
+		Type:       ChangeSporedTimeSlotInvalidated,
+		TimeSlotID: &timeSlotID,
+	})
+}
+
+// NotifySporedRoomInvalidated announces that the cached lookups of a room are
+// stale.
+func NotifySporedRoomInvalidated(tx *gorm.DB, theaterID, roomID uuid.UUID) error {
+	return notifyChange(tx, ChangeNotification{
+		EventID:   uuid.New(),
+		Type:      ChangeSporedRoomInvalidated,
+		TheaterID: &theaterID,
+		RoomID:    &roomID,
+	})
+}
+
+// NotifySporedCachePurged announces that every cached lookup was dropped.
+func NotifySporedCachePurged(tx *gorm.DB) error {
+	return notifyChange(tx, ChangeNotification{
+		EventID: uuid.New(),
+		Type:    ChangeSporedCachePurged,
+	})
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StaffTheater assigns an employee to a theater. Employees can only manage
+// reservations of the theaters they are assigned to.
+type StaffTheater struct {
+	UserID    uuid.UUID `gorm:"primaryKey"`
+	TheaterID uuid.UUID `gorm:"primaryKey"`
+	CreatedAt time.Time
+}
+
+// GetStaffTheaterIDs returns the theaters userID is assigned to.
+func GetStaffTheaterIDs(tx *gorm.DB, userID uuid.UUID) ([]uuid.UUID, error) {
+	theaterIDs := []uuid.UUID{}
+
+	query := tx.Model(&StaffTheater{}).
+		Where("user_id = ?", userID).
+		Order("theater_id")
+
+	if err := query.Pluck("theater_id", &theaterIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return theaterIDs, nil
+}
+
+// SetStaffTheaterIDs replaces the theaters userID is assigned to.
+func SetStaffTheaterIDs(tx *gorm.DB, userID uuid.UUID, theaterIDs []uuid.UUID) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&StaffTheater{}).Error; err != nil {
+		return err
+	}
+
+	if len(theaterIDs) == 0 {
+		return nil
+	}
+
+	assignments := make([]StaffTheater, 0, len(theaterIDs))
+	for _, theaterID := range theaterIDs {
+		assignments = append(assignments, StaffTheater{
+			UserID:    userID,
+			TheaterID: theaterID,
+		})
+	}
+
+	if err := tx.Create(&assignments).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// TheaterScope limits a query to rows of the given theaters. A nil slice does
+// not limit the query, while an empty one matches nothing.
+func TheaterScope(column string, theaterIDs []uuid.UUID) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if theaterIDs == nil {
+			return db
+		}
+		return db.Where(column+" IN ?", theaterIDs)
+	}
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StreamTicketTTL is how long a stream ticket can be used to open a stream.
+const StreamTicketTTL = 30 * time.Second
+
+// StreamTicket lets a client that cannot send headers, such as a browser's
+// EventSource, open one seat stream of a time slot on behalf of the user or API
+// key that asked for it. Only the hash of the ticket is stored.
+type StreamTicket struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	TicketHash string
+	TimeSlotID uuid.UUID
+	UserID     *uuid.UUID
+	UserRole   *string
+	APIKeyID   *uuid.UUID
+}
+
+// SetTicket stores the hash of ticket.
+func (t *StreamTicket) SetTicket(ticket string) {
+	t.TicketHash = HashAPIKey(ticket)
+}
+
+func (t *StreamTicket) Create(tx *gorm.DB) error {
+	if err := tx.Create(t).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// RedeemStreamTicket deletes the ticket for a time slot and returns it, so it
+// can only be used once. Expired and unknown tickets are not found.
+func RedeemStreamTicket(tx *gorm.DB, timeSlotID uuid.UUID, ticket string, now time.Time) (StreamTicket, error) {
+	var tickets []StreamTicket
+
+	query := tx.Clauses(clause.Returning{}).
+		Where("ticket_hash = ? AND time_slot_id = ? AND expires_at > ?", HashAPIKey(ticket), timeSlotID, now).
+		Delete(&tickets)
+
+	if err := query.Error; err != nil {
+		return StreamTicket{}, err
+	}
+	if len(tickets) == 0 {
+		return StreamTicket{}, gorm.ErrRecordNotFound
+	}
+
+	return tickets[0], nil
+}
+
+func DeleteExpiredStreamTickets(tx *gorm.DB, now time.Time) error {
+	if err := tx.Where("expires_at <= ?", now).Delete(&StreamTicket{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
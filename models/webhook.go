@@ -0,0 +1,270 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/request"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryDead      WebhookDeliveryStatus = "DEAD"
+)
+
+// EventTypes is a list of event types stored as a JSON array.
+type EventTypes []string
+
+func (e EventTypes) Value() (driver.Value, error) {
+	if e == nil {
+		return "[]", nil
+	}
+	value, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return string(value), nil
+}
+
+func (e *EventTypes) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, e)
+	case string:
+		return json.Unmarshal([]byte(v), e)
+	default:
+		return errors.New("unsupported event types value")
+	}
+}
+
+type WebhookSubscription struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	URL        string
+	EventTypes EventTypes `gorm:"type:jsonb"`
+	Secret     string
+	Active     bool
+}
+
+func (s *WebhookSubscription) Create(tx *gorm.DB) error {
+	if err := tx.Create(s).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *WebhookSubscription) Save(tx *gorm.DB) error {
+	if err := tx.Save(s).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetWebhookSubscriptions(tx *gorm.DB, pagination *request.PaginationOptions, sort *request.SortOptions) ([]WebhookSubscription, int, error) {
+	var subscriptions []WebhookSubscription
+
+	query := tx.Model(&WebhookSubscription{}).Session(&gorm.Session{})
+
+	if err := query.Scopes(request.PaginateScope(pagination), request.SortScope(sort)).Find(&subscriptions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return subscriptions, int(total), nil
+}
+
+func GetWebhookSubscription(tx *gorm.DB, id uuid.UUID) (WebhookSubscription, error) {
+	subscription := WebhookSubscription{
+		ID: id,
+	}
+
+	if err := tx.Where(&subscription).First(&subscription).Error; err != nil {
+		return subscription, err
+	}
+
+	return subscription, nil
+}
+
+func GetActiveWebhookSubscriptions(tx *gorm.DB, eventType string) ([]WebhookSubscription, error) {
+	var subscriptions []WebhookSubscription
+
+	query := tx.Model(&WebhookSubscription{}).
+		Where("active AND event_types @> jsonb_build_array(?::text)", eventType).
+		Order("created_at, id")
+
+	if err := query.Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func DeleteWebhookSubscription(tx *gorm.DB, id uuid.UUID) error {
+	subscription := WebhookSubscription{
+		ID: id,
+	}
+
+	if err := tx.Where(&subscription).First(&subscription).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Delete(&subscription).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	Payload        json.RawMessage `gorm:"type:jsonb"`
+
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  *time.Time
+	LastStatusCode *int
+	LastError      *string
+	DeliveredAt    *time.Time
+}
+
+func (d *WebhookDelivery) Save(tx *gorm.DB) error {
+	if err := tx.Save(d).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateWebhookDeliveries stores new deliveries, skipping events that were
+// already queued for a subscription. The outbox delivers events at least once,
+// so the same event can arrive more than once.
+func CreateWebhookDeliveries(tx *gorm.DB, deliveries []WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	query := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "subscription_id"}, {Name: "event_id"}},
+		DoNothing: true,
+	}).Create(&deliveries)
+
+	if err := query.Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetWebhookDeliveries(tx *gorm.DB, subscriptionID uuid.UUID, pagination *request.PaginationOptions, sort *request.SortOptions) ([]WebhookDelivery, int, error) {
+	var deliveries []WebhookDelivery
+
+	query := tx.Model(&WebhookDelivery{}).Where("subscription_id = ?", subscriptionID).Session(&gorm.Session{})
+
+	if err := query.Scopes(request.PaginateScope(pagination), request.SortScope(sort)).Find(&deliveries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, int(total), nil
+}
+
+func GetWebhookDelivery(tx *gorm.DB, subscriptionID, id uuid.UUID) (WebhookDelivery, error) {
+	delivery := WebhookDelivery{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+	}
+
+	if err := tx.Where(&delivery).First(&delivery).Error; err != nil {
+		return delivery, err
+	}
+
+	return delivery, nil
+}
+
+// ClaimDueWebhookDeliveries locks up to limit pending deliveries that are due
+// and pushes their next attempt to leaseUntil, so that other dispatchers leave
+// them alone while they are being sent. If the dispatcher dies mid-send the
+// delivery becomes due again once the lease expires.
+func ClaimDueWebhookDeliveries(tx *gorm.DB, now, leaseUntil time.Time, limit int) ([]WebhookDelivery, error) {
+	var deliveries []WebhookDelivery
+
+	err := tx.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&WebhookDelivery{}).
+			Where("status = ? AND next_attempt_at <= ?", WebhookDeliveryPending, now).
+			Order("next_attempt_at, id").
+			Limit(limit).
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked})
+
+		if err := query.Find(&deliveries).Error; err != nil {
+			return err
+		}
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, 0, len(deliveries))
+		for i := range deliveries {
+			ids = append(ids, deliveries[i].ID)
+			deliveries[i].NextAttemptAt = &leaseUntil
+		}
+
+		return tx.Model(&WebhookDelivery{}).Where("id IN ?", ids).Update("next_attempt_at", leaseUntil).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+type WebhookDeliveryAttempt struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	DeliveryID uuid.UUID
+	StatusCode *int
+	Error      *string
+	DurationMs int
+}
+
+func (a *WebhookDeliveryAttempt) Create(tx *gorm.DB) error {
+	if err := tx.Create(a).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetWebhookDeliveryAttempts(tx *gorm.DB, deliveryID uuid.UUID) ([]WebhookDeliveryAttempt, error) {
+	var attempts []WebhookDeliveryAttempt
+
+	query := tx.Model(&WebhookDeliveryAttempt{}).
+		Where("delivery_id = ?", deliveryID).
+		Order("created_at, id")
+
+	if err := query.Find(&attempts).Error; err != nil {
+		return nil, err
+	}
+
+	return attempts, nil
+}
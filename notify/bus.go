@@ -0,0 +1,355 @@
+package notify
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPollInterval   = time.Second
+	DefaultReconnectDelay = time.Second
+
+	maxReconnectDelay = 30 * time.Second
+
+	// pollBatchSize is how many outbox events a poll reads at once.
+	pollBatchSize = 1000
+
+	// subscriberBuffer is how many notifications a subscriber may fall behind
+	// before it misses notifications and is sent a resync.
+	subscriberBuffer = 64
+)
+
+// Mode selects how a Bus learns about changes.
+type Mode string
+
+const (
+	// ModeListen receives changes with Postgres LISTEN on a dedicated
+	// connection.
+	ModeListen Mode = "listen"
+	// ModePoll reads changes from the outbox every poll interval, for
+	// deployments where LISTEN is not available, such as behind a pooler in
+	// transaction mode.
+	ModePoll Mode = "poll"
+)
+
+// TypeResync is the type of the notification sent when a subscriber may have
+// missed notifications. Subscribers should reload everything they keep.
+const TypeResync = "resync"
+
+// Notification announces a committed reservation, purchase or seat hold
+// change, or stale spored lookups.
+type Notification = models.ChangeNotification
+
+var (
+	ReservationEvents = []string{
+		models.EventReservationCreated,
+		models.EventReservationUpdated,
+		models.EventReservationCancelled,
+		models.EventReservationRestored,
+	}
+	PurchaseEvents = []string{
+		models.EventPurchaseCreated,
+		models.EventPurchaseUpdated,
+		models.EventPurchaseDeleted,
+		models.EventPurchaseRestored,
+	}
+	SeatHoldEvents = []string{
+		models.ChangeSeatHold,
+	}
+	SporedCacheEvents = []string{
+		models.ChangeSporedTimeSlotInvalidated,
+		models.ChangeSporedRoomInvalidated,
+		models.ChangeSporedCachePurged,
+	}
+)
+
+// Subscription receives the notifications of the types it subscribed to.
+// Notifications are sent to C without blocking the bus. A subscriber that falls
+// behind misses notifications and is sent a TypeResync notification once it
+// catches up.
+type Subscription struct {
+	C <-chan Notification
+
+	c      chan Notification
+	types  []string
+	resync bool
+	bus    *Bus
+}
+
+// Close stops the subscription and closes C.
+func (s *Subscription) Close() {
+	s.bus.unsubscribe(s)
+}
+
+func (s *Subscription) deliver(notification Notification) {
+	if notification.Type != TypeResync && len(s.types) > 0 && !slices.Contains(s.types, notification.Type) {
+		return
+	}
+
+	if s.resync {
+		select {
+		case s.c <- Notification{Type: TypeResync}:
+			s.resync = false
+		default:
+			return
+		}
+		if notification.Type == TypeResync {
+			return
+		}
+	}
+
+	select {
+	case s.c <- notification:
+	default:
+		s.resync = true
+	}
+}
+
+// Bus tells subscribers about reservation, purchase and seat hold changes and
+// stale spored lookups committed by any replica. Changes are announced with
+// Postgres NOTIFY in the transaction that made them, or read from the outbox
+// in ModePoll, which leaves out seat hold and spored cache changes as they are
+// not stored there.
+type Bus struct {
+	db             *gorm.DB
+	mode           Mode
+	pollInterval   time.Duration
+	reconnectDelay time.Duration
+
+	// onListen is called once the bus listens for notifications.
+	onListen func()
+
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+
+	pollMu   sync.Mutex
+	polled   bool
+	position models.OutboxPosition
+}
+
+func NewBus(db *gorm.DB, mode Mode, pollInterval time.Duration) *Bus {
+	return &Bus{
+		db:             db,
+		mode:           mode,
+		pollInterval:   pollInterval,
+		reconnectDelay: DefaultReconnectDelay,
+		subscribers:    map[*Subscription]struct{}{},
+	}
+}
+
+// Subscribe starts receiving notifications of the given types, or of every
+// type when none are given. TypeResync notifications are always received.
+func (b *Bus) Subscribe(types ...string) *Subscription {
+	c := make(chan Notification, subscriberBuffer)
+	subscription := &Subscription{
+		C:     c,
+		c:     c,
+		types: types,
+		bus:   b,
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subscribers[subscription] = struct{}{}
+	return subscription
+}
+
+func (b *Bus) unsubscribe(subscription *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[subscription]; ok {
+		delete(b.subscribers, subscription)
+		close(subscription.c)
+	}
+}
+
+func (b *Bus) publish(notification Notification) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for subscription := range b.subscribers {
+		subscription.deliver(notification)
+	}
+}
+
+// Run receives changes until the context is cancelled.
+func (b *Bus) Run(ctx context.Context) {
+	if b.mode == ModePoll {
+		b.runPoll(ctx)
+		return
+	}
+	b.runListen(ctx)
+}
+
+// runListen listens for notifications and reconnects with a growing delay
+// whenever the connection is lost. Notifications sent while no connection was
+// listening are lost, so subscribers are sent a resync after reconnecting.
+func (b *Bus) runListen(ctx context.Context) {
+	delay := b.reconnectDelay
+	reconnect := false
+
+	for {
+		listened, err := b.listen(ctx, reconnect)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if listened {
+			delay = b.reconnectDelay
+			reconnect = true
+		}
+
+		slog.Error("lost change notification connection", "err", err, "retry_in", delay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		if !listened {
+			delay = min(delay*2, maxReconnectDelay)
+		}
+	}
+}
+
+// listen receives notifications on a connection taken from the pool until it
+// fails. It reports whether it got to listen for notifications.
+func (b *Bus) listen(ctx context.Context, resync bool) (bool, error) {
+	sqlDB, err := b.db.DB()
+	if err != nil {
+		return false, err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	listened := false
+	var listenErr error
+
+	_ = conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			listenErr = fmt.Errorf("unsupported database connection %T", driverConn)
+			return nil
+		}
+
+		listenErr = b.receive(ctx, stdlibConn.Conn(), resync, &listened)
+
+		// The connection still listens, so it must not go back to the pool.
+		return driver.ErrBadConn
+	})
+
+	return listened, listenErr
+}
+
+func (b *Bus) receive(ctx context.Context, conn *pgx.Conn, resync bool, listened *bool) error {
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{models.ChangesChannel}.Sanitize()); err != nil {
+		return err
+	}
+
+	*listened = true
+	if b.onListen != nil {
+		b.onListen()
+	}
+
+	if resync {
+		b.publish(Notification{Type: TypeResync})
+	}
+
+	for {
+		received, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var notification Notification
+		if err := json.Unmarshal([]byte(received.Payload), &notification); err != nil {
+			slog.Error("invalid change notification", "payload", received.Payload, "err", err)
+			continue
+		}
+
+		b.publish(notification)
+	}
+}
+
+// runPoll polls the outbox every poll interval.
+func (b *Bus) runPoll(ctx context.Context) {
+	slog.Warn("change notifications are polled from the outbox, so seat hold changes and spored cache invalidations are not announced to other replicas")
+
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := b.Poll(ctx); err != nil {
+			slog.Error("failed to poll changes", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll sends the notifications of the outbox events stored since the previous
+// poll. The first poll only records where the outbox ends. Events are read in
+// outbox position order, so events of transactions that commit late are
+// announced late rather than missed.
+func (b *Bus) Poll(ctx context.Context) error {
+	b.pollMu.Lock()
+	defer b.pollMu.Unlock()
+
+	db := b.db.WithContext(ctx)
+
+	if !b.polled {
+		position, err := models.GetOutboxPosition(db)
+		if err != nil {
+			return err
+		}
+
+		b.position = position
+		b.polled = true
+		return nil
+	}
+
+	for {
+		events, position, err := models.GetOutboxEventsAfter(db, b.position, pollBatchSize)
+		if err != nil {
+			return err
+		}
+
+		b.position = position
+
+		for _, event := range events {
+			notification, err := models.NewChangeNotification(event)
+			if err != nil {
+				slog.Error("invalid outbox event", "id", event.ID, "err", err)
+				continue
+			}
+
+			b.publish(notification)
+		}
+
+		if len(events) < pollBatchSize {
+			return nil
+		}
+	}
+}
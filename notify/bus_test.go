@@ -0,0 +1,183 @@
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReservation() models.Reservation {
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	return models.Reservation{
+		ID:         uuid.New(),
+		TimeSlotID: uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295"),
+		TheaterID:  uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d"),
+		RoomID:     uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1"),
+		UserID:     &userID,
+		Type:       models.Online,
+		Row:        7,
+		Col:        7,
+	}
+}
+
+func receive(t *testing.T, subscription *Subscription) Notification {
+	t.Helper()
+
+	select {
+	case notification := <-subscription.C:
+		return notification
+	case <-time.After(5 * time.Second):
+		t.Fatal("no notification received")
+		return Notification{}
+	}
+}
+
+func TestBusListen(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	require.NoError(t, fixtures.Load())
+
+	bus := NewBus(db, ModeListen, DefaultPollInterval)
+	listening := make(chan struct{})
+	bus.onListen = func() { close(listening) }
+
+	reservations := bus.Subscribe(ReservationEvents...)
+	defer reservations.Close()
+	purchases := bus.Subscribe(PurchaseEvents...)
+	defer purchases.Close()
+	sporedCache := bus.Subscribe(SporedCacheEvents...)
+	defer sporedCache.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go bus.Run(ctx)
+	<-listening
+
+	reservation := newTestReservation()
+	require.NoError(t, reservation.Create(db))
+
+	notification := receive(t, reservations)
+	assert.Equal(t, models.EventReservationCreated, notification.Type)
+	assert.Equal(t, reservation.ID, notification.ReservationID)
+	assert.Equal(t, &reservation.TimeSlotID, notification.TimeSlotID)
+	assert.Nil(t, notification.PurchaseID)
+
+	purchase := models.Purchase{
+		ID:                uuid.New(),
+		ReservationID:     reservation.ID,
+		Type:              models.Snack,
+		Name:              "Water",
+		Count:             1,
+		PricePerItemCents: 200,
+	}
+	require.NoError(t, purchase.Create(db))
+
+	notification = receive(t, purchases)
+	assert.Equal(t, models.EventPurchaseCreated, notification.Type)
+	assert.Equal(t, reservation.ID, notification.ReservationID)
+	assert.Equal(t, &purchase.ID, notification.PurchaseID)
+
+	require.NoError(t, models.NotifySporedRoomInvalidated(db, reservation.TheaterID, reservation.RoomID))
+
+	notification = receive(t, sporedCache)
+	assert.Equal(t, models.ChangeSporedRoomInvalidated, notification.Type)
+	assert.Equal(t, &reservation.TheaterID, notification.TheaterID)
+	assert.Equal(t, &reservation.RoomID, notification.RoomID)
+
+	// Changes of a rolled back transaction are never announced.
+	tx := db.Begin()
+	rolledBack := newTestReservation()
+	rolledBack.Row = 8
+	require.NoError(t, rolledBack.Create(tx))
+	require.NoError(t, tx.Rollback().Error)
+
+	require.NoError(t, models.DeleteReservation(db, reservation.ID))
+
+	notification = receive(t, reservations)
+	assert.Equal(t, models.EventReservationCancelled, notification.Type)
+	assert.Equal(t, reservation.ID, notification.ReservationID)
+	assert.Empty(t, purchases.C)
+}
+
+func TestBusPoll(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	require.NoError(t, fixtures.Load())
+
+	existing := newTestReservation()
+	require.NoError(t, existing.Create(db))
+
+	bus := NewBus(db, ModePoll, DefaultPollInterval)
+	subscription := bus.Subscribe()
+	defer subscription.Close()
+
+	require.NoError(t, bus.Poll(t.Context()))
+	assert.Empty(t, subscription.C, "events from before the first poll are not announced")
+
+	reservation := newTestReservation()
+	reservation.Row = 8
+	require.NoError(t, reservation.Create(db))
+
+	reservation.Col = 8
+	require.NoError(t, reservation.Save(db))
+
+	require.NoError(t, bus.Poll(t.Context()))
+	assert.Equal(t, models.EventReservationCreated, receive(t, subscription).Type)
+	assert.Equal(t, models.EventReservationUpdated, receive(t, subscription).Type)
+
+	// Events are only announced once.
+	require.NoError(t, bus.Poll(t.Context()))
+	assert.Empty(t, subscription.C)
+
+	// Events of a transaction that commits after later transactions are
+	// announced once it commits.
+	tx := db.Begin()
+	late := newTestReservation()
+	late.Row = 9
+	require.NoError(t, late.Create(tx))
+
+	early := newTestReservation()
+	early.Row = 10
+	require.NoError(t, early.Create(db))
+
+	require.NoError(t, bus.Poll(t.Context()))
+	assert.Empty(t, subscription.C, "events are held back while an older transaction runs")
+
+	require.NoError(t, tx.Commit().Error)
+	require.NoError(t, bus.Poll(t.Context()))
+	assert.Equal(t, late.ID, receive(t, subscription).ReservationID)
+	assert.Equal(t, early.ID, receive(t, subscription).ReservationID)
+	assert.Empty(t, subscription.C)
+}
+
+func TestSubscriptionResync(t *testing.T) {
+	bus := NewBus(nil, ModeListen, DefaultPollInterval)
+	subscription := bus.Subscribe(ReservationEvents...)
+
+	for range subscriberBuffer + 1 {
+		bus.publish(Notification{Type: models.EventReservationCreated})
+	}
+	bus.publish(Notification{Type: models.EventPurchaseCreated})
+
+	for range subscriberBuffer {
+		assert.Equal(t, models.EventReservationCreated, (<-subscription.C).Type)
+	}
+	assert.Empty(t, subscription.C)
+
+	// The missed notification is made up for with a resync.
+	bus.publish(Notification{Type: models.EventReservationUpdated})
+	assert.Equal(t, TypeResync, (<-subscription.C).Type)
+	assert.Equal(t, models.EventReservationUpdated, (<-subscription.C).Type)
+	assert.Empty(t, subscription.C)
+
+	subscription.Close()
+	_, open := <-subscription.C
+	assert.False(t, open)
+	assert.Empty(t, bus.subscribers)
+}
@@ -0,0 +1,309 @@
+package seats
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/notify"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPollInterval = time.Second
+
+	// subscriberBuffer is how many batches of changes a subscriber may fall
+	// behind before it is dropped.
+	subscriberBuffer = 16
+)
+
+type State string
+
+const (
+	StateReserved State = "reserved"
+	StateHeld     State = "held"
+	StateReleased State = "released"
+)
+
+type Seat struct {
+	Row int
+	Col int
+}
+
+// Change tells that a seat was reserved, held or released.
+type Change struct {
+	Seat
+	State State
+}
+
+// Subscription receives the seat changes of one time slot, starting from
+// Snapshot, which lists the reserved and held seats ordered by row and column.
+// Changes is closed when the subscriber fell too far behind, it then has to
+// subscribe again to get a fresh snapshot.
+type Subscription struct {
+	Snapshot []Change
+	Changes  <-chan []Change
+
+	close func()
+}
+
+// Close stops the subscription.
+func (s *Subscription) Close() {
+	s.close()
+}
+
+// Watcher tells subscribers when seats of the time slots they watch are
+// reserved, held or released. It polls the reservations and seat holds of
+// every watched time slot from the database and compares them with the
+// previous poll, so changes made by any replica and expired holds are seen.
+// Time slots are only polled while they have subscribers.
+type Watcher struct {
+	db       *gorm.DB
+	interval time.Duration
+	wake     chan struct{}
+
+	// pollMu keeps polls in order, so an older result never replaces a newer
+	// one.
+	pollMu sync.Mutex
+
+	mu    sync.Mutex
+	slots map[uuid.UUID]*watchedSlot
+}
+
+type watchedSlot struct {
+	seats       map[Seat]State
+	subscribers map[chan []Change]struct{}
+}
+
+func NewWatcher(db *gorm.DB, interval time.Duration) *Watcher {
+	return &Watcher{
+		db:       db,
+		interval: interval,
+		wake:     make(chan struct{}, 1),
+		slots:    map[uuid.UUID]*watchedSlot{},
+	}
+}
+
+// Subscribe starts watching the seats of a time slot.
+func (w *Watcher) Subscribe(ctx context.Context, timeSlotID uuid.UUID) (*Subscription, error) {
+	w.mu.Lock()
+	_, watched := w.slots[timeSlotID]
+	w.mu.Unlock()
+
+	var loaded map[Seat]State
+	if !watched {
+		states, err := w.load(ctx, []uuid.UUID{timeSlotID})
+		if err != nil {
+			return nil, err
+		}
+		loaded = states[timeSlotID]
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	slot, ok := w.slots[timeSlotID]
+	if !ok {
+		slot = &watchedSlot{
+			seats:       loaded,
+			subscribers: map[chan []Change]struct{}{},
+		}
+		w.slots[timeSlotID] = slot
+	}
+
+	changes := make(chan []Change, subscriberBuffer)
+	slot.subscribers[changes] = struct{}{}
+
+	return &Subscription{
+		Snapshot: snapshot(slot.seats),
+		Changes:  changes,
+		close: func() {
+			w.unsubscribe(timeSlotID, slot, changes)
+		},
+	}, nil
+}
+
+func (w *Watcher) unsubscribe(timeSlotID uuid.UUID, slot *watchedSlot, changes chan []Change) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, ok := slot.subscribers[changes]; ok {
+		delete(slot.subscribers, changes)
+		close(changes)
+	}
+
+	if len(slot.subscribers) == 0 && w.slots[timeSlotID] == slot {
+		delete(w.slots, timeSlotID)
+	}
+}
+
+// Run polls the watched time slots every interval, and whenever it is woken,
+// until the context is cancelled.
+func (w *Watcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.wake:
+		}
+
+		if err := w.Poll(ctx); err != nil {
+			slog.Error("failed to poll reserved seats", "err", err)
+		}
+	}
+}
+
+// Wake makes Run poll without waiting for the next interval.
+func (w *Watcher) Wake() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Follow wakes the watcher whenever the bus announces a reservation or seat
+// hold change, until the context is cancelled, so changes reach subscribers
+// without waiting for the next interval.
+func (w *Watcher) Follow(ctx context.Context, bus *notify.Bus) {
+	subscription := bus.Subscribe(slices.Concat(notify.ReservationEvents, notify.SeatHoldEvents)...)
+	defer subscription.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-subscription.C:
+			w.Wake()
+		}
+	}
+}
+
+// Poll loads the reserved and held seats of every watched time slot and sends
+// the seats that changed since the previous poll to the subscribers.
+func (w *Watcher) Poll(ctx context.Context) error {
+	w.pollMu.Lock()
+	defer w.pollMu.Unlock()
+
+	w.mu.Lock()
+	watched := maps.Clone(w.slots)
+	w.mu.Unlock()
+
+	if len(watched) == 0 {
+		return nil
+	}
+
+	states, err := w.load(ctx, slices.Collect(maps.Keys(watched)))
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for timeSlotID, slot := range watched {
+		if w.slots[timeSlotID] != slot {
+			continue
+		}
+
+		current := states[timeSlotID]
+		changes := Diff(slot.seats, current)
+		slot.seats = current
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		for subscriber := range slot.subscribers {
+			select {
+			case subscriber <- changes:
+			default:
+				delete(slot.subscribers, subscriber)
+				close(subscriber)
+			}
+		}
+	}
+
+	return nil
+}
+
+// load returns the state of the reserved and held seats of the time slots.
+// A reserved seat counts as reserved even if it is still held.
+func (w *Watcher) load(ctx context.Context, timeSlotIDs []uuid.UUID) (map[uuid.UUID]map[Seat]State, error) {
+	tx := w.db.WithContext(ctx)
+
+	reservations, err := models.GetTimeSlotsReservedSeats(tx, timeSlotIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	holds, err := models.GetTimeSlotsHeldSeats(tx, timeSlotIDs, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	states := map[uuid.UUID]map[Seat]State{}
+	for _, timeSlotID := range timeSlotIDs {
+		states[timeSlotID] = map[Seat]State{}
+	}
+
+	for _, hold := range holds {
+		states[hold.TimeSlotID][Seat{Row: hold.Row, Col: hold.Col}] = StateHeld
+	}
+
+	for _, reservation := range reservations {
+		states[reservation.TimeSlotID][Seat{Row: reservation.Row, Col: reservation.Col}] = StateReserved
+	}
+
+	return states, nil
+}
+
+// Diff returns the seats whose state changed between two polls, ordered by row
+// and column. Seats missing from after were released.
+func Diff(before, after map[Seat]State) []Change {
+	var changes []Change
+
+	for seat, state := range after {
+		if before[seat] != state {
+			changes = append(changes, Change{Seat: seat, State: state})
+		}
+	}
+
+	for seat := range before {
+		if _, ok := after[seat]; !ok {
+			changes = append(changes, Change{Seat: seat, State: StateReleased})
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b Change) int {
+		return compareSeats(a.Seat, b.Seat)
+	})
+
+	return changes
+}
+
+func snapshot(seats map[Seat]State) []Change {
+	changes := make([]Change, 0, len(seats))
+	for seat, state := range seats {
+		changes = append(changes, Change{Seat: seat, State: state})
+	}
+
+	slices.SortFunc(changes, func(a, b Change) int {
+		return compareSeats(a.Seat, b.Seat)
+	})
+
+	return changes
+}
+
+func compareSeats(a, b Seat) int {
+	return cmp.Or(cmp.Compare(a.Row, b.Row), cmp.Compare(a.Col, b.Col))
+}
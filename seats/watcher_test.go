@@ -0,0 +1,139 @@
+package seats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	before := map[Seat]State{{Row: 1, Col: 1}: StateReserved, {Row: 2, Col: 5}: StateReserved, {Row: 4, Col: 4}: StateHeld}
+	after := map[Seat]State{{Row: 2, Col: 5}: StateReserved, {Row: 1, Col: 3}: StateReserved, {Row: 3, Col: 1}: StateHeld, {Row: 4, Col: 4}: StateReserved}
+
+	assert.Equal(t, []Change{
+		{Seat: Seat{Row: 1, Col: 1}, State: StateReleased},
+		{Seat: Seat{Row: 1, Col: 3}, State: StateReserved},
+		{Seat: Seat{Row: 3, Col: 1}, State: StateHeld},
+		{Seat: Seat{Row: 4, Col: 4}, State: StateReserved},
+	}, Diff(before, after))
+
+	assert.Empty(t, Diff(after, after))
+}
+
+func TestWatcher(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	require.NoError(t, fixtures.Load())
+
+	timeSlotID := uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295")
+	watcher := NewWatcher(db, DefaultPollInterval)
+
+	subscription, err := watcher.Subscribe(t.Context(), timeSlotID)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Seat: Seat{Row: 5, Col: 10}, State: StateReserved},
+		{Seat: Seat{Row: 8, Col: 8}, State: StateHeld},
+	}, subscription.Snapshot)
+
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	reservation := models.Reservation{
+		ID:         uuid.New(),
+		TimeSlotID: timeSlotID,
+		TheaterID:  uuid.MustParse("bae209f6-d059-11f0-b2a4-cbf992c2eb6d"),
+		RoomID:     uuid.MustParse("925c2358-df46-11f0-a38e-abe580bde3d1"),
+		UserID:     &userID,
+		Type:       models.Online,
+		Row:        7,
+		Col:        7,
+	}
+	require.NoError(t, reservation.Create(db))
+
+	require.NoError(t, watcher.Poll(t.Context()))
+	assert.Equal(t, []Change{{Seat: Seat{Row: 7, Col: 7}, State: StateReserved}}, <-subscription.Changes)
+
+	require.NoError(t, models.DeleteReservation(db, reservation.ID))
+
+	require.NoError(t, watcher.Poll(t.Context()))
+	assert.Equal(t, []Change{{Seat: Seat{Row: 7, Col: 7}, State: StateReleased}}, <-subscription.Changes)
+
+	owner := "user:" + userID.String()
+	hold := models.SeatHold{
+		ID:         uuid.New(),
+		ExpiresAt:  time.Now().Add(models.SeatHoldTTL),
+		TimeSlotID: timeSlotID,
+		TheaterID:  reservation.TheaterID,
+		RoomID:     reservation.RoomID,
+		Row:        7,
+		Col:        8,
+		Owner:      owner,
+	}
+	claimed, err := models.ClaimSeatHold(db, &hold, time.Now())
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	require.NoError(t, watcher.Poll(t.Context()))
+	assert.Equal(t, []Change{{Seat: Seat{Row: 7, Col: 8}, State: StateHeld}}, <-subscription.Changes)
+
+	// Reserving the seat uses the hold up.
+	taken, err := models.TakeSeatHold(db, timeSlotID, 7, 8, owner, time.Now())
+	require.NoError(t, err)
+	require.True(t, taken)
+	reservation.ID = uuid.New()
+	reservation.Col = 8
+	require.NoError(t, reservation.Create(db))
+
+	require.NoError(t, watcher.Poll(t.Context()))
+	assert.Equal(t, []Change{{Seat: Seat{Row: 7, Col: 8}, State: StateReserved}}, <-subscription.Changes)
+
+	// Someone else's hold cannot be taken.
+	taken, err = models.TakeSeatHold(db, timeSlotID, 8, 8, owner, time.Now())
+	require.NoError(t, err)
+	assert.False(t, taken)
+
+	// Expired holds free their seat.
+	require.NoError(t, db.Model(&models.SeatHold{}).Where("row = 8 AND col = 8").Update("expires_at", time.Now()).Error)
+
+	require.NoError(t, watcher.Poll(t.Context()))
+	assert.Equal(t, []Change{{Seat: Seat{Row: 8, Col: 8}, State: StateReleased}}, <-subscription.Changes)
+
+	// Nothing changed since the last poll.
+	require.NoError(t, watcher.Poll(t.Context()))
+	assert.Empty(t, subscription.Changes)
+
+	subscription.Close()
+	_, open := <-subscription.Changes
+	assert.False(t, open)
+	assert.Empty(t, watcher.slots)
+}
+
+func TestWatcherDropsSlowSubscribers(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	require.NoError(t, fixtures.Load())
+
+	timeSlotID := uuid.MustParse("9d71d7fd-d88e-41a1-86dc-21b7f2550295")
+	watcher := NewWatcher(db, DefaultPollInterval)
+
+	subscription, err := watcher.Subscribe(t.Context(), timeSlotID)
+	require.NoError(t, err)
+	defer subscription.Close()
+
+	// Pretend every poll sees a change by forgetting the reserved seats.
+	for range subscriberBuffer + 1 {
+		watcher.mu.Lock()
+		watcher.slots[timeSlotID].seats = map[Seat]State{}
+		watcher.mu.Unlock()
+
+		require.NoError(t, watcher.Poll(t.Context()))
+	}
+
+	received := 0
+	for range subscription.Changes {
+		received++
+	}
+	assert.Equal(t, subscriberBuffer, received)
+}
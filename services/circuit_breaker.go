@@ -0,0 +1,102 @@
+package services
+
+import (
+	"sync"
+	"time"
+)
+
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"
+	CircuitOpen     CircuitState = "open"
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+// CircuitBreaker stops calls to a dependency after Threshold consecutive
+// failures. Once Cooldown has passed a single probe call is let through; if it
+// succeeds the circuit closes again, otherwise it stays open for another
+// cooldown.
+type CircuitBreaker struct {
+	Threshold int
+	Cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+	now      func() time.Time
+}
+
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		Threshold: threshold,
+		Cooldown:  cooldown,
+		state:     CircuitClosed,
+		now:       time.Now,
+	}
+}
+
+// Allow reports whether a call may be made. When it may not, it also returns
+// how long until the circuit will let a probe call through.
+func (b *CircuitBreaker) Allow() (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		elapsed := b.now().Sub(b.openedAt)
+		if elapsed < b.Cooldown {
+			return false, b.Cooldown - elapsed
+		}
+		b.state = CircuitHalfOpen
+		b.probing = true
+		return true, 0
+	case CircuitHalfOpen:
+		if b.probing {
+			return false, b.Cooldown
+		}
+		b.probing = true
+		return true, 0
+	}
+
+	return true, 0
+}
+
+func (b *CircuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = CircuitClosed
+	b.failures = 0
+	b.probing = false
+}
+
+func (b *CircuitBreaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.state == CircuitHalfOpen || b.failures >= b.Threshold {
+		b.state = CircuitOpen
+		b.openedAt = b.now()
+	}
+}
+
+// Release ends a call that told nothing about the dependency, for example
+// because the caller gave up on it, so that another probe may be made.
+func (b *CircuitBreaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
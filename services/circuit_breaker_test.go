@@ -0,0 +1,55 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	breaker := NewCircuitBreaker(3, 30*time.Second)
+
+	now := time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC)
+	breaker.now = func() time.Time { return now }
+
+	breaker.Failure()
+	breaker.Failure()
+	breaker.Success()
+	breaker.Failure()
+	breaker.Failure()
+	assert.Equal(t, CircuitClosed, breaker.State(), "successes reset the failure count")
+
+	breaker.Failure()
+	assert.Equal(t, CircuitOpen, breaker.State())
+
+	now = now.Add(10 * time.Second)
+	allowed, retryAfter := breaker.Allow()
+	assert.False(t, allowed)
+	assert.Equal(t, 20*time.Second, retryAfter)
+
+	now = now.Add(20 * time.Second)
+	allowed, _ = breaker.Allow()
+	assert.True(t, allowed)
+	assert.Equal(t, CircuitHalfOpen, breaker.State())
+
+	allowed, _ = breaker.Allow()
+	assert.False(t, allowed, "only one probe is let through")
+
+	breaker.Failure()
+	assert.Equal(t, CircuitOpen, breaker.State(), "a failed probe opens the circuit again")
+
+	now = now.Add(30 * time.Second)
+	allowed, _ = breaker.Allow()
+	assert.True(t, allowed)
+
+	breaker.Release()
+	allowed, _ = breaker.Allow()
+	assert.True(t, allowed, "a released probe lets another one through")
+
+	breaker.Success()
+	assert.Equal(t, CircuitClosed, breaker.State())
+
+	allowed, _ = breaker.Allow()
+	assert.True(t, allowed)
+}
@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultJWKSRefreshInterval    = 15 * time.Minute
+	DefaultJWKSMinRefreshInterval = 30 * time.Second
+	DefaultJWKSTimeout            = 5 * time.Second
+)
+
+var (
+	// ErrUnknownKey is returned when a token is signed with a key that is not
+	// in the key set, even after refreshing it.
+	ErrUnknownKey = errors.New("unknown signing key")
+	// ErrKeysUnavailable is returned when the key set has never been loaded
+	// successfully.
+	ErrKeysUnavailable = errors.New("signing keys unavailable")
+)
+
+// KeySet returns the public key a token was signed with by its key ID.
+type KeySet interface {
+	Key(ctx context.Context, keyID string) (crypto.PublicKey, error)
+}
+
+// StaticKeySet is a single public key that verifies every token regardless of
+// its key ID.
+type StaticKeySet struct {
+	key crypto.PublicKey
+}
+
+// LoadPublicKeyFile reads a PEM encoded PKIX or PKCS #1 public key.
+func LoadPublicKeyFile(path string) (*StaticKeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found in %s", path)
+	}
+
+	var key crypto.PublicKey
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q in %s", block.Type, path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &StaticKeySet{key: key}, nil
+}
+
+func (s *StaticKeySet) Key(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	return s.key, nil
+}
+
+// JWKSKeySet serves keys from a JSON Web Key Set fetched over HTTP. The set is
+// refetched every RefreshInterval, and as soon as a token with an unknown key
+// ID arrives so rotated keys are picked up, but not more often than
+// MinRefreshInterval. When a refresh fails the previously fetched keys keep
+// being used.
+type JWKSKeySet struct {
+	URL                string
+	Client             *http.Client
+	RefreshInterval    time.Duration
+	MinRefreshInterval time.Duration
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+	now       func() time.Time
+}
+
+func NewJWKSKeySet(url string, refreshInterval time.Duration) *JWKSKeySet {
+	return &JWKSKeySet{
+		URL:                url,
+		Client:             &http.Client{Timeout: DefaultJWKSTimeout},
+		RefreshInterval:    refreshInterval,
+		MinRefreshInterval: DefaultJWKSMinRefreshInterval,
+		now:                time.Now,
+	}
+}
+
+func (s *JWKSKeySet) Key(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.keys == nil || s.now().Sub(s.fetchedAt) >= s.RefreshInterval {
+		s.refresh(ctx)
+	}
+	if s.keys == nil {
+		return nil, ErrKeysUnavailable
+	}
+
+	key, ok := s.keys[keyID]
+	if !ok && s.now().Sub(s.fetchedAt) >= s.MinRefreshInterval {
+		s.refresh(ctx)
+		key, ok = s.keys[keyID]
+	}
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	return key, nil
+}
+
+func (s *JWKSKeySet) refresh(ctx context.Context) {
+	keys, err := s.fetch(ctx)
+	if err != nil {
+		slog.Warn("failed to fetch JWKS", "url", s.URL, "err", err)
+		return
+	}
+
+	s.keys = keys
+	s.fetchedAt = s.now()
+}
+
+func (s *JWKSKeySet) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&set)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			slog.Warn("skipping JWKS key", "kid", jwk.KeyID, "err", err)
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+
+	return keys, nil
+}
+
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	Curve   string `json:"crv"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBase64URL(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64URL(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+
+		x, err := decodeBase64URL(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64URL(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) > size || len(y) > size {
+			return nil, errors.New("invalid EC key coordinates")
+		}
+
+		point := make([]byte, 1+2*size)
+		point[0] = 4
+		new(big.Int).SetBytes(x).FillBytes(point[1 : 1+size])
+		new(big.Int).SetBytes(y).FillBytes(point[1+size:])
+
+		return ecdsa.ParseUncompressedPublicKey(curve, point)
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+
+		x, err := decodeBase64URL(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.KeyType)
+	}
+}
+
+func decodeBase64URL(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(value)
+}
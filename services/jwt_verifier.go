@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/clients/auth/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const DefaultJWTLeeway = 30 * time.Second
+
+// UserClaims are the claims of access tokens issued by the auth service. The
+// subject is the user ID.
+type UserClaims struct {
+	jwt.RegisteredClaims
+	Email     string                `json:"email"`
+	FirstName string                `json:"first_name"`
+	LastName  string                `json:"last_name"`
+	Role      models.ModelsUserRole `json:"role"`
+}
+
+// JWTVerifier verifies access tokens locally against the auth service's public
+// keys instead of asking the auth service about every token. Issuer and
+// Audience are only checked when set.
+type JWTVerifier struct {
+	Keys     KeySet
+	Issuer   string
+	Audience string
+	Leeway   time.Duration
+}
+
+func NewJWTVerifier(keys KeySet) *JWTVerifier {
+	return &JWTVerifier{
+		Keys:   keys,
+		Leeway: DefaultJWTLeeway,
+	}
+}
+
+var jwtSigningMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+var userRoles = []models.ModelsUserRole{
+	models.ModelsUserRoleCustomer,
+	models.ModelsUserRoleEmployee,
+	models.ModelsUserRoleAdmin,
+}
+
+// Verify checks the token's signature and claims and returns the user it was
+// issued to. Errors wrap ErrUnknownKey or ErrKeysUnavailable when the token
+// could not be checked because its signing key is not available.
+func (v *JWTVerifier) Verify(ctx context.Context, token string) (*models.APIUserResponse, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(jwtSigningMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(v.Leeway),
+	}
+	if v.Issuer != "" {
+		options = append(options, jwt.WithIssuer(v.Issuer))
+	}
+	if v.Audience != "" {
+		options = append(options, jwt.WithAudience(v.Audience))
+	}
+
+	var claims UserClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(token *jwt.Token) (any, error) {
+		keyID, _ := token.Header["kid"].(string)
+		return v.Keys.Key(ctx, keyID)
+	}, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subject: %w", err)
+	}
+
+	if !slices.Contains(userRoles, claims.Role) {
+		return nil, fmt.Errorf("invalid role %q", claims.Role)
+	}
+
+	return &models.APIUserResponse{
+		ID:        userID.String(),
+		Email:     claims.Email,
+		FirstName: claims.FirstName,
+		LastName:  claims.LastName,
+		Role:      claims.Role,
+		Active:    true,
+	}, nil
+}
+
+// IsKeyUnavailable reports whether err means the token's signing key could not
+// be found, as opposed to the token being invalid.
+func IsKeyUnavailable(err error) bool {
+	return errors.Is(err, ErrUnknownKey) || errors.Is(err, ErrKeysUnavailable)
+}
@@ -0,0 +1,362 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/clients/auth/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jwksServer serves a mutable JSON Web Key Set and counts how often it is
+// fetched.
+type jwksServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	keys     []map[string]string
+	fail     bool
+	requests int
+}
+
+func newJWKSServer(t *testing.T) *jwksServer {
+	server := &jwksServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+
+		server.requests++
+		if server.fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": server.keys})
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func (s *jwksServer) setKeys(keys ...map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *jwksServer) setFail(fail bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fail = fail
+}
+
+func (s *jwksServer) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func encodeBase64URL(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func rsaJWK(keyID string, key *rsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"kid": keyID,
+		"use": "sig",
+		"n":   encodeBase64URL(key.N.Bytes()),
+		"e":   encodeBase64URL(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(keyID string, key *ecdsa.PrivateKey) map[string]string {
+	point, err := key.PublicKey.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	size := (len(point) - 1) / 2
+
+	return map[string]string{
+		"kty": "EC",
+		"kid": keyID,
+		"crv": key.Curve.Params().Name,
+		"x":   encodeBase64URL(point[1 : 1+size]),
+		"y":   encodeBase64URL(point[1+size:]),
+	}
+}
+
+func ed25519JWK(keyID string, key ed25519.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "OKP",
+		"kid": keyID,
+		"crv": "Ed25519",
+		"x":   encodeBase64URL(key),
+	}
+}
+
+func validClaims(userID uuid.UUID) UserClaims {
+	return UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID.String(),
+			Issuer:    "auth",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Email:     "test@example.com",
+		FirstName: "Test",
+		LastName:  "User",
+		Role:      models.ModelsUserRoleEmployee,
+	}
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, key crypto.PrivateKey, keyID string, claims jwt.Claims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = keyID
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestJWTVerifier(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherRSAKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edPublicKey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	server := newJWKSServer(t)
+	server.setKeys(
+		rsaJWK("rsa", &rsaKey.PublicKey),
+		ecJWK("ec", ecKey),
+		ed25519JWK("ed", edPublicKey),
+		map[string]string{"kty": "RSA", "kid": "enc", "use": "enc"},
+	)
+
+	verifier := NewJWTVerifier(NewJWKSKeySet(server.URL, time.Hour))
+	verifier.Issuer = "auth"
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name  string
+		token func() string
+		err   error
+	}{
+		{
+			name: "rsa",
+			token: func() string {
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa", validClaims(userID))
+			},
+		},
+		{
+			name: "ecdsa",
+			token: func() string {
+				return signToken(t, jwt.SigningMethodES256, ecKey, "ec", validClaims(userID))
+			},
+		},
+		{
+			name: "ed25519",
+			token: func() string {
+				return signToken(t, jwt.SigningMethodEdDSA, edKey, "ed", validClaims(userID))
+			},
+		},
+		{
+			name: "expired",
+			token: func() string {
+				claims := validClaims(userID)
+				claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa", claims)
+			},
+			err: jwt.ErrTokenExpired,
+		},
+		{
+			name: "no-expiry",
+			token: func() string {
+				claims := validClaims(userID)
+				claims.ExpiresAt = nil
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa", claims)
+			},
+			err: jwt.ErrTokenRequiredClaimMissing,
+		},
+		{
+			name: "wrong-issuer",
+			token: func() string {
+				claims := validClaims(userID)
+				claims.Issuer = "someone-else"
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa", claims)
+			},
+			err: jwt.ErrTokenInvalidIssuer,
+		},
+		{
+			name: "wrong-key",
+			token: func() string {
+				return signToken(t, jwt.SigningMethodRS256, otherRSAKey, "rsa", validClaims(userID))
+			},
+			err: jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			name: "symmetric",
+			token: func() string {
+				return signToken(t, jwt.SigningMethodHS256, []byte("secret"), "rsa", validClaims(userID))
+			},
+			err: jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			name: "unknown-key",
+			token: func() string {
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, "unknown", validClaims(userID))
+			},
+			err: ErrUnknownKey,
+		},
+		{
+			name: "encryption-key",
+			token: func() string {
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, "enc", validClaims(userID))
+			},
+			err: ErrUnknownKey,
+		},
+		{
+			name: "invalid-subject",
+			token: func() string {
+				claims := validClaims(userID)
+				claims.Subject = "user"
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa", claims)
+			},
+		},
+		{
+			name: "invalid-role",
+			token: func() string {
+				claims := validClaims(userID)
+				claims.Role = "superuser"
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa", claims)
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			user, err := verifier.Verify(t.Context(), testCase.token())
+
+			switch {
+			case testCase.err != nil:
+				assert.ErrorIs(t, err, testCase.err)
+				assert.Equal(t, testCase.err == ErrUnknownKey, IsKeyUnavailable(err))
+			case testCase.name == "invalid-subject" || testCase.name == "invalid-role":
+				assert.Error(t, err)
+				assert.False(t, IsKeyUnavailable(err))
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, &models.APIUserResponse{
+					ID:        userID.String(),
+					Email:     "test@example.com",
+					FirstName: "Test",
+					LastName:  "User",
+					Role:      models.ModelsUserRoleEmployee,
+					Active:    true,
+				}, user)
+			}
+		})
+	}
+}
+
+func TestJWKSKeySetRotation(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	server := newJWKSServer(t)
+	server.setKeys(rsaJWK("old", &oldKey.PublicKey))
+
+	keys := NewJWKSKeySet(server.URL, time.Hour)
+	keys.MinRefreshInterval = time.Minute
+	now := time.Now()
+	keys.now = func() time.Time { return now }
+	verifier := NewJWTVerifier(keys)
+
+	userID := uuid.New()
+	oldToken := signToken(t, jwt.SigningMethodRS256, oldKey, "old", validClaims(userID))
+	newToken := signToken(t, jwt.SigningMethodRS256, newKey, "new", validClaims(userID))
+
+	_, err = verifier.Verify(t.Context(), oldToken)
+	require.NoError(t, err)
+	_, err = verifier.Verify(t.Context(), oldToken)
+	require.NoError(t, err)
+	assert.Equal(t, 1, server.requestCount(), "keys are cached")
+
+	server.setKeys(rsaJWK("old", &oldKey.PublicKey), rsaJWK("new", &newKey.PublicKey))
+
+	_, err = verifier.Verify(t.Context(), newToken)
+	assert.ErrorIs(t, err, ErrUnknownKey, "unknown keys do not refresh more often than MinRefreshInterval")
+	assert.Equal(t, 1, server.requestCount())
+
+	now = now.Add(time.Minute)
+	_, err = verifier.Verify(t.Context(), newToken)
+	require.NoError(t, err, "an unknown key refreshes the set")
+	assert.Equal(t, 2, server.requestCount())
+
+	server.setFail(true)
+	now = now.Add(2 * time.Hour)
+	_, err = verifier.Verify(t.Context(), oldToken)
+	assert.NoError(t, err, "keys are kept when a refresh fails")
+	assert.Equal(t, 3, server.requestCount())
+}
+
+func TestJWKSKeySetUnavailable(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	server := newJWKSServer(t)
+	server.setFail(true)
+
+	verifier := NewJWTVerifier(NewJWKSKeySet(server.URL, time.Hour))
+
+	_, err = verifier.Verify(t.Context(), signToken(t, jwt.SigningMethodRS256, key, "rsa", validClaims(uuid.New())))
+	assert.ErrorIs(t, err, ErrKeysUnavailable)
+	assert.True(t, IsKeyUnavailable(err))
+}
+
+func TestLoadPublicKeyFile(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "auth.pem")
+	err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600)
+	require.NoError(t, err)
+
+	keys, err := LoadPublicKeyFile(path)
+	require.NoError(t, err)
+
+	userID := uuid.New()
+	user, err := NewJWTVerifier(keys).Verify(t.Context(), signToken(t, jwt.SigningMethodES384, key, "", validClaims(userID)))
+	require.NoError(t, err)
+	assert.Equal(t, userID.String(), user.ID)
+
+	_, err = LoadPublicKeyFile(filepath.Join(t.TempDir(), "missing.pem"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+)
+
+const scheduleResolverConcurrency = 8
+
+type TimeSlotRef struct {
+	TimeSlotID uuid.UUID
+	TheaterID  uuid.UUID
+	RoomID     uuid.UUID
+}
+
+type RoomRef struct {
+	TheaterID uuid.UUID
+	RoomID    uuid.UUID
+}
+
+type ResolvedTimeSlot struct {
+	TimeSlot TimeSlotDetails
+	Movie    MovieInfo
+}
+
+// ScheduleResolver maps time slots to their schedule, movies and rooms. Lookups
+// for a batch are deduplicated and fetched from the service in parallel. It
+// keeps nothing itself, so results are only cached by the service (see
+// CachedTimeSlotService) and invalidating the service's cache is enough.
+type ScheduleResolver struct {
+	service TimeSlotService
+}
+
+func NewScheduleResolver(service TimeSlotService) *ScheduleResolver {
+	return &ScheduleResolver{
+		service: service,
+	}
+}
+
+// Resolve returns the resolved time slots keyed by time slot ID. Time slots or
+// movies that no longer exist in spored are left out of the result.
+func (r *ScheduleResolver) Resolve(ctx context.Context, refs []TimeSlotRef) (map[uuid.UUID]ResolvedTimeSlot, error) {
+	timeSlots, err := r.ResolveTimeSlots(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	movieIDs := []uuid.UUID{}
+	seen := map[uuid.UUID]bool{}
+	for _, timeSlot := range timeSlots {
+		if seen[timeSlot.MovieID] {
+			continue
+		}
+		seen[timeSlot.MovieID] = true
+		movieIDs = append(movieIDs, timeSlot.MovieID)
+	}
+
+	movies, err := r.ResolveMovies(ctx, movieIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make(map[uuid.UUID]ResolvedTimeSlot, len(timeSlots))
+	for id, timeSlot := range timeSlots {
+		movie, ok := movies[timeSlot.MovieID]
+		if !ok {
+			continue
+		}
+		resolved[id] = ResolvedTimeSlot{
+			TimeSlot: timeSlot,
+			Movie:    movie,
+		}
+	}
+
+	return resolved, nil
+}
+
+// ResolveTimeSlots returns the schedule of each time slot keyed by time slot
+// ID. Time slots that no longer exist in spored are left out of the result.
+func (r *ScheduleResolver) ResolveTimeSlots(ctx context.Context, refs []TimeSlotRef) (map[uuid.UUID]TimeSlotDetails, error) {
+	result := map[uuid.UUID]TimeSlotDetails{}
+	lookups := []TimeSlotRef{}
+	seen := map[uuid.UUID]bool{}
+
+	for _, ref := range refs {
+		if seen[ref.TimeSlotID] {
+			continue
+		}
+		seen[ref.TimeSlotID] = true
+
+		if ref.TheaterID == uuid.Nil || ref.RoomID == uuid.Nil {
+			slog.Warn("cannot resolve time slot without theater and room", "time_slot_id", ref.TimeSlotID)
+			continue
+		}
+		lookups = append(lookups, ref)
+	}
+
+	var mu sync.Mutex
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(scheduleResolverConcurrency)
+
+	for _, ref := range lookups {
+		g.Go(func() error {
+			timeSlot, err := r.service.GetTimeSlot(ctx, ref.TheaterID, ref.RoomID, ref.TimeSlotID)
+			if isNotFound(err) {
+				slog.Warn("time slot no longer exists in spored", "time_slot_id", ref.TimeSlotID)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			result[ref.TimeSlotID] = *timeSlot
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ResolveMovies returns the movies keyed by movie ID. Movies that no longer
+// exist in spored are left out of the result.
+func (r *ScheduleResolver) ResolveMovies(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]MovieInfo, error) {
+	result := map[uuid.UUID]MovieInfo{}
+	lookups := []uuid.UUID{}
+	seen := map[uuid.UUID]bool{}
+
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		lookups = append(lookups, id)
+	}
+
+	var mu sync.Mutex
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(scheduleResolverConcurrency)
+
+	for _, id := range lookups {
+		g.Go(func() error {
+			movie, err := r.service.GetMovie(ctx, id)
+			if isNotFound(err) {
+				slog.Warn("movie no longer exists in spored", "movie_id", id)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			result[id] = *movie
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ResolveRooms returns the rooms keyed by their reference. Rooms that no longer
+// exist in spored are left out of the result.
+func (r *ScheduleResolver) ResolveRooms(ctx context.Context, refs []RoomRef) (map[RoomRef]RoomInfo, error) {
+	result := map[RoomRef]RoomInfo{}
+	lookups := []RoomRef{}
+	seen := map[RoomRef]bool{}
+
+	for _, ref := range refs {
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+
+		if ref.TheaterID == uuid.Nil || ref.RoomID == uuid.Nil {
+			continue
+		}
+		lookups = append(lookups, ref)
+	}
+
+	var mu sync.Mutex
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(scheduleResolverConcurrency)
+
+	for _, ref := range lookups {
+		g.Go(func() error {
+			room, err := r.service.GetRoom(ctx, ref.TheaterID, ref.RoomID)
+			if isNotFound(err) {
+				slog.Warn("room no longer exists in spored", "theater_id", ref.TheaterID, "room_id", ref.RoomID)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			result[ref] = *room
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func isNotFound(err error) bool {
+	var httpError *middleware.HttpError
+	return errors.As(err, &httpError) && httpError.Code == http.StatusNotFound
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingTimeSlotService struct {
+	*MockTimeSlotService
+	timeSlotCalls atomic.Int32
+	movieCalls    atomic.Int32
+}
+
+func (s *countingTimeSlotService) GetTimeSlot(ctx context.Context, theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotDetails, error) {
+	s.timeSlotCalls.Add(1)
+	return s.MockTimeSlotService.GetTimeSlot(ctx, theaterID, roomID, timeSlotID)
+}
+
+func (s *countingTimeSlotService) GetMovie(ctx context.Context, movieID uuid.UUID) (*MovieInfo, error) {
+	s.movieCalls.Add(1)
+	return s.MockTimeSlotService.GetMovie(ctx, movieID)
+}
+
+func TestScheduleResolver(t *testing.T) {
+	mock := NewMockTimeSlotService()
+	service := &countingTimeSlotService{MockTimeSlotService: mock}
+
+	theaterID := uuid.New()
+	roomID := uuid.New()
+	movieID := uuid.New()
+	timeSlotID1 := uuid.New()
+	timeSlotID2 := uuid.New()
+	deletedTimeSlotID := uuid.New()
+	startTime := time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC)
+
+	mock.AddMovie(movieID, "Dune: Part Two", 166)
+	mock.SetTimeSlotSchedule(theaterID, roomID, timeSlotID1, movieID, startTime)
+	mock.SetTimeSlotSchedule(theaterID, roomID, timeSlotID2, movieID, startTime.Add(3*time.Hour))
+
+	refs := []TimeSlotRef{
+		{TimeSlotID: timeSlotID1, TheaterID: theaterID, RoomID: roomID},
+		{TimeSlotID: timeSlotID1, TheaterID: theaterID, RoomID: roomID},
+		{TimeSlotID: timeSlotID2, TheaterID: theaterID, RoomID: roomID},
+		{TimeSlotID: deletedTimeSlotID, TheaterID: theaterID, RoomID: roomID},
+		{TimeSlotID: uuid.New()},
+	}
+
+	resolver := NewScheduleResolver(service)
+
+	resolved, err := resolver.Resolve(t.Context(), refs)
+	require.NoError(t, err)
+
+	assert.Len(t, resolved, 2)
+	assert.Equal(t, "Dune: Part Two", resolved[timeSlotID1].Movie.Name)
+	assert.Equal(t, startTime, resolved[timeSlotID1].TimeSlot.StartTime)
+	assert.Equal(t, movieID, resolved[timeSlotID2].TimeSlot.MovieID)
+	assert.EqualValues(t, 3, service.timeSlotCalls.Load())
+	assert.EqualValues(t, 1, service.movieCalls.Load())
+}
+
+func TestScheduleResolverCachedService(t *testing.T) {
+	mock := NewMockTimeSlotService()
+	service := &countingTimeSlotService{MockTimeSlotService: mock}
+
+	theaterID := uuid.New()
+	roomID := uuid.New()
+	movieID := uuid.New()
+	timeSlotID := uuid.New()
+	startTime := time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC)
+
+	mock.AddMovie(movieID, "Dune: Part Two", 166)
+	mock.SetTimeSlotSchedule(theaterID, roomID, timeSlotID, movieID, startTime)
+
+	cache := NewCachedTimeSlotService(service, time.Minute, time.Second)
+	resolver := NewScheduleResolver(cache)
+	refs := []TimeSlotRef{{TimeSlotID: timeSlotID, TheaterID: theaterID, RoomID: roomID}}
+
+	_, err := resolver.Resolve(t.Context(), refs)
+	require.NoError(t, err)
+	_, err = resolver.Resolve(t.Context(), refs)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, service.timeSlotCalls.Load(), "lookups are cached by the service")
+	assert.EqualValues(t, 1, service.movieCalls.Load())
+
+	// Invalidating the service's cache is enough for the resolver to see a
+	// rescheduled time slot.
+	mock.SetTimeSlotSchedule(theaterID, roomID, timeSlotID, movieID, startTime.Add(time.Hour))
+	cache.InvalidateTimeSlot(timeSlotID)
+
+	resolved, err := resolver.Resolve(t.Context(), refs)
+	require.NoError(t, err)
+	assert.Equal(t, startTime.Add(time.Hour), resolved[timeSlotID].TimeSlot.StartTime)
+	assert.EqualValues(t, 2, service.timeSlotCalls.Load())
+}
+
+func TestScheduleResolverError(t *testing.T) {
+	mock := NewMockTimeSlotService()
+	mock.ShouldError = true
+
+	resolver := NewScheduleResolver(mock)
+
+	_, err := resolver.Resolve(t.Context(), []TimeSlotRef{{TimeSlotID: uuid.New(), TheaterID: uuid.New(), RoomID: uuid.New()}})
+	assert.Error(t, err)
+}
+
+func TestScheduleResolverRooms(t *testing.T) {
+	mock := NewMockTimeSlotService()
+
+	theaterID := uuid.New()
+	roomID := uuid.New()
+	mock.AddRoom(theaterID, roomID, "Dvorana 1", 10, 15)
+
+	resolver := NewScheduleResolver(mock)
+
+	room := RoomRef{TheaterID: theaterID, RoomID: roomID}
+	deletedRoom := RoomRef{TheaterID: theaterID, RoomID: uuid.New()}
+
+	rooms, err := resolver.ResolveRooms(t.Context(), []RoomRef{room, room, deletedRoom})
+	require.NoError(t, err)
+
+	assert.Len(t, rooms, 1)
+	assert.Equal(t, "Dvorana 1", rooms[room].Name)
+	assert.Equal(t, 150, rooms[room].Rows*rooms[room].Columns)
+}
@@ -0,0 +1,271 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/notify"
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	DefaultTimeSlotCacheTTL         = time.Minute
+	DefaultTimeSlotCacheNegativeTTL = 10 * time.Second
+
+	// timeSlotCacheMaxEntries bounds each kind of cached lookup, so that
+	// lookups of many distinct entities cannot grow the cache without end.
+	timeSlotCacheMaxEntries = 10000
+)
+
+// TimeSlotCache is implemented by time slot services that cache spored
+// lookups, so that callers that learn about changes in spored can drop stale
+// entries.
+type TimeSlotCache interface {
+	InvalidateTimeSlot(timeSlotID uuid.UUID)
+	InvalidateRoom(theaterID, roomID uuid.UUID)
+	InvalidateMovie(movieID uuid.UUID)
+	Purge()
+	Stats() TimeSlotCacheStats
+}
+
+type CacheStats struct {
+	Hits         uint64
+	NegativeHits uint64
+	Misses       uint64
+	Fetches      uint64
+	Entries      int
+}
+
+type TimeSlotCacheStats struct {
+	Validations CacheStats
+	TimeSlots   CacheStats
+	Rooms       CacheStats
+	Movies      CacheStats
+}
+
+// CachedTimeSlotService caches the lookups of another TimeSlotService.
+// Entities that spored reports as not found are cached for a shorter time, and
+// concurrent lookups of the same entity are merged into a single request.
+type CachedTimeSlotService struct {
+	service     TimeSlotService
+	negativeTTL time.Duration
+	group       singleflight.Group
+
+	validations *cachedLookup[TimeSlotRef, TimeSlotInfo]
+	timeSlots   *cachedLookup[TimeSlotRef, TimeSlotDetails]
+	rooms       *cachedLookup[RoomRef, RoomInfo]
+	movies      *cachedLookup[uuid.UUID, MovieInfo]
+}
+
+func NewCachedTimeSlotService(service TimeSlotService, ttl, negativeTTL time.Duration) *CachedTimeSlotService {
+	return &CachedTimeSlotService{
+		service:     service,
+		negativeTTL: negativeTTL,
+		validations: newCachedLookup[TimeSlotRef, TimeSlotInfo]("validation", ttl),
+		timeSlots:   newCachedLookup[TimeSlotRef, TimeSlotDetails]("time_slot", ttl),
+		rooms:       newCachedLookup[RoomRef, RoomInfo]("room", ttl),
+		movies:      newCachedLookup[uuid.UUID, MovieInfo]("movie", ttl),
+	}
+}
+
+func (s *CachedTimeSlotService) ValidateTimeSlotExists(ctx context.Context, theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotInfo, error) {
+	key := TimeSlotRef{TimeSlotID: timeSlotID, TheaterID: theaterID, RoomID: roomID}
+	return s.validations.get(ctx, s, key, func(ctx context.Context) (*TimeSlotInfo, error) {
+		return s.service.ValidateTimeSlotExists(ctx, theaterID, roomID, timeSlotID)
+	})
+}
+
+func (s *CachedTimeSlotService) GetTimeSlot(ctx context.Context, theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotDetails, error) {
+	key := TimeSlotRef{TimeSlotID: timeSlotID, TheaterID: theaterID, RoomID: roomID}
+	return s.timeSlots.get(ctx, s, key, func(ctx context.Context) (*TimeSlotDetails, error) {
+		return s.service.GetTimeSlot(ctx, theaterID, roomID, timeSlotID)
+	})
+}
+
+func (s *CachedTimeSlotService) GetRoom(ctx context.Context, theaterID, roomID uuid.UUID) (*RoomInfo, error) {
+	key := RoomRef{TheaterID: theaterID, RoomID: roomID}
+	return s.rooms.get(ctx, s, key, func(ctx context.Context) (*RoomInfo, error) {
+		return s.service.GetRoom(ctx, theaterID, roomID)
+	})
+}
+
+func (s *CachedTimeSlotService) GetMovie(ctx context.Context, movieID uuid.UUID) (*MovieInfo, error) {
+	return s.movies.get(ctx, s, movieID, func(ctx context.Context) (*MovieInfo, error) {
+		return s.service.GetMovie(ctx, movieID)
+	})
+}
+
+func (s *CachedTimeSlotService) InvalidateTimeSlot(timeSlotID uuid.UUID) {
+	matches := func(ref TimeSlotRef) bool {
+		return ref.TimeSlotID == timeSlotID
+	}
+	s.validations.invalidate(matches)
+	s.timeSlots.invalidate(matches)
+}
+
+// InvalidateRoom drops the room and every time slot validation in it, since
+// validations carry the size of the room.
+func (s *CachedTimeSlotService) InvalidateRoom(theaterID, roomID uuid.UUID) {
+	s.rooms.invalidate(func(ref RoomRef) bool {
+		return ref.TheaterID == theaterID && ref.RoomID == roomID
+	})
+	s.validations.invalidate(func(ref TimeSlotRef) bool {
+		return ref.TheaterID == theaterID && ref.RoomID == roomID
+	})
+}
+
+func (s *CachedTimeSlotService) InvalidateMovie(movieID uuid.UUID) {
+	s.movies.invalidate(func(id uuid.UUID) bool {
+		return id == movieID
+	})
+}
+
+func (s *CachedTimeSlotService) Purge() {
+	s.validations.purge()
+	s.timeSlots.purge()
+	s.rooms.purge()
+	s.movies.purge()
+}
+
+// Follow drops the entries that the bus announces as stale, until the context
+// is cancelled, so invalidations made through any replica apply to this one.
+// Everything is dropped when notifications may have been missed.
+func (s *CachedTimeSlotService) Follow(ctx context.Context, bus *notify.Bus) {
+	subscription := bus.Subscribe(notify.SporedCacheEvents...)
+	defer subscription.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case notification := <-subscription.C:
+			s.apply(notification)
+		}
+	}
+}
+
+func (s *CachedTimeSlotService) apply(notification notify.Notification) {
+	switch notification.Type {
+	case models.ChangeSporedTimeSlotInvalidated:
+		if notification.TimeSlotID != nil {
+			s.InvalidateTimeSlot(*notification.TimeSlotID)
+		}
+	case models.ChangeSporedRoomInvalidated:
+		if notification.TheaterID != nil && notification.RoomID != nil {
+			s.InvalidateRoom(*notification.TheaterID, *notification.RoomID)
+		}
+	case models.ChangeSporedCachePurged, notify.TypeResync:
+		s.Purge()
+	}
+}
+
+func (s *CachedTimeSlotService) Stats() TimeSlotCacheStats {
+	return TimeSlotCacheStats{
+		Validations: s.validations.stats(),
+		TimeSlots:   s.timeSlots.stats(),
+		Rooms:       s.rooms.stats(),
+		Movies:      s.movies.stats(),
+	}
+}
+
+type cachedResult[V any] struct {
+	value V
+	err   error
+}
+
+type cachedLookup[K comparable, V any] struct {
+	name    string
+	entries *ttlCache[K, cachedResult[V]]
+
+	// generation is bumped on every invalidation so that lookups that were
+	// already in flight do not store what they fetched before it.
+	generation atomic.Uint64
+
+	hits         atomic.Uint64
+	negativeHits atomic.Uint64
+	misses       atomic.Uint64
+	fetches      atomic.Uint64
+}
+
+func newCachedLookup[K comparable, V any](name string, ttl time.Duration) *cachedLookup[K, V] {
+	return &cachedLookup[K, V]{
+		name:    name,
+		entries: newTTLCache[K, cachedResult[V]](ttl, timeSlotCacheMaxEntries),
+	}
+}
+
+func (l *cachedLookup[K, V]) get(ctx context.Context, s *CachedTimeSlotService, key K, fetch func(ctx context.Context) (*V, error)) (*V, error) {
+	if result, ok := l.entries.Get(key); ok {
+		if result.err != nil {
+			l.negativeHits.Add(1)
+			return nil, result.err
+		}
+		l.hits.Add(1)
+		value := result.value
+		return &value, nil
+	}
+	l.misses.Add(1)
+
+	ch := s.group.DoChan(fmt.Sprintf("%s:%v", l.name, key), func() (any, error) {
+		generation := l.generation.Load()
+		l.fetches.Add(1)
+
+		// The fetch is shared by every caller waiting for this key, so it must
+		// not be cancelled when the caller that started it goes away. Callers
+		// that go away stop waiting for it instead.
+		value, err := fetch(context.WithoutCancel(ctx))
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		}
+
+		if l.generation.Load() == generation {
+			if err != nil {
+				l.entries.SetWithTTL(key, cachedResult[V]{err: err}, s.negativeTTL)
+			} else {
+				l.entries.Set(key, cachedResult[V]{value: *value})
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		return *value, nil
+	})
+
+	var shared singleflight.Result
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case shared = <-ch:
+	}
+	if shared.Err != nil {
+		return nil, shared.Err
+	}
+
+	result := shared.Val.(V)
+	return &result, nil
+}
+
+func (l *cachedLookup[K, V]) invalidate(match func(K) bool) {
+	l.generation.Add(1)
+	l.entries.DeleteFunc(match)
+}
+
+func (l *cachedLookup[K, V]) purge() {
+	l.generation.Add(1)
+	l.entries.Clear()
+}
+
+func (l *cachedLookup[K, V]) stats() CacheStats {
+	return CacheStats{
+		Hits:         l.hits.Load(),
+		NegativeHits: l.negativeHits.Load(),
+		Misses:       l.misses.Load(),
+		Fetches:      l.fetches.Load(),
+		Entries:      l.entries.Len(),
+	}
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/PRPO-skupina-02/nakup/notify"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gatedTimeSlotService counts calls to spored and, when gate is set, blocks
+// them until the gate is closed.
+type gatedTimeSlotService struct {
+	*MockTimeSlotService
+	gate            chan struct{}
+	validationCalls atomic.Int32
+	roomCalls       atomic.Int32
+}
+
+func (s *gatedTimeSlotService) wait() {
+	if s.gate != nil {
+		<-s.gate
+	}
+}
+
+func (s *gatedTimeSlotService) ValidateTimeSlotExists(ctx context.Context, theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotInfo, error) {
+	s.validationCalls.Add(1)
+	s.wait()
+	return s.MockTimeSlotService.ValidateTimeSlotExists(ctx, theaterID, roomID, timeSlotID)
+}
+
+func (s *gatedTimeSlotService) GetRoom(ctx context.Context, theaterID, roomID uuid.UUID) (*RoomInfo, error) {
+	s.roomCalls.Add(1)
+	s.wait()
+	return s.MockTimeSlotService.GetRoom(ctx, theaterID, roomID)
+}
+
+func TestCachedTimeSlotService(t *testing.T) {
+	ctx := t.Context()
+	mock := NewMockTimeSlotService()
+	service := &gatedTimeSlotService{MockTimeSlotService: mock}
+	cache := NewCachedTimeSlotService(service, time.Minute, 10*time.Second)
+
+	now := time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC)
+	cache.validations.entries.now = func() time.Time { return now }
+
+	theaterID := uuid.New()
+	roomID := uuid.New()
+	timeSlotID := uuid.New()
+	deletedTimeSlotID := uuid.New()
+	mock.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID, 12, 20)
+
+	info, err := cache.ValidateTimeSlotExists(ctx, theaterID, roomID, timeSlotID)
+	require.NoError(t, err)
+	assert.Equal(t, 12, info.Rows)
+
+	info.Rows = 1
+	info, err = cache.ValidateTimeSlotExists(ctx, theaterID, roomID, timeSlotID)
+	require.NoError(t, err)
+	assert.Equal(t, 12, info.Rows, "callers get a copy of the cached value")
+	assert.EqualValues(t, 1, service.validationCalls.Load())
+
+	_, err = cache.ValidateTimeSlotExists(ctx, theaterID, roomID, deletedTimeSlotID)
+	assert.Equal(t, middleware.NewNotFoundError(), err)
+	_, err = cache.ValidateTimeSlotExists(ctx, theaterID, roomID, deletedTimeSlotID)
+	assert.Equal(t, middleware.NewNotFoundError(), err)
+	assert.EqualValues(t, 2, service.validationCalls.Load(), "not found is cached")
+
+	now = now.Add(11 * time.Second)
+	_, err = cache.ValidateTimeSlotExists(ctx, theaterID, roomID, deletedTimeSlotID)
+	assert.Error(t, err)
+	_, err = cache.ValidateTimeSlotExists(ctx, theaterID, roomID, timeSlotID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, service.validationCalls.Load(), "not found expires sooner than found")
+
+	mock.ShouldError = true
+	_, err = cache.GetRoom(ctx, theaterID, uuid.New())
+	assert.Error(t, err)
+	mock.ShouldError = false
+	_, err = cache.GetRoom(ctx, theaterID, roomID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, service.roomCalls.Load(), "other errors are not cached")
+
+	assert.Equal(t, CacheStats{Hits: 2, NegativeHits: 1, Misses: 3, Fetches: 3, Entries: 2}, cache.Stats().Validations)
+	assert.Equal(t, CacheStats{Misses: 2, Fetches: 2, Entries: 1}, cache.Stats().Rooms)
+}
+
+func TestCachedTimeSlotServiceMergesConcurrentLookups(t *testing.T) {
+	ctx := t.Context()
+	mock := NewMockTimeSlotService()
+	service := &gatedTimeSlotService{MockTimeSlotService: mock, gate: make(chan struct{})}
+	cache := NewCachedTimeSlotService(service, time.Minute, time.Second)
+
+	theaterID := uuid.New()
+	roomID := uuid.New()
+	mock.AddRoom(theaterID, roomID, "Dvorana 1", 10, 15)
+
+	const lookups = 10
+
+	var wg sync.WaitGroup
+	rooms := make([]*RoomInfo, lookups)
+	for i := range lookups {
+		wg.Go(func() {
+			room, err := cache.GetRoom(ctx, theaterID, roomID)
+			assert.NoError(t, err)
+			rooms[i] = room
+		})
+	}
+
+	assert.Eventually(t, func() bool {
+		return cache.Stats().Rooms.Misses == lookups
+	}, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(service.gate)
+	wg.Wait()
+
+	assert.EqualValues(t, 1, service.roomCalls.Load())
+	for _, room := range rooms {
+		assert.Equal(t, "Dvorana 1", room.Name)
+	}
+	assert.EqualValues(t, 1, cache.Stats().Rooms.Fetches)
+}
+
+func TestCachedTimeSlotServiceInvalidation(t *testing.T) {
+	ctx := t.Context()
+	mock := NewMockTimeSlotService()
+	service := &gatedTimeSlotService{MockTimeSlotService: mock}
+	cache := NewCachedTimeSlotService(service, time.Minute, time.Second)
+
+	theaterID := uuid.New()
+	roomID := uuid.New()
+	timeSlotID1 := uuid.New()
+	timeSlotID2 := uuid.New()
+	mock.AddValidTimeSlot(theaterID, roomID, timeSlotID1)
+	mock.AddValidTimeSlot(theaterID, roomID, timeSlotID2)
+
+	lookup := func(timeSlotID uuid.UUID) int {
+		info, err := cache.ValidateTimeSlotExists(ctx, theaterID, roomID, timeSlotID)
+		require.NoError(t, err)
+		return info.Rows
+	}
+
+	lookup(timeSlotID1)
+	lookup(timeSlotID2)
+	assert.EqualValues(t, 2, service.validationCalls.Load())
+
+	cache.InvalidateTimeSlot(timeSlotID1)
+	lookup(timeSlotID1)
+	lookup(timeSlotID2)
+	assert.EqualValues(t, 3, service.validationCalls.Load(), "only the invalidated time slot is fetched again")
+
+	mock.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID1, 6, 6)
+	mock.AddValidTimeSlotWithRoom(theaterID, roomID, timeSlotID2, 6, 6)
+	cache.InvalidateRoom(theaterID, roomID)
+	assert.Equal(t, 6, lookup(timeSlotID1), "room changes invalidate the validations of the room")
+	assert.Equal(t, 6, lookup(timeSlotID2))
+	assert.EqualValues(t, 5, service.validationCalls.Load())
+
+	cache.Purge()
+	assert.Zero(t, cache.Stats().Validations.Entries)
+
+	// A lookup that is in flight while the cache is invalidated must not store
+	// the value it fetched before the invalidation.
+	service.gate = make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lookup(timeSlotID1)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return service.validationCalls.Load() == 6
+	}, time.Second, time.Millisecond)
+	cache.InvalidateTimeSlot(timeSlotID1)
+	close(service.gate)
+	<-done
+
+	service.gate = nil
+	lookup(timeSlotID1)
+	assert.EqualValues(t, 7, service.validationCalls.Load())
+}
+
+func TestCachedTimeSlotServiceApply(t *testing.T) {
+	ctx := t.Context()
+	mock := NewMockTimeSlotService()
+	service := &gatedTimeSlotService{MockTimeSlotService: mock}
+	cache := NewCachedTimeSlotService(service, time.Minute, time.Second)
+
+	theaterID := uuid.New()
+	roomID := uuid.New()
+	timeSlotID := uuid.New()
+	otherTimeSlotID := uuid.New()
+	mock.AddValidTimeSlot(theaterID, roomID, timeSlotID)
+
+	lookup := func() {
+		_, err := cache.ValidateTimeSlotExists(ctx, theaterID, roomID, timeSlotID)
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name         string
+		notification notify.Notification
+		fetched      bool
+	}{
+		{
+			name:         "time-slot-invalidated",
+			notification: notify.Notification{Type: models.ChangeSporedTimeSlotInvalidated, TimeSlotID: &timeSlotID},
+			fetched:      true,
+		},
+		{
+			name:         "other-time-slot-invalidated",
+			notification: notify.Notification{Type: models.ChangeSporedTimeSlotInvalidated, TimeSlotID: &otherTimeSlotID},
+		},
+		{
+			name:         "room-invalidated",
+			notification: notify.Notification{Type: models.ChangeSporedRoomInvalidated, TheaterID: &theaterID, RoomID: &roomID},
+			fetched:      true,
+		},
+		{
+			name:         "purged",
+			notification: notify.Notification{Type: models.ChangeSporedCachePurged},
+			fetched:      true,
+		},
+		{
+			name:         "resync",
+			notification: notify.Notification{Type: notify.TypeResync},
+			fetched:      true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			lookup()
+			calls := service.validationCalls.Load()
+
+			cache.apply(testCase.notification)
+			lookup()
+
+			assert.Equal(t, testCase.fetched, service.validationCalls.Load() > calls)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/clients/spored/client/rooms"
+	"github.com/PRPO-skupina-02/nakup/clients/spored/client/theaters"
+	"github.com/PRPO-skupina-02/nakup/clients/spored/client/timeslots"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+const sporedListPageSize = 100
+
+// TimeSlotLocator finds the theater and room of time slots that are only known
+// by their ID.
+type TimeSlotLocator interface {
+	LocateTimeSlots(ctx context.Context, timeSlotIDs []uuid.UUID) (map[uuid.UUID]TimeSlotRef, error)
+}
+
+// LocateTimeSlots walks the rooms and time slots of every theater until all
+// time slots are found. It is slow and meant for one-off jobs such as
+// backfilling old reservations. Time slots that spored does not know are left
+// out of the result.
+func (v *SporedTimeSlotService) LocateTimeSlots(ctx context.Context, timeSlotIDs []uuid.UUID) (map[uuid.UUID]TimeSlotRef, error) {
+	wanted := map[uuid.UUID]bool{}
+	for _, timeSlotID := range timeSlotIDs {
+		wanted[timeSlotID] = true
+	}
+
+	located := map[uuid.UUID]TimeSlotRef{}
+	if len(wanted) == 0 {
+		return located, nil
+	}
+
+	theaterIDs, err := listSporedIDs(ctx, v, "theaters", func(ctx context.Context, limit, offset int64) ([]string, int64, error) {
+		params := theaters.NewTheatersListParams().WithContext(ctx).WithLimit(&limit).WithOffset(&offset)
+		resp, err := v.theaterClient.TheatersList(params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		ids := []string{}
+		for _, theater := range resp.Payload.Data {
+			ids = append(ids, theater.ID)
+		}
+		return ids, resp.Payload.Total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, theaterID := range theaterIDs {
+		roomIDs, err := listSporedIDs(ctx, v, "rooms", func(ctx context.Context, limit, offset int64) ([]string, int64, error) {
+			params := rooms.NewRoomsListParams().WithContext(ctx).WithTheaterID(strfmt.UUID(theaterID.String())).WithLimit(&limit).WithOffset(&offset)
+			resp, err := v.roomClient.RoomsList(params)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			ids := []string{}
+			for _, room := range resp.Payload.Data {
+				ids = append(ids, room.ID)
+			}
+			return ids, resp.Payload.Total, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, roomID := range roomIDs {
+			ids, err := listSporedIDs(ctx, v, "time slots", func(ctx context.Context, limit, offset int64) ([]string, int64, error) {
+				params := timeslots.NewTimeSlotsListParams().WithContext(ctx).WithTheaterID(strfmt.UUID(theaterID.String())).WithRoomID(strfmt.UUID(roomID.String())).WithLimit(&limit).WithOffset(&offset)
+				resp, err := v.timeslotClient.TimeSlotsList(params)
+				if err != nil {
+					return nil, 0, err
+				}
+
+				ids := []string{}
+				for _, timeSlot := range resp.Payload.Data {
+					ids = append(ids, timeSlot.ID)
+				}
+				return ids, resp.Payload.Total, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, timeSlotID := range ids {
+				if wanted[timeSlotID] {
+					located[timeSlotID] = TimeSlotRef{TimeSlotID: timeSlotID, TheaterID: theaterID, RoomID: roomID}
+				}
+			}
+
+			if len(located) == len(wanted) {
+				return located, nil
+			}
+		}
+	}
+
+	return located, nil
+}
+
+type sporedPage struct {
+	ids   []string
+	total int64
+}
+
+// listSporedIDs fetches every page of a spored listing and returns the IDs of
+// the listed entities.
+func listSporedIDs(ctx context.Context, s *SporedTimeSlotService, name string, fetch func(ctx context.Context, limit, offset int64) ([]string, int64, error)) ([]uuid.UUID, error) {
+	ids := []uuid.UUID{}
+
+	for offset := int64(0); ; {
+		page, err := callSpored(ctx, s, name, middleware.NewNamedNotFoundError(name), func(ctx context.Context) (sporedPage, error) {
+			ids, total, err := fetch(ctx, sporedListPageSize, offset)
+			return sporedPage{ids: ids, total: total}, err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, value := range page.ids {
+			id, err := uuid.Parse(value)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+
+		offset += int64(len(page.ids))
+		if len(page.ids) == 0 || offset >= page.total {
+			return ids, nil
+		}
+	}
+}
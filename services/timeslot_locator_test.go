@@ -0,0 +1,53 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/nakup/clients/spored/sporedtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSporedTimeSlotServiceLocateTimeSlots(t *testing.T) {
+	service, server, ref := newFakeSporedService(t)
+
+	otherRef := RoomRef{TheaterID: uuid.New(), RoomID: uuid.New()}
+	server.AddRoom(sporedtest.Room{ID: otherRef.RoomID, TheaterID: otherRef.TheaterID, Name: "Dvorana 2", Rows: 8, Columns: 12})
+
+	startTime := time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC)
+	addTimeSlot := func(roomID uuid.UUID) uuid.UUID {
+		timeSlotID := uuid.New()
+		server.AddTimeSlot(sporedtest.TimeSlot{
+			ID:        timeSlotID,
+			RoomID:    roomID,
+			MovieID:   uuid.New(),
+			StartTime: startTime,
+			EndTime:   startTime.Add(2 * time.Hour),
+		})
+		return timeSlotID
+	}
+
+	first := addTimeSlot(ref.RoomID)
+	second := addTimeSlot(otherRef.RoomID)
+	addTimeSlot(otherRef.RoomID)
+	unknown := uuid.New()
+
+	located, err := service.LocateTimeSlots(t.Context(), []uuid.UUID{first, second, unknown})
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]TimeSlotRef{
+		first:  {TimeSlotID: first, TheaterID: ref.TheaterID, RoomID: ref.RoomID},
+		second: {TimeSlotID: second, TheaterID: otherRef.TheaterID, RoomID: otherRef.RoomID},
+	}, located)
+
+	located, err = service.LocateTimeSlots(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, located)
+
+	server.Fail(http.StatusInternalServerError)
+	_, err = service.LocateTimeSlots(t.Context(), []uuid.UUID{first})
+	var unavailable *UnavailableError
+	assert.ErrorAs(t, err, &unavailable)
+}
@@ -1,59 +1,214 @@
 package services
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"net/http"
+	"time"
 
 	"github.com/PRPO-skupina-02/common/middleware"
 	"github.com/PRPO-skupina-02/nakup/clients/spored/client"
+	"github.com/PRPO-skupina-02/nakup/clients/spored/client/movies"
 	"github.com/PRPO-skupina-02/nakup/clients/spored/client/rooms"
+	"github.com/PRPO-skupina-02/nakup/clients/spored/client/theaters"
 	"github.com/PRPO-skupina-02/nakup/clients/spored/client/timeslots"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 )
 
+const (
+	DefaultSporedTimeout          = 2 * time.Second
+	DefaultSporedMaxRetries       = 2
+	DefaultSporedRetryBackoff     = 100 * time.Millisecond
+	DefaultSporedBreakerThreshold = 5
+	DefaultSporedBreakerCooldown  = 30 * time.Second
+)
+
 type TimeSlotInfo struct {
 	TimeSlotID uuid.UUID
 	RoomID     uuid.UUID
 	TheaterID  uuid.UUID
 	Rows       int
 	Columns    int
+	StartTime  time.Time
+}
+
+type TimeSlotDetails struct {
+	TimeSlotID uuid.UUID
+	RoomID     uuid.UUID
+	TheaterID  uuid.UUID
+	MovieID    uuid.UUID
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+type RoomInfo struct {
+	RoomID    uuid.UUID
+	TheaterID uuid.UUID
+	Name      string
+	Rows      int
+	Columns   int
+}
+
+type MovieInfo struct {
+	MovieID       uuid.UUID
+	Name          string
+	LengthMinutes int
 }
 
 type TimeSlotService interface {
-	ValidateTimeSlotExists(theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotInfo, error)
+	ValidateTimeSlotExists(ctx context.Context, theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotInfo, error)
+	GetTimeSlot(ctx context.Context, theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotDetails, error)
+	GetRoom(ctx context.Context, theaterID, roomID uuid.UUID) (*RoomInfo, error)
+	GetMovie(ctx context.Context, movieID uuid.UUID) (*MovieInfo, error)
+}
+
+// UnavailableError is returned when spored could not be reached, kept failing
+// or the circuit breaker in front of it is open. It is reported to clients as
+// 503 Service Unavailable.
+type UnavailableError struct {
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *UnavailableError) Error() string {
+	if e.Err == nil {
+		return "spored unavailable"
+	}
+	return fmt.Sprintf("spored unavailable: %s", e.Err)
+}
+
+func (e *UnavailableError) Unwrap() []error {
+	httpError := &middleware.HttpError{
+		Code:    http.StatusServiceUnavailable,
+		Message: "spored unavailable",
+	}
+	if e.Err == nil {
+		return []error{httpError}
+	}
+	return []error{httpError, e.Err}
 }
 
+// SporedTimeSlotService looks entities up in spored. Every request is bounded
+// by Timeout, failed requests are retried up to MaxRetries times with
+// exponential backoff (all lookups are idempotent GETs) and Breaker stops
+// calling spored while it keeps failing.
 type SporedTimeSlotService struct {
+	Timeout      time.Duration
+	MaxRetries   int
+	RetryBackoff time.Duration
+	Breaker      *CircuitBreaker
+
+	theaterClient  theaters.ClientService
 	timeslotClient timeslots.ClientService
 	roomClient     rooms.ClientService
+	movieClient    movies.ClientService
 }
 
-func NewSporedTimeSlotService(client *client.Spored) TimeSlotService {
+func NewSporedTimeSlotService(client *client.Spored) *SporedTimeSlotService {
 	return &SporedTimeSlotService{
+		Timeout:        DefaultSporedTimeout,
+		MaxRetries:     DefaultSporedMaxRetries,
+		RetryBackoff:   DefaultSporedRetryBackoff,
+		Breaker:        NewCircuitBreaker(DefaultSporedBreakerThreshold, DefaultSporedBreakerCooldown),
+		theaterClient:  client.Theaters,
 		timeslotClient: client.Timeslots,
 		roomClient:     client.Rooms,
+		movieClient:    client.Movies,
 	}
 }
 
-func (v *SporedTimeSlotService) ValidateTimeSlotExists(theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotInfo, error) {
+// sporedResponse is implemented by the responses of the generated spored
+// client, including unexpected ones.
+type sporedResponse interface {
+	IsClientError() bool
+	IsCode(code int) bool
+}
+
+// callSpored runs fetch with retries and returns notFound when spored responds
+// with 404. Client errors are returned as they are, everything else is turned
+// into an UnavailableError once the retries run out.
+func callSpored[T any](ctx context.Context, s *SporedTimeSlotService, name string, notFound error, fetch func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+
+	allowed, retryAfter := s.Breaker.Allow()
+	if !allowed {
+		return zero, &UnavailableError{RetryAfter: retryAfter}
+	}
+
+	var err error
+	for attempt := 0; attempt <= s.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				s.Breaker.Release()
+				return zero, ctx.Err()
+			case <-time.After(s.RetryBackoff << (attempt - 1)):
+			}
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, s.Timeout)
+		var value T
+		value, err = fetch(callCtx)
+		cancel()
+
+		if err == nil {
+			s.Breaker.Success()
+			return value, nil
+		}
+
+		var response sporedResponse
+		if errors.As(err, &response) && response.IsClientError() {
+			s.Breaker.Success()
+			if response.IsCode(http.StatusNotFound) {
+				return zero, notFound
+			}
+			slog.Error("spored rejected request", "entity", name, "err", err)
+			return zero, err
+		}
+
+		if ctx.Err() != nil {
+			s.Breaker.Release()
+			return zero, ctx.Err()
+		}
+
+		slog.Warn("failed to fetch from spored", "entity", name, "attempt", attempt+1, "err", err)
+	}
+
+	s.Breaker.Failure()
+	slog.Error("spored unavailable", "entity", name, "err", err)
+	return zero, &UnavailableError{RetryAfter: s.Breaker.Cooldown, Err: err}
+}
+
+func (v *SporedTimeSlotService) ValidateTimeSlotExists(ctx context.Context, theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotInfo, error) {
 	params := timeslots.NewTimeSlotsShowParams()
 	params.TheaterID = strfmt.UUID(theaterID.String())
 	params.RoomID = strfmt.UUID(roomID.String())
 	params.TimeSlotID = strfmt.UUID(timeSlotID.String())
 
-	_, err := v.timeslotClient.TimeSlotsShow(params)
+	resp, err := callSpored(ctx, v, "time slot", middleware.NewNotFoundError(), func(ctx context.Context) (*timeslots.TimeSlotsShowOK, error) {
+		return v.timeslotClient.TimeSlotsShow(params.WithContext(ctx))
+	})
 	if err != nil {
-		slog.Error("failed to fetch timeslot", "err", err)
-		return nil, middleware.NewNotFoundError()
+		return nil, err
+	}
+
+	startTime, err := time.Parse(time.RFC3339, resp.Payload.StartTime)
+	if err != nil {
+		return nil, err
 	}
 
 	roomParams := rooms.NewRoomsShowParams()
 	roomParams.TheaterID = strfmt.UUID(theaterID.String())
 	roomParams.RoomID = strfmt.UUID(roomID.String())
 
-	roomResp, err := v.roomClient.RoomsShow(roomParams)
+	roomResp, err := callSpored(ctx, v, "room", middleware.NewNotFoundError(), func(ctx context.Context) (*rooms.RoomsShowOK, error) {
+		return v.roomClient.RoomsShow(roomParams.WithContext(ctx))
+	})
 	if err != nil {
-		return nil, middleware.NewNotFoundError()
+		return nil, err
 	}
 
 	return &TimeSlotInfo{
@@ -62,5 +217,83 @@ func (v *SporedTimeSlotService) ValidateTimeSlotExists(theaterID, roomID, timeSl
 		TheaterID:  theaterID,
 		Rows:       int(roomResp.Payload.Rows),
 		Columns:    int(roomResp.Payload.Columns),
+		StartTime:  startTime,
+	}, nil
+}
+
+func (v *SporedTimeSlotService) GetTimeSlot(ctx context.Context, theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotDetails, error) {
+	params := timeslots.NewTimeSlotsShowParams()
+	params.TheaterID = strfmt.UUID(theaterID.String())
+	params.RoomID = strfmt.UUID(roomID.String())
+	params.TimeSlotID = strfmt.UUID(timeSlotID.String())
+
+	resp, err := callSpored(ctx, v, "time slot", middleware.NewNamedNotFoundError("time slot"), func(ctx context.Context) (*timeslots.TimeSlotsShowOK, error) {
+		return v.timeslotClient.TimeSlotsShow(params.WithContext(ctx))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	movieID, err := uuid.Parse(resp.Payload.MovieID)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, err := time.Parse(time.RFC3339, resp.Payload.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, err := time.Parse(time.RFC3339, resp.Payload.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimeSlotDetails{
+		TimeSlotID: timeSlotID,
+		RoomID:     roomID,
+		TheaterID:  theaterID,
+		MovieID:    movieID,
+		StartTime:  startTime,
+		EndTime:    endTime,
+	}, nil
+}
+
+func (v *SporedTimeSlotService) GetRoom(ctx context.Context, theaterID, roomID uuid.UUID) (*RoomInfo, error) {
+	params := rooms.NewRoomsShowParams()
+	params.TheaterID = strfmt.UUID(theaterID.String())
+	params.RoomID = strfmt.UUID(roomID.String())
+
+	resp, err := callSpored(ctx, v, "room", middleware.NewNamedNotFoundError("room"), func(ctx context.Context) (*rooms.RoomsShowOK, error) {
+		return v.roomClient.RoomsShow(params.WithContext(ctx))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RoomInfo{
+		RoomID:    roomID,
+		TheaterID: theaterID,
+		Name:      resp.Payload.Name,
+		Rows:      int(resp.Payload.Rows),
+		Columns:   int(resp.Payload.Columns),
+	}, nil
+}
+
+func (v *SporedTimeSlotService) GetMovie(ctx context.Context, movieID uuid.UUID) (*MovieInfo, error) {
+	params := movies.NewMoviesShowParams()
+	params.MovieID = strfmt.UUID(movieID.String())
+
+	resp, err := callSpored(ctx, v, "movie", middleware.NewNamedNotFoundError("movie"), func(ctx context.Context) (*movies.MoviesShowOK, error) {
+		return v.movieClient.MoviesShow(params.WithContext(ctx))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MovieInfo{
+		MovieID:       movieID,
+		Name:          resp.Payload.Name,
+		LengthMinutes: int(resp.Payload.LengthMinutes),
 	}, nil
 }
@@ -1,19 +1,26 @@
 package services
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/PRPO-skupina-02/common/middleware"
 	"github.com/google/uuid"
 )
 
 type MockTimeSlotInfo struct {
-	Rows    int
-	Columns int
+	Rows      int
+	Columns   int
+	MovieID   uuid.UUID
+	StartTime time.Time
+	EndTime   time.Time
 }
 
 type MockTimeSlotService struct {
 	ValidTimeSlots map[string]MockTimeSlotInfo
+	Rooms          map[string]RoomInfo
+	Movies         map[uuid.UUID]MovieInfo
 	ShouldError    bool
 	Error          error
 }
@@ -21,6 +28,8 @@ type MockTimeSlotService struct {
 func NewMockTimeSlotService() *MockTimeSlotService {
 	return &MockTimeSlotService{
 		ValidTimeSlots: make(map[string]MockTimeSlotInfo),
+		Rooms:          make(map[string]RoomInfo),
+		Movies:         make(map[uuid.UUID]MovieInfo),
 		ShouldError:    false,
 	}
 }
@@ -35,18 +44,64 @@ func (m *MockTimeSlotService) AddValidTimeSlotWithRoom(theaterID, roomID, timeSl
 		Rows:    rows,
 		Columns: columns,
 	}
+	m.AddRoom(theaterID, roomID, "", rows, columns)
+}
+
+func (m *MockTimeSlotService) AddRoom(theaterID, roomID uuid.UUID, name string, rows, columns int) {
+	m.Rooms[m.makeRoomKey(theaterID, roomID)] = RoomInfo{
+		RoomID:    roomID,
+		TheaterID: theaterID,
+		Name:      name,
+		Rows:      rows,
+		Columns:   columns,
+	}
+}
+
+func (m *MockTimeSlotService) SetTimeSlotSchedule(theaterID, roomID, timeSlotID, movieID uuid.UUID, startTime time.Time) {
+	key := m.makeKey(theaterID, roomID, timeSlotID)
+	info, exists := m.ValidTimeSlots[key]
+	if !exists {
+		info = MockTimeSlotInfo{
+			Rows:    10,
+			Columns: 10,
+		}
+		if _, exists := m.Rooms[m.makeRoomKey(theaterID, roomID)]; !exists {
+			m.AddRoom(theaterID, roomID, "", info.Rows, info.Columns)
+		}
+	}
+
+	info.MovieID = movieID
+	info.StartTime = startTime
+	info.EndTime = startTime.Add(2 * time.Hour)
+	m.ValidTimeSlots[key] = info
+}
+
+func (m *MockTimeSlotService) AddMovie(movieID uuid.UUID, name string, lengthMinutes int) {
+	m.Movies[movieID] = MovieInfo{
+		MovieID:       movieID,
+		Name:          name,
+		LengthMinutes: lengthMinutes,
+	}
 }
 
 func (m *MockTimeSlotService) makeKey(theaterID, roomID, timeSlotID uuid.UUID) string {
 	return theaterID.String() + "|" + roomID.String() + "|" + timeSlotID.String()
 }
 
-func (m *MockTimeSlotService) ValidateTimeSlotExists(theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotInfo, error) {
+func (m *MockTimeSlotService) makeRoomKey(theaterID, roomID uuid.UUID) string {
+	return theaterID.String() + "|" + roomID.String()
+}
+
+func (m *MockTimeSlotService) mockError() error {
+	if m.Error != nil {
+		return m.Error
+	}
+	return errors.New("mock error")
+}
+
+func (m *MockTimeSlotService) ValidateTimeSlotExists(ctx context.Context, theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotInfo, error) {
 	if m.ShouldError {
-		if m.Error != nil {
-			return nil, m.Error
-		}
-		return nil, errors.New("mock error")
+		return nil, m.mockError()
 	}
 
 	key := m.makeKey(theaterID, roomID, timeSlotID)
@@ -61,5 +116,53 @@ func (m *MockTimeSlotService) ValidateTimeSlotExists(theaterID, roomID, timeSlot
 		TheaterID:  theaterID,
 		Rows:       info.Rows,
 		Columns:    info.Columns,
+		StartTime:  info.StartTime,
 	}, nil
 }
+
+func (m *MockTimeSlotService) GetTimeSlot(ctx context.Context, theaterID, roomID, timeSlotID uuid.UUID) (*TimeSlotDetails, error) {
+	if m.ShouldError {
+		return nil, m.mockError()
+	}
+
+	key := m.makeKey(theaterID, roomID, timeSlotID)
+	info, exists := m.ValidTimeSlots[key]
+	if !exists {
+		return nil, middleware.NewNamedNotFoundError("time slot")
+	}
+
+	return &TimeSlotDetails{
+		TimeSlotID: timeSlotID,
+		RoomID:     roomID,
+		TheaterID:  theaterID,
+		MovieID:    info.MovieID,
+		StartTime:  info.StartTime,
+		EndTime:    info.EndTime,
+	}, nil
+}
+
+func (m *MockTimeSlotService) GetRoom(ctx context.Context, theaterID, roomID uuid.UUID) (*RoomInfo, error) {
+	if m.ShouldError {
+		return nil, m.mockError()
+	}
+
+	room, exists := m.Rooms[m.makeRoomKey(theaterID, roomID)]
+	if !exists {
+		return nil, middleware.NewNamedNotFoundError("room")
+	}
+
+	return &room, nil
+}
+
+func (m *MockTimeSlotService) GetMovie(ctx context.Context, movieID uuid.UUID) (*MovieInfo, error) {
+	if m.ShouldError {
+		return nil, m.mockError()
+	}
+
+	movie, exists := m.Movies[movieID]
+	if !exists {
+		return nil, middleware.NewNamedNotFoundError("movie")
+	}
+
+	return &movie, nil
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/middleware"
+	"github.com/PRPO-skupina-02/nakup/clients/spored/sporedtest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeSporedService(t *testing.T) (*SporedTimeSlotService, *sporedtest.Server, RoomRef) {
+	server := sporedtest.NewServer(t)
+	ref := RoomRef{TheaterID: uuid.New(), RoomID: uuid.New()}
+	server.AddRoom(sporedtest.Room{ID: ref.RoomID, TheaterID: ref.TheaterID, Name: "Dvorana 1", Rows: 10, Columns: 15})
+
+	service := NewSporedTimeSlotService(server.Client())
+	service.RetryBackoff = time.Millisecond
+
+	return service, server, ref
+}
+
+func TestSporedTimeSlotServiceGetRoom(t *testing.T) {
+	tests := []struct {
+		name        string
+		fail        func(server *sporedtest.Server)
+		missing     bool
+		requests    int
+		err         error
+		unavailable bool
+	}{
+		{
+			name:     "ok",
+			requests: 1,
+		},
+		{
+			name:     "not-found",
+			missing:  true,
+			requests: 1,
+			err:      middleware.NewNamedNotFoundError("room"),
+		},
+		{
+			name: "retried",
+			fail: func(server *sporedtest.Server) {
+				server.FailNext(http.StatusInternalServerError, 2)
+			},
+			requests: 3,
+		},
+		{
+			name: "unavailable",
+			fail: func(server *sporedtest.Server) {
+				server.Fail(http.StatusBadGateway)
+			},
+			requests:    3,
+			unavailable: true,
+		},
+		{
+			name: "bad-request",
+			fail: func(server *sporedtest.Server) {
+				server.FailNext(http.StatusBadRequest, 1)
+			},
+			requests: 1,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			service, server, ref := newFakeSporedService(t)
+			if testCase.missing {
+				server.RemoveRoom(ref.RoomID)
+			}
+			if testCase.fail != nil {
+				testCase.fail(server)
+			}
+
+			room, err := service.GetRoom(t.Context(), ref.TheaterID, ref.RoomID)
+
+			assert.Equal(t, testCase.requests, server.Requests())
+
+			var unavailable *UnavailableError
+			assert.Equal(t, testCase.unavailable, errors.As(err, &unavailable))
+
+			switch {
+			case testCase.err != nil:
+				assert.Equal(t, testCase.err, err)
+			case testCase.unavailable:
+				var httpError *middleware.HttpError
+				require.ErrorAs(t, err, &httpError)
+				assert.Equal(t, http.StatusServiceUnavailable, httpError.Code)
+				assert.Equal(t, DefaultSporedBreakerCooldown, unavailable.RetryAfter)
+			case testCase.name == "bad-request":
+				assert.Error(t, err)
+				assert.False(t, isNotFound(err))
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, "Dvorana 1", room.Name)
+				assert.Equal(t, 15, room.Columns)
+			}
+		})
+	}
+}
+
+func TestSporedTimeSlotServiceTimeout(t *testing.T) {
+	service, server, ref := newFakeSporedService(t)
+	server.SetDelay(200 * time.Millisecond)
+
+	service.Timeout = 20 * time.Millisecond
+	service.MaxRetries = 0
+
+	_, err := service.GetRoom(t.Context(), ref.TheaterID, ref.RoomID)
+
+	var unavailable *UnavailableError
+	assert.ErrorAs(t, err, &unavailable)
+}
+
+func TestSporedTimeSlotServiceCircuitBreaker(t *testing.T) {
+	service, server, ref := newFakeSporedService(t)
+	server.FailNext(http.StatusInternalServerError, 2)
+
+	service.MaxRetries = 0
+	service.Breaker = NewCircuitBreaker(2, time.Minute)
+
+	for range 2 {
+		_, err := service.GetRoom(t.Context(), ref.TheaterID, ref.RoomID)
+		assert.Error(t, err)
+	}
+	assert.Equal(t, CircuitOpen, service.Breaker.State())
+
+	_, err := service.GetRoom(t.Context(), ref.TheaterID, ref.RoomID)
+
+	var unavailable *UnavailableError
+	require.ErrorAs(t, err, &unavailable)
+	assert.Equal(t, 2, server.Requests(), "spored is not called while the circuit is open")
+	assert.LessOrEqual(t, unavailable.RetryAfter, time.Minute)
+	assert.Greater(t, unavailable.RetryAfter, 59*time.Second)
+}
+
+func TestSporedTimeSlotServiceValidateTimeSlotExists(t *testing.T) {
+	service, server, ref := newFakeSporedService(t)
+
+	timeSlotID := uuid.New()
+	server.AddTimeSlot(sporedtest.TimeSlot{
+		ID:        timeSlotID,
+		RoomID:    ref.RoomID,
+		MovieID:   uuid.New(),
+		StartTime: time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 12, 5, 21, 0, 0, 0, time.UTC),
+	})
+
+	info, err := service.ValidateTimeSlotExists(t.Context(), ref.TheaterID, ref.RoomID, timeSlotID)
+	require.NoError(t, err)
+	assert.Equal(t, 10, info.Rows)
+	assert.Equal(t, 15, info.Columns)
+	assert.Equal(t, time.Date(2025, 12, 5, 18, 0, 0, 0, time.UTC), info.StartTime.UTC())
+
+	_, err = service.ValidateTimeSlotExists(t.Context(), ref.TheaterID, ref.RoomID, uuid.New())
+	assert.Equal(t, middleware.NewNotFoundError(), err)
+
+	server.Fail(http.StatusInternalServerError)
+	_, err = service.ValidateTimeSlotExists(t.Context(), ref.TheaterID, ref.RoomID, timeSlotID)
+	var unavailable *UnavailableError
+	assert.ErrorAs(t, err, &unavailable, "spored being down is not reported as not found")
+}
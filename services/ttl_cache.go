@@ -0,0 +1,191 @@
+package services
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+type ttlCacheEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+	// index is the position of the entry in the expiry heap.
+	index int
+}
+
+// ttlCacheExpiry is a heap of entries ordered by when they expire, so the
+// entry closest to expiring is always first.
+type ttlCacheExpiry[K comparable, V any] []*ttlCacheEntry[K, V]
+
+func (h ttlCacheExpiry[K, V]) Len() int { return len(h) }
+
+func (h ttlCacheExpiry[K, V]) Less(i, j int) bool {
+	return h[i].expiresAt.Before(h[j].expiresAt)
+}
+
+func (h ttlCacheExpiry[K, V]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *ttlCacheExpiry[K, V]) Push(x any) {
+	entry := x.(*ttlCacheEntry[K, V])
+	entry.index = len(*h)
+	*h = append(*h, entry)
+}
+
+func (h *ttlCacheExpiry[K, V]) Pop() any {
+	old := *h
+	entry := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return entry
+}
+
+// ttlCache keeps values until their time to live passes. Expired entries are
+// dropped when they are read and whenever the cache is full. A full cache makes
+// room for new entries by evicting the ones closest to expiring.
+type ttlCache[K comparable, V any] struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+	entries    map[K]*ttlCacheEntry[K, V]
+	expiry     ttlCacheExpiry[K, V]
+}
+
+func newTTLCache[K comparable, V any](ttl time.Duration, maxEntries int) *ttlCache[K, V] {
+	return &ttlCache[K, V]{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[K]*ttlCacheEntry[K, V]),
+	}
+}
+
+func (c *ttlCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	if c.now().After(entry.expiresAt) {
+		c.remove(entry)
+		var zero V
+		return zero, false
+	}
+
+	return entry.value, true
+}
+
+func (c *ttlCache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.ttl)
+}
+
+func (c *ttlCache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+
+	if entry, ok := c.entries[key]; ok {
+		entry.value = value
+		entry.expiresAt = expiresAt
+		heap.Fix(&c.expiry, entry.index)
+		return
+	}
+
+	if len(c.entries) >= c.maxEntries {
+		c.makeRoom()
+	}
+
+	entry := &ttlCacheEntry[K, V]{
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+	}
+	c.entries[key] = entry
+	heap.Push(&c.expiry, entry)
+}
+
+// makeRoom drops the expired entries, or the entry closest to expiring when
+// none have expired. The lock must be held.
+func (c *ttlCache[K, V]) makeRoom() {
+	c.dropExpired()
+	if len(c.entries) < c.maxEntries {
+		return
+	}
+
+	c.remove(c.expiry[0])
+}
+
+// dropExpired drops entries from the front of the expiry heap until the first
+// one has not expired. The lock must be held.
+func (c *ttlCache[K, V]) dropExpired() {
+	now := c.now()
+	for len(c.expiry) > 0 && now.After(c.expiry[0].expiresAt) {
+		c.remove(c.expiry[0])
+	}
+}
+
+// remove drops the entry from the map and the expiry heap. The lock must be
+// held.
+func (c *ttlCache[K, V]) remove(entry *ttlCacheEntry[K, V]) {
+	delete(c.entries, entry.key)
+	heap.Remove(&c.expiry, entry.index)
+}
+
+func (c *ttlCache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[key]; ok {
+		c.remove(entry)
+	}
+}
+
+// DeleteFunc removes every entry whose key matches.
+func (c *ttlCache[K, V]) DeleteFunc(match func(K) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	kept := c.expiry[:0]
+	for _, entry := range c.expiry {
+		if match(entry.key) {
+			delete(c.entries, entry.key)
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	clear(c.expiry[len(kept):])
+	c.expiry = kept
+
+	for i, entry := range c.expiry {
+		entry.index = i
+	}
+	heap.Init(&c.expiry)
+}
+
+func (c *ttlCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+	c.expiry = nil
+}
+
+// Len returns the number of entries that have not expired yet and drops the
+// ones that have.
+func (c *ttlCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.dropExpired()
+	return len(c.entries)
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTLCacheMaxEntries(t *testing.T) {
+	now := time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC)
+	cache := newTTLCache[string, int](time.Minute, 2)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("a", 1)
+	now = now.Add(time.Second)
+	cache.Set("b", 2)
+	now = now.Add(time.Second)
+
+	// Replacing an entry does not evict anything.
+	cache.Set("b", 3)
+	assert.Equal(t, 2, cache.Len())
+
+	// The entry closest to expiring makes room.
+	now = now.Add(time.Second)
+	cache.Set("c", 4)
+	assert.Equal(t, 2, cache.Len())
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+	value, ok := cache.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+
+	// Expired entries are dropped before anything else is evicted.
+	cache.SetWithTTL("d", 5, time.Second)
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	now = now.Add(2 * time.Second)
+	cache.Set("e", 6)
+
+	_, ok = cache.Get("d")
+	assert.False(t, ok)
+	_, ok = cache.Get("c")
+	assert.True(t, ok)
+	_, ok = cache.Get("e")
+	assert.True(t, ok)
+}
+
+func TestTTLCacheEvictionOrder(t *testing.T) {
+	now := time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC)
+	cache := newTTLCache[string, int](time.Minute, 3)
+	cache.now = func() time.Time { return now }
+
+	for _, key := range []string{"a", "b", "c", "d"} {
+		cache.Set(key, 1)
+		now = now.Add(time.Second)
+	}
+
+	// Renewing an entry moves it to the back of the eviction order.
+	cache.Set("b", 2)
+	now = now.Add(time.Second)
+
+	// Deleted entries leave the eviction order too.
+	cache.DeleteFunc(func(key string) bool { return key == "c" })
+	cache.Set("e", 3)
+	cache.Set("f", 4)
+
+	assert.Equal(t, 3, cache.Len())
+	for key, want := range map[string]bool{"a": false, "b": true, "c": false, "d": false, "e": true, "f": true} {
+		_, ok := cache.Get(key)
+		assert.Equal(t, want, ok, key)
+	}
+}
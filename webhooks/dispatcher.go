@@ -0,0 +1,229 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultDispatchInterval = 5 * time.Second
+	DefaultMaxAttempts      = 8
+	DefaultBackoffBase      = 30 * time.Second
+	DefaultBackoffMax       = time.Hour
+	DefaultRequestTimeout   = 10 * time.Second
+	DefaultConcurrency      = 4
+
+	dispatchBatchSize = 20
+	maxErrorLength    = 512
+)
+
+var errSubscriptionInactive = errors.New("subscription is inactive")
+
+// Dispatcher sends queued webhook deliveries. The deliveries of a webhook are
+// sent one after another, while up to Concurrency webhooks are sent to at the
+// same time, so a slow receiver does not hold up the others. Failed deliveries
+// are retried with exponential backoff until MaxAttempts is reached, after
+// which they are moved to the dead-letter state and only sent again when
+// redelivered by an admin.
+type Dispatcher struct {
+	db          *gorm.DB
+	client      *http.Client
+	interval    time.Duration
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	Concurrency int
+	now         func() time.Time
+}
+
+func NewDispatcher(db *gorm.DB, client *http.Client, interval time.Duration) *Dispatcher {
+	return &Dispatcher{
+		db:          db,
+		client:      client,
+		interval:    interval,
+		MaxAttempts: DefaultMaxAttempts,
+		BackoffBase: DefaultBackoffBase,
+		BackoffMax:  DefaultBackoffMax,
+		Concurrency: DefaultConcurrency,
+		now:         time.Now,
+	}
+}
+
+// Backoff returns how long to wait after the given number of failed attempts.
+func (d *Dispatcher) Backoff(attempts int) time.Duration {
+	backoff := d.BackoffBase
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= d.BackoffMax {
+			return d.BackoffMax
+		}
+	}
+	return backoff
+}
+
+// Run sends due deliveries every interval until the context is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			sent, err := d.ProcessBatch(ctx)
+			if err != nil {
+				slog.Error("failed to dispatch webhooks", "err", err)
+				break
+			}
+			if sent < dispatchBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch sends one batch of due deliveries and returns how many were
+// attempted.
+func (d *Dispatcher) ProcessBatch(ctx context.Context) (int, error) {
+	db := d.db.WithContext(ctx)
+	now := d.now()
+
+	// The deliveries of one webhook are sent one after another, so the lease has
+	// to last until all of them could have timed out.
+	lease := time.Duration(dispatchBatchSize)*d.client.Timeout + d.interval
+	deliveries, err := models.ClaimDueWebhookDeliveries(db, now, now.Add(lease), dispatchBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptions := []models.WebhookSubscription{}
+	grouped := map[uuid.UUID][]models.WebhookDelivery{}
+	for _, delivery := range deliveries {
+		if _, ok := grouped[delivery.SubscriptionID]; !ok {
+			subscription, err := models.GetWebhookSubscription(db, delivery.SubscriptionID)
+			if err != nil {
+				return 0, err
+			}
+			subscriptions = append(subscriptions, subscription)
+		}
+		grouped[delivery.SubscriptionID] = append(grouped[delivery.SubscriptionID], delivery)
+	}
+
+	var g errgroup.Group
+	g.SetLimit(max(d.Concurrency, 1))
+
+	for _, subscription := range subscriptions {
+		g.Go(func() error {
+			for _, delivery := range grouped[subscription.ID] {
+				if err := d.deliver(ctx, db, subscription, delivery); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return 0, err
+	}
+
+	return len(deliveries), nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, db *gorm.DB, subscription models.WebhookSubscription, delivery models.WebhookDelivery) error {
+	attempt := models.WebhookDeliveryAttempt{
+		ID:         uuid.New(),
+		DeliveryID: delivery.ID,
+	}
+
+	started := d.now()
+	statusCode, sendErr := 0, errSubscriptionInactive
+	if subscription.Active {
+		statusCode, sendErr = d.send(ctx, subscription, delivery, started)
+	}
+	finished := d.now()
+
+	attempt.DurationMs = int(finished.Sub(started).Milliseconds())
+	if statusCode != 0 {
+		attempt.StatusCode = &statusCode
+	}
+	if sendErr != nil {
+		message := truncate(sendErr.Error(), maxErrorLength)
+		attempt.Error = &message
+	}
+
+	delivery.Attempts++
+	delivery.LastStatusCode = attempt.StatusCode
+	delivery.LastError = attempt.Error
+
+	switch {
+	case sendErr == nil:
+		delivery.Status = models.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &finished
+		delivery.NextAttemptAt = nil
+	case delivery.Attempts >= d.MaxAttempts || !subscription.Active:
+		slog.Warn("webhook delivery moved to dead letter", "delivery_id", delivery.ID, "subscription_id", subscription.ID, "attempts", delivery.Attempts, "err", sendErr)
+		delivery.Status = models.WebhookDeliveryDead
+		delivery.NextAttemptAt = nil
+	default:
+		nextAttemptAt := finished.Add(d.Backoff(delivery.Attempts))
+		delivery.NextAttemptAt = &nextAttemptAt
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := attempt.Create(tx); err != nil {
+			return err
+		}
+		return delivery.Save(tx)
+	})
+}
+
+func (d *Dispatcher) send(ctx context.Context, subscription models.WebhookSubscription, delivery models.WebhookDelivery, timestamp time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("receiver responded with %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func truncate(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+	return s[:length]
+}
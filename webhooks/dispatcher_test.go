@@ -0,0 +1,293 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PRPO-skupina-02/common/database"
+	"github.com/PRPO-skupina-02/nakup/db"
+	"github.com/PRPO-skupina-02/nakup/events"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var loyaltySubscriptionID = uuid.MustParse("c1a5e0d2-df46-11f0-8b1c-3d5e7f9a1b2c")
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+type testReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	status   int
+	received []receivedWebhook
+}
+
+func newTestReceiver(t *testing.T, status int) *testReceiver {
+	receiver := &testReceiver{status: status}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+		receiver.received = append(receiver.received, receivedWebhook{header: r.Header.Clone(), body: body})
+		w.WriteHeader(receiver.status)
+	}))
+	t.Cleanup(receiver.Close)
+	return receiver
+}
+
+func (r *testReceiver) Received() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedWebhook{}, r.received...)
+}
+
+// prepareDelivery points the loyalty subscription from the fixtures at url and
+// publishes a reservation.created event to it.
+func prepareDelivery(t *testing.T, db *gorm.DB, url string, occurredAt time.Time) models.WebhookDelivery {
+	subscription, err := models.GetWebhookSubscription(db, loyaltySubscriptionID)
+	require.NoError(t, err)
+	subscription.URL = url
+	require.NoError(t, subscription.Save(db))
+
+	event := events.Event{
+		ID:          uuid.New(),
+		Type:        models.EventReservationCreated,
+		AggregateID: uuid.MustParse("fb126c8c-d059-11f0-8fa4-b35f33be83b7"),
+		OccurredAt:  occurredAt,
+		Data:        json.RawMessage(`{"id":"fb126c8c-d059-11f0-8fa4-b35f33be83b7"}`),
+	}
+	require.NoError(t, NewPublisher(db).Publish(context.Background(), event))
+	// The same event published again must not be delivered twice.
+	require.NoError(t, NewPublisher(db).Publish(context.Background(), event))
+
+	var deliveries []models.WebhookDelivery
+	require.NoError(t, db.Where("event_id = ?", event.ID).Find(&deliveries).Error)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, loyaltySubscriptionID, deliveries[0].SubscriptionID)
+	return deliveries[0]
+}
+
+func newTestDispatcher(db *gorm.DB, now *time.Time) *Dispatcher {
+	dispatcher := NewDispatcher(db, &http.Client{Timeout: time.Second}, time.Second)
+	dispatcher.now = func() time.Time {
+		return *now
+	}
+	return dispatcher
+}
+
+func TestDispatcher(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	require.NoError(t, fixtures.Load())
+
+	now := time.Date(2025, 12, 10, 12, 0, 0, 0, time.UTC)
+	receiver := newTestReceiver(t, http.StatusNoContent)
+	delivery := prepareDelivery(t, db, receiver.URL, now)
+
+	dispatcher := newTestDispatcher(db, &now)
+
+	sent, err := dispatcher.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	received := receiver.Received()
+	require.Len(t, received, 1)
+	assert.Equal(t, models.EventReservationCreated, received[0].header.Get(HeaderEvent))
+	assert.Equal(t, delivery.ID.String(), received[0].header.Get(HeaderDelivery))
+	assert.NoError(t, Verify("loyalty-secret-0123456789", received[0].header.Get(HeaderTimestamp), received[0].header.Get(HeaderSignature), received[0].body, now, time.Minute))
+	assert.JSONEq(t, string(delivery.Payload), string(received[0].body))
+
+	delivery, err = models.GetWebhookDelivery(db, loyaltySubscriptionID, delivery.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliverySucceeded, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Nil(t, delivery.NextAttemptAt)
+	require.NotNil(t, delivery.LastStatusCode)
+	assert.Equal(t, http.StatusNoContent, *delivery.LastStatusCode)
+	require.NotNil(t, delivery.DeliveredAt)
+
+	attempts, err := models.GetWebhookDeliveryAttempts(db, delivery.ID)
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	assert.Nil(t, attempts[0].Error)
+
+	sent, err = dispatcher.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Len(t, receiver.Received(), 1)
+}
+
+func TestDispatcherSendsWebhooksConcurrently(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	require.NoError(t, fixtures.Load())
+
+	now := time.Date(2025, 12, 10, 12, 0, 0, 0, time.UTC)
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	slowReceived := make(chan string, 2)
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slowReceived <- r.Header.Get(HeaderDelivery)
+		started <- struct{}{}
+		<-release
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(slow.Close)
+
+	slowSubscription := models.WebhookSubscription{
+		ID:         uuid.New(),
+		URL:        slow.URL,
+		EventTypes: models.EventTypes{models.EventPurchaseCreated},
+		Secret:     "slow-secret-0123456789",
+		Active:     true,
+	}
+	require.NoError(t, slowSubscription.Create(db))
+
+	for range 2 {
+		event := events.Event{
+			ID:          uuid.New(),
+			Type:        models.EventPurchaseCreated,
+			AggregateID: uuid.New(),
+			OccurredAt:  now,
+			Data:        json.RawMessage(`{}`),
+		}
+		require.NoError(t, NewPublisher(db).Publish(context.Background(), event))
+	}
+
+	fast := newTestReceiver(t, http.StatusNoContent)
+	prepareDelivery(t, db, fast.URL, now)
+	prepareDelivery(t, db, fast.URL, now)
+
+	dispatcher := newTestDispatcher(db, &now)
+
+	type result struct {
+		sent int
+		err  error
+	}
+	done := make(chan result)
+	go func() {
+		sent, err := dispatcher.ProcessBatch(context.Background())
+		done <- result{sent, err}
+	}()
+
+	// The slow receiver holds its first delivery, while the other webhook gets
+	// all of its deliveries.
+	<-started
+	assert.Eventually(t, func() bool { return len(fast.Received()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, slowReceived, 1, "deliveries of one webhook are sent one after another")
+
+	close(release)
+	res := <-done
+	require.NoError(t, res.err)
+	assert.Equal(t, 4, res.sent)
+	assert.Len(t, slowReceived, 2)
+}
+
+func TestDispatcherRetriesUntilDead(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	require.NoError(t, fixtures.Load())
+
+	now := time.Date(2025, 12, 10, 12, 0, 0, 0, time.UTC)
+	receiver := newTestReceiver(t, http.StatusServiceUnavailable)
+	delivery := prepareDelivery(t, db, receiver.URL, now)
+
+	dispatcher := newTestDispatcher(db, &now)
+	dispatcher.MaxAttempts = 3
+
+	sent, err := dispatcher.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	delivery, err = models.GetWebhookDelivery(db, loyaltySubscriptionID, delivery.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryPending, delivery.Status)
+	require.NotNil(t, delivery.NextAttemptAt)
+	assert.True(t, now.Add(DefaultBackoffBase).Equal(*delivery.NextAttemptAt))
+
+	// Not due yet.
+	now = now.Add(DefaultBackoffBase - time.Second)
+	sent, err = dispatcher.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+
+	now = now.Add(time.Second)
+	sent, err = dispatcher.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	delivery, err = models.GetWebhookDelivery(db, loyaltySubscriptionID, delivery.ID)
+	require.NoError(t, err)
+	require.NotNil(t, delivery.NextAttemptAt)
+	assert.True(t, now.Add(2*DefaultBackoffBase).Equal(*delivery.NextAttemptAt))
+
+	now = now.Add(2 * DefaultBackoffBase)
+	sent, err = dispatcher.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	delivery, err = models.GetWebhookDelivery(db, loyaltySubscriptionID, delivery.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryDead, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Nil(t, delivery.NextAttemptAt)
+	require.NotNil(t, delivery.LastError)
+	assert.Equal(t, "receiver responded with 503 Service Unavailable", *delivery.LastError)
+
+	attempts, err := models.GetWebhookDeliveryAttempts(db, delivery.ID)
+	require.NoError(t, err)
+	assert.Len(t, attempts, 3)
+	assert.Len(t, receiver.Received(), 3)
+
+	now = now.Add(DefaultBackoffMax)
+	sent, err = dispatcher.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+}
+
+func TestDispatcherInactiveSubscription(t *testing.T) {
+	db, fixtures := database.PrepareTestDatabase(t, db.FixtureFS, db.MigrationsFS)
+	require.NoError(t, fixtures.Load())
+
+	now := time.Date(2025, 12, 10, 12, 0, 0, 0, time.UTC)
+	receiver := newTestReceiver(t, http.StatusOK)
+	delivery := prepareDelivery(t, db, receiver.URL, now)
+
+	subscription, err := models.GetWebhookSubscription(db, loyaltySubscriptionID)
+	require.NoError(t, err)
+	subscription.Active = false
+	require.NoError(t, subscription.Save(db))
+
+	sent, err := newTestDispatcher(db, &now).ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Empty(t, receiver.Received())
+
+	delivery, err = models.GetWebhookDelivery(db, loyaltySubscriptionID, delivery.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryDead, delivery.Status)
+	require.NotNil(t, delivery.LastError)
+	assert.Equal(t, errSubscriptionInactive.Error(), *delivery.LastError)
+}
+
+func TestBackoff(t *testing.T) {
+	dispatcher := NewDispatcher(nil, http.DefaultClient, time.Second)
+
+	assert.Equal(t, 30*time.Second, dispatcher.Backoff(1))
+	assert.Equal(t, time.Minute, dispatcher.Backoff(2))
+	assert.Equal(t, 4*time.Minute, dispatcher.Backoff(4))
+	assert.Equal(t, time.Hour, dispatcher.Backoff(8))
+	assert.Equal(t, time.Hour, dispatcher.Backoff(100))
+}
@@ -0,0 +1,57 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/PRPO-skupina-02/nakup/events"
+	"github.com/PRPO-skupina-02/nakup/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Publisher queues a delivery for every active subscription interested in the
+// published event. The deliveries are sent by the Dispatcher.
+type Publisher struct {
+	db *gorm.DB
+}
+
+func NewPublisher(db *gorm.DB) *Publisher {
+	return &Publisher{
+		db: db,
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, event events.Event) error {
+	tx := p.db.WithContext(ctx)
+
+	subscriptions, err := models.GetActiveWebhookSubscriptions(tx, event.Type)
+	if err != nil {
+		return err
+	}
+
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	now := event.OccurredAt
+	deliveries := make([]models.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		deliveries = append(deliveries, models.WebhookDelivery{
+			ID:             uuid.New(),
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         models.WebhookDeliveryPending,
+			NextAttemptAt:  &now,
+		})
+	}
+
+	return models.CreateWebhookDeliveries(tx, deliveries)
+}
@@ -0,0 +1,60 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderEvent     = "X-Nakup-Event"
+	HeaderDelivery  = "X-Nakup-Delivery"
+	HeaderTimestamp = "X-Nakup-Timestamp"
+	HeaderSignature = "X-Nakup-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrStaleTimestamp   = errors.New("webhook timestamp outside of tolerance")
+)
+
+// Sign returns the signature of a webhook body. The timestamp is part of the
+// signed message so that a captured request cannot be replayed later.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the timestamp and signature headers of a received webhook. It
+// is what receivers are expected to do and is used by the tests.
+func Verify(secret, timestampHeader, signatureHeader string, body []byte, now time.Time, tolerance time.Duration) error {
+	seconds, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	timestamp := time.Unix(seconds, 0)
+	if now.Sub(timestamp).Abs() > tolerance {
+		return ErrStaleTimestamp
+	}
+
+	if !strings.HasPrefix(signatureHeader, signaturePrefix) {
+		return ErrInvalidSignature
+	}
+
+	expected := Sign(secret, timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(signatureHeader)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
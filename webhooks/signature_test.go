@@ -0,0 +1,97 @@
+package webhooks
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	secret := "loyalty-secret-0123456789"
+	body := []byte(`{"id":"0f1e2d3c-df46-11f0-be4f-6a8b0c2d4e5f"}`)
+	timestamp := time.Date(2025, 12, 1, 8, 0, 0, 0, time.UTC)
+	timestampHeader := strconv.FormatInt(timestamp.Unix(), 10)
+	signature := Sign(secret, timestamp, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		signature string
+		body      []byte
+		now       time.Time
+		err       error
+	}{
+		{
+			name:      "ok",
+			secret:    secret,
+			timestamp: timestampHeader,
+			signature: signature,
+			body:      body,
+			now:       timestamp.Add(time.Minute),
+		},
+		{
+			name:      "tampered-body",
+			secret:    secret,
+			timestamp: timestampHeader,
+			signature: signature,
+			body:      []byte(`{"id":"1a2b3c4d-df46-11f0-8a5b-7c9d1e3f5a6b"}`),
+			now:       timestamp,
+			err:       ErrInvalidSignature,
+		},
+		{
+			name:      "wrong-secret",
+			secret:    "signage-secret-0123456789",
+			timestamp: timestampHeader,
+			signature: signature,
+			body:      body,
+			now:       timestamp,
+			err:       ErrInvalidSignature,
+		},
+		{
+			name:      "replayed-timestamp",
+			secret:    secret,
+			timestamp: strconv.FormatInt(timestamp.Add(time.Second).Unix(), 10),
+			signature: signature,
+			body:      body,
+			now:       timestamp,
+			err:       ErrInvalidSignature,
+		},
+		{
+			name:      "stale-timestamp",
+			secret:    secret,
+			timestamp: timestampHeader,
+			signature: signature,
+			body:      body,
+			now:       timestamp.Add(10 * time.Minute),
+			err:       ErrStaleTimestamp,
+		},
+		{
+			name:      "malformed-timestamp",
+			secret:    secret,
+			timestamp: "yesterday",
+			signature: signature,
+			body:      body,
+			now:       timestamp,
+			err:       ErrInvalidSignature,
+		},
+		{
+			name:      "missing-prefix",
+			secret:    secret,
+			timestamp: timestampHeader,
+			signature: signature[len(signaturePrefix):],
+			body:      body,
+			now:       timestamp,
+			err:       ErrInvalidSignature,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := Verify(testCase.secret, testCase.timestamp, testCase.signature, testCase.body, testCase.now, 5*time.Minute)
+			assert.Equal(t, testCase.err, err)
+		})
+	}
+}